@@ -9,9 +9,11 @@ import (
 	"github.com/lavanet/lava/app"
 	"github.com/lavanet/lava/cmd/lavad/cmd"
 	"github.com/lavanet/lava/protocol/badgegenerator"
+	"github.com/lavanet/lava/protocol/chainlib/nodefixtures"
 	"github.com/lavanet/lava/protocol/rpcconsumer"
 	"github.com/lavanet/lava/protocol/rpcprovider"
 	"github.com/lavanet/lava/protocol/statetracker"
+	speccli "github.com/lavanet/lava/x/spec/client/cli"
 	"github.com/spf13/cobra"
 )
 
@@ -45,6 +47,14 @@ func main() {
 	testCmd.AddCommand(rpcconsumer.CreateTestRPCConsumerCobraCommand())
 	testCmd.AddCommand(rpcprovider.CreateTestRPCProviderCobraCommand())
 	testCmd.AddCommand(statetracker.CreateEventsCobraCommand())
+
+	specCmd := &cobra.Command{
+		Use:   "spec",
+		Short: "Offline tools for spec proposals",
+	}
+	rootCmd.AddCommand(specCmd)
+	specCmd.AddCommand(speccli.CmdLintSpec())
+	specCmd.AddCommand(nodefixtures.CreateVerifyFixturesCobraCommand())
 	if err := svrcmd.Execute(rootCmd, "", app.DefaultNodeHome); err != nil {
 		switch e := err.(type) {
 		case server.ErrorCode:
@@ -0,0 +1,145 @@
+package chainlib
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/lavanet/lava/utils"
+)
+
+var restParamRegex = regexp.MustCompile(`{[^}]+}`)
+
+// restApiMatcher matches rest urls to the spec apis, it is compiled once when the spec is set
+// exact api names are found with a map lookup, templated names ({param}) are matched in a trie of path segments
+type restApiMatcher struct {
+	// api name to the apis by connection type
+	exact map[string]map[string]*ApiContainer
+	root  *restApiTrieNode
+}
+
+type restApiTrieNode struct {
+	literals map[string]*restApiTrieNode
+	patterns []*restApiTriePattern
+	// apis ending in this node by connection type
+	apis map[string]*ApiContainer
+}
+
+type restApiTriePattern struct {
+	segment string
+	re      *regexp.Regexp
+	node    *restApiTrieNode
+}
+
+func newRestApiTrieNode() *restApiTrieNode {
+	return &restApiTrieNode{literals: map[string]*restApiTrieNode{}, apis: map[string]*ApiContainer{}}
+}
+
+// compileRestSegment compiles a templated path segment the same way the spec api name regex is built,
+// a param matches any non empty text without slashes or whitespaces
+func compileRestSegment(segment string) (*regexp.Regexp, error) {
+	processed := restParamRegex.ReplaceAllString(segment, "replace-me-with-regex")
+	processed = regexp.QuoteMeta(processed)
+	processed = strings.ReplaceAll(processed, "replace-me-with-regex", `[^\/\s]+`)
+	return regexp.Compile("^" + processed + "$")
+}
+
+func newRestApiMatcher(serverApis map[ApiKey]ApiContainer) *restApiMatcher {
+	matcher := &restApiMatcher{exact: map[string]map[string]*ApiContainer{}, root: newRestApiTrieNode()}
+	for apiKey, apiCont := range serverApis {
+		apiCont := apiCont
+		name := apiCont.api.Name
+		if !restParamRegex.MatchString(name) {
+			if _, ok := matcher.exact[name]; !ok {
+				matcher.exact[name] = map[string]*ApiContainer{}
+			}
+			matcher.exact[name][apiKey.ConnectionType] = &apiCont
+			continue
+		}
+		node := matcher.root
+		for _, segment := range strings.Split(name, "/") {
+			if !restParamRegex.MatchString(segment) {
+				child, ok := node.literals[segment]
+				if !ok {
+					child = newRestApiTrieNode()
+					node.literals[segment] = child
+				}
+				node = child
+				continue
+			}
+			var child *restApiTrieNode
+			for _, pattern := range node.patterns {
+				if pattern.segment == segment {
+					child = pattern.node
+					break
+				}
+			}
+			if child == nil {
+				re, err := compileRestSegment(segment)
+				if err != nil {
+					utils.LavaFormatError("regex Compile api", err, utils.Attribute{Key: "apiName", Value: name})
+					node = nil
+					break
+				}
+				child = newRestApiTrieNode()
+				node.patterns = append(node.patterns, &restApiTriePattern{segment: segment, re: re, node: child})
+			}
+			node = child
+		}
+		if node != nil {
+			node.apis[apiKey.ConnectionType] = &apiCont
+		}
+	}
+	return matcher
+}
+
+// match returns the api matching the name on the connection type, literal segments are preferred over templated ones
+func (ram *restApiMatcher) match(name, connectionType string) (*ApiContainer, bool) {
+	foundNameOnDifferentConnectionType := ""
+	if exactApis, ok := ram.exact[name]; ok {
+		if apiCont, ok := exactApis[connectionType]; ok {
+			return apiCont, true
+		}
+		for otherConnectionType := range exactApis {
+			foundNameOnDifferentConnectionType = otherConnectionType
+			break
+		}
+	}
+	apiCont := ram.root.match(strings.Split(name, "/"), connectionType, &foundNameOnDifferentConnectionType)
+	if apiCont != nil {
+		return apiCont, true
+	}
+	if foundNameOnDifferentConnectionType != "" { // its hard to notice when we have an API on only one connection type.
+		utils.LavaFormatWarning("API was found on a different connection type", nil,
+			utils.Attribute{Key: "connection_type_found", Value: foundNameOnDifferentConnectionType},
+			utils.Attribute{Key: "connection_type_requested", Value: connectionType},
+		)
+	}
+	return nil, false
+}
+
+func (node *restApiTrieNode) match(segments []string, connectionType string, foundNameOnDifferentConnectionType *string) *ApiContainer {
+	if len(segments) == 0 {
+		if apiCont, ok := node.apis[connectionType]; ok {
+			return apiCont
+		}
+		for otherConnectionType := range node.apis {
+			*foundNameOnDifferentConnectionType = otherConnectionType
+			break
+		}
+		return nil
+	}
+	segment, rest := segments[0], segments[1:]
+	if child, ok := node.literals[segment]; ok {
+		if apiCont := child.match(rest, connectionType, foundNameOnDifferentConnectionType); apiCont != nil {
+			return apiCont
+		}
+	}
+	for _, pattern := range node.patterns {
+		if pattern.re.MatchString(segment) {
+			if apiCont := pattern.node.match(rest, connectionType, foundNameOnDifferentConnectionType); apiCont != nil {
+				return apiCont
+			}
+		}
+	}
+	return nil
+}
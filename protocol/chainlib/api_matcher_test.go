@@ -0,0 +1,98 @@
+package chainlib
+
+import (
+	"testing"
+
+	keepertest "github.com/lavanet/lava/testutil/keeper"
+	spectypes "github.com/lavanet/lava/x/spec/types"
+	"github.com/stretchr/testify/require"
+)
+
+// returns the rest server apis of a cookbook spec and a request for each api
+func restApiMatcherTestServerApis(t testing.TB, specIndex string) (map[ApiKey]ApiContainer, []ApiKey) {
+	spec, err := keepertest.GetASpec(specIndex, "../../", nil, nil)
+	require.NoError(t, err)
+	serverApis, _, _, _, _ := getServiceApis(spec, spectypes.APIInterfaceRest)
+	require.NotEmpty(t, serverApis)
+	requests := []ApiKey{}
+	for apiKey, apiCont := range serverApis {
+		requests = append(requests, ApiKey{Name: restParamRegex.ReplaceAllString(apiCont.api.Name, "10"), ConnectionType: apiKey.ConnectionType})
+	}
+	return serverApis, requests
+}
+
+func TestRestApiMatcherCookbookSpecs(t *testing.T) {
+	for _, specIndex := range []string{"LAV1", "COS3", "EVMOS"} {
+		t.Run(specIndex, func(t *testing.T) {
+			serverApis, requests := restApiMatcherTestServerApis(t, specIndex)
+			matcher := newRestApiMatcher(serverApis)
+			for _, request := range requests {
+				expected, expectedOk := matchSpecApiByName(request.Name, request.ConnectionType, serverApis)
+				apiCont, ok := matcher.match(request.Name, request.ConnectionType)
+				require.Equal(t, expectedOk, ok, request.Name)
+				require.True(t, ok, request.Name)
+				if expected.api.Name != apiCont.api.Name {
+					// ambiguous paths may match several templates, the one picked must match the path as well
+					re, err := compileRestSegment(apiCont.api.Name)
+					require.NoError(t, err)
+					require.True(t, re.MatchString(request.Name), "path %s matched %s", request.Name, apiCont.api.Name)
+				}
+			}
+			_, ok := matcher.match("/not/an/api", "GET")
+			require.False(t, ok)
+		})
+	}
+}
+
+func TestRestApiMatcher(t *testing.T) {
+	serverApis := map[ApiKey]ApiContainer{}
+	for _, apiName := range []string{"/blocks/{height}", "/blocks/latest", "/txs/{hash}/logs", "/txs/{hash}.json", "/accounts/{address}/balance/{denom}"} {
+		serverApis[ApiKey{Name: apiName, ConnectionType: ""}] = ApiContainer{api: &spectypes.Api{Name: apiName, Enabled: true}}
+	}
+	serverApis[ApiKey{Name: "/post/{id}", ConnectionType: "POST"}] = ApiContainer{api: &spectypes.Api{Name: "/post/{id}", Enabled: true}}
+	matcher := newRestApiMatcher(serverApis)
+
+	testTable := []struct {
+		path           string
+		connectionType string
+		expected       string
+	}{
+		{path: "/blocks/10", expected: "/blocks/{height}"},
+		{path: "/blocks/latest", expected: "/blocks/latest"},
+		{path: "/txs/0xabc/logs", expected: "/txs/{hash}/logs"},
+		{path: "/txs/0xabc.json", expected: "/txs/{hash}.json"},
+		{path: "/accounts/lava@1/balance/ulava", expected: "/accounts/{address}/balance/{denom}"},
+		{path: "/post/1", connectionType: "POST", expected: "/post/{id}"},
+		{path: "/post/1", expected: ""},
+		{path: "/blocks/", expected: ""},
+		{path: "/blocks/1 2", expected: ""},
+		{path: "/txs/0xabc/logs/extra", expected: ""},
+	}
+	for _, testCase := range testTable {
+		apiCont, ok := matcher.match(testCase.path, testCase.connectionType)
+		if testCase.expected == "" {
+			require.False(t, ok, testCase.path)
+			continue
+		}
+		require.True(t, ok, testCase.path)
+		require.Equal(t, testCase.expected, apiCont.api.Name)
+	}
+}
+
+func BenchmarkRestApiMatching(b *testing.B) {
+	serverApis, requests := restApiMatcherTestServerApis(b, "LAV1")
+	b.Run("regex", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			request := requests[i%len(requests)]
+			matchSpecApiByName(request.Name, request.ConnectionType, serverApis)
+		}
+	})
+	b.Run("trie", func(b *testing.B) {
+		matcher := newRestApiMatcher(serverApis)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			request := requests[i%len(requests)]
+			matcher.match(request.Name, request.ConnectionType)
+		}
+	})
+}
@@ -7,21 +7,65 @@ import (
 	"sync"
 
 	"github.com/lavanet/lava/protocol/chainlib/extensionslib"
+	"github.com/lavanet/lava/protocol/parser"
 	"github.com/lavanet/lava/utils"
 	pairingtypes "github.com/lavanet/lava/x/pairing/types"
 	spectypes "github.com/lavanet/lava/x/spec/types"
 )
 
 type BaseChainParser struct {
-	taggedApis      map[spectypes.FUNCTION_TAG]TaggedContainer
-	spec            spectypes.Spec
-	rwLock          sync.RWMutex
-	serverApis      map[ApiKey]ApiContainer
-	apiCollections  map[CollectionKey]*spectypes.ApiCollection
-	headers         map[ApiKey]*spectypes.Header
-	verifications   map[VerificationKey][]VerificationContainer
-	allowedAddons   map[string]struct{}
-	extensionParser extensionslib.ExtensionParser
+	taggedApis        map[spectypes.FUNCTION_TAG]TaggedContainer
+	spec              spectypes.Spec
+	rwLock            sync.RWMutex
+	serverApis        map[ApiKey]ApiContainer
+	apiCollections    map[CollectionKey]*spectypes.ApiCollection
+	headers           map[ApiKey]*spectypes.Header
+	verifications     map[VerificationKey][]VerificationContainer
+	allowedAddons     map[string]struct{}
+	extensionParser   extensionslib.ExtensionParser
+	blockHashResolver BlockHashResolver
+}
+
+func (bcp *BaseChainParser) SetBlockHashResolver(blockHashResolver BlockHashResolver) {
+	bcp.rwLock.Lock()
+	defer bcp.rwLock.Unlock()
+	bcp.blockHashResolver = blockHashResolver
+}
+
+// blockHashResolution describes a requested block hash, when it isn't resolved the requested block is the default block
+type blockHashResolution struct {
+	requested    bool
+	resolved     bool
+	defaultBlock int64
+}
+
+// parseBlockFromParams returns the block that was requested, a requested block hash is resolved to the block height when it's known to the
+// block hash resolver. an unresolved hash falls back to the default block of the block parser, or not applicable if there isn't one
+func (bcp *BaseChainParser) parseBlockFromParams(rpcInput parser.RPCInput, blockParser spectypes.BlockParser) (int64, blockHashResolution, error) {
+	if !blockParser.BlockHash {
+		block, err := parser.ParseBlockFromParams(rpcInput, blockParser)
+		return block, blockHashResolution{}, err
+	}
+	blockHash, block, err := parser.ParseBlockHashFromParams(rpcInput, blockParser)
+	if err != nil || blockHash == "" {
+		return block, blockHashResolution{}, err
+	}
+	resolution := blockHashResolution{requested: true, defaultBlock: spectypes.NOT_APPLICABLE}
+	if blockParser.DefaultValue != "" {
+		if defaultBlock, err := rpcInput.ParseBlock(blockParser.DefaultValue); err == nil {
+			resolution.defaultBlock = defaultBlock
+		}
+	}
+	bcp.rwLock.RLock()
+	blockHashResolver := bcp.blockHashResolver
+	bcp.rwLock.RUnlock()
+	if blockHashResolver != nil {
+		if block, resolved := blockHashResolver.ResolveBlockHash(blockHash); resolved {
+			resolution.resolved = true
+			return block, resolution, nil
+		}
+	}
+	return resolution.defaultBlock, resolution, nil
 }
 
 func (bcp *BaseChainParser) HandleHeaders(metadata []pairingtypes.Metadata, apiCollection *spectypes.ApiCollection, headersDirection spectypes.Header_HeaderType) (filteredHeaders []pairingtypes.Metadata, overwriteRequestedBlock string, ignoredMetadata []pairingtypes.Metadata) {
@@ -333,7 +377,7 @@ func getServiceApis(spec spectypes.Spec, rpcInterface string) (retServerApis map
 
 // matchSpecApiByName returns service api which match given name
 func matchSpecApiByName(name, connectionType string, serverApis map[ApiKey]ApiContainer) (*ApiContainer, bool) {
-	// TODO: make it faster and better by not doing a regex instead using a better algorithm
+	// parsers set with a spec use the precompiled restApiMatcher instead, this is used for parsers constructed without a spec
 	foundNameOnDifferentConnectionType := ""
 	for apiName, api := range serverApis {
 		re, err := regexp.Compile("^" + apiName.Name + "$")
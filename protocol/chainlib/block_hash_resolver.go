@@ -0,0 +1,112 @@
+package chainlib
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/dgraph-io/ristretto"
+	"github.com/lavanet/lava/protocol/parser"
+	"github.com/lavanet/lava/utils"
+	spectypes "github.com/lavanet/lava/x/spec/types"
+)
+
+const (
+	blockHashFetchTimeout          = 3 * time.Second
+	maxConcurrentBlockHashFetches  = 10
+	resolvedBlockHashesTTL         = 30 * time.Minute // a hash of a block that wasn't finalized yet can be forked out
+	resolvedBlockHashesMaxCost     = 2000             // each item cost would be 1
+	resolvedBlockHashesNumCounters = 20000            // expect 2000 items
+)
+
+// BlockHashLookup finds block hashes in already known blocks, the chain tracker on the provider and the finalized blocks reported by providers on the consumer
+type BlockHashLookup interface {
+	GetBlockNumByHash(blockHash string) (int64, bool)
+}
+
+// BlockNumByHashFetcher queries the height of a block hash, from the node on the provider and through a relay on the consumer
+type BlockNumByHashFetcher interface {
+	FetchBlockNumByHash(ctx context.Context, blockHash string) (int64, error)
+}
+
+type blockHashResolver struct {
+	ctx             context.Context
+	blockHashLookup BlockHashLookup
+	fetcher         BlockNumByHashFetcher
+	chainParser     ChainParser
+	resolvedHashes  *ristretto.Cache
+	// hashes being fetched, the fetch request is parsed by the chain parser as well so it must not trigger another fetch
+	fetching     sync.Map
+	fetchesSlots chan struct{}
+}
+
+// NewBlockHashResolver returns a resolver looking up requested block hashes in the known blocks first, and in the hashes it resolved before.
+// hashes that aren't known are fetched in the background so parsing a message never waits on a query, the fetcher is optional
+func NewBlockHashResolver(ctx context.Context, blockHashLookup BlockHashLookup, fetcher BlockNumByHashFetcher, chainParser ChainParser) BlockHashResolver {
+	resolvedHashes, err := ristretto.NewCache(&ristretto.Config{NumCounters: resolvedBlockHashesNumCounters, MaxCost: resolvedBlockHashesMaxCost, BufferItems: 64, IgnoreInternalCost: true})
+	if err != nil {
+		utils.LavaFormatFatal("failed setting up cache for resolved block hashes", err)
+	}
+	return &blockHashResolver{
+		ctx:             ctx,
+		blockHashLookup: blockHashLookup,
+		fetcher:         fetcher,
+		chainParser:     chainParser,
+		resolvedHashes:  resolvedHashes,
+		fetchesSlots:    make(chan struct{}, maxConcurrentBlockHashFetches),
+	}
+}
+
+func (bhr *blockHashResolver) ResolveBlockHash(blockHash string) (int64, bool) {
+	if bhr.blockHashLookup != nil {
+		// known blocks are saved with hashes in the encoding of the GET_BLOCK_BY_NUM result
+		trackedHash := blockHash
+		if parsing, _, ok := bhr.chainParser.GetParsingByTag(spectypes.FUNCTION_TAG_GET_BLOCK_BY_NUM); ok && parsing.ResultParsing.Encoding != "" {
+			encodedHash, err := parser.ParseBlockHashByEncoding(blockHash, parsing.ResultParsing.Encoding)
+			if err == nil {
+				trackedHash = encodedHash
+			}
+		}
+		if block, ok := bhr.blockHashLookup.GetBlockNumByHash(trackedHash); ok {
+			return block, true
+		}
+	}
+	if storedVal, found := bhr.resolvedHashes.Get(blockHash); found {
+		if block, ok := storedVal.(int64); ok {
+			return block, true
+		}
+	}
+	bhr.fetchInBackground(blockHash)
+	return spectypes.NOT_APPLICABLE, false
+}
+
+func (bhr *blockHashResolver) fetchInBackground(blockHash string) {
+	if bhr.fetcher == nil {
+		return
+	}
+	if _, loaded := bhr.fetching.LoadOrStore(blockHash, struct{}{}); loaded {
+		return
+	}
+	select {
+	case bhr.fetchesSlots <- struct{}{}:
+	default:
+		// too many hashes are being fetched, the hash will be fetched when it's requested again
+		bhr.fetching.Delete(blockHash)
+		return
+	}
+	go func() {
+		defer func() {
+			<-bhr.fetchesSlots
+			bhr.fetching.Delete(blockHash)
+		}()
+		ctx, cancel := context.WithTimeout(bhr.ctx, blockHashFetchTimeout)
+		defer cancel()
+		block, err := bhr.fetcher.FetchBlockNumByHash(ctx, blockHash)
+		if err != nil || block <= spectypes.NOT_APPLICABLE {
+			utils.LavaFormatDebug("failed fetching requested block hash", utils.Attribute{Key: "blockHash", Value: blockHash}, utils.Attribute{Key: "block", Value: block}, utils.Attribute{Key: "error", Value: err})
+			return
+		}
+		bhr.resolvedHashes.SetWithTTL(blockHash, block, 1, resolvedBlockHashesTTL)
+		bhr.resolvedHashes.Wait()
+	}()
+}
@@ -22,6 +22,12 @@ const (
 	ChainFetcherHeaderName = "X-LAVA-Provider"
 )
 
+// node subscriptions notifying on new blocks, by api interface
+var newBlocksSubscriptions = map[string]string{
+	spectypes.APIInterfaceJsonRPC:       `{"jsonrpc":"2.0","id":1,"method":"eth_subscribe","params":["newHeads"]}`,
+	spectypes.APIInterfaceTendermintRPC: `{"jsonrpc":"2.0","id":1,"method":"subscribe","params":{"query":"tm.event='NewBlock'"}}`,
+}
+
 type ChainFetcherIf interface {
 	FetchLatestBlockNum(ctx context.Context) (int64, error)
 	FetchBlockHashByNum(ctx context.Context, blockNum int64) (string, error)
@@ -153,6 +159,46 @@ func (cf *ChainFetcher) Verify(ctx context.Context, verification VerificationCon
 	return nil
 }
 
+// SubscribeNewBlocks subscribes to new block notifications on the node, this requires a websocket node url
+func (cf *ChainFetcher) SubscribeNewBlocks(ctx context.Context) (<-chan struct{}, error) {
+	subscriptionData, ok := newBlocksSubscriptions[cf.endpoint.ApiInterface]
+	if !ok {
+		return nil, utils.LavaFormatDebug("new blocks subscription not supported for api interface", utils.Attribute{Key: "apiInterface", Value: cf.endpoint.ApiInterface})
+	}
+	chainMessage, err := cf.chainParser.ParseMsg("", []byte(subscriptionData), "", cf.ChainFetcherMetadata(), 0)
+	if err != nil {
+		return nil, err
+	}
+	subscribeRepliesChan := make(chan interface{})
+	_, _, clientSub, err := cf.chainRouter.SendNodeMsg(ctx, subscribeRepliesChan, chainMessage, nil)
+	if err != nil {
+		return nil, err
+	}
+	if clientSub == nil {
+		return nil, utils.LavaFormatDebug("node didn't create a new blocks subscription", utils.Attribute{Key: "endpoint", Value: cf.endpoint.String()})
+	}
+	newBlocks := make(chan struct{}, 1)
+	go func() {
+		defer close(newBlocks)
+		for {
+			select {
+			case <-ctx.Done():
+				clientSub.Unsubscribe()
+				return
+			case <-clientSub.Err():
+				return
+			case <-subscribeRepliesChan:
+				select {
+				case newBlocks <- struct{}{}:
+				default:
+					// a notification is already pending
+				}
+			}
+		}
+	}()
+	return newBlocks, nil
+}
+
 func (cf *ChainFetcher) ChainFetcherMetadata() []pairingtypes.Metadata {
 	ret := []pairingtypes.Metadata{
 		{Name: ChainFetcherHeaderName, Value: cf.FetchEndpoint().NetworkAddress.Address},
@@ -259,6 +305,45 @@ func (cf *ChainFetcher) FetchBlockHashByNum(ctx context.Context, blockNum int64)
 	return res, nil
 }
 
+// FetchBlockNumByHash returns the height of the block with the hash, using the spec GET_BLOCK_BY_HASH function
+func (cf *ChainFetcher) FetchBlockNumByHash(ctx context.Context, blockHash string) (int64, error) {
+	parsing, collectionData, ok := cf.chainParser.GetParsingByTag(spectypes.FUNCTION_TAG_GET_BLOCK_BY_HASH)
+	tagName := spectypes.FUNCTION_TAG_GET_BLOCK_BY_HASH.String()
+	if !ok {
+		return spectypes.NOT_APPLICABLE, utils.LavaFormatDebug(tagName+" tag function not found", []utils.Attribute{{Key: "chainID", Value: cf.endpoint.ChainID}, {Key: "APIInterface", Value: cf.endpoint.ApiInterface}}...)
+	}
+	if parsing.FunctionTemplate == "" {
+		return spectypes.NOT_APPLICABLE, utils.LavaFormatError(tagName+" missing function template", nil, []utils.Attribute{{Key: "chainID", Value: cf.endpoint.ChainID}, {Key: "APIInterface", Value: cf.endpoint.ApiInterface}}...)
+	}
+	path := parsing.ApiName
+	data := []byte(fmt.Sprintf(parsing.FunctionTemplate, blockHash))
+	chainMessage, err := CraftChainMessage(parsing, collectionData.Type, cf.chainParser, &CraftData{Path: path, Data: data, ConnectionType: collectionData.Type}, cf.ChainFetcherMetadata())
+	if err != nil {
+		return spectypes.NOT_APPLICABLE, utils.LavaFormatError(tagName+" failed CraftChainMessage on function template", err, []utils.Attribute{{Key: "chainID", Value: cf.endpoint.ChainID}, {Key: "APIInterface", Value: cf.endpoint.ApiInterface}}...)
+	}
+	reply, _, _, err := cf.chainRouter.SendNodeMsg(ctx, nil, chainMessage, nil)
+	if err != nil {
+		return spectypes.NOT_APPLICABLE, utils.LavaFormatWarning(tagName+" failed sending chainMessage", err, []utils.Attribute{{Key: "chainID", Value: cf.endpoint.ChainID}, {Key: "APIInterface", Value: cf.endpoint.ApiInterface}}...)
+	}
+	parserInput, err := FormatResponseForParsing(reply, chainMessage)
+	if err != nil {
+		return spectypes.NOT_APPLICABLE, utils.LavaFormatWarning(tagName+" Failed formatResponseForParsing", err, []utils.Attribute{
+			{Key: "nodeUrl", Value: cf.endpoint.UrlsString()},
+			{Key: "Method", Value: parsing.ApiName},
+			{Key: "Response", Value: string(reply.Data)},
+		}...)
+	}
+	blockNum, err := parser.ParseBlockFromReply(parserInput, parsing.ResultParsing)
+	if err != nil {
+		return spectypes.NOT_APPLICABLE, utils.LavaFormatWarning(tagName+" Failed to parse Response", err, []utils.Attribute{
+			{Key: "nodeUrl", Value: cf.endpoint.UrlsString()},
+			{Key: "Method", Value: parsing.ApiName},
+			{Key: "Response", Value: string(reply.Data)},
+		}...)
+	}
+	return blockNum, nil
+}
+
 func NewChainFetcher(ctx context.Context, chainRouter ChainRouter, chainParser ChainParser, endpoint *lavasession.RPCProviderEndpoint, cache *performance.Cache) *ChainFetcher {
 	cf := &ChainFetcher{chainRouter: chainRouter, chainParser: chainParser, endpoint: endpoint, cache: cache}
 	return cf
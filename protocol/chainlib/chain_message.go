@@ -19,6 +19,7 @@ type parsedMessage struct {
 	msg                    updatableRPCInput
 	apiCollection          *spectypes.ApiCollection
 	extensions             []*spectypes.Extension
+	requestedBlockHash     blockHashResolution
 }
 
 func (pm parsedMessage) AppendHeader(metadata []pairingtypes.Metadata) {
@@ -58,6 +59,26 @@ func (pm *parsedMessage) UpdateLatestBlockInMessage(latestBlock int64, modifyCon
 	return false
 }
 
+// UpdateBlockHashRequestedBlock aligns the requested block of a message requesting a block hash with the block set elsewhere (e.g. by the consumer)
+// when only one of them resolved the hash: a resolved block replaces the default block, and the default block replaces a resolved one.
+// a block resolved elsewhere can't be verified here, so it's only accepted up to maxUnresolvedBlock (blocks after it are known and would have resolved)
+func (pm *parsedMessage) UpdateBlockHashRequestedBlock(requestedBlock int64, maxUnresolvedBlock int64) (updated bool) {
+	if !pm.requestedBlockHash.requested {
+		return false
+	}
+	if !pm.requestedBlockHash.resolved && requestedBlock > spectypes.NOT_APPLICABLE && requestedBlock <= maxUnresolvedBlock {
+		pm.latestRequestedBlock = requestedBlock
+		pm.requestedBlockHash.resolved = true
+		return true
+	}
+	if pm.requestedBlockHash.resolved && requestedBlock == pm.requestedBlockHash.defaultBlock {
+		pm.latestRequestedBlock = requestedBlock
+		pm.requestedBlockHash.resolved = false
+		return true
+	}
+	return false
+}
+
 func (pm *parsedMessage) GetExtensions() []*spectypes.Extension {
 	return pm.extensions
 }
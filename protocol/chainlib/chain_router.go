@@ -170,3 +170,36 @@ func populateRequiredForAddon(addon string, extensions []string, required map[re
 		populateRequiredForAddon(addon, extensionsWithoutI, required)
 	}
 }
+
+// SwappableChainRouter lets the node connections of a running endpoint be replaced without interrupting its users
+type SwappableChainRouter struct {
+	lock   sync.RWMutex
+	router ChainRouter
+}
+
+func (scr *SwappableChainRouter) getRouter() ChainRouter {
+	scr.lock.RLock()
+	defer scr.lock.RUnlock()
+	return scr.router
+}
+
+func (scr *SwappableChainRouter) SendNodeMsg(ctx context.Context, ch chan interface{}, chainMessage ChainMessageForSend, extensions []string) (relayReply *pairingtypes.RelayReply, subscriptionID string, relayReplyServer *rpcclient.ClientSubscription, err error) {
+	return scr.getRouter().SendNodeMsg(ctx, ch, chainMessage, extensions)
+}
+
+func (scr *SwappableChainRouter) ExtensionsSupported(extensions []string) bool {
+	return scr.getRouter().ExtensionsSupported(extensions)
+}
+
+// Swap replaces the inner router and returns the previous one, requests already in flight finish on the previous router
+func (scr *SwappableChainRouter) Swap(router ChainRouter) (previous ChainRouter) {
+	scr.lock.Lock()
+	defer scr.lock.Unlock()
+	previous = scr.router
+	scr.router = router
+	return previous
+}
+
+func NewSwappableChainRouter(router ChainRouter) *SwappableChainRouter {
+	return &SwappableChainRouter{router: router}
+}
@@ -4,9 +4,11 @@ import (
 	"context"
 	"testing"
 
+	"github.com/lavanet/lava/protocol/chainlib/chainproxy/rpcclient"
 	"github.com/lavanet/lava/protocol/common"
 	"github.com/lavanet/lava/protocol/lavasession"
 	testcommon "github.com/lavanet/lava/testutil/common"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
 	spectypes "github.com/lavanet/lava/x/spec/types"
 	"github.com/stretchr/testify/require"
 )
@@ -341,3 +343,30 @@ func TestChainRouter(t *testing.T) {
 		})
 	}
 }
+
+type stubChainRouter struct {
+	extensions map[string]struct{}
+}
+
+func (scr stubChainRouter) SendNodeMsg(ctx context.Context, ch chan interface{}, chainMessage ChainMessageForSend, extensions []string) (relayReply *pairingtypes.RelayReply, subscriptionID string, relayReplyServer *rpcclient.ClientSubscription, err error) {
+	return nil, "", nil, nil
+}
+
+func (scr stubChainRouter) ExtensionsSupported(extensions []string) bool {
+	for _, extension := range extensions {
+		if _, ok := scr.extensions[extension]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+func TestSwappableChainRouter(t *testing.T) {
+	first := stubChainRouter{extensions: map[string]struct{}{}}
+	second := stubChainRouter{extensions: map[string]struct{}{"archive": {}}}
+	router := NewSwappableChainRouter(first)
+	require.False(t, router.ExtensionsSupported([]string{"archive"}))
+	previous := router.Swap(second)
+	require.Equal(t, first, previous)
+	require.True(t, router.ExtensionsSupported([]string{"archive"}))
+}
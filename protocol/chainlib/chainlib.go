@@ -58,11 +58,18 @@ type ChainParser interface {
 	GetVerifications(supported []string) ([]VerificationContainer, error)
 	SeparateAddonsExtensions(supported []string) (addons, extensions []string, err error)
 	SetConfiguredExtensions(extensions map[string]struct{}) error
+	SetBlockHashResolver(blockHashResolver BlockHashResolver)
+}
+
+// BlockHashResolver resolves a requested block hash to the block height without blocking, it is set on consumers and providers
+type BlockHashResolver interface {
+	ResolveBlockHash(blockHash string) (block int64, resolved bool)
 }
 
 type ChainMessage interface {
 	RequestedBlock() (latest int64, earliest int64)
 	UpdateLatestBlockInMessage(latestBlock int64, modifyContent bool) (modified bool)
+	UpdateBlockHashRequestedBlock(requestedBlock int64, maxUnresolvedBlock int64) (updated bool)
 	AppendHeader(metadata []pairingtypes.Metadata)
 	GetExtensions() []*spectypes.Extension
 	ChainMessageForSend
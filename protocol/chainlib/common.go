@@ -1,6 +1,7 @@
 package chainlib
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net"
@@ -164,9 +165,21 @@ func verifyTendermintEndpoint(endpoints []common.NodeUrl) (websocketEndpoint, ht
 	return websocketEndpoint, httpEndpoint
 }
 
-func ListenWithRetry(app *fiber.App, address string) {
+// ListenWithRetry serves the app until ctx is done, retrying when listening fails
+func ListenWithRetry(ctx context.Context, app *fiber.App, address string) {
+	go func() {
+		<-ctx.Done()
+		err := app.Shutdown()
+		if err != nil {
+			utils.LavaFormatError("app.Shutdown()", err, utils.Attribute{Key: "listenAddr", Value: address})
+		}
+	}()
 	for {
 		err := app.Listen(address)
+		if ctx.Err() != nil {
+			utils.LavaFormatInfo("listener closed server", utils.Attribute{Key: "listenAddr", Value: address})
+			return
+		}
 		if err != nil {
 			utils.LavaFormatError("app.Listen(listenAddr)", err)
 		}
@@ -0,0 +1,47 @@
+package extensionslib
+
+import (
+	"github.com/lavanet/lava/protocol/parser"
+	spectypes "github.com/lavanet/lava/x/spec/types"
+)
+
+type BlockRangeParserRule struct {
+	extension *spectypes.Extension
+}
+
+func (brpr BlockRangeParserRule) isPassingRule(extensionChainMessage ExtensionsChainMessage, latestBlock uint64) bool {
+	latestRequestedBlock, earliestRequestedBlock := extensionChainMessage.RequestedBlock()
+	if brpr.extension.Rule.RangeStartParsing.ParserFunc != spectypes.PARSER_FUNC_EMPTY {
+		// the requested block of a range api is usually its end, the start is parsed separately
+		if rpcInput, ok := extensionChainMessage.GetRPCMessage().(parser.RPCInput); ok {
+			rangeStart, err := parser.ParseBlockFromParams(rpcInput, brpr.extension.Rule.RangeStartParsing)
+			if err == nil && rangeStart != spectypes.NOT_APPLICABLE {
+				earliestRequestedBlock = rangeStart
+			}
+		}
+	}
+	rangeEnd, ok := resolveRangeBlock(latestRequestedBlock, latestBlock)
+	if !ok {
+		return false
+	}
+	rangeStart, ok := resolveRangeBlock(earliestRequestedBlock, latestBlock)
+	if !ok || rangeStart > rangeEnd {
+		return false
+	}
+	return rangeEnd-rangeStart > brpr.extension.Rule.BlockRange
+}
+
+// resolveRangeBlock converts block tags to a block number, returns false when it can't be known
+func resolveRangeBlock(block int64, latestBlock uint64) (uint64, bool) {
+	if block >= 0 {
+		return uint64(block), true
+	}
+	switch block {
+	case spectypes.EARLIEST_BLOCK:
+		return 0, true
+	case spectypes.LATEST_BLOCK, spectypes.PENDING_BLOCK, spectypes.SAFE_BLOCK, spectypes.FINALIZED_BLOCK:
+		return latestBlock, latestBlock != 0
+	default:
+		return 0, false
+	}
+}
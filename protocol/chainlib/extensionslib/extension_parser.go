@@ -1,12 +1,15 @@
 package extensionslib
 
 import (
+	"github.com/lavanet/lava/protocol/chainlib/chainproxy/rpcInterfaceMessages"
 	spectypes "github.com/lavanet/lava/x/spec/types"
 )
 
 type ExtensionsChainMessage interface {
 	SetExtension(*spectypes.Extension)
 	RequestedBlock() (latest int64, earliest int64)
+	GetApi() *spectypes.Api
+	GetRPCMessage() rpcInterfaceMessages.GenericMessage
 }
 
 type ExtensionKey struct {
@@ -48,18 +51,53 @@ func (ep *ExtensionParser) ExtensionParsing(addon string, extensionsChainMessage
 			continue
 		}
 		extensionParserRule := NewExtensionParserRule(extension)
+		if extensionParserRule == nil {
+			// the extension has no rule, it is never set automatically
+			continue
+		}
 		if extensionParserRule.isPassingRule(extensionsChainMessage, latestBlock) {
 			extensionsChainMessage.SetExtension(extension)
 		}
 	}
 }
 
+// NewExtensionParserRule returns the rule deciding when a request needs the extension, archive is decided by the requested block
+// and any extension can add spec rules for methods, block ranges and headers, a request must pass all of them
 func NewExtensionParserRule(extension *spectypes.Extension) ExtensionParserRule {
-	switch extension.Name {
-	case "archive":
-		return ArchiveParserRule{extension: extension}
-	default:
+	rules := CombinedParserRule{}
+	if extension.Name == "archive" {
+		rules = append(rules, ArchiveParserRule{extension: extension})
+	}
+	if extension.Rule != nil {
+		if len(extension.Rule.Methods) > 0 {
+			rules = append(rules, MethodParserRule{extension: extension})
+		}
+		if extension.Rule.BlockRange > 0 {
+			rules = append(rules, BlockRangeParserRule{extension: extension})
+		}
+		if len(extension.Rule.Headers) > 0 {
+			rules = append(rules, HeaderParserRule{extension: extension})
+		}
+	}
+	switch len(rules) {
+	case 0:
 		// unsupported rule
 		return nil
+	case 1:
+		return rules[0]
+	default:
+		return rules
+	}
+}
+
+// CombinedParserRule passes when all of its rules pass
+type CombinedParserRule []ExtensionParserRule
+
+func (cpr CombinedParserRule) isPassingRule(extensionChainMessage ExtensionsChainMessage, latestBlock uint64) bool {
+	for _, rule := range cpr {
+		if !rule.isPassingRule(extensionChainMessage, latestBlock) {
+			return false
+		}
 	}
+	return true
 }
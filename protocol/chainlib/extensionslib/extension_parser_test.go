@@ -0,0 +1,94 @@
+package extensionslib
+
+import (
+	"testing"
+
+	"github.com/lavanet/lava/protocol/chainlib/chainproxy"
+	"github.com/lavanet/lava/protocol/chainlib/chainproxy/rpcInterfaceMessages"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	spectypes "github.com/lavanet/lava/x/spec/types"
+	"github.com/stretchr/testify/require"
+)
+
+// implements parser.RPCInput so range starts can be parsed from the params
+type mockRPCMessage struct {
+	rpcInterfaceMessages.ParsableRPCInput
+	params interface{}
+}
+
+func (m mockRPCMessage) GetParams() interface{} {
+	return m.params
+}
+
+type mockExtensionsChainMessage struct {
+	api        *spectypes.Api
+	latest     int64
+	earliest   int64
+	msg        mockRPCMessage
+	extensions []*spectypes.Extension
+}
+
+func (m *mockExtensionsChainMessage) SetExtension(extension *spectypes.Extension) {
+	m.extensions = append(m.extensions, extension)
+}
+
+func (m *mockExtensionsChainMessage) RequestedBlock() (latest int64, earliest int64) {
+	return m.latest, m.earliest
+}
+
+func (m *mockExtensionsChainMessage) GetApi() *spectypes.Api {
+	return m.api
+}
+
+func (m *mockExtensionsChainMessage) GetRPCMessage() rpcInterfaceMessages.GenericMessage {
+	return m.msg
+}
+
+func TestExtensionParserRules(t *testing.T) {
+	traceExtension := &spectypes.Extension{Name: "trace", Rule: &spectypes.Rule{Methods: []string{"debug_*", "trace_block"}}}
+	logsExtension := &spectypes.Extension{Name: "logs", Rule: &spectypes.Rule{
+		Methods:           []string{"eth_getLogs"},
+		BlockRange:        1000,
+		RangeStartParsing: spectypes.BlockParser{ParserArg: []string{"0"}, ParserFunc: spectypes.PARSER_FUNC_PARSE_BY_ARG},
+	}}
+	headerExtension := &spectypes.Extension{Name: "private", Rule: &spectypes.Rule{Headers: []string{"x-private-tx"}}}
+	archiveExtension := &spectypes.Extension{Name: "archive", Rule: &spectypes.Rule{Block: 100, Methods: []string{"eth_getBalance"}}}
+
+	tests := []struct {
+		name      string
+		extension *spectypes.Extension
+		message   *mockExtensionsChainMessage
+		passing   bool
+	}{
+		{name: "method prefix", extension: traceExtension, message: &mockExtensionsChainMessage{api: &spectypes.Api{Name: "debug_traceTransaction"}}, passing: true},
+		{name: "exact method", extension: traceExtension, message: &mockExtensionsChainMessage{api: &spectypes.Api{Name: "trace_block"}}, passing: true},
+		{name: "method not matching", extension: traceExtension, message: &mockExtensionsChainMessage{api: &spectypes.Api{Name: "trace_blocks"}}, passing: false},
+		{name: "batch with a matching method", extension: traceExtension, message: &mockExtensionsChainMessage{api: &spectypes.Api{Name: "eth_call&debug_traceCall"}}, passing: true},
+		{name: "range above threshold", extension: logsExtension, message: &mockExtensionsChainMessage{api: &spectypes.Api{Name: "eth_getLogs"}, latest: spectypes.LATEST_BLOCK, earliest: spectypes.LATEST_BLOCK, msg: mockRPCMessage{params: []interface{}{"0x1"}}}, passing: true},
+		{name: "range below threshold", extension: logsExtension, message: &mockExtensionsChainMessage{api: &spectypes.Api{Name: "eth_getLogs"}, latest: spectypes.LATEST_BLOCK, earliest: spectypes.LATEST_BLOCK, msg: mockRPCMessage{params: []interface{}{"0x1388"}}}, passing: false},
+		{name: "range from earliest", extension: logsExtension, message: &mockExtensionsChainMessage{api: &spectypes.Api{Name: "eth_getLogs"}, latest: 3000, earliest: 3000, msg: mockRPCMessage{params: []interface{}{"earliest"}}}, passing: true},
+		{name: "range on another method", extension: logsExtension, message: &mockExtensionsChainMessage{api: &spectypes.Api{Name: "eth_call"}, latest: 3000, earliest: 10}, passing: false},
+		{name: "range without a start parsed", extension: logsExtension, message: &mockExtensionsChainMessage{api: &spectypes.Api{Name: "eth_getLogs"}, latest: 3000, earliest: 10, msg: mockRPCMessage{params: []interface{}{}}}, passing: true},
+		{name: "header present", extension: headerExtension, message: &mockExtensionsChainMessage{api: &spectypes.Api{Name: "eth_sendRawTransaction"}, msg: mockRPCMessage{ParsableRPCInput: rpcInterfaceMessages.ParsableRPCInput{BaseMessage: chainproxy.BaseMessage{Headers: []pairingtypes.Metadata{{Name: "X-Private-Tx", Value: "true"}}}}}}, passing: true},
+		{name: "header missing", extension: headerExtension, message: &mockExtensionsChainMessage{api: &spectypes.Api{Name: "eth_sendRawTransaction"}}, passing: false},
+		{name: "archive with a method rule", extension: archiveExtension, message: &mockExtensionsChainMessage{api: &spectypes.Api{Name: "eth_getBalance"}, latest: 10, earliest: 10}, passing: true},
+		{name: "archive on another method", extension: archiveExtension, message: &mockExtensionsChainMessage{api: &spectypes.Api{Name: "eth_call"}, latest: 10, earliest: 10}, passing: false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			rule := NewExtensionParserRule(test.extension)
+			require.NotNil(t, rule)
+			require.Equal(t, test.passing, rule.isPassingRule(test.message, 5000))
+		})
+	}
+}
+
+func TestExtensionParsingWithoutRule(t *testing.T) {
+	extension := &spectypes.Extension{Name: "no-rule"}
+	require.Nil(t, NewExtensionParserRule(extension))
+	extensionParser := ExtensionParser{AllowedExtensions: map[string]struct{}{"no-rule": {}}}
+	extensionParser.SetConfiguredExtensions(map[ExtensionKey]*spectypes.Extension{{Extension: "no-rule"}: extension})
+	message := &mockExtensionsChainMessage{api: &spectypes.Api{Name: "eth_call"}}
+	extensionParser.ExtensionParsing("", message, 100)
+	require.Empty(t, message.extensions)
+}
@@ -0,0 +1,27 @@
+package extensionslib
+
+import (
+	"strings"
+
+	spectypes "github.com/lavanet/lava/x/spec/types"
+)
+
+// headers are filtered by the chain parser before extension parsing, so a triggering header has to be defined in the api collection headers
+type HeaderParserRule struct {
+	extension *spectypes.Extension
+}
+
+func (hpr HeaderParserRule) isPassingRule(extensionChainMessage ExtensionsChainMessage, latestBlock uint64) bool {
+	rpcMessage := extensionChainMessage.GetRPCMessage()
+	if rpcMessage == nil {
+		return false
+	}
+	for _, metadata := range rpcMessage.GetHeaders() {
+		for _, header := range hpr.extension.Rule.Headers {
+			if strings.EqualFold(metadata.Name, header) {
+				return true
+			}
+		}
+	}
+	return false
+}
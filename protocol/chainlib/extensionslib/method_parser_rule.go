@@ -0,0 +1,38 @@
+package extensionslib
+
+import (
+	"strings"
+
+	spectypes "github.com/lavanet/lava/x/spec/types"
+)
+
+// batch api names are joined by chainlib.SEP
+const batchApiNameSeparator = "&"
+
+type MethodParserRule struct {
+	extension *spectypes.Extension
+}
+
+// a batch passes if any of its apis matches, since the whole batch is sent to the same provider
+func (mpr MethodParserRule) isPassingRule(extensionChainMessage ExtensionsChainMessage, latestBlock uint64) bool {
+	api := extensionChainMessage.GetApi()
+	if api == nil {
+		return false
+	}
+	for _, apiName := range strings.Split(api.Name, batchApiNameSeparator) {
+		for _, method := range mpr.extension.Rule.Methods {
+			if matchMethod(method, apiName) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// a trailing * in the method matches any api name with that prefix
+func matchMethod(method string, apiName string) bool {
+	if strings.HasSuffix(method, "*") {
+		return strings.HasPrefix(apiName, strings.TrimSuffix(method, "*"))
+	}
+	return method == apiName
+}
@@ -13,7 +13,6 @@ import (
 	"time"
 
 	dyncodec "github.com/lavanet/lava/protocol/chainlib/grpcproxy/dyncodec"
-	"github.com/lavanet/lava/protocol/parser"
 
 	"github.com/gogo/protobuf/jsonpb"
 	"github.com/lavanet/lava/protocol/chainlib/grpcproxy"
@@ -164,8 +163,9 @@ func (apip *GrpcChainParser) ParseMsg(url string, data []byte, connectionType st
 	// // Extract default block parser
 	blockParser := apiCont.api.BlockParsing
 	var requestedBlock int64
+	var requestedBlockHash blockHashResolution
 	if overwriteReqBlock == "" {
-		requestedBlock, err = parser.ParseBlockFromParams(grpcMessage, blockParser)
+		requestedBlock, requestedBlockHash, err = apip.parseBlockFromParams(grpcMessage, blockParser)
 		if err != nil {
 			return nil, utils.LavaFormatError("ParseBlockFromParams failed parsing block", err, utils.Attribute{Key: "chain", Value: apip.spec.Name}, utils.Attribute{Key: "blockParsing", Value: apiCont.api.BlockParsing})
 		}
@@ -177,6 +177,7 @@ func (apip *GrpcChainParser) ParseMsg(url string, data []byte, connectionType st
 	}
 
 	nodeMsg := apip.newChainMessage(apiCont.api, requestedBlock, &grpcMessage, apiCollection)
+	nodeMsg.requestedBlockHash = requestedBlockHash
 	apip.BaseChainParser.ExtensionParsing(apiCollection.CollectionData.AddOn, nodeMsg, latestBlock)
 	return nodeMsg, nil
 }
@@ -316,6 +317,12 @@ func (apil *GrpcChainListener) Serve(ctx context.Context) {
 	apil.chainParser.setupForConsumer(sendRelayCallback)
 
 	utils.LavaFormatInfo("Server listening", utils.Attribute{Key: "Address", Value: lis.Addr()})
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, shutdownRelease := context.WithTimeout(context.Background(), 10*time.Second)
+		defer shutdownRelease()
+		httpServer.Shutdown(shutdownCtx)
+	}()
 
 	if err := httpServer.Serve(lis); !errors.Is(err, http.ErrServerClosed) {
 		utils.LavaFormatFatal("Portal failed to serve", err, utils.Attribute{Key: "Address", Value: lis.Addr()}, utils.Attribute{Key: "ChainID", Value: apil.endpoint.ChainID})
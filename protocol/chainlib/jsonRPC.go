@@ -16,7 +16,6 @@ import (
 	"github.com/lavanet/lava/protocol/common"
 	"github.com/lavanet/lava/protocol/lavasession"
 	"github.com/lavanet/lava/protocol/metrics"
-	"github.com/lavanet/lava/protocol/parser"
 
 	"github.com/lavanet/lava/protocol/chainlib/chainproxy"
 	"github.com/lavanet/lava/protocol/chainlib/chainproxy/rpcclient"
@@ -97,6 +96,7 @@ func (apip *JsonRPCChainParser) ParseMsg(url string, data []byte, connectionType
 	var api *spectypes.Api
 	var apiCollection *spectypes.ApiCollection
 	var latestRequestedBlock, earliestRequestedBlock int64 = 0, 0
+	var requestedBlockHash blockHashResolution
 	for idx, msg := range msgs {
 		var requestedBlockForMessage int64
 		// Check api is supported and save it in nodeMsg
@@ -116,7 +116,7 @@ func (apip *JsonRPCChainParser) ParseMsg(url string, data []byte, connectionType
 
 		if overwriteReqBlock == "" {
 			// Fetch requested block, it is used for data reliability
-			requestedBlockForMessage, err = parser.ParseBlockFromParams(msg, apiCont.api.BlockParsing)
+			requestedBlockForMessage, requestedBlockHash, err = apip.parseBlockFromParams(msg, apiCont.api.BlockParsing)
 			if err != nil {
 				return nil, utils.LavaFormatError("ParseBlockFromParams failed parsing block", err, utils.Attribute{Key: "chain", Value: apip.spec.Name}, utils.Attribute{Key: "blockParsing", Value: apiCont.api.BlockParsing})
 			}
@@ -171,6 +171,7 @@ func (apip *JsonRPCChainParser) ParseMsg(url string, data []byte, connectionType
 	var nodeMsg *parsedMessage
 	if len(msgs) == 1 {
 		nodeMsg = apip.newChainMessage(api, latestRequestedBlock, &msgs[0], apiCollection)
+		nodeMsg.requestedBlockHash = requestedBlockHash // a batch combines the requested blocks so only a single message follows the block hash
 	} else {
 		nodeMsg, err = apip.newBatchChainMessage(api, latestRequestedBlock, earliestRequestedBlock, msgs, apiCollection)
 		if err != nil {
@@ -429,7 +430,7 @@ func (apil *JsonRPCChainListener) Serve(ctx context.Context) {
 	})
 
 	// Go
-	ListenWithRetry(app, apil.endpoint.NetworkAddress)
+	ListenWithRetry(ctx, app, apil.endpoint.NetworkAddress)
 }
 
 type JrpcChainProxy struct {
@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/lavanet/lava/protocol/chainlib/chainproxy/rpcInterfaceMessages"
+	keepertest "github.com/lavanet/lava/testutil/keeper"
 	spectypes "github.com/lavanet/lava/x/spec/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -273,3 +274,72 @@ func TestJsonRpcBatchCall(t *testing.T) {
 		}
 	}()
 }
+
+type mockBlockHashLookup map[string]int64
+
+func (m mockBlockHashLookup) GetBlockNumByHash(blockHash string) (int64, bool) {
+	block, ok := m[blockHash]
+	return block, ok
+}
+
+func TestBlockHashResolver(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	serverHandle := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Handle the incoming request and provide the desired response
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, `{"jsonrpc":"2.0","id":1,"result":{"number":"0x20","hash":"0x968ec00fd34eedc03b0577ee8116f74c75127b7d775e51c7a72519f760b821a8"}}`)
+	})
+
+	chainParser, chainRouter, chainFetcher, closeServer, err := CreateChainLibMocks(ctx, "ETH1", spectypes.APIInterfaceJsonRPC, serverHandle, "../../", nil)
+	require.NoError(t, err)
+	require.NotNil(t, chainRouter)
+	spec, err := keepertest.GetASpec("ETH1", "../../", nil, nil)
+	require.NoError(t, err)
+	chainParser.SetSpec(spec)
+	_, collectionData, ok := chainParser.GetParsingByTag(spectypes.FUNCTION_TAG_GET_BLOCK_BY_HASH)
+	require.True(t, ok)
+	trackedHash := "0x968ec00fd34eedc03b0577ee8116f74c75127b7d775e51c7a72519f760b821a8"
+	untrackedHash := "0x1c3c7b5a8d37b1e0d80ac1e8fd35e3dc9f6ea4a1a8e1d5ed02e2c5c7ab11a0f2"
+	request := func(blockHash string) []byte {
+		return []byte(fmt.Sprintf(`{"jsonrpc":"2.0","id":1,"method":"eth_getBlockByHash","params":["%s", false]}`, blockHash))
+	}
+	requestedBlock := func(blockHash string) int64 {
+		chainMessage, err := chainParser.ParseMsg("", request(blockHash), collectionData.Type, nil, 0)
+		require.NoError(t, err)
+		requestedBlock, _ := chainMessage.RequestedBlock()
+		return requestedBlock
+	}
+
+	// without a resolver the requested block is the default one
+	require.Equal(t, spectypes.LATEST_BLOCK, requestedBlock(trackedHash))
+
+	// known hashes are saved in the encoding of the GET_BLOCK_BY_NUM result
+	lookup := mockBlockHashLookup{"lo7AD9NO7cA7BXfugRb3THUSe313XlHHpyUZ92C4Iag=": 100}
+	chainParser.SetBlockHashResolver(NewBlockHashResolver(ctx, lookup, chainFetcher.(*ChainFetcher), chainParser))
+	require.Equal(t, int64(100), requestedBlock(trackedHash))
+
+	// hashes that aren't known are fetched in the background, meanwhile the requested block is the default one
+	chainMessage, err := chainParser.ParseMsg("", request(untrackedHash), collectionData.Type, nil, 0)
+	require.NoError(t, err)
+	block, _ := chainMessage.RequestedBlock()
+	require.Equal(t, spectypes.LATEST_BLOCK, block)
+	require.Eventually(t, func() bool { return requestedBlock(untrackedHash) == 32 }, time.Second, 10*time.Millisecond)
+
+	// the block resolved elsewhere replaces the default block only when it isn't after the max unresolved block,
+	// and the default block replaces a resolved block
+	require.False(t, chainMessage.UpdateBlockHashRequestedBlock(32, 31))
+	require.True(t, chainMessage.UpdateBlockHashRequestedBlock(32, 100))
+	block, _ = chainMessage.RequestedBlock()
+	require.Equal(t, int64(32), block)
+	require.False(t, chainMessage.UpdateBlockHashRequestedBlock(40, 100))
+	require.True(t, chainMessage.UpdateBlockHashRequestedBlock(spectypes.LATEST_BLOCK, 100))
+	block, _ = chainMessage.RequestedBlock()
+	require.Equal(t, spectypes.LATEST_BLOCK, block)
+
+	// block numbers in the hash param are parsed as blocks
+	require.Equal(t, int64(16), requestedBlock("0x10"))
+	if closeServer != nil {
+		closeServer()
+	}
+}
@@ -0,0 +1,79 @@
+package nodefixtures
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	specutils "github.com/lavanet/lava/x/spec/client/utils"
+	"github.com/spf13/cobra"
+)
+
+const (
+	FlagFixtures     = "fixtures"
+	FlagChainID      = "chain-id"
+	FlagApiInterface = "api-interface"
+)
+
+func CreateVerifyFixturesCobraCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify-fixtures [spec-proposal-files...]",
+		Short: "replay recorded node fixtures through a spec",
+		Long: `Replays request and response fixtures recorded by a provider (rpcprovider --record-fixtures) through the spec parsing.
+Every recorded consumer request is parsed again and the requested block is compared with the recorded one,
+and the chain fetcher tags and the spec verifications are run against the recorded node responses.
+Imports are resolved from the given files, so every imported spec must be in one of them.
+The report is printed as json, the command fails if any api parsed the wrong block or any verification failed.`,
+		Example: `lavad spec verify-fixtures cookbook/specs/spec_add_ethereum.json --fixtures ./fixtures/ETH1_jsonrpc.jsonl --chain-id ETH1 --api-interface jsonrpc`,
+		Args:    cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// verification failures are not usage errors
+			cmd.SilenceUsage = true
+			fixturesFile, _ := cmd.Flags().GetString(FlagFixtures)
+			chainID, _ := cmd.Flags().GetString(FlagChainID)
+			apiInterface, _ := cmd.Flags().GetString(FlagApiInterface)
+			fileNames := []string{}
+			for _, arg := range args {
+				fileNames = append(fileNames, strings.Split(arg, ",")...)
+			}
+			specs, _, err := specutils.ReadSpecFiles(fileNames)
+			if err != nil {
+				return err
+			}
+			lintResult, err := specutils.LintSpecs(specs)
+			if err != nil {
+				return err
+			}
+			fixtures, err := ReadFixtures(fixturesFile)
+			if err != nil {
+				return err
+			}
+			for _, spec := range lintResult.ExpandedSpecs {
+				if spec.Index != chainID {
+					continue
+				}
+				report, err := VerifySpecFixtures(cmd.Context(), spec, apiInterface, fixtures)
+				if err != nil {
+					return err
+				}
+				reportJson, err := json.MarshalIndent(report, "", "  ")
+				if err != nil {
+					return err
+				}
+				cmd.Println(string(reportJson))
+				if report.Failed() {
+					return fmt.Errorf("spec %s failed verification against %s", chainID, fixturesFile)
+				}
+				return nil
+			}
+			return fmt.Errorf("spec %s was not found or failed expanding", chainID)
+		},
+	}
+	cmd.Flags().String(FlagFixtures, "", "fixtures file recorded by the provider")
+	cmd.Flags().String(FlagChainID, "", "index of the spec to verify")
+	cmd.Flags().String(FlagApiInterface, "", "api interface of the fixtures")
+	cmd.MarkFlagRequired(FlagFixtures)
+	cmd.MarkFlagRequired(FlagChainID)
+	cmd.MarkFlagRequired(FlagApiInterface)
+	return cmd
+}
@@ -0,0 +1,113 @@
+package nodefixtures
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"unicode/utf8"
+
+	sdkerrors "cosmossdk.io/errors"
+	"github.com/lavanet/lava/protocol/chainlib"
+	"github.com/lavanet/lava/protocol/chainlib/chainproxy/rpcInterfaceMessages"
+	spectypes "github.com/lavanet/lava/x/spec/types"
+)
+
+var FixtureNotFoundError = sdkerrors.New("Fixture Not Found Error", 1100, "no recorded fixture for the request")
+
+// NodeFixture is a request sent to the node and its response, url and data are the inputs of ChainParser.ParseMsg
+type NodeFixture struct {
+	ApiInterface   string   `json:"api_interface"`
+	ConnectionType string   `json:"connection_type"`
+	ApiName        string   `json:"api_name"`
+	Addon          string   `json:"addon,omitempty"`
+	Extensions     []string `json:"extensions,omitempty"`
+	Url            string   `json:"url,omitempty"`
+	Data           string   `json:"data,omitempty"`
+	RequestedBlock int64    `json:"requested_block"`
+	// requests crafted by the chain fetcher (verifications, chain tracker) and not relayed by consumers
+	Fetcher bool `json:"fetcher,omitempty"`
+	// responses that are not valid utf8 (grpc) are saved encoded
+	Response       string `json:"response,omitempty"`
+	ResponseBase64 []byte `json:"response_base64,omitempty"`
+}
+
+func (nf *NodeFixture) SetResponse(response []byte) {
+	if utf8.Valid(response) {
+		nf.Response = string(response)
+		return
+	}
+	nf.ResponseBase64 = response
+}
+
+func (nf *NodeFixture) GetResponse() []byte {
+	if len(nf.ResponseBase64) > 0 {
+		return nf.ResponseBase64
+	}
+	return []byte(nf.Response)
+}
+
+func (nf *NodeFixture) key() string {
+	return fixtureKey(nf.ConnectionType, nf.Url, nf.Data)
+}
+
+func fixtureKey(connectionType string, url string, data string) string {
+	return connectionType + "\n" + url + "\n" + data
+}
+
+// ReadFixtures reads a fixtures file, one json fixture per line
+func ReadFixtures(fileName string) ([]*NodeFixture, error) {
+	file, err := os.Open(fileName)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	fixtures := []*NodeFixture{}
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 0, 1024*1024), 64*1024*1024) // node responses can be large
+	line := 0
+	for scanner.Scan() {
+		line++
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		fixture := &NodeFixture{}
+		if err := json.Unmarshal(scanner.Bytes(), fixture); err != nil {
+			return nil, fmt.Errorf("invalid fixture in %s line %d: %w", fileName, line, err)
+		}
+		fixtures = append(fixtures, fixture)
+	}
+	return fixtures, scanner.Err()
+}
+
+// rawRequest returns the url and data the chain message can be parsed from again
+func rawRequest(chainMessage chainlib.ChainMessageForSend) (url string, data []byte, err error) {
+	switch rpcMessage := chainMessage.GetRPCMessage().(type) {
+	case *rpcInterfaceMessages.JsonrpcMessage:
+		data, err = json.Marshal(rpcMessage)
+		return "", data, err
+	case *rpcInterfaceMessages.TendermintrpcMessage:
+		if rpcMessage.Path != "" {
+			// uri requests are parsed from the url
+			return rpcMessage.Path, nil, nil
+		}
+		data, err = json.Marshal(rpcMessage.JsonrpcMessage)
+		return "", data, err
+	case *rpcInterfaceMessages.RestMessage:
+		// get requests have their query in the path
+		return rpcMessage.Path, rpcMessage.Msg, nil
+	default:
+		return "", nil, fmt.Errorf("unsupported message type %T, only single jsonrpc, tendermintrpc and rest requests are supported", rpcMessage)
+	}
+}
+
+// supportedApiInterface returns an error for api interfaces that can't be replayed offline,
+// grpc requests and responses can only be decoded with the node reflection
+func supportedApiInterface(apiInterface string) error {
+	switch apiInterface {
+	case spectypes.APIInterfaceJsonRPC, spectypes.APIInterfaceTendermintRPC, spectypes.APIInterfaceRest:
+		return nil
+	default:
+		return fmt.Errorf("fixtures are not supported for api interface %s", apiInterface)
+	}
+}
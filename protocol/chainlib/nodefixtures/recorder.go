@@ -0,0 +1,102 @@
+package nodefixtures
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/lavanet/lava/protocol/chainlib"
+	"github.com/lavanet/lava/protocol/chainlib/chainproxy/rpcclient"
+	"github.com/lavanet/lava/utils"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+)
+
+const RecordFixturesFlagName = "record-fixtures"
+
+type requestedBlockGetter interface {
+	RequestedBlock() (latest int64, earliest int64)
+}
+
+// RecordingChainRouter sends messages through the wrapped router, and saves every successful request and reply as a fixture
+type RecordingChainRouter struct {
+	chainlib.ChainRouter
+	apiInterface string
+	lock         sync.Mutex
+	file         *os.File
+}
+
+// FixturesFileName is the file fixtures of a chain and api interface are saved to in the fixtures directory
+func FixturesFileName(dir string, chainID string, apiInterface string) string {
+	return filepath.Join(dir, chainID+"_"+apiInterface+".jsonl")
+}
+
+func NewRecordingChainRouter(ctx context.Context, chainRouter chainlib.ChainRouter, dir string, chainID string, apiInterface string) (*RecordingChainRouter, error) {
+	if err := supportedApiInterface(apiInterface); err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+		return nil, err
+	}
+	file, err := os.OpenFile(FixturesFileName(dir, chainID, apiInterface), os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return nil, err
+	}
+	rcr := &RecordingChainRouter{ChainRouter: chainRouter, apiInterface: apiInterface, file: file}
+	go func() {
+		<-ctx.Done()
+		rcr.lock.Lock()
+		defer rcr.lock.Unlock()
+		rcr.file.Close()
+		rcr.file = nil
+	}()
+	return rcr, nil
+}
+
+func (rcr *RecordingChainRouter) SendNodeMsg(ctx context.Context, ch chan interface{}, chainMessage chainlib.ChainMessageForSend, extensions []string) (relayReply *pairingtypes.RelayReply, subscriptionID string, relayReplyServer *rpcclient.ClientSubscription, err error) {
+	relayReply, subscriptionID, relayReplyServer, err = rcr.ChainRouter.SendNodeMsg(ctx, ch, chainMessage, extensions)
+	if err == nil && ch == nil && relayReply != nil {
+		rcr.record(chainMessage, extensions, relayReply)
+	}
+	return relayReply, subscriptionID, relayReplyServer, err
+}
+
+func (rcr *RecordingChainRouter) record(chainMessage chainlib.ChainMessageForSend, extensions []string, relayReply *pairingtypes.RelayReply) {
+	url, data, err := rawRequest(chainMessage)
+	if err != nil {
+		utils.LavaFormatDebug("not recording fixture", utils.Attribute{Key: "reason", Value: err.Error()})
+		return
+	}
+	fixture := NodeFixture{
+		ApiInterface:   rcr.apiInterface,
+		ConnectionType: chainMessage.GetApiCollection().CollectionData.Type,
+		ApiName:        chainMessage.GetApi().Name,
+		Addon:          chainMessage.GetApiCollection().CollectionData.AddOn,
+		Extensions:     extensions,
+		Url:            url,
+		Data:           string(data),
+	}
+	if blockGetter, ok := chainMessage.(requestedBlockGetter); ok {
+		fixture.RequestedBlock, _ = blockGetter.RequestedBlock()
+	}
+	for _, header := range chainMessage.GetRPCMessage().GetHeaders() {
+		if header.Name == chainlib.ChainFetcherHeaderName {
+			fixture.Fetcher = true
+		}
+	}
+	fixture.SetResponse(relayReply.Data)
+	line, err := json.Marshal(fixture)
+	if err != nil {
+		utils.LavaFormatWarning("failed marshaling fixture", err, utils.Attribute{Key: "api", Value: fixture.ApiName})
+		return
+	}
+	rcr.lock.Lock()
+	defer rcr.lock.Unlock()
+	if rcr.file == nil {
+		return
+	}
+	if _, err := rcr.file.Write(append(line, '\n')); err != nil {
+		utils.LavaFormatWarning("failed writing fixture", err, utils.Attribute{Key: "api", Value: fixture.ApiName})
+	}
+}
@@ -0,0 +1,42 @@
+package nodefixtures
+
+import (
+	"context"
+
+	"github.com/lavanet/lava/protocol/chainlib"
+	"github.com/lavanet/lava/protocol/chainlib/chainproxy/rpcclient"
+	"github.com/lavanet/lava/utils"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+)
+
+// ReplayChainRouter answers messages with the responses of recorded fixtures instead of sending them to a node
+type ReplayChainRouter struct {
+	responses map[string][]byte
+}
+
+func NewReplayChainRouter(fixtures []*NodeFixture) *ReplayChainRouter {
+	responses := map[string][]byte{}
+	for _, fixture := range fixtures {
+		responses[fixture.key()] = fixture.GetResponse()
+	}
+	return &ReplayChainRouter{responses: responses}
+}
+
+func (rcr *ReplayChainRouter) SendNodeMsg(ctx context.Context, ch chan interface{}, chainMessage chainlib.ChainMessageForSend, extensions []string) (relayReply *pairingtypes.RelayReply, subscriptionID string, relayReplyServer *rpcclient.ClientSubscription, err error) {
+	if ch != nil {
+		return nil, "", nil, utils.LavaFormatDebug("subscriptions can't be replayed")
+	}
+	url, data, err := rawRequest(chainMessage)
+	if err != nil {
+		return nil, "", nil, err
+	}
+	response, ok := rcr.responses[fixtureKey(chainMessage.GetApiCollection().CollectionData.Type, url, string(data))]
+	if !ok {
+		return nil, "", nil, FixtureNotFoundError.Wrapf("api %s url %s data %s", chainMessage.GetApi().Name, url, string(data))
+	}
+	return &pairingtypes.RelayReply{Data: response}, "", nil, nil
+}
+
+func (rcr *ReplayChainRouter) ExtensionsSupported(extensions []string) bool {
+	return true
+}
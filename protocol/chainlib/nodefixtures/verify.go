@@ -0,0 +1,221 @@
+package nodefixtures
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/lavanet/lava/protocol/chainlib"
+	"github.com/lavanet/lava/protocol/lavasession"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	spectypes "github.com/lavanet/lava/x/spec/types"
+)
+
+const (
+	ResultPassed  = "passed"
+	ResultFailed  = "failed"
+	ResultMissing = "missing" // no recorded fixture to verify with
+)
+
+type ApiResult struct {
+	ApiName        string `json:"api_name"`
+	ConnectionType string `json:"connection_type"`
+	Url            string `json:"url,omitempty"`
+	Data           string `json:"data,omitempty"`
+	ExpectedBlock  int64  `json:"expected_block"`
+	ParsedBlock    int64  `json:"parsed_block"`
+	Result         string `json:"result"`
+	Error          string `json:"error,omitempty"`
+}
+
+type VerificationResult struct {
+	Name      string `json:"name"`
+	Addon     string `json:"addon,omitempty"`
+	Extension string `json:"extension,omitempty"`
+	Result    string `json:"result"`
+	Error     string `json:"error,omitempty"`
+}
+
+type TagResult struct {
+	Tag    string `json:"tag"`
+	Result string `json:"result"`
+	Value  string `json:"value,omitempty"`
+	Error  string `json:"error,omitempty"`
+}
+
+// Report is the result of replaying the fixtures of a chain against its spec
+type Report struct {
+	ChainID       string               `json:"chain_id"`
+	ApiInterface  string               `json:"api_interface"`
+	LatestBlock   int64                `json:"latest_block"`
+	Tags          []TagResult          `json:"tags"`
+	Apis          []ApiResult          `json:"apis"`
+	Verifications []VerificationResult `json:"verifications"`
+}
+
+// Failed returns true if any api parsed the wrong block or any tag or verification failed, missing fixtures are not failures
+func (r *Report) Failed() bool {
+	for _, tag := range r.Tags {
+		if tag.Result == ResultFailed {
+			return true
+		}
+	}
+	for _, api := range r.Apis {
+		if api.Result == ResultFailed {
+			return true
+		}
+	}
+	for _, verification := range r.Verifications {
+		if verification.Result == ResultFailed {
+			return true
+		}
+	}
+	return false
+}
+
+func errorString(err error) string {
+	if err == nil {
+		return ""
+	}
+	return err.Error()
+}
+
+// VerifySpecFixtures replays recorded node fixtures through the chain parser and chain fetcher of the spec:
+// every consumer request is parsed again and its requested block compared with the recorded one,
+// and the chain fetcher tags and the spec verifications are run against the recorded responses.
+// spec must be expanded
+func VerifySpecFixtures(ctx context.Context, spec spectypes.Spec, apiInterface string, fixtures []*NodeFixture) (*Report, error) {
+	if err := supportedApiInterface(apiInterface); err != nil {
+		return nil, err
+	}
+	chainParser, err := chainlib.NewChainParser(apiInterface)
+	if err != nil {
+		return nil, err
+	}
+	chainParser.SetSpec(spec)
+	endpoint := &lavasession.RPCProviderEndpoint{ChainID: spec.Index, ApiInterface: apiInterface}
+	chainFetcher := chainlib.NewChainFetcher(ctx, NewReplayChainRouter(fixtures), chainParser, endpoint, nil)
+	chainParser.SetBlockHashResolver(newSyncBlockHashResolver(ctx, chainFetcher))
+	report := &Report{ChainID: spec.Index, ApiInterface: apiInterface}
+
+	latestBlock, err := chainFetcher.FetchLatestBlockNum(ctx)
+	report.Tags = append(report.Tags, newTagResult(spectypes.FUNCTION_TAG_GET_BLOCKNUM, fmt.Sprintf("%d", latestBlock), err))
+	if err == nil {
+		report.LatestBlock = latestBlock
+		blockHash, err := chainFetcher.FetchBlockHashByNum(ctx, latestBlock)
+		report.Tags = append(report.Tags, newTagResult(spectypes.FUNCTION_TAG_GET_BLOCK_BY_NUM, blockHash, err))
+	}
+
+	for _, fixture := range fixtures {
+		if fixture.Fetcher || fixture.ApiInterface != apiInterface {
+			continue
+		}
+		report.Apis = append(report.Apis, verifyApiFixture(chainParser, fixture, latestBlock))
+	}
+
+	// verifications of extensions are returned only when the extension is requested, so they are fetched separately from the base ones
+	addons, extensions := spec.ServicesMap()
+	supportedAddons := []string{}
+	for addon := range addons {
+		if addon != "" {
+			supportedAddons = append(supportedAddons, addon)
+		}
+	}
+	sort.Strings(supportedAddons)
+	supportedExtensions := []string{""}
+	for extension := range extensions {
+		supportedExtensions = append(supportedExtensions, extension)
+	}
+	sort.Strings(supportedExtensions)
+	verifications := []chainlib.VerificationContainer{}
+	for _, extension := range supportedExtensions {
+		supported := supportedAddons
+		if extension != "" {
+			supported = append(append([]string{}, supportedAddons...), extension)
+		}
+		extensionVerifications, err := chainParser.GetVerifications(supported)
+		if err != nil {
+			return nil, err
+		}
+		verifications = append(verifications, extensionVerifications...)
+	}
+	for _, verification := range verifications {
+		result := VerificationResult{Name: verification.Name, Addon: verification.Addon, Extension: verification.Extension, Result: ResultPassed}
+		err := chainFetcher.Verify(ctx, verification, uint64(report.LatestBlock))
+		if FixtureNotFoundError.Is(err) {
+			result.Result = ResultMissing
+		} else if err != nil {
+			result.Result = ResultFailed
+		}
+		result.Error = errorString(err)
+		report.Verifications = append(report.Verifications, result)
+	}
+	return report, nil
+}
+
+// syncBlockHashResolver fetches requested block hashes while parsing, fixtures are recorded and replayed one request at a time
+// so unlike the consumer and provider resolvers there's no need to fetch them in the background
+type syncBlockHashResolver struct {
+	ctx      context.Context
+	fetcher  chainlib.BlockNumByHashFetcher
+	fetching bool // the fetch request is parsed by the chain parser as well so it must not be resolved again
+}
+
+func newSyncBlockHashResolver(ctx context.Context, fetcher chainlib.BlockNumByHashFetcher) *syncBlockHashResolver {
+	return &syncBlockHashResolver{ctx: ctx, fetcher: fetcher}
+}
+
+func (sbhr *syncBlockHashResolver) ResolveBlockHash(blockHash string) (int64, bool) {
+	if sbhr.fetching {
+		return spectypes.NOT_APPLICABLE, false
+	}
+	sbhr.fetching = true
+	defer func() { sbhr.fetching = false }()
+	block, err := sbhr.fetcher.FetchBlockNumByHash(sbhr.ctx, blockHash)
+	if err != nil {
+		return spectypes.NOT_APPLICABLE, false
+	}
+	return block, true
+}
+
+func newTagResult(tag spectypes.FUNCTION_TAG, value string, err error) TagResult {
+	if FixtureNotFoundError.Is(err) {
+		return TagResult{Tag: tag.String(), Result: ResultMissing, Error: err.Error()}
+	}
+	if err != nil {
+		return TagResult{Tag: tag.String(), Result: ResultFailed, Error: err.Error()}
+	}
+	return TagResult{Tag: tag.String(), Result: ResultPassed, Value: value}
+}
+
+func verifyApiFixture(chainParser chainlib.ChainParser, fixture *NodeFixture, latestBlock int64) ApiResult {
+	result := ApiResult{
+		ApiName:        fixture.ApiName,
+		ConnectionType: fixture.ConnectionType,
+		Url:            fixture.Url,
+		Data:           fixture.Data,
+		ExpectedBlock:  fixture.RequestedBlock,
+		Result:         ResultFailed,
+	}
+	var data []byte
+	if fixture.Data != "" {
+		data = []byte(fixture.Data)
+	}
+	chainMessage, err := chainParser.ParseMsg(fixture.Url, data, fixture.ConnectionType, nil, uint64(latestBlock))
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+	result.ParsedBlock, _ = chainMessage.RequestedBlock()
+	if result.ParsedBlock != result.ExpectedBlock {
+		result.Error = fmt.Sprintf("parsed block %d, expected %d", result.ParsedBlock, result.ExpectedBlock)
+		return result
+	}
+	_, err = chainlib.FormatResponseForParsing(&pairingtypes.RelayReply{Data: fixture.GetResponse()}, chainMessage)
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+	result.Result = ResultPassed
+	return result
+}
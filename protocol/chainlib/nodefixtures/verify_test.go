@@ -0,0 +1,168 @@
+package nodefixtures
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/lavanet/lava/protocol/chainlib"
+	"github.com/lavanet/lava/protocol/chainlib/chainproxy/rpcInterfaceMessages"
+	"github.com/lavanet/lava/protocol/chainlib/chainproxy/rpcclient"
+	"github.com/lavanet/lava/protocol/lavasession"
+	keepertest "github.com/lavanet/lava/testutil/keeper"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	spectypes "github.com/lavanet/lava/x/spec/types"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testLatestBlock = 0x1000
+	testBlockHash   = "0x88e96d4537bea4d9c05d12549907b32561d3bf31f45aae734cdc119f13406cb6"
+)
+
+// mockNodeRouter answers ethereum jsonrpc requests with canned responses
+type mockNodeRouter struct{}
+
+func (mockNodeRouter) SendNodeMsg(ctx context.Context, ch chan interface{}, chainMessage chainlib.ChainMessageForSend, extensions []string) (*pairingtypes.RelayReply, string, *rpcclient.ClientSubscription, error) {
+	msg, ok := chainMessage.GetRPCMessage().(*rpcInterfaceMessages.JsonrpcMessage)
+	if !ok {
+		return nil, "", nil, fmt.Errorf("unexpected message %T", chainMessage.GetRPCMessage())
+	}
+	var result interface{}
+	switch msg.Method {
+	case "eth_blockNumber":
+		result = fmt.Sprintf("0x%x", testLatestBlock)
+	case "eth_chainId":
+		result = "0x1"
+	case "eth_getBlockByNumber", "eth_getBlockByHash":
+		number := fmt.Sprintf("0x%x", testLatestBlock-10)
+		if params, ok := msg.Params.([]interface{}); ok && len(params) > 0 && params[0] == "earliest" {
+			number = "0x0"
+		}
+		result = map[string]interface{}{"hash": testBlockHash, "number": number}
+	case "eth_getBalance", "debug_getRawHeader":
+		result = "0x10"
+	default:
+		return nil, "", nil, fmt.Errorf("unexpected method %s", msg.Method)
+	}
+	data, err := json.Marshal(map[string]interface{}{"jsonrpc": "2.0", "id": msg.ID, "result": result})
+	return &pairingtypes.RelayReply{Data: data}, "", nil, err
+}
+
+func (mockNodeRouter) ExtensionsSupported([]string) bool {
+	return true
+}
+
+// recordFixtures sends the chain fetcher requests and the relays to the mock node through a recording router
+func recordFixtures(t *testing.T, spec spectypes.Spec, dir string, relays []string) []*NodeFixture {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	chainParser, err := chainlib.NewChainParser(spectypes.APIInterfaceJsonRPC)
+	require.NoError(t, err)
+	chainParser.SetSpec(spec)
+	router, err := NewRecordingChainRouter(ctx, mockNodeRouter{}, dir, spec.Index, spectypes.APIInterfaceJsonRPC)
+	require.NoError(t, err)
+	endpoint := &lavasession.RPCProviderEndpoint{ChainID: spec.Index, ApiInterface: spectypes.APIInterfaceJsonRPC}
+	chainFetcher := chainlib.NewChainFetcher(ctx, router, chainParser, endpoint, nil)
+	chainParser.SetBlockHashResolver(newSyncBlockHashResolver(ctx, chainFetcher))
+
+	latestBlock, err := chainFetcher.FetchLatestBlockNum(ctx)
+	require.NoError(t, err)
+	_, err = chainFetcher.FetchBlockHashByNum(ctx, latestBlock)
+	require.NoError(t, err)
+	for _, supported := range [][]string{{"debug"}, {"debug", "archive"}} {
+		verifications, err := chainParser.GetVerifications(supported)
+		require.NoError(t, err)
+		for _, verification := range verifications {
+			require.NoError(t, chainFetcher.Verify(ctx, verification, uint64(latestBlock)))
+		}
+	}
+	for _, relay := range relays {
+		chainMessage, err := chainParser.ParseMsg("", []byte(relay), http.MethodPost, nil, uint64(latestBlock))
+		require.NoError(t, err)
+		_, _, _, err = router.SendNodeMsg(ctx, nil, chainMessage, nil)
+		require.NoError(t, err)
+	}
+	fixtures, err := ReadFixtures(FixturesFileName(dir, spec.Index, spectypes.APIInterfaceJsonRPC))
+	require.NoError(t, err)
+	return fixtures
+}
+
+func TestVerifySpecFixtures(t *testing.T) {
+	spec, err := keepertest.GetASpec("ETH1", "../../../", nil, nil)
+	require.NoError(t, err)
+	relays := []string{
+		`{"jsonrpc":"2.0","method":"eth_getBalance","params":["0x1f9090aae28b8a3dceadf281b0f12828e676c326","0x10"],"id":7}`,
+		`{"jsonrpc":"2.0","method":"eth_getBlockByNumber","params":["latest",false],"id":8}`,
+		`{"jsonrpc":"2.0","method":"eth_getBlockByHash","params":["` + testBlockHash + `",false],"id":9}`,
+	}
+	fixtures := recordFixtures(t, spec, t.TempDir(), relays)
+	requestedBlocks := map[string]int64{}
+	for _, fixture := range fixtures {
+		if !fixture.Fetcher {
+			requestedBlocks[fixture.ApiName] = fixture.RequestedBlock
+		}
+	}
+	require.Equal(t, map[string]int64{"eth_getBalance": 0x10, "eth_getBlockByNumber": spectypes.LATEST_BLOCK, "eth_getBlockByHash": testLatestBlock - 10}, requestedBlocks)
+
+	ctx := context.Background()
+	report, err := VerifySpecFixtures(ctx, spec, spectypes.APIInterfaceJsonRPC, fixtures)
+	require.NoError(t, err)
+	require.False(t, report.Failed(), report)
+	require.Equal(t, int64(testLatestBlock), report.LatestBlock)
+	require.Len(t, report.Apis, len(relays))
+	require.NotEmpty(t, report.Verifications)
+	for _, verification := range report.Verifications {
+		require.Equal(t, ResultPassed, verification.Result, verification)
+	}
+
+	t.Run("wrong block", func(t *testing.T) {
+		tampered := []*NodeFixture{}
+		for _, fixture := range fixtures {
+			fixtureCopy := *fixture
+			if fixtureCopy.ApiName == "eth_getBalance" {
+				fixtureCopy.RequestedBlock = 0x11
+			}
+			tampered = append(tampered, &fixtureCopy)
+		}
+		report, err := VerifySpecFixtures(ctx, spec, spectypes.APIInterfaceJsonRPC, tampered)
+		require.NoError(t, err)
+		require.True(t, report.Failed())
+		for _, api := range report.Apis {
+			if api.ApiName == "eth_getBalance" {
+				require.Equal(t, ResultFailed, api.Result)
+				require.Equal(t, int64(0x10), api.ParsedBlock)
+			} else {
+				require.Equal(t, ResultPassed, api.Result, api)
+			}
+		}
+	})
+
+	t.Run("failed verification", func(t *testing.T) {
+		tampered := []*NodeFixture{}
+		for _, fixture := range fixtures {
+			fixtureCopy := *fixture
+			if fixtureCopy.ApiName == "eth_chainId" {
+				fixtureCopy.SetResponse([]byte(`{"jsonrpc":"2.0","id":1,"result":"0x5"}`))
+			}
+			tampered = append(tampered, &fixtureCopy)
+		}
+		report, err := VerifySpecFixtures(ctx, spec, spectypes.APIInterfaceJsonRPC, tampered)
+		require.NoError(t, err)
+		require.True(t, report.Failed(), report)
+	})
+
+	t.Run("missing fixtures", func(t *testing.T) {
+		report, err := VerifySpecFixtures(ctx, spec, spectypes.APIInterfaceJsonRPC, nil)
+		require.NoError(t, err)
+		require.False(t, report.Failed())
+		for _, verification := range report.Verifications {
+			require.Equal(t, ResultMissing, verification.Result)
+		}
+	})
+
+	_, err = VerifySpecFixtures(ctx, spec, spectypes.APIInterfaceGrpc, fixtures)
+	require.Error(t, err)
+}
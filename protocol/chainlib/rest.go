@@ -13,7 +13,6 @@ import (
 	"github.com/lavanet/lava/protocol/chainlib/chainproxy/rpcInterfaceMessages"
 	"github.com/lavanet/lava/protocol/chainlib/chainproxy/rpcclient"
 	"github.com/lavanet/lava/protocol/lavasession"
-	"github.com/lavanet/lava/protocol/parser"
 	"github.com/lavanet/lava/utils"
 
 	pairingtypes "github.com/lavanet/lava/x/pairing/types"
@@ -27,6 +26,7 @@ import (
 
 type RestChainParser struct {
 	BaseChainParser
+	apiMatcher *restApiMatcher
 }
 
 // NewRestChainParser creates a new instance of RestChainParser
@@ -102,9 +102,10 @@ func (apip *RestChainParser) ParseMsg(url string, data []byte, connectionType st
 	// add spec path to rest message so we can extract the requested block.
 	restMessage.SpecPath = apiCont.api.Name
 	var requestedBlock int64
+	var requestedBlockHash blockHashResolution
 	if overwriteReqBlock == "" {
 		// Fetch requested block, it is used for data reliability
-		requestedBlock, err = parser.ParseBlockFromParams(restMessage, blockParser)
+		requestedBlock, requestedBlockHash, err = apip.parseBlockFromParams(restMessage, blockParser)
 		if err != nil {
 			return nil, utils.LavaFormatError("ParseBlockFromParams failed parsing block", err, utils.Attribute{Key: "chain", Value: apip.spec.Name}, utils.Attribute{Key: "blockParsing", Value: apiCont.api.BlockParsing})
 		}
@@ -116,6 +117,7 @@ func (apip *RestChainParser) ParseMsg(url string, data []byte, connectionType st
 	}
 
 	nodeMsg := apip.newChainMessage(apiCont.api, requestedBlock, &restMessage, apiCollection)
+	nodeMsg.requestedBlockHash = requestedBlockHash
 	apip.BaseChainParser.ExtensionParsing(apiCollection.CollectionData.AddOn, nodeMsg, latestBlock)
 	return nodeMsg, nil
 }
@@ -148,8 +150,14 @@ func (apip *RestChainParser) getSupportedApi(name, connectionType string) (*ApiC
 	apip.rwLock.RLock()
 	defer apip.rwLock.RUnlock()
 
-	// Fetch server apiCont by name
-	apiCont, ok := matchSpecApiByName(name, connectionType, apip.serverApis)
+	// Fetch server apiCont by name, parsers that weren't set with a spec have no compiled matcher
+	var apiCont *ApiContainer
+	var ok bool
+	if apip.apiMatcher != nil {
+		apiCont, ok = apip.apiMatcher.match(name, connectionType)
+	} else {
+		apiCont, ok = matchSpecApiByName(name, connectionType, apip.serverApis)
+	}
 
 	// Return an error if spec does not exist
 	if !ok {
@@ -179,6 +187,7 @@ func (apip *RestChainParser) SetSpec(spec spectypes.Spec) {
 	// extract server and tagged apis from spec
 	serverApis, taggedApis, apiCollections, headers, verifications := getServiceApis(spec, spectypes.APIInterfaceRest)
 	apip.BaseChainParser.Construct(spec, taggedApis, serverApis, apiCollections, headers, verifications)
+	apip.apiMatcher = newRestApiMatcher(serverApis)
 }
 
 // DataReliabilityParams returns data reliability params from spec (spec.enabled and spec.dataReliabilityThreshold)
@@ -344,7 +353,7 @@ func (apil *RestChainListener) Serve(ctx context.Context) {
 	})
 
 	// Go
-	ListenWithRetry(app, apil.endpoint.NetworkAddress)
+	ListenWithRetry(ctx, app, apil.endpoint.NetworkAddress)
 }
 
 func addHeadersAndSendString(c *fiber.Ctx, metaData []pairingtypes.Metadata, data string) error {
@@ -19,7 +19,6 @@ import (
 	"github.com/lavanet/lava/protocol/common"
 	"github.com/lavanet/lava/protocol/lavasession"
 	"github.com/lavanet/lava/protocol/metrics"
-	"github.com/lavanet/lava/protocol/parser"
 	"github.com/lavanet/lava/utils"
 	pairingtypes "github.com/lavanet/lava/x/pairing/types"
 	spectypes "github.com/lavanet/lava/x/spec/types"
@@ -134,6 +133,7 @@ func (apip *TendermintChainParser) ParseMsg(url string, data []byte, connectionT
 	var api *spectypes.Api
 	var apiCollection *spectypes.ApiCollection
 	var latestRequestedBlock, earliestRequestedBlock int64 = 0, 0
+	var requestedBlockHash blockHashResolution
 	for idx, msg := range msgs {
 		var requestedBlockForMessage int64
 		// Check api is supported and save it in nodeMsg
@@ -153,7 +153,7 @@ func (apip *TendermintChainParser) ParseMsg(url string, data []byte, connectionT
 
 		if overwriteReqBlock == "" {
 			// Fetch requested block, it is used for data reliability
-			requestedBlockForMessage, err = parser.ParseBlockFromParams(msg, apiCont.api.BlockParsing)
+			requestedBlockForMessage, requestedBlockHash, err = apip.parseBlockFromParams(msg, apiCont.api.BlockParsing)
 			if err != nil {
 				return nil, utils.LavaFormatError("ParseBlockFromParams failed parsing block", err, utils.Attribute{Key: "chain", Value: apip.spec.Name}, utils.Attribute{Key: "blockParsing", Value: apiCont.api.BlockParsing})
 			}
@@ -213,6 +213,7 @@ func (apip *TendermintChainParser) ParseMsg(url string, data []byte, connectionT
 			tenderMsg.Path = url // add path
 		}
 		nodeMsg = apip.newChainMessage(api, latestRequestedBlock, &tenderMsg, apiCollection)
+		nodeMsg.requestedBlockHash = requestedBlockHash // a batch combines the requested blocks so only a single message follows the block hash
 	} else {
 		var err error
 		nodeMsg, err = apip.newBatchChainMessage(api, latestRequestedBlock, earliestRequestedBlock, msgs, apiCollection)
@@ -499,7 +500,7 @@ func (apil *TendermintRpcChainListener) Serve(ctx context.Context) {
 	})
 	//
 	// Go
-	ListenWithRetry(app, apil.endpoint.NetworkAddress)
+	ListenWithRetry(ctx, app, apil.endpoint.NetworkAddress)
 }
 
 type tendermintRpcChainProxy struct {
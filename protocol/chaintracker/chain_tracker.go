@@ -28,6 +28,10 @@ const (
 	initRetriesCount = 4
 	BACKOFF_MAX_TIME = 10 * time.Minute
 	maxFails         = 10
+	// how long to wait before subscribing to new blocks again after a subscription ended or failed
+	subscriptionRetryInterval = time.Minute
+	// how many notifications a block subscriber can fall behind before it is disconnected
+	blockNotificationsBuffer = 100
 )
 
 type ChainFetcher interface {
@@ -36,6 +40,12 @@ type ChainFetcher interface {
 	FetchEndpoint() lavasession.RPCProviderEndpoint
 }
 
+// BlockSubscriber is implemented by chain fetchers that can push new blocks from the node instead of being polled,
+// the returned channel is notified on new blocks and closed when the subscription ends
+type BlockSubscriber interface {
+	SubscribeNewBlocks(ctx context.Context) (<-chan struct{}, error)
+}
+
 type ChainTracker struct {
 	chainFetcher            ChainFetcher // used to communicate with the node
 	blocksToSave            uint64       // how many finalized blocks to keep
@@ -46,9 +56,14 @@ type ChainTracker struct {
 	newLatestCallback       func(int64, string) // a function to be called when a new block is detected
 	serverBlockMemory       uint64
 	endpoint                lavasession.RPCProviderEndpoint
-	blockCheckpointDistance uint64 // used to do something every X blocks
-	blockCheckpoint         uint64 // last time checkpoint was met
-	ticker                  *time.Ticker
+	blockCheckpointDistance uint64       // used to do something every X blocks
+	blockCheckpoint         uint64       // last time checkpoint was met
+	reorgCallback           func(*Reorg) // a function to be called when saved blocks were replaced by a reorg
+	reorgHistoryMu          sync.RWMutex
+	reorgHistory            []*Reorg // the latest reorgs, oldest first
+	reorgHistorySize        uint64
+	blockSubscribersMu      sync.Mutex
+	blockSubscribers        map[chan *BlockNotification]struct{} // notified on new blocks and forks
 }
 
 // this function returns block hashes of the blocks: [from block - to block] inclusive. an additional specific block hash can be provided. order is sorted ascending
@@ -84,6 +99,18 @@ func (cs *ChainTracker) GetLatestBlockData(fromBlock, toBlock, specificBlock int
 	return
 }
 
+// GetBlockNumByHash returns the block number of a hash saved in the tracked blocks, and false if the hash isn't saved
+func (cs *ChainTracker) GetBlockNumByHash(blockHash string) (int64, bool) {
+	cs.blockQueueMu.RLock()
+	defer cs.blockQueueMu.RUnlock()
+	for idx := len(cs.blocksQueue) - 1; idx >= 0; idx-- {
+		if cs.blocksQueue[idx].Hash == blockHash {
+			return cs.blocksQueue[idx].Block, true
+		}
+	}
+	return 0, false
+}
+
 // blockQueueMu must be locked
 func (cs *ChainTracker) getEarliestBlockUnsafe() BlockStore {
 	return cs.blocksQueue[0]
@@ -131,7 +158,10 @@ func (cs *ChainTracker) fetchAllPreviousBlocks(ctx context.Context, latestBlock
 		return "", err
 	}
 	blocksCopied := int64(cs.blocksToSave)
-	blocksCopied, blocksQueueLen, latestHash := cs.replaceBlocksQueue(latestBlock, newQueueStartIndex, blocksQueueStartIndex, blocksQueueEndIndex, newBlocksQueue, blocksCopied)
+	blocksCopied, blocksQueueLen, latestHash, reorg := cs.replaceBlocksQueue(latestBlock, newQueueStartIndex, blocksQueueStartIndex, blocksQueueEndIndex, newBlocksQueue, blocksCopied)
+	if reorg != nil {
+		cs.addReorg(reorg)
+	}
 	if blocksQueueLen < cs.blocksToSave {
 		return "", utils.LavaFormatError("fetchAllPreviousBlocks didn't save enough blocks in Chain Tracker", nil, utils.Attribute{Key: "blocksQueueLen", Value: blocksQueueLen})
 	}
@@ -143,21 +173,71 @@ func (cs *ChainTracker) fetchAllPreviousBlocks(ctx context.Context, latestBlock
 	return latestHash, nil
 }
 
-func (cs *ChainTracker) replaceBlocksQueue(latestBlock, newQueueStartIndex, blocksQueueStartIndex, blocksQueueEndIndex int64, newBlocksQueue []BlockStore, blocksCopied int64) (int64, uint64, string) {
+func (cs *ChainTracker) replaceBlocksQueue(latestBlock, newQueueStartIndex, blocksQueueStartIndex, blocksQueueEndIndex int64, newBlocksQueue []BlockStore, blocksCopied int64) (int64, uint64, string, *Reorg) {
 	cs.blockQueueMu.Lock()
 	defer cs.blockQueueMu.Unlock()
 	cs.setLatestBlockNum(latestBlock)
+	var reorg *Reorg
 	if newQueueStartIndex > 0 {
+		reorg = cs.findReorgUnsafe(latestBlock, newBlocksQueue[newQueueStartIndex:])
 		// means we copy previous blocks
 		cs.blocksQueue = append(cs.blocksQueue[blocksQueueStartIndex:blocksQueueEndIndex], newBlocksQueue[newQueueStartIndex:]...)
 		blocksCopied = blocksQueueEndIndex - blocksQueueStartIndex
 	} else {
 		// this should only happens if we lost connection for a really long time and readIndexDiff is big, or there was a bigger fork than memory
+		reorg = cs.findReorgUnsafe(latestBlock, newBlocksQueue)
 		cs.blocksQueue = newBlocksQueue
 	}
 	blocksQueueLen := uint64(len(cs.blocksQueue))
 	latestHash := cs.getLatestBlockUnsafe().Hash
-	return blocksCopied, blocksQueueLen, latestHash
+	return blocksCopied, blocksQueueLen, latestHash, reorg
+}
+
+// this function compares the saved blocks to the blocks replacing them, it returns the reorg if any saved hash was replaced by a different one or nil otherwise
+// blockQueueMu must be locked
+func (cs *ChainTracker) findReorgUnsafe(latestBlock int64, newBlocks []BlockStore) *Reorg {
+	savedHashes := make(map[int64]string, len(cs.blocksQueue))
+	for _, blockStore := range cs.blocksQueue {
+		savedHashes[blockStore.Block] = blockStore.Hash
+	}
+	reorg := &Reorg{Block: latestBlock}
+	for _, blockStore := range newBlocks {
+		savedHash, ok := savedHashes[blockStore.Block]
+		if !ok || savedHash == blockStore.Hash {
+			continue
+		}
+		reorg.OldHashes = append(reorg.OldHashes, &BlockStore{Block: blockStore.Block, Hash: savedHash})
+		reorg.NewHashes = append(reorg.NewHashes, &BlockStore{Block: blockStore.Block, Hash: blockStore.Hash})
+	}
+	if len(reorg.NewHashes) == 0 {
+		return nil
+	}
+	reorg.Depth = uint64(len(reorg.NewHashes))
+	reorg.Timestamp = time.Now().Unix()
+	return reorg
+}
+
+// this function saves a detected reorg in the history, dropping the oldest reorgs once the history is full
+func (cs *ChainTracker) addReorg(reorg *Reorg) {
+	utils.LavaFormatInfo("Chain Tracker detected a reorg", utils.Attribute{Key: "block", Value: reorg.Block}, utils.Attribute{Key: "depth", Value: reorg.Depth}, utils.Attribute{Key: "oldHashes", Value: reorg.OldHashes}, utils.Attribute{Key: "newHashes", Value: reorg.NewHashes}, utils.Attribute{Key: "ChainID", Value: cs.endpoint.ChainID}, utils.Attribute{Key: "ApiInterface", Value: cs.endpoint.ApiInterface})
+	cs.reorgHistoryMu.Lock()
+	cs.reorgHistory = append(cs.reorgHistory, reorg)
+	if uint64(len(cs.reorgHistory)) > cs.reorgHistorySize {
+		cs.reorgHistory = cs.reorgHistory[uint64(len(cs.reorgHistory))-cs.reorgHistorySize:]
+	}
+	cs.reorgHistoryMu.Unlock()
+	if cs.reorgCallback != nil {
+		cs.reorgCallback(reorg)
+	}
+}
+
+// GetReorgHistory returns the latest reorgs detected, oldest first
+func (cs *ChainTracker) GetReorgHistory() []*Reorg {
+	cs.reorgHistoryMu.RLock()
+	defer cs.reorgHistoryMu.RUnlock()
+	reorgs := make([]*Reorg, len(cs.reorgHistory))
+	copy(reorgs, cs.reorgHistory)
+	return reorgs
 }
 
 func (cs *ChainTracker) readHashes(latestBlock int64, ctx context.Context, blocksQueueStartIndex, blocksQueueEndIndex, newQueueStartIndex, readIndexDiff int64, newBlocksQueue []BlockStore) (int64, int64, int64, error) {
@@ -269,58 +349,173 @@ func (cs *ChainTracker) fetchAllPreviousBlocksIfNecessary(ctx context.Context) (
 					cs.newLatestCallback(i, latestHash) // TODO: this is calling the latest hash only repeatedly, this is not precise, currently not used anywhere except for prints
 				}
 			}
+			cs.notifyNewBlocks(prev_latest, newLatestBlock)
 		}
 		if forked {
 			if cs.forkCallback != nil {
 				cs.forkCallback(newLatestBlock)
 			}
+			cs.notifyBlockSubscribers(&BlockNotification{Block: &BlockStore{Block: newLatestBlock, Hash: latestHash}, Forked: true})
 		}
 	}
 	return err
 }
 
-// this function starts the fetching timer periodically checking by polling if updates are necessary
+// SubscribeBlocks returns a channel notified on every new latest block and on forks, the channel is closed when the context is done
+// or when the subscriber falls behind by more than blockNotificationsBuffer notifications
+func (cs *ChainTracker) SubscribeBlocks(ctx context.Context) <-chan *BlockNotification {
+	notifications := make(chan *BlockNotification, blockNotificationsBuffer)
+	cs.blockSubscribersMu.Lock()
+	if cs.blockSubscribers == nil {
+		cs.blockSubscribers = map[chan *BlockNotification]struct{}{}
+	}
+	cs.blockSubscribers[notifications] = struct{}{}
+	cs.blockSubscribersMu.Unlock()
+	go func() {
+		<-ctx.Done()
+		cs.blockSubscribersMu.Lock()
+		defer cs.blockSubscribersMu.Unlock()
+		if _, ok := cs.blockSubscribers[notifications]; ok {
+			delete(cs.blockSubscribers, notifications)
+			close(notifications)
+		}
+	}()
+	return notifications
+}
+
+func (cs *ChainTracker) notifyBlockSubscribers(notification *BlockNotification) {
+	cs.blockSubscribersMu.Lock()
+	defer cs.blockSubscribersMu.Unlock()
+	for notifications := range cs.blockSubscribers {
+		select {
+		case notifications <- notification:
+		default:
+			utils.LavaFormatWarning("block subscriber is too slow, disconnecting it", nil, utils.Attribute{Key: "block", Value: notification.Block.Block}, utils.Attribute{Key: "endpoint", Value: cs.endpoint.String()})
+			delete(cs.blockSubscribers, notifications)
+			close(notifications)
+		}
+	}
+}
+
+// this function notifies the subscribers on every block after prevLatest up to newLatest, blocks that are no longer saved are notified without a hash
+func (cs *ChainTracker) notifyNewBlocks(prevLatest, newLatest int64) {
+	cs.blockSubscribersMu.Lock()
+	subscribers := len(cs.blockSubscribers)
+	cs.blockSubscribersMu.Unlock()
+	if subscribers == 0 {
+		return
+	}
+	notifications := []*BlockNotification{}
+	cs.blockQueueMu.RLock()
+	for block := prevLatest + 1; block <= newLatest; block++ {
+		blockStore := &BlockStore{Block: block}
+		if len(cs.blocksQueue) > 0 {
+			// the blocks queue is sorted and has no gaps
+			blocksQueueIdx := block - cs.getEarliestBlockUnsafe().Block
+			if blocksQueueIdx >= 0 && blocksQueueIdx < int64(len(cs.blocksQueue)) {
+				blockStore.Hash = cs.blocksQueue[blocksQueueIdx].Hash
+			}
+		}
+		notifications = append(notifications, &BlockNotification{Block: blockStore})
+	}
+	cs.blockQueueMu.RUnlock()
+	for _, notification := range notifications {
+		cs.notifyBlockSubscribers(notification)
+	}
+}
+
+// this function fetches the initial data and starts tracking new blocks, by node subscription if supported or by polling
 func (cs *ChainTracker) start(ctx context.Context, pollingBlockTime time.Duration) error {
-	// how often to query latest block.
-	// TODO: improve the polling time, we don't need to poll the first half of every block change
-	tickerTime := pollingBlockTime / 10
-	cs.ticker = time.NewTicker(tickerTime) // divide here so we don't miss new blocks by all that much
 	err := cs.fetchInitDataWithRetry(ctx)
 	if err != nil {
 		return err
 	}
-	// Polls blocks and keeps a queue of them
-	go func() {
-		fetchFails := uint64(0)
-		for {
-			select {
-			case <-cs.ticker.C:
-				err := cs.fetchAllPreviousBlocksIfNecessary(ctx)
-				if err != nil {
-					fetchFails += 1
-					cs.updateTicker(tickerTime, fetchFails)
-					if fetchFails > maxFails {
-						utils.LavaFormatError("failed to fetch all previous blocks and was necessary", err, utils.Attribute{Key: "fetchFails", Value: fetchFails}, utils.Attribute{Key: "endpoint", Value: cs.endpoint.String()})
-					}
-				} else {
-					if fetchFails != 0 {
-						// means we had failures and they are gone, need to reset the ticker
-						cs.updateTicker(tickerTime, 0)
-					}
-					fetchFails = 0
-				}
-			case <-ctx.Done():
-				cs.ticker.Stop()
-				return
-			}
-		}
-	}()
+	go cs.track(ctx, pollingBlockTime)
 	return nil
 }
 
-func (cs *ChainTracker) updateTicker(tickerBaseTime time.Duration, fetchFails uint64) {
-	cs.ticker.Stop()
-	cs.ticker = time.NewTicker(exponentialBackoff(tickerBaseTime, fetchFails))
+// subscribeNewBlocks returns a channel notified on every new block, or nil if the chain fetcher can't push new blocks
+func (cs *ChainTracker) subscribeNewBlocks(ctx context.Context) <-chan struct{} {
+	blockSubscriber, ok := cs.chainFetcher.(BlockSubscriber)
+	if !ok {
+		return nil
+	}
+	newBlocks, err := blockSubscriber.SubscribeNewBlocks(ctx)
+	if err != nil {
+		utils.LavaFormatDebug("node doesn't support new block subscriptions, chain tracker is polling", utils.Attribute{Key: "endpoint", Value: cs.endpoint.String()}, utils.Attribute{Key: "error", Value: err})
+		return nil
+	}
+	utils.LavaFormatDebug("chain tracker subscribed to new blocks", utils.Attribute{Key: "endpoint", Value: cs.endpoint.String()})
+	return newBlocks
+}
+
+// track checks for new blocks and forks whenever the node notifies a new block, or by adaptive polling when there is no subscription.
+// a new block isn't expected in the first half of the block time so polling sleeps through it, after that it polls every averageBlockTime/10.
+// while subscribed it still polls once every averageBlockTime in case a notification was missed
+func (cs *ChainTracker) track(ctx context.Context, averageBlockTime time.Duration) {
+	pollingTime := averageBlockTime / 10
+	newBlocks := cs.subscribeNewBlocks(ctx)
+	var resubscribe <-chan time.Time
+	timer := time.NewTimer(pollingTime)
+	defer timer.Stop()
+	fetchFails := uint64(0)
+	awaitingBlock := false // a new block was notified and wasn't fetched yet
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-resubscribe:
+			resubscribe = nil
+			newBlocks = cs.subscribeNewBlocks(ctx)
+			if newBlocks == nil {
+				resubscribe = time.After(subscriptionRetryInterval)
+			}
+			continue
+		case _, ok := <-newBlocks:
+			if !ok {
+				utils.LavaFormatDebug("new blocks subscription ended, chain tracker is polling", utils.Attribute{Key: "endpoint", Value: cs.endpoint.String()})
+				newBlocks = nil
+				resubscribe = time.After(subscriptionRetryInterval)
+				continue
+			}
+			awaitingBlock = true
+		case <-timer.C:
+		}
+		prevLatest := cs.GetLatestBlockNum()
+		err := cs.fetchAllPreviousBlocksIfNecessary(ctx)
+		var nextCheck time.Duration
+		if err != nil {
+			fetchFails += 1
+			if fetchFails > maxFails {
+				utils.LavaFormatError("failed to fetch all previous blocks and was necessary", err, utils.Attribute{Key: "fetchFails", Value: fetchFails}, utils.Attribute{Key: "endpoint", Value: cs.endpoint.String()})
+			}
+			nextCheck = exponentialBackoff(pollingTime, fetchFails)
+		} else {
+			fetchFails = 0
+			gotNewBlock := cs.GetLatestBlockNum() > prevLatest
+			if gotNewBlock {
+				awaitingBlock = false
+			}
+			switch {
+			case awaitingBlock:
+				// notified but the node didn't serve the new block yet
+				nextCheck = pollingTime
+			case newBlocks != nil:
+				nextCheck = averageBlockTime
+			case gotNewBlock:
+				nextCheck = averageBlockTime / 2
+			default:
+				nextCheck = pollingTime
+			}
+		}
+		if !timer.Stop() {
+			select {
+			case <-timer.C:
+			default:
+			}
+		}
+		timer.Reset(nextCheck)
+	}
 }
 
 func (cs *ChainTracker) fetchInitDataWithRetry(ctx context.Context) (err error) {
@@ -414,7 +609,7 @@ func NewChainTracker(ctx context.Context, chainFetcher ChainFetcher, config Chai
 	if err != nil {
 		return nil, err
 	}
-	chainTracker = &ChainTracker{forkCallback: config.ForkCallback, newLatestCallback: config.NewLatestCallback, blocksToSave: config.BlocksToSave, chainFetcher: chainFetcher, latestBlockNum: 0, serverBlockMemory: config.ServerBlockMemory, blockCheckpointDistance: config.blocksCheckpointDistance}
+	chainTracker = &ChainTracker{forkCallback: config.ForkCallback, newLatestCallback: config.NewLatestCallback, blocksToSave: config.BlocksToSave, chainFetcher: chainFetcher, latestBlockNum: 0, serverBlockMemory: config.ServerBlockMemory, blockCheckpointDistance: config.blocksCheckpointDistance, reorgCallback: config.ReorgCallback, reorgHistorySize: config.ReorgHistorySize}
 	if chainFetcher == nil {
 		return nil, utils.LavaFormatError("can't start chainTracker with nil chainFetcher argument", nil)
 	}
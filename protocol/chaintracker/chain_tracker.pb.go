@@ -188,39 +188,224 @@ func (m *BlockStore) GetHash() string {
 	return ""
 }
 
+type Reorg struct {
+	Block     int64         `protobuf:"varint,1,opt,name=block,proto3" json:"block,omitempty"`
+	Depth     uint64        `protobuf:"varint,2,opt,name=depth,proto3" json:"depth,omitempty"`
+	OldHashes []*BlockStore `protobuf:"bytes,3,rep,name=oldHashes,proto3" json:"oldHashes,omitempty"`
+	NewHashes []*BlockStore `protobuf:"bytes,4,rep,name=newHashes,proto3" json:"newHashes,omitempty"`
+	Timestamp int64         `protobuf:"varint,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (m *Reorg) Reset()         { *m = Reorg{} }
+func (m *Reorg) String() string { return proto.CompactTextString(m) }
+func (*Reorg) ProtoMessage()    {}
+func (*Reorg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_90f7d15fc8a35cee, []int{3}
+}
+func (m *Reorg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Reorg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Reorg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Reorg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Reorg.Merge(m, src)
+}
+func (m *Reorg) XXX_Size() int {
+	return m.Size()
+}
+func (m *Reorg) XXX_DiscardUnknown() {
+	xxx_messageInfo_Reorg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Reorg proto.InternalMessageInfo
+
+func (m *Reorg) GetBlock() int64 {
+	if m != nil {
+		return m.Block
+	}
+	return 0
+}
+
+func (m *Reorg) GetDepth() uint64 {
+	if m != nil {
+		return m.Depth
+	}
+	return 0
+}
+
+func (m *Reorg) GetOldHashes() []*BlockStore {
+	if m != nil {
+		return m.OldHashes
+	}
+	return nil
+}
+
+func (m *Reorg) GetNewHashes() []*BlockStore {
+	if m != nil {
+		return m.NewHashes
+	}
+	return nil
+}
+
+func (m *Reorg) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+type ReorgHistoryResponse struct {
+	Reorgs []*Reorg `protobuf:"bytes,1,rep,name=reorgs,proto3" json:"reorgs,omitempty"`
+}
+
+func (m *ReorgHistoryResponse) Reset()         { *m = ReorgHistoryResponse{} }
+func (m *ReorgHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*ReorgHistoryResponse) ProtoMessage()    {}
+func (*ReorgHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_90f7d15fc8a35cee, []int{4}
+}
+func (m *ReorgHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReorgHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReorgHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReorgHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReorgHistoryResponse.Merge(m, src)
+}
+func (m *ReorgHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReorgHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReorgHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReorgHistoryResponse proto.InternalMessageInfo
+
+func (m *ReorgHistoryResponse) GetReorgs() []*Reorg {
+	if m != nil {
+		return m.Reorgs
+	}
+	return nil
+}
+
+type BlockNotification struct {
+	Block  *BlockStore `protobuf:"bytes,1,opt,name=block,proto3" json:"block,omitempty"`
+	Forked bool        `protobuf:"varint,2,opt,name=forked,proto3" json:"forked,omitempty"`
+}
+
+func (m *BlockNotification) Reset()         { *m = BlockNotification{} }
+func (m *BlockNotification) String() string { return proto.CompactTextString(m) }
+func (*BlockNotification) ProtoMessage()    {}
+func (*BlockNotification) Descriptor() ([]byte, []int) {
+	return fileDescriptor_90f7d15fc8a35cee, []int{5}
+}
+func (m *BlockNotification) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockNotification) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockNotification.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlockNotification) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockNotification.Merge(m, src)
+}
+func (m *BlockNotification) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockNotification) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockNotification.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockNotification proto.InternalMessageInfo
+
+func (m *BlockNotification) GetBlock() *BlockStore {
+	if m != nil {
+		return m.Block
+	}
+	return nil
+}
+
+func (m *BlockNotification) GetForked() bool {
+	if m != nil {
+		return m.Forked
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*LatestBlockData)(nil), "chainTracker.LatestBlockData")
 	proto.RegisterType((*LatestBlockDataResponse)(nil), "chainTracker.LatestBlockDataResponse")
 	proto.RegisterType((*BlockStore)(nil), "chainTracker.BlockStore")
+	proto.RegisterType((*Reorg)(nil), "chainTracker.Reorg")
+	proto.RegisterType((*ReorgHistoryResponse)(nil), "chainTracker.ReorgHistoryResponse")
+	proto.RegisterType((*BlockNotification)(nil), "chainTracker.BlockNotification")
 }
 
 func init() { proto.RegisterFile("chainTracker.proto", fileDescriptor_90f7d15fc8a35cee) }
 
 var fileDescriptor_90f7d15fc8a35cee = []byte{
-	// 359 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0xcf, 0x4e, 0xc2, 0x40,
-	0x10, 0xc6, 0x5b, 0xf0, 0x4f, 0x18, 0x54, 0xe2, 0x6a, 0xb4, 0xa9, 0xd8, 0x90, 0x46, 0x13, 0x4e,
-	0x25, 0x41, 0xc3, 0x03, 0xa0, 0x46, 0x8d, 0xc6, 0x43, 0x51, 0x0f, 0xc6, 0xcb, 0x52, 0x07, 0xda,
-	0x50, 0xd8, 0xba, 0xbb, 0xd5, 0x78, 0xf2, 0x15, 0x7c, 0x24, 0x8f, 0x1e, 0x39, 0x7a, 0x34, 0xf0,
-	0x22, 0xc6, 0xad, 0x84, 0xb6, 0x26, 0xde, 0x76, 0xbe, 0xdf, 0xec, 0x7c, 0x3b, 0xdf, 0x02, 0xf1,
-	0x7c, 0x1a, 0x8c, 0xae, 0x39, 0xf5, 0x06, 0xc8, 0x9d, 0x88, 0x33, 0xc9, 0xc8, 0x4a, 0x5a, 0x33,
-	0xad, 0x3e, 0x63, 0xfd, 0x10, 0x1b, 0x8a, 0x75, 0xe3, 0x5e, 0xe3, 0x99, 0xd3, 0x28, 0x42, 0x2e,
-	0x92, 0x6e, 0x73, 0x27, 0xcf, 0x71, 0x18, 0xc9, 0x97, 0x04, 0xda, 0x0c, 0x2a, 0x97, 0x54, 0xa2,
-	0x90, 0xed, 0x90, 0x79, 0x83, 0x63, 0x2a, 0x29, 0xa9, 0x42, 0xa9, 0xc7, 0xd9, 0x50, 0x09, 0x86,
-	0x5e, 0xd3, 0xeb, 0x45, 0x77, 0x2e, 0x10, 0x03, 0x96, 0x25, 0x4b, 0x58, 0x41, 0xb1, 0x59, 0x49,
-	0xf6, 0x60, 0x55, 0x44, 0xe8, 0x05, 0xbd, 0xc0, 0x4b, 0x78, 0x51, 0xf1, 0xac, 0x68, 0xbf, 0xc2,
-	0x76, 0xce, 0xd0, 0x45, 0x11, 0xb1, 0x91, 0x40, 0x52, 0x83, 0x72, 0x38, 0x47, 0xbf, 0xd6, 0x69,
-	0x89, 0xb4, 0xa1, 0xc2, 0xf1, 0x31, 0x46, 0x21, 0xf1, 0xe1, 0x8c, 0x0a, 0x1f, 0x85, 0x51, 0xa8,
-	0x15, 0xeb, 0xe5, 0xa6, 0xe1, 0x64, 0x62, 0x52, 0xdd, 0x1d, 0xc9, 0x38, 0xba, 0xf9, 0x0b, 0x76,
-	0x0b, 0x60, 0x8e, 0xc9, 0x26, 0x2c, 0x76, 0x53, 0x6e, 0x49, 0x41, 0x08, 0x2c, 0xf8, 0x54, 0xf8,
-	0x6a, 0xc3, 0x92, 0xab, 0xce, 0xcd, 0x77, 0x1d, 0x36, 0x8e, 0x52, 0x26, 0x1d, 0xe4, 0x4f, 0x81,
-	0x87, 0xe4, 0x02, 0xd6, 0x4f, 0x51, 0xa6, 0x76, 0xba, 0x8a, 0x87, 0x64, 0xcb, 0x49, 0x42, 0x77,
-	0x66, 0xa1, 0x3b, 0x27, 0x3f, 0xa1, 0x9b, 0xd5, 0x3f, 0xfa, 0xcd, 0xf9, 0x48, 0xb6, 0x0e, 0x6f,
-	0x69, 0x18, 0xa3, 0xad, 0x91, 0x7b, 0x20, 0xd9, 0x61, 0xea, 0x47, 0x76, 0xb3, 0xdb, 0xe5, 0xb0,
-	0xb9, 0xff, 0x2f, 0x9e, 0xc5, 0x6b, 0x6b, 0xed, 0xfa, 0xc7, 0xc4, 0xd2, 0xc7, 0x13, 0x4b, 0xff,
-	0x9a, 0x58, 0xfa, 0xdb, 0xd4, 0xd2, 0xc6, 0x53, 0x4b, 0xfb, 0x9c, 0x5a, 0xda, 0xdd, 0x9a, 0xd3,
-	0x50, 0x33, 0x64, 0x32, 0xa3, 0xbb, 0xa4, 0xde, 0x77, 0xf0, 0x1d, 0x00, 0x00, 0xff, 0xff, 0x72,
-	0xe1, 0x3c, 0xae, 0x7e, 0x02, 0x00, 0x00,
+	// 522 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x52, 0x4d, 0x6f, 0xd3, 0x40,
+	0x10, 0xb5, 0xf3, 0x05, 0x99, 0x02, 0x51, 0xb7, 0x51, 0xb1, 0x42, 0x31, 0xd1, 0x0a, 0xa4, 0x48,
+	0x48, 0x0e, 0x2a, 0x28, 0x3f, 0x20, 0x05, 0xb5, 0x08, 0x14, 0x24, 0x07, 0x38, 0x00, 0x97, 0x8d,
+	0x33, 0x49, 0xac, 0x7c, 0xac, 0xd9, 0xdd, 0x50, 0xf5, 0xc4, 0x5f, 0xe0, 0x2f, 0x71, 0xe3, 0xd8,
+	0x23, 0x47, 0x48, 0xfe, 0x08, 0xca, 0xae, 0x93, 0xd8, 0xa6, 0x69, 0x6f, 0x9e, 0xf7, 0xe6, 0xed,
+	0x1b, 0xbf, 0x19, 0x20, 0xc1, 0x88, 0x85, 0xb3, 0xf7, 0x82, 0x05, 0x63, 0x14, 0x5e, 0x24, 0xb8,
+	0xe2, 0xe4, 0x4e, 0x12, 0xab, 0xb9, 0x43, 0xce, 0x87, 0x13, 0x6c, 0x6a, 0xae, 0x37, 0x1f, 0x34,
+	0xcf, 0x05, 0x8b, 0x22, 0x14, 0xd2, 0x74, 0xd7, 0x1e, 0x64, 0x79, 0x9c, 0x46, 0xea, 0xc2, 0x90,
+	0x94, 0x43, 0xe5, 0x2d, 0x53, 0x28, 0x55, 0x7b, 0xc2, 0x83, 0xf1, 0x4b, 0xa6, 0x18, 0x39, 0x82,
+	0xf2, 0x40, 0xf0, 0xa9, 0x06, 0x1c, 0xbb, 0x6e, 0x37, 0xf2, 0xfe, 0x16, 0x20, 0x0e, 0xdc, 0x52,
+	0xdc, 0x70, 0x39, 0xcd, 0xad, 0x4b, 0xf2, 0x18, 0xee, 0xca, 0x08, 0x83, 0x70, 0x10, 0x06, 0x86,
+	0xcf, 0x6b, 0x3e, 0x0d, 0xd2, 0xef, 0x70, 0x3f, 0x63, 0xe8, 0xa3, 0x8c, 0xf8, 0x4c, 0x22, 0xa9,
+	0xc3, 0xde, 0x64, 0x4b, 0xc5, 0xd6, 0x49, 0x88, 0xb4, 0xa1, 0x22, 0xf0, 0xeb, 0x1c, 0xa5, 0xc2,
+	0xfe, 0x19, 0x93, 0x23, 0x94, 0x4e, 0xae, 0x9e, 0x6f, 0xec, 0x1d, 0x3b, 0x5e, 0x2a, 0x26, 0xdd,
+	0xdd, 0x55, 0x5c, 0xa0, 0x9f, 0x15, 0xd0, 0x16, 0xc0, 0x96, 0x26, 0x55, 0x28, 0xf6, 0x12, 0x6e,
+	0xa6, 0x20, 0x04, 0x0a, 0x23, 0x26, 0x47, 0xfa, 0x0f, 0xcb, 0xbe, 0xfe, 0xa6, 0x3f, 0x6d, 0x28,
+	0xfa, 0xc8, 0xc5, 0x70, 0x87, 0xa6, 0x0a, 0xc5, 0x3e, 0x46, 0xca, 0x88, 0x0a, 0xbe, 0x29, 0x48,
+	0x0b, 0xca, 0x7c, 0xb2, 0x9e, 0x35, 0x7f, 0xc3, 0xac, 0xdb, 0xd6, 0x95, 0x6e, 0x86, 0xe7, 0xb1,
+	0xae, 0x70, 0x93, 0x6e, 0xd3, 0xba, 0x5a, 0x9e, 0x0a, 0xa7, 0x28, 0x15, 0x9b, 0x46, 0x4e, 0xd1,
+	0x2c, 0x6f, 0x03, 0xd0, 0x13, 0xa8, 0xea, 0x5f, 0x38, 0x0b, 0xa5, 0xe2, 0xe2, 0x62, 0x93, 0xfc,
+	0x53, 0x28, 0x89, 0x15, 0x2e, 0x1d, 0x5b, 0x5b, 0x1d, 0xa4, 0xad, 0xb4, 0xc6, 0x8f, 0x5b, 0xe8,
+	0x67, 0xd8, 0xd7, 0xde, 0x1d, 0xae, 0x56, 0x6b, 0x65, 0x2a, 0xe4, 0x33, 0xe2, 0x25, 0x33, 0xb9,
+	0x6e, 0xd6, 0x38, 0xad, 0x43, 0x28, 0x0d, 0xb8, 0x18, 0x63, 0x5f, 0xc7, 0x75, 0xdb, 0x8f, 0xab,
+	0xe3, 0xbf, 0x39, 0x38, 0x38, 0x49, 0x48, 0xbb, 0x28, 0xbe, 0x85, 0x01, 0x92, 0x37, 0xb0, 0x7f,
+	0x8a, 0x2a, 0x71, 0x39, 0x9d, 0xf9, 0x94, 0x1c, 0x7a, 0xe6, 0xb4, 0xbd, 0xf5, 0x69, 0x7b, 0xaf,
+	0x56, 0xa7, 0x5d, 0x3b, 0xfa, 0x0f, 0xff, 0xf0, 0x7a, 0xa6, 0x5a, 0x2f, 0x3e, 0xb2, 0xc9, 0x1c,
+	0xa9, 0x45, 0xbe, 0x00, 0x49, 0x3f, 0xa6, 0xef, 0xfe, 0x61, 0x7a, 0xe6, 0x0c, 0x5d, 0x7b, 0x72,
+	0x2d, 0xbd, 0x8e, 0x92, 0x5a, 0xe4, 0x1d, 0x54, 0x4e, 0x51, 0x25, 0x73, 0xde, 0x39, 0x28, 0xbd,
+	0x22, 0xe7, 0xcc, 0x6e, 0xa8, 0x45, 0x3a, 0x50, 0xe9, 0xce, 0x7b, 0x32, 0x10, 0x61, 0x0f, 0xb5,
+	0xa1, 0xdc, 0xf9, 0xe0, 0xa3, 0x2b, 0x72, 0x4f, 0xee, 0x89, 0x5a, 0xcf, 0xec, 0x76, 0xe3, 0xd7,
+	0xc2, 0xb5, 0x2f, 0x17, 0xae, 0xfd, 0x67, 0xe1, 0xda, 0x3f, 0x96, 0xae, 0x75, 0xb9, 0x74, 0xad,
+	0xdf, 0x4b, 0xd7, 0xfa, 0x74, 0xcf, 0x6b, 0x6a, 0xbd, 0x32, 0xfa, 0x5e, 0x49, 0x3f, 0xff, 0xfc,
+	0xdf, 0x00, 0x99, 0xb1, 0x2b, 0x25, 0x85, 0x04, 0x00, 0x00,
 }
 
 func (m *LatestBlockData) Marshal() (dAtA []byte, err error) {
@@ -338,6 +523,154 @@ func (m *BlockStore) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Reorg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Reorg) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Reorg) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Timestamp != 0 {
+		i = encodeVarintChainTracker(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.NewHashes) > 0 {
+		for iNdEx := len(m.NewHashes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NewHashes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintChainTracker(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.OldHashes) > 0 {
+		for iNdEx := len(m.OldHashes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OldHashes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintChainTracker(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Depth != 0 {
+		i = encodeVarintChainTracker(dAtA, i, uint64(m.Depth))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Block != 0 {
+		i = encodeVarintChainTracker(dAtA, i, uint64(m.Block))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReorgHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReorgHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReorgHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reorgs) > 0 {
+		for iNdEx := len(m.Reorgs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Reorgs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintChainTracker(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockNotification) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockNotification) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockNotification) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Forked {
+		i--
+		if m.Forked {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Block != nil {
+		{
+			size, err := m.Block.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintChainTracker(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintChainTracker(dAtA []byte, offset int, v uint64) int {
 	offset -= sovChainTracker(v)
 	base := offset
@@ -382,32 +715,303 @@ func (m *LatestBlockDataResponse) Size() (n int) {
 			n += 1 + l + sovChainTracker(uint64(l))
 		}
 	}
-	return n
-}
+	return n
+}
+
+func (m *BlockStore) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Block != 0 {
+		n += 1 + sovChainTracker(uint64(m.Block))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovChainTracker(uint64(l))
+	}
+	return n
+}
+
+func (m *Reorg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Block != 0 {
+		n += 1 + sovChainTracker(uint64(m.Block))
+	}
+	if m.Depth != 0 {
+		n += 1 + sovChainTracker(uint64(m.Depth))
+	}
+	if len(m.OldHashes) > 0 {
+		for _, e := range m.OldHashes {
+			l = e.Size()
+			n += 1 + l + sovChainTracker(uint64(l))
+		}
+	}
+	if len(m.NewHashes) > 0 {
+		for _, e := range m.NewHashes {
+			l = e.Size()
+			n += 1 + l + sovChainTracker(uint64(l))
+		}
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovChainTracker(uint64(m.Timestamp))
+	}
+	return n
+}
+
+func (m *ReorgHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Reorgs) > 0 {
+		for _, e := range m.Reorgs {
+			l = e.Size()
+			n += 1 + l + sovChainTracker(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BlockNotification) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Block != nil {
+		l = m.Block.Size()
+		n += 1 + l + sovChainTracker(uint64(l))
+	}
+	if m.Forked {
+		n += 2
+	}
+	return n
+}
+
+func sovChainTracker(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozChainTracker(x uint64) (n int) {
+	return sovChainTracker(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *LatestBlockData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChainTracker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LatestBlockData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LatestBlockData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromBlock", wireType)
+			}
+			m.FromBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChainTracker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromBlock |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToBlock", wireType)
+			}
+			m.ToBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChainTracker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToBlock |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpecificBlock", wireType)
+			}
+			m.SpecificBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChainTracker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpecificBlock |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChainTracker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChainTracker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LatestBlockDataResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChainTracker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LatestBlockDataResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LatestBlockDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestBlock", wireType)
+			}
+			m.LatestBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChainTracker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LatestBlock |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestedHashes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChainTracker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthChainTracker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthChainTracker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestedHashes = append(m.RequestedHashes, &BlockStore{})
+			if err := m.RequestedHashes[len(m.RequestedHashes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChainTracker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChainTracker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *BlockStore) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Block != 0 {
-		n += 1 + sovChainTracker(uint64(m.Block))
-	}
-	l = len(m.Hash)
-	if l > 0 {
-		n += 1 + l + sovChainTracker(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovChainTracker(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozChainTracker(x uint64) (n int) {
-	return sovChainTracker(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *LatestBlockData) Unmarshal(dAtA []byte) error {
+func (m *BlockStore) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -430,17 +1034,17 @@ func (m *LatestBlockData) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LatestBlockData: wiretype end group for non-group")
+			return fmt.Errorf("proto: BlockStore: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LatestBlockData: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BlockStore: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
 			}
-			m.FromBlock = 0
+			m.Block = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowChainTracker
@@ -450,16 +1054,16 @@ func (m *LatestBlockData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.FromBlock |= int64(b&0x7F) << shift
+				m.Block |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToBlock", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
 			}
-			m.ToBlock = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowChainTracker
@@ -469,30 +1073,24 @@ func (m *LatestBlockData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ToBlock |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpecificBlock", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChainTracker
 			}
-			m.SpecificBlock = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowChainTracker
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SpecificBlock |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChainTracker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipChainTracker(dAtA[iNdEx:])
@@ -514,7 +1112,7 @@ func (m *LatestBlockData) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LatestBlockDataResponse) Unmarshal(dAtA []byte) error {
+func (m *Reorg) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -537,17 +1135,17 @@ func (m *LatestBlockDataResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LatestBlockDataResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: Reorg: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LatestBlockDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Reorg: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LatestBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
 			}
-			m.LatestBlock = 0
+			m.Block = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowChainTracker
@@ -557,14 +1155,33 @@ func (m *LatestBlockDataResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LatestBlock |= int64(b&0x7F) << shift
+				m.Block |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depth", wireType)
+			}
+			m.Depth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChainTracker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Depth |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestedHashes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OldHashes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -591,11 +1208,64 @@ func (m *LatestBlockDataResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RequestedHashes = append(m.RequestedHashes, &BlockStore{})
-			if err := m.RequestedHashes[len(m.RequestedHashes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.OldHashes = append(m.OldHashes, &BlockStore{})
+			if err := m.OldHashes[len(m.OldHashes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewHashes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChainTracker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthChainTracker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthChainTracker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewHashes = append(m.NewHashes, &BlockStore{})
+			if err := m.NewHashes[len(m.NewHashes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChainTracker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipChainTracker(dAtA[iNdEx:])
@@ -617,7 +1287,7 @@ func (m *LatestBlockDataResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BlockStore) Unmarshal(dAtA []byte) error {
+func (m *ReorgHistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -640,17 +1310,17 @@ func (m *BlockStore) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BlockStore: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReorgHistoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BlockStore: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReorgHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reorgs", wireType)
 			}
-			m.Block = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowChainTracker
@@ -660,16 +1330,81 @@ func (m *BlockStore) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Block |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			if msglen < 0 {
+				return ErrInvalidLengthChainTracker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthChainTracker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reorgs = append(m.Reorgs, &Reorg{})
+			if err := m.Reorgs[len(m.Reorgs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChainTracker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChainTracker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockNotification) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChainTracker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockNotification: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockNotification: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowChainTracker
@@ -679,24 +1414,48 @@ func (m *BlockStore) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthChainTracker
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthChainTracker
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hash = string(dAtA[iNdEx:postIndex])
+			if m.Block == nil {
+				m.Block = &BlockStore{}
+			}
+			if err := m.Block.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Forked", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChainTracker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Forked = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipChainTracker(dAtA[iNdEx:])
@@ -26,6 +26,8 @@ const _ = grpc.SupportPackageIsVersion7
 type ChainTrackerServiceClient interface {
 	GetLatestBlockNum(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*wrappers.UInt64Value, error)
 	GetLatestBlockData(ctx context.Context, in *LatestBlockData, opts ...grpc.CallOption) (*LatestBlockDataResponse, error)
+	GetReorgHistory(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ReorgHistoryResponse, error)
+	SubscribeBlocks(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (ChainTrackerService_SubscribeBlocksClient, error)
 }
 
 type chainTrackerServiceClient struct {
@@ -54,12 +56,55 @@ func (c *chainTrackerServiceClient) GetLatestBlockData(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *chainTrackerServiceClient) GetReorgHistory(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ReorgHistoryResponse, error) {
+	out := new(ReorgHistoryResponse)
+	err := c.cc.Invoke(ctx, "/chainTracker.ChainTrackerService/GetReorgHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chainTrackerServiceClient) SubscribeBlocks(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (ChainTrackerService_SubscribeBlocksClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ChainTrackerService_ServiceDesc.Streams[0], "/chainTracker.ChainTrackerService/SubscribeBlocks", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &chainTrackerServiceSubscribeBlocksClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ChainTrackerService_SubscribeBlocksClient interface {
+	Recv() (*BlockNotification, error)
+	grpc.ClientStream
+}
+
+type chainTrackerServiceSubscribeBlocksClient struct {
+	grpc.ClientStream
+}
+
+func (x *chainTrackerServiceSubscribeBlocksClient) Recv() (*BlockNotification, error) {
+	m := new(BlockNotification)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // ChainTrackerServiceServer is the server API for ChainTrackerService service.
 // All implementations must embed UnimplementedChainTrackerServiceServer
 // for forward compatibility
 type ChainTrackerServiceServer interface {
 	GetLatestBlockNum(context.Context, *empty.Empty) (*wrappers.UInt64Value, error)
 	GetLatestBlockData(context.Context, *LatestBlockData) (*LatestBlockDataResponse, error)
+	GetReorgHistory(context.Context, *empty.Empty) (*ReorgHistoryResponse, error)
+	SubscribeBlocks(*empty.Empty, ChainTrackerService_SubscribeBlocksServer) error
 	mustEmbedUnimplementedChainTrackerServiceServer()
 }
 
@@ -73,6 +118,12 @@ func (UnimplementedChainTrackerServiceServer) GetLatestBlockNum(context.Context,
 func (UnimplementedChainTrackerServiceServer) GetLatestBlockData(context.Context, *LatestBlockData) (*LatestBlockDataResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetLatestBlockData not implemented")
 }
+func (UnimplementedChainTrackerServiceServer) GetReorgHistory(context.Context, *empty.Empty) (*ReorgHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReorgHistory not implemented")
+}
+func (UnimplementedChainTrackerServiceServer) SubscribeBlocks(*empty.Empty, ChainTrackerService_SubscribeBlocksServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeBlocks not implemented")
+}
 func (UnimplementedChainTrackerServiceServer) mustEmbedUnimplementedChainTrackerServiceServer() {}
 
 // UnsafeChainTrackerServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -122,6 +173,45 @@ func _ChainTrackerService_GetLatestBlockData_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ChainTrackerService_GetReorgHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChainTrackerServiceServer).GetReorgHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chainTracker.ChainTrackerService/GetReorgHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChainTrackerServiceServer).GetReorgHistory(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChainTrackerService_SubscribeBlocks_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(empty.Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ChainTrackerServiceServer).SubscribeBlocks(m, &chainTrackerServiceSubscribeBlocksServer{stream})
+}
+
+type ChainTrackerService_SubscribeBlocksServer interface {
+	Send(*BlockNotification) error
+	grpc.ServerStream
+}
+
+type chainTrackerServiceSubscribeBlocksServer struct {
+	grpc.ServerStream
+}
+
+func (x *chainTrackerServiceSubscribeBlocksServer) Send(m *BlockNotification) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // ChainTrackerService_ServiceDesc is the grpc.ServiceDesc for ChainTrackerService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -137,7 +227,17 @@ var ChainTrackerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetLatestBlockData",
 			Handler:    _ChainTrackerService_GetLatestBlockData_Handler,
 		},
+		{
+			MethodName: "GetReorgHistory",
+			Handler:    _ChainTrackerService_GetReorgHistory_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribeBlocks",
+			Handler:       _ChainTrackerService_SubscribeBlocks_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "chainTracker.proto",
 }
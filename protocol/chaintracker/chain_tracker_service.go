@@ -5,6 +5,7 @@ import (
 
 	empty "github.com/golang/protobuf/ptypes/empty"
 	wrappers "github.com/golang/protobuf/ptypes/wrappers"
+	"google.golang.org/grpc/metadata"
 )
 
 type ChainTrackerService struct {
@@ -33,3 +34,27 @@ func (cts *ChainTrackerService) GetLatestBlockData(ctx context.Context, latestBl
 	}
 	return &LatestBlockDataResponse{LatestBlock: latestBlockNum, RequestedHashes: requestedHashes}, nil
 }
+
+func (cts *ChainTrackerService) GetReorgHistory(context.Context, *empty.Empty) (*ReorgHistoryResponse, error) {
+	return &ReorgHistoryResponse{Reorgs: cts.ChainTracker.GetReorgHistory()}, nil
+}
+
+func (cts *ChainTrackerService) SubscribeBlocks(_ *empty.Empty, stream ChainTrackerService_SubscribeBlocksServer) error {
+	notifications := cts.ChainTracker.SubscribeBlocks(stream.Context())
+	// headers let the client know the subscription is active
+	err := stream.SendHeader(metadata.MD{})
+	if err != nil {
+		return err
+	}
+	for notification := range notifications {
+		err = stream.Send(notification)
+		if err != nil {
+			return err
+		}
+	}
+	if stream.Context().Err() != nil {
+		// the subscriber disconnected
+		return nil
+	}
+	return BlockSubscriberTooSlow
+}
@@ -3,16 +3,21 @@ package chaintracker_test
 import (
 	"context"
 	fmt "fmt"
+	"net"
 	"strconv"
 	"sync"
 	"testing"
 	"time"
 
+	empty "github.com/golang/protobuf/ptypes/empty"
 	chaintracker "github.com/lavanet/lava/protocol/chaintracker"
 	"github.com/lavanet/lava/protocol/lavasession"
 	"github.com/lavanet/lava/utils"
 	spectypes "github.com/lavanet/lava/x/spec/types"
 	"github.com/stretchr/testify/require"
+	grpc "google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
 )
 
 const (
@@ -395,6 +400,157 @@ func TestChainTrackerMaintainMemory(t *testing.T) {
 	})
 }
 
+type MockSubscribingChainFetcher struct {
+	*MockChainFetcher
+	newBlocks chan struct{}
+}
+
+func (mscf *MockSubscribingChainFetcher) SubscribeNewBlocks(ctx context.Context) (<-chan struct{}, error) {
+	return mscf.newBlocks, nil
+}
+
+func TestChainTrackerSubscription(t *testing.T) {
+	mockBlocks := int64(100)
+	fetcherBlocks := 10
+	mockChainFetcher := &MockSubscribingChainFetcher{MockChainFetcher: NewMockChainFetcher(1000, mockBlocks), newBlocks: make(chan struct{})}
+	currentLatestBlockInMock := mockChainFetcher.AdvanceBlock()
+	newBlocksCallback := make(chan int64, 100)
+	newBlockCallback := func(blockNum int64, hash string) {
+		newBlocksCallback <- blockNum
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	// polling is too slow for the test, so new blocks can only be detected by the subscription
+	chainTrackerConfig := chaintracker.ChainTrackerConfig{BlocksToSave: uint64(fetcherBlocks), AverageBlockTime: time.Hour, ServerBlockMemory: uint64(mockBlocks), NewLatestCallback: newBlockCallback}
+	chainTracker, err := chaintracker.NewChainTracker(ctx, mockChainFetcher, chainTrackerConfig)
+	require.NoError(t, err)
+	require.Equal(t, currentLatestBlockInMock, chainTracker.GetLatestBlockNum())
+
+	for i := 0; i < 3; i++ {
+		currentLatestBlockInMock = mockChainFetcher.AdvanceBlock()
+		mockChainFetcher.newBlocks <- struct{}{}
+		select {
+		case blockNum := <-newBlocksCallback:
+			require.Equal(t, currentLatestBlockInMock, blockNum)
+		case <-time.After(time.Second):
+			require.Fail(t, "new block wasn't detected from the subscription")
+		}
+		require.Equal(t, currentLatestBlockInMock, chainTracker.GetLatestBlockNum())
+	}
+}
+
+func TestChainTrackerReorgHistory(t *testing.T) {
+	mockBlocks := int64(100)
+	fetcherBlocks := 10
+	mockChainFetcher := &MockSubscribingChainFetcher{MockChainFetcher: NewMockChainFetcher(1000, mockBlocks), newBlocks: make(chan struct{})}
+	currentLatestBlockInMock := mockChainFetcher.AdvanceBlock()
+	reorgsCallback := make(chan *chaintracker.Reorg, 100)
+	reorgCallback := func(reorg *chaintracker.Reorg) {
+		reorgsCallback <- reorg
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	chainTrackerConfig := chaintracker.ChainTrackerConfig{BlocksToSave: uint64(fetcherBlocks), AverageBlockTime: time.Hour, ServerBlockMemory: uint64(mockBlocks), ReorgCallback: reorgCallback, ReorgHistorySize: 2}
+	chainTracker, err := chaintracker.NewChainTracker(ctx, mockChainFetcher, chainTrackerConfig)
+	require.NoError(t, err)
+	require.Empty(t, chainTracker.GetReorgHistory())
+
+	tests := []struct {
+		name        string
+		advancement int64
+		fork        string
+		depth       uint64
+	}{
+		{name: "reorg of all saved blocks", advancement: 0, fork: "fork", depth: uint64(fetcherBlocks)},
+		{name: "new block without a reorg", advancement: 1, fork: "fork", depth: 0},
+		{name: "reorg with a new block", advancement: 1, fork: "another-fork", depth: uint64(fetcherBlocks) - 1},
+		{name: "reorg of the latest block", advancement: 0, fork: "", depth: uint64(fetcherBlocks)},
+	}
+	reorgsDetected := []*chaintracker.Reorg{}
+	for _, tt := range tests {
+		for i := 0; i < int(tt.advancement); i++ {
+			currentLatestBlockInMock = mockChainFetcher.AdvanceBlock()
+		}
+		mockChainFetcher.Fork(tt.fork)
+		mockChainFetcher.newBlocks <- struct{}{}
+		if tt.depth == 0 {
+			require.Eventually(t, func() bool { return chainTracker.GetLatestBlockNum() == currentLatestBlockInMock }, time.Second, time.Millisecond, tt.name)
+			require.Empty(t, reorgsCallback, tt.name)
+			continue
+		}
+		select {
+		case reorg := <-reorgsCallback:
+			require.Equal(t, currentLatestBlockInMock, reorg.Block, tt.name)
+			require.Equal(t, tt.depth, reorg.Depth, tt.name)
+			require.Len(t, reorg.OldHashes, int(tt.depth), tt.name)
+			require.Len(t, reorg.NewHashes, int(tt.depth), tt.name)
+			for idx := range reorg.NewHashes {
+				require.Equal(t, reorg.OldHashes[idx].Block, reorg.NewHashes[idx].Block, tt.name)
+				require.NotEqual(t, reorg.OldHashes[idx].Hash, reorg.NewHashes[idx].Hash, tt.name)
+				require.True(t, mockChainFetcher.IsCorrectHash(reorg.NewHashes[idx].Hash, reorg.NewHashes[idx].Block), tt.name)
+			}
+			reorgsDetected = append(reorgsDetected, reorg)
+		case <-time.After(time.Second):
+			require.Fail(t, "reorg wasn't detected", tt.name)
+		}
+	}
+	// only the latest reorgs are kept
+	require.Equal(t, reorgsDetected[len(reorgsDetected)-2:], chainTracker.GetReorgHistory())
+}
+
+func TestChainTrackerSubscribeBlocks(t *testing.T) {
+	mockBlocks := int64(100)
+	fetcherBlocks := 10
+	mockChainFetcher := &MockSubscribingChainFetcher{MockChainFetcher: NewMockChainFetcher(1000, mockBlocks), newBlocks: make(chan struct{})}
+	currentLatestBlockInMock := mockChainFetcher.AdvanceBlock()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	chainTrackerConfig := chaintracker.ChainTrackerConfig{BlocksToSave: uint64(fetcherBlocks), AverageBlockTime: time.Hour, ServerBlockMemory: uint64(mockBlocks)}
+	chainTracker, err := chaintracker.NewChainTracker(ctx, mockChainFetcher, chainTrackerConfig)
+	require.NoError(t, err)
+
+	listener := bufconn.Listen(1024 * 1024)
+	server := grpc.NewServer()
+	chaintracker.RegisterChainTrackerServiceServer(server, &chaintracker.ChainTrackerService{ChainTracker: chainTracker})
+	go server.Serve(listener)
+	defer server.Stop()
+	conn, err := grpc.DialContext(ctx, "bufnet", grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) { return listener.Dial() }), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer conn.Close()
+	streamCtx, streamCancel := context.WithCancel(ctx)
+	stream, err := chaintracker.NewChainTrackerServiceClient(conn).SubscribeBlocks(streamCtx, &empty.Empty{})
+	require.NoError(t, err)
+	// headers are sent once the server subscribed to the chain tracker
+	_, err = stream.Header()
+	require.NoError(t, err)
+
+	// catching up on several blocks notifies each one
+	prevLatest := currentLatestBlockInMock
+	for i := 0; i < 3; i++ {
+		currentLatestBlockInMock = mockChainFetcher.AdvanceBlock()
+	}
+	mockChainFetcher.newBlocks <- struct{}{}
+	for block := prevLatest + 1; block <= currentLatestBlockInMock; block++ {
+		notification, err := stream.Recv()
+		require.NoError(t, err)
+		require.Equal(t, block, notification.Block.Block)
+		require.True(t, mockChainFetcher.IsCorrectHash(notification.Block.Hash, block))
+		require.False(t, notification.Forked)
+	}
+
+	mockChainFetcher.Fork("fork")
+	mockChainFetcher.newBlocks <- struct{}{}
+	notification, err := stream.Recv()
+	require.NoError(t, err)
+	require.Equal(t, currentLatestBlockInMock, notification.Block.Block)
+	require.True(t, mockChainFetcher.IsCorrectHash(notification.Block.Hash, currentLatestBlockInMock))
+	require.True(t, notification.Forked)
+
+	streamCancel()
+	_, err = stream.Recv()
+	require.Error(t, err)
+}
+
 func TestFindRequestedBlockHash(t *testing.T) {
 	mockBlocks := int64(100)
 	fetcherBlocks := 50
@@ -5,15 +5,18 @@ import "time"
 const (
 	DefualtAssumedBlockMemory      = 20
 	DefaultBlockCheckpointDistance = 100
+	DefaultReorgHistorySize        = 100
 )
 
 type ChainTrackerConfig struct {
 	ForkCallback             func(block int64)              // a function to be called when a fork is detected
 	NewLatestCallback        func(block int64, hash string) // a function to be called when a new block is detected
+	ReorgCallback            func(reorg *Reorg)             // a function to be called when saved blocks were replaced by a reorg
 	ServerAddress            string                         // if not empty will open up a grpc server for that address
 	BlocksToSave             uint64
 	AverageBlockTime         time.Duration // how often to query latest block
 	ServerBlockMemory        uint64
+	ReorgHistorySize         uint64 // how many of the latest reorgs to keep
 	blocksCheckpointDistance uint64 // this causes the chainTracker to trigger it's checkpoint every X blocks
 }
 
@@ -28,6 +31,9 @@ func (cnf *ChainTrackerConfig) validate() error {
 	if cnf.ServerBlockMemory == 0 {
 		cnf.ServerBlockMemory = DefualtAssumedBlockMemory
 	}
+	if cnf.ReorgHistorySize == 0 {
+		cnf.ReorgHistorySize = DefaultReorgHistorySize
+	}
 	if cnf.blocksCheckpointDistance == 0 {
 		cnf.blocksCheckpointDistance = DefaultBlockCheckpointDistance
 	}
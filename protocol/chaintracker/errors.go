@@ -14,4 +14,5 @@ var ( // Consumer Side Errors
 	RequestedBlocksOutOfRange       = sdkerrors.New("RequestedBlocksOutOfRange", 10707, "requested blocks are outside the supported range by the state tracker")
 	ErrorFailedToFetchTooEarlyBlock = sdkerrors.New("Error ErrorFailedToFetchTooEarlyBlock", 10708, "server memory protection triggered, requested block is too early")
 	InvalidRequestedSpecificBlock   = sdkerrors.New("Error InvalidRequestedSpecificBlock", 10709, "provided requested specific blocks for function do not compose a stored entry")
+	BlockSubscriberTooSlow          = sdkerrors.New("Error BlockSubscriberTooSlow", 10710, "block subscriber didn't read notifications fast enough and was disconnected")
 )
@@ -178,6 +178,22 @@ func (fc *FinalizationConsensus) NewEpoch(epoch uint64) {
 	}
 }
 
+// GetBlockNumByHash returns the block number of a hash in the finalized blocks reported by the providers, and false if no provider reported it
+func (s *FinalizationConsensus) GetBlockNumByHash(blockHash string) (int64, bool) {
+	s.providerDataContainersMu.RLock()
+	defer s.providerDataContainersMu.RUnlock()
+	for _, consensusList := range [][]ProviderHashesConsensus{s.currentProviderHashesConsensus, s.prevEpochProviderHashesConsensus} {
+		for _, consensus := range consensusList {
+			for blockNum, hash := range consensus.FinalizedBlocksHashes {
+				if hash == blockHash {
+					return blockNum, true
+				}
+			}
+		}
+	}
+	return 0, false
+}
+
 func (s *FinalizationConsensus) LatestBlock() uint64 {
 	s.providerDataContainersMu.RLock()
 	defer s.providerDataContainersMu.RUnlock()
@@ -2,6 +2,7 @@ package lavasession
 
 import (
 	"context"
+	"sort"
 	"sync"
 	"sync/atomic"
 
@@ -18,6 +19,20 @@ type ProviderSessionManager struct {
 	rpcProviderEndpoint           *RPCProviderEndpoint
 	blockDistanceForEpochValidity uint64                             // sessionsWithAllConsumers with epochs older than ((latest epoch) - numberOfBlocksKeptInMemory) are deleted.
 	consumerPairedWithProjectMap  map[uint64]*projectConsumerMapping // consumer address as key, project as value
+	blockedConsumers              map[string]struct{}                // consumer addresses blocked by the provider operator, kept across epochs
+}
+
+// snapshot of a project's sessions in a specific epoch
+type ProviderSessionsInfo struct {
+	Epoch               uint64
+	ProjectId           string
+	Consumers           []string
+	UsedComputeUnits    uint64
+	MaxComputeUnits     uint64
+	MissingComputeUnits uint64
+	SessionsCount       int
+	Blocked             bool
+	SubscriptionIDs     []string
 }
 
 // reads cs.BlockedEpoch atomically
@@ -65,6 +80,9 @@ func (psm *ProviderSessionManager) getSingleSessionFromProviderSessionWithConsum
 }
 
 func (psm *ProviderSessionManager) GetSession(ctx context.Context, consumerAddress string, epoch, sessionId, relayNumber uint64, badge *pairingtypes.Badge) (*SingleProviderSession, error) {
+	if psm.IsConsumerBlocked(consumerAddress) {
+		return nil, ConsumerIsBlockListed
+	}
 	if !psm.IsValidEpoch(epoch) { // fast checking to see if epoch is even relevant
 		utils.LavaFormatError("GetSession", InvalidEpochError, utils.Attribute{Key: "RequestedEpoch", Value: epoch}, utils.Attribute{Key: "blockedEpochHeight", Value: psm.blockedEpochHeight}, utils.Attribute{Key: "blockDistanceForEpochValidity", Value: psm.blockDistanceForEpochValidity})
 		return nil, InvalidEpochError
@@ -169,6 +187,9 @@ func (psm *ProviderSessionManager) writeConsumerToPairedWithProjectMap(consumerA
 }
 
 func (psm *ProviderSessionManager) RegisterProviderSessionWithConsumer(ctx context.Context, consumerAddress string, epoch, sessionId, relayNumber, maxCuForConsumer uint64, pairedProviders int64, projectId string, badge *pairingtypes.Badge) (*SingleProviderSession, error) {
+	if psm.IsConsumerBlocked(consumerAddress) {
+		return nil, utils.LavaFormatWarning("RegisterProviderSessionWithConsumer refused a blocked consumer", ConsumerIsBlockListed, utils.Attribute{Key: "consumer", Value: consumerAddress})
+	}
 	_, err := psm.IsActiveProject(epoch, projectId)
 	if err != nil {
 		if ConsumerNotRegisteredYet.Is(err) {
@@ -220,6 +241,83 @@ func (psm *ProviderSessionManager) getSessionFromAnActiveConsumer(ctx context.Co
 	}
 }
 
+// BlockConsumer stops serving a consumer address on all epochs until it is unblocked
+func (psm *ProviderSessionManager) BlockConsumer(consumerAddress string) {
+	psm.lock.Lock()
+	defer psm.lock.Unlock()
+	psm.blockedConsumers[consumerAddress] = struct{}{}
+}
+
+func (psm *ProviderSessionManager) UnblockConsumer(consumerAddress string) {
+	psm.lock.Lock()
+	defer psm.lock.Unlock()
+	delete(psm.blockedConsumers, consumerAddress)
+}
+
+func (psm *ProviderSessionManager) IsConsumerBlocked(consumerAddress string) bool {
+	psm.lock.RLock()
+	defer psm.lock.RUnlock()
+	_, blocked := psm.blockedConsumers[consumerAddress]
+	return blocked
+}
+
+func (psm *ProviderSessionManager) GetBlockedConsumers() (blockedConsumers []string) {
+	psm.lock.RLock()
+	defer psm.lock.RUnlock()
+	for consumer := range psm.blockedConsumers {
+		blockedConsumers = append(blockedConsumers, consumer)
+	}
+	sort.Strings(blockedConsumers)
+	return blockedConsumers
+}
+
+// GetSessionsInfo returns a snapshot of all the projects with sessions on this endpoint, filtered by epoch unless epoch is 0
+func (psm *ProviderSessionManager) GetSessionsInfo(epoch uint64) (sessionsInfo []ProviderSessionsInfo) {
+	psm.lock.RLock()
+	defer psm.lock.RUnlock()
+	for sessionsEpoch, sessions := range psm.sessionsWithAllConsumers {
+		if epoch != 0 && sessionsEpoch != epoch {
+			continue
+		}
+		projectConsumers := map[string][]string{}
+		if consumerMapping, ok := psm.consumerPairedWithProjectMap[sessionsEpoch]; ok {
+			for consumer, projectId := range consumerMapping.consumerToProjectMap {
+				projectConsumers[projectId] = append(projectConsumers[projectId], consumer)
+			}
+		}
+		for projectId, providerSessionsWithConsumer := range sessions.sessionMap {
+			consumers := projectConsumers[projectId]
+			sort.Strings(consumers)
+			subscriptionIDs := make([]string, 0, len(providerSessionsWithConsumer.ongoingSubscriptions))
+			for subscriptionID := range providerSessionsWithConsumer.ongoingSubscriptions {
+				subscriptionIDs = append(subscriptionIDs, subscriptionID)
+			}
+			sort.Strings(subscriptionIDs)
+			providerSessionsWithConsumer.Lock.RLock()
+			sessionsCount := len(providerSessionsWithConsumer.Sessions)
+			providerSessionsWithConsumer.Lock.RUnlock()
+			sessionsInfo = append(sessionsInfo, ProviderSessionsInfo{
+				Epoch:               sessionsEpoch,
+				ProjectId:           projectId,
+				Consumers:           consumers,
+				UsedComputeUnits:    providerSessionsWithConsumer.atomicReadUsedComputeUnits(),
+				MaxComputeUnits:     providerSessionsWithConsumer.atomicReadMaxComputeUnits(),
+				MissingComputeUnits: providerSessionsWithConsumer.atomicReadMissingComputeUnits(),
+				SessionsCount:       sessionsCount,
+				Blocked:             providerSessionsWithConsumer.atomicReadConsumerBlocked() == blockListedConsumer,
+				SubscriptionIDs:     subscriptionIDs,
+			})
+		}
+	}
+	sort.Slice(sessionsInfo, func(i, j int) bool {
+		if sessionsInfo[i].Epoch != sessionsInfo[j].Epoch {
+			return sessionsInfo[i].Epoch < sessionsInfo[j].Epoch
+		}
+		return sessionsInfo[i].ProjectId < sessionsInfo[j].ProjectId
+	})
+	return sessionsInfo
+}
+
 func (psm *ProviderSessionManager) ReportConsumer() (address string, epoch uint64, err error) {
 	return "", 0, nil // TBD
 }
@@ -457,6 +555,7 @@ func NewProviderSessionManager(rpcProviderEndpoint *RPCProviderEndpoint, numberO
 		blockDistanceForEpochValidity: numberOfBlocksKeptInMemory,
 		sessionsWithAllConsumers:      map[uint64]sessionData{},
 		consumerPairedWithProjectMap:  map[uint64]*projectConsumerMapping{},
+		blockedConsumers:              map[string]struct{}{},
 	}
 }
 
@@ -170,6 +170,45 @@ func TestHappyFlowPSM(t *testing.T) {
 	require.Equal(t, sps.PairingEpoch, epoch1)
 }
 
+func TestPSMBlockConsumer(t *testing.T) {
+	ctx := context.Background()
+	psm, sps := prepareSession(t, ctx)
+	require.NoError(t, psm.OnSessionDone(sps, relayNumber))
+
+	psm.BlockConsumer(consumerOneAddress)
+	require.Equal(t, []string{consumerOneAddress}, psm.GetBlockedConsumers())
+
+	// existing sessions are refused
+	_, err := psm.GetSession(ctx, consumerOneAddress, epoch1, sessionId, relayNumber+1, nil)
+	require.True(t, ConsumerIsBlockListed.Is(err))
+	// and so are new registrations
+	_, err = psm.RegisterProviderSessionWithConsumer(ctx, consumerOneAddress, epoch1, sessionId+1, relayNumber, maxCu, pairedProviders, projectId, nil)
+	require.True(t, ConsumerIsBlockListed.Is(err))
+
+	psm.UnblockConsumer(consumerOneAddress)
+	require.Empty(t, psm.GetBlockedConsumers())
+	sps, err = psm.GetSession(ctx, consumerOneAddress, epoch1, sessionId, relayNumber+1, nil)
+	require.NoError(t, err)
+	require.NoError(t, psm.OnSessionFailure(sps, relayNumber+1))
+}
+
+func TestPSMGetSessionsInfo(t *testing.T) {
+	ctx := context.Background()
+	psm, sps := prepareSession(t, ctx)
+	require.NoError(t, psm.OnSessionDone(sps, relayNumber))
+
+	sessionsInfo := psm.GetSessionsInfo(0)
+	require.Len(t, sessionsInfo, 1)
+	require.Equal(t, epoch1, sessionsInfo[0].Epoch)
+	require.Equal(t, projectId, sessionsInfo[0].ProjectId)
+	require.Equal(t, []string{consumerOneAddress}, sessionsInfo[0].Consumers)
+	require.Equal(t, relayCu, sessionsInfo[0].UsedComputeUnits)
+	require.Equal(t, maxCu, sessionsInfo[0].MaxComputeUnits)
+	require.Equal(t, 1, sessionsInfo[0].SessionsCount)
+
+	require.Empty(t, psm.GetSessionsInfo(epoch1+1))
+}
+
 func TestMissingCu(t *testing.T) {
 	ctx := context.Background()
 	psm, sps := prepareSession(t, ctx)
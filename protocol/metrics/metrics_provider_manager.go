@@ -26,6 +26,8 @@ type ProviderMetricsManager struct {
 	consumerQoSMetric           *prometheus.GaugeVec
 	blockMetric                 *prometheus.GaugeVec
 	lastServicedBlockTimeMetric *prometheus.GaugeVec
+	totalReorgsMetric           *prometheus.CounterVec
+	reorgDepthMetric            *prometheus.HistogramVec
 }
 
 func NewProviderMetricsManager(networkAddress string) *ProviderMetricsManager {
@@ -72,6 +74,19 @@ func NewProviderMetricsManager(networkAddress string) *ProviderMetricsManager {
 		Help: "Timestamp of the last block update received from the serviced node.",
 	}, []string{"spec"})
 
+	// Create a new CounterVec metric to represent the reorgs detected on the serviced node over time.
+	totalReorgsMetric := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "lava_provider_total_reorgs",
+		Help: "The total number of reorgs detected on the serviced node over time.",
+	}, []string{"spec"})
+
+	// Create a new HistogramVec metric to represent how many blocks were replaced in each reorg.
+	reorgDepthMetric := prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "lava_provider_reorg_depth",
+		Help:    "The number of blocks replaced by reorgs detected on the serviced node.",
+		Buckets: []float64{1, 2, 3, 5, 10, 20, 50, 100},
+	}, []string{"spec"})
+
 	// Register the metrics with the Prometheus registry.
 	prometheus.MustRegister(totalCUServicedMetric)
 	prometheus.MustRegister(totalCUPaidMetric)
@@ -80,6 +95,8 @@ func NewProviderMetricsManager(networkAddress string) *ProviderMetricsManager {
 	prometheus.MustRegister(consumerQoSMetric)
 	prometheus.MustRegister(blockMetric)
 	prometheus.MustRegister(lastServicedBlockTimeMetric)
+	prometheus.MustRegister(totalReorgsMetric)
+	prometheus.MustRegister(reorgDepthMetric)
 	http.Handle("/metrics", promhttp.Handler())
 	go func() {
 		utils.LavaFormatInfo("prometheus endpoint listening", utils.Attribute{Key: "Listen Address", Value: networkAddress})
@@ -94,6 +111,8 @@ func NewProviderMetricsManager(networkAddress string) *ProviderMetricsManager {
 		consumerQoSMetric:           consumerQoSMetric,
 		blockMetric:                 blockMetric,
 		lastServicedBlockTimeMetric: lastServicedBlockTimeMetric,
+		totalReorgsMetric:           totalReorgsMetric,
+		reorgDepthMetric:            reorgDepthMetric,
 	}
 }
 
@@ -132,6 +151,14 @@ func (pme *ProviderMetricsManager) SetLatestBlock(specID string, block uint64) {
 	pme.lastServicedBlockTimeMetric.WithLabelValues(specID).Set(float64(time.Now().Unix()))
 }
 
+func (pme *ProviderMetricsManager) AddReorg(specID string, depth uint64) {
+	if pme == nil {
+		return
+	}
+	pme.totalReorgsMetric.WithLabelValues(specID).Inc()
+	pme.reorgDepthMetric.WithLabelValues(specID).Observe(float64(depth))
+}
+
 func (pme *ProviderMetricsManager) AddPayment(specID string, cu uint64) {
 	if pme == nil {
 		return
@@ -0,0 +1,108 @@
+package parser
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// jsonPathStep is a single step in a json path, either an object key or an array index
+type jsonPathStep struct {
+	key     string
+	index   int
+	isIndex bool
+}
+
+// parseJsonPath parses a JSONPath-like expression, the supported syntax is a subset of JSONPath:
+// an optional root $, object keys as .key or ['key'] and array indexes as [n], a negative index counts from the end of the array
+// for example: $.result.blocks[-1]['header'].height
+func parseJsonPath(path string) ([]jsonPathStep, error) {
+	path = strings.TrimSpace(path)
+	steps := []jsonPathStep{}
+	idx := 0
+	if strings.HasPrefix(path, "$") {
+		idx++
+	} else if path != "" && path[0] != '.' && path[0] != '[' {
+		// a path without the root starts with a key
+		path = "." + path
+	}
+	for idx < len(path) {
+		switch path[idx] {
+		case '.':
+			idx++
+			end := idx
+			for end < len(path) && path[end] != '.' && path[end] != '[' {
+				end++
+			}
+			key := path[idx:end]
+			if key == "" {
+				return nil, fmt.Errorf("empty key at position %d in json path %s", idx, path)
+			}
+			if key == "*" {
+				return nil, fmt.Errorf("wildcards are not supported in json path %s", path)
+			}
+			steps = append(steps, jsonPathStep{key: key})
+			idx = end
+		case '[':
+			idx++
+			if idx < len(path) && (path[idx] == '\'' || path[idx] == '"') {
+				quote := path[idx]
+				end := strings.IndexByte(path[idx+1:], quote)
+				if end < 0 {
+					return nil, fmt.Errorf("missing closing quote at position %d in json path %s", idx, path)
+				}
+				end += idx + 1
+				if end+1 >= len(path) || path[end+1] != ']' {
+					return nil, fmt.Errorf("missing closing bracket at position %d in json path %s", end+1, path)
+				}
+				steps = append(steps, jsonPathStep{key: path[idx+1 : end]})
+				idx = end + 2
+				continue
+			}
+			end := strings.IndexByte(path[idx:], ']')
+			if end < 0 {
+				return nil, fmt.Errorf("missing closing bracket at position %d in json path %s", idx, path)
+			}
+			end += idx
+			index, err := strconv.Atoi(strings.TrimSpace(path[idx:end]))
+			if err != nil {
+				return nil, fmt.Errorf("invalid array index %s in json path %s, only numeric indexes are supported", path[idx:end], path)
+			}
+			steps = append(steps, jsonPathStep{index: index, isIndex: true})
+			idx = end + 1
+		default:
+			return nil, fmt.Errorf("unexpected character %q at position %d in json path %s", path[idx], idx, path)
+		}
+	}
+	return steps, nil
+}
+
+// evaluateJsonPath returns the value in the path of the data, and false if the path doesn't exist in the data
+func evaluateJsonPath(data interface{}, steps []jsonPathStep) (interface{}, bool) {
+	for _, step := range steps {
+		if step.isIndex {
+			array, ok := data.([]interface{})
+			if !ok {
+				return nil, false
+			}
+			index := step.index
+			if index < 0 {
+				index += len(array)
+			}
+			if index < 0 || index >= len(array) {
+				return nil, false
+			}
+			data = array[index]
+			continue
+		}
+		object, ok := data.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		data, ok = object[step.key]
+		if !ok {
+			return nil, false
+		}
+	}
+	return data, true
+}
@@ -1,6 +1,7 @@
 package parser
 
 import (
+	"bytes"
 	"encoding/base64"
 	"encoding/hex"
 	"encoding/json"
@@ -72,6 +73,8 @@ func Parse(rpcInput RPCInput, blockParser spectypes.BlockParser, dataSource int)
 		retval, err = ParseDictionaryOrOrdered(rpcInput, blockParser.ParserArg, dataSource)
 	case spectypes.PARSER_FUNC_DEFAULT:
 		retval = ParseDefault(rpcInput, blockParser.ParserArg, dataSource)
+	case spectypes.PARSER_FUNC_PARSE_JSON_PATH:
+		retval, err = ParseJsonPath(rpcInput, blockParser.ParserArg, dataSource)
 	default:
 		return nil, fmt.Errorf("unsupported block parser parserFunc")
 	}
@@ -107,6 +110,24 @@ func ParseBlockFromParams(rpcInput RPCInput, blockParser spectypes.BlockParser)
 	return rpcInput.ParseBlock(resString)
 }
 
+// this function returns the block hash that was requested, the same param can hold a block number or a block tag (or the default value can be one)
+// in which case it is returned as the block instead of a hash
+func ParseBlockHashFromParams(rpcInput RPCInput, blockParser spectypes.BlockParser) (blockHash string, block int64, err error) {
+	result, err := Parse(rpcInput, blockParser, PARSE_PARAMS)
+	if err != nil || result == nil {
+		return "", spectypes.NOT_APPLICABLE, err
+	}
+	resString, ok := result[0].(string)
+	if !ok {
+		return "", spectypes.NOT_APPLICABLE, fmt.Errorf("ParseBlockHashFromParams - result[0].(string) - type assertion failed, type:" + fmt.Sprintf("%s", result[0]))
+	}
+	block, err = rpcInput.ParseBlock(resString)
+	if err == nil {
+		return "", block, nil
+	}
+	return resString, spectypes.NOT_APPLICABLE, nil
+}
+
 func ParseFromReply(rpcInput RPCInput, blockParser spectypes.BlockParser) (string, error) {
 	result, err := Parse(rpcInput, blockParser, PARSE_RESULT)
 	if err != nil || result == nil {
@@ -150,6 +171,11 @@ func ParseMessageResponse(rpcInput RPCInput, resultParser spectypes.BlockParser)
 	return parseResponseByEncoding([]byte(rawResult), resultParser.Encoding)
 }
 
+// ParseBlockHashByEncoding aligns a requested block hash to the encoding of hashes parsed from responses so they can be compared
+func ParseBlockHashByEncoding(blockHash, encoding string) (string, error) {
+	return parseResponseByEncoding([]byte(blockHash), encoding)
+}
+
 // align hash encoding to base64 string, to save up on space and allow comparisons
 func parseResponseByEncoding(rawResult []byte, encoding string) (string, error) {
 	switch encoding {
@@ -427,6 +453,58 @@ func ParseDictionaryOrOrdered(rpcInput RPCInput, input []string, dataSource int)
 	}
 }
 
+// ParseJsonPath return the value in the json path specified in args if it exists in the params or the result
+// if not return a valueNotSetError
+func ParseJsonPath(rpcInput RPCInput, input []string, dataSource int) ([]interface{}, error) {
+	// Validate number of arguments
+	// The number of arguments should be 1
+	// [json path]
+	if len(input) != 1 {
+		return nil, fmt.Errorf("ParseJsonPath: invalid input format, input length: %d and needs to be 1: %s", len(input), strings.Join(input, ","))
+	}
+	steps, err := parseJsonPath(input[0])
+	if err != nil {
+		return nil, utils.LavaFormatProduction("invalid input format, input isn't a valid json path", err, utils.Attribute{Key: "input", Value: input[0]})
+	}
+
+	var data interface{}
+	switch dataSource {
+	case PARSE_PARAMS:
+		data = rpcInput.GetParams()
+	case PARSE_RESULT:
+		result := rpcInput.GetResult()
+		if len(result) == 0 {
+			return nil, fmt.Errorf("ParseJsonPath failure Get.Result is empty")
+		}
+		decoder := json.NewDecoder(bytes.NewReader(result))
+		decoder.UseNumber() // keep big block numbers as they are instead of converting them to float
+		err = decoder.Decode(&data)
+		if err != nil {
+			return nil, fmt.Errorf("invalid input format, data is not json: %s, error: %s", result, err)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported block parser parserFunc")
+	}
+
+	value, found := evaluateJsonPath(data, steps)
+	if !found || value == nil {
+		return nil, ValueNotSetError
+	}
+	switch typedValue := value.(type) {
+	case json.Number:
+		return appendInterfaceToInterfaceArray(typedValue.String()), nil
+	case map[string]interface{}, []interface{}:
+		// the path points at an object, return it as json
+		marshalled, err := json.Marshal(typedValue)
+		if err != nil {
+			return nil, err
+		}
+		return appendInterfaceToInterfaceArray(string(marshalled)), nil
+	default:
+		return appendInterfaceToInterfaceArray(blockInterfaceToString(typedValue)), nil
+	}
+}
+
 // parseArrayOfInterfaces returns value of item with specified prop name
 // If it doesn't exist return nil
 func parseArrayOfInterfaces(data []interface{}, propName, innerSeparator string) []interface{} {
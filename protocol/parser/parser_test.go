@@ -1,9 +1,11 @@
 package parser
 
 import (
+	"encoding/json"
 	"reflect"
 	"testing"
 
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
 	spectypes "github.com/lavanet/lava/x/spec/types"
 	"github.com/stretchr/testify/require"
 )
@@ -115,3 +117,120 @@ func TestParseResponseByEncoding(t *testing.T) {
 	testData = []data{{bytes: []byte("0x968ec00fd34eedc03b0577ee8116f74c75127b7d775e51c7a72519f760b821a8"), encoding: spectypes.EncodingHex}, {bytes: []byte("lo7AD9NO7cA7BXfugRb3THUSe313XlHHpyUZ92C4Iag="), encoding: spectypes.EncodingBase64}}
 	testInputs(testData)
 }
+
+type mockRPCInput struct {
+	params interface{}
+	result json.RawMessage
+}
+
+func (m *mockRPCInput) GetParams() interface{} {
+	return m.params
+}
+
+func (m *mockRPCInput) GetResult() json.RawMessage {
+	return m.result
+}
+
+func (m *mockRPCInput) ParseBlock(block string) (int64, error) {
+	return ParseDefaultBlockParameter(block)
+}
+
+func (m *mockRPCInput) GetHeaders() []pairingtypes.Metadata {
+	return nil
+}
+
+func TestParseJsonPath(t *testing.T) {
+	var params interface{}
+	err := json.Unmarshal([]byte(`["banana",{"blocks":[{"height":"0x10"},{"height":17,"hashes":["0xabc"]}],"block.id":{"number":"latest"}}]`), &params)
+	require.NoError(t, err)
+	rpcInput := &mockRPCInput{
+		params: params,
+		result: json.RawMessage(`{"header":{"height":"18446744073709551615","hash":"0x968ec00fd34eedc03b0577ee8116f74c75127b7d775e51c7a72519f760b821a8"},"txs":[{"height":1},{"height":2}]}`),
+	}
+	tests := []struct {
+		name       string
+		path       string
+		dataSource int
+		expected   string
+		valid      bool
+		notSet     bool
+	}{
+		{name: "nested array in params", path: "$[1].blocks[0].height", dataSource: PARSE_PARAMS, expected: "0x10", valid: true},
+		{name: "number in params", path: "$[1].blocks[1].height", dataSource: PARSE_PARAMS, expected: "17", valid: true},
+		{name: "negative index", path: "$[1].blocks[-1].hashes[-1]", dataSource: PARSE_PARAMS, expected: "0xabc", valid: true},
+		{name: "bracket key", path: "$[1]['block.id'].number", dataSource: PARSE_PARAMS, expected: "latest", valid: true},
+		{name: "double quoted bracket key", path: `$[1]["block.id"]["number"]`, dataSource: PARSE_PARAMS, expected: "latest", valid: true},
+		{name: "result without root", path: "header.height", dataSource: PARSE_RESULT, expected: "18446744073709551615", valid: true},
+		{name: "nested array in result", path: "$.txs[1].height", dataSource: PARSE_RESULT, expected: "2", valid: true},
+		{name: "object in result", path: "$.txs[0]", dataSource: PARSE_RESULT, expected: `{"height":1}`, valid: true},
+		{name: "missing key", path: "$[1].blocks[0].number", dataSource: PARSE_PARAMS, notSet: true},
+		{name: "index out of range", path: "$[1].blocks[2].height", dataSource: PARSE_PARAMS, notSet: true},
+		{name: "index on an object", path: "$.header[0]", dataSource: PARSE_RESULT, notSet: true},
+		{name: "wildcard", path: "$.txs[*].height", dataSource: PARSE_RESULT},
+		{name: "unsupported recursive descent", path: "$..height", dataSource: PARSE_RESULT},
+		{name: "unclosed bracket", path: "$.txs[1", dataSource: PARSE_RESULT},
+		{name: "unclosed quote", path: "$['txs]", dataSource: PARSE_RESULT},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result, err := ParseJsonPath(rpcInput, []string{test.path}, test.dataSource)
+			if test.valid {
+				require.NoError(t, err)
+				require.Equal(t, []interface{}{test.expected}, result)
+			} else if test.notSet {
+				require.True(t, ValueNotSetError.Is(err))
+			} else {
+				require.Error(t, err)
+				require.False(t, ValueNotSetError.Is(err))
+			}
+		})
+	}
+}
+
+func TestParseJsonPathBlockParser(t *testing.T) {
+	rpcInput := &mockRPCInput{
+		params: map[string]interface{}{"filter": map[string]interface{}{"blocks": []interface{}{"0x20"}}},
+		result: json.RawMessage(`{"blocks":[{"hash":"9291EDC036AE254F9A6E0237F0EF13C452E7F08722E8DBD68B2F34CC8132C91D"}]}`),
+	}
+	blockParser := spectypes.BlockParser{ParserArg: []string{"$.filter.blocks[0]"}, ParserFunc: spectypes.PARSER_FUNC_PARSE_JSON_PATH}
+	block, err := ParseBlockFromParams(rpcInput, blockParser)
+	require.NoError(t, err)
+	require.Equal(t, int64(32), block)
+
+	// default value is used when the path doesn't exist
+	blockParser = spectypes.BlockParser{ParserArg: []string{"$.filter.blocks[1]"}, ParserFunc: spectypes.PARSER_FUNC_PARSE_JSON_PATH, DefaultValue: "latest"}
+	block, err = ParseBlockFromParams(rpcInput, blockParser)
+	require.NoError(t, err)
+	require.Equal(t, spectypes.LATEST_BLOCK, block)
+
+	// encoding is applied on the parsed result
+	resultParser := spectypes.BlockParser{ParserArg: []string{"$.blocks[0].hash"}, ParserFunc: spectypes.PARSER_FUNC_PARSE_JSON_PATH, Encoding: spectypes.EncodingHex}
+	hash, err := ParseMessageResponse(rpcInput, resultParser)
+	require.NoError(t, err)
+	require.Equal(t, "kpHtwDauJU+abgI38O8TxFLn8Ici6NvWiy80zIEyyR0=", hash)
+}
+
+func TestParseBlockHashFromParams(t *testing.T) {
+	hash := "0x968ec00fd34eedc03b0577ee8116f74c75127b7d775e51c7a72519f760b821a8"
+	blockParser := spectypes.BlockParser{ParserArg: []string{"0"}, ParserFunc: spectypes.PARSER_FUNC_PARSE_BY_ARG, BlockHash: true}
+
+	blockHash, block, err := ParseBlockHashFromParams(&mockRPCInput{params: []interface{}{hash, false}}, blockParser)
+	require.NoError(t, err)
+	require.Equal(t, hash, blockHash)
+	require.Equal(t, spectypes.NOT_APPLICABLE, block)
+
+	// a block number or tag in the param is returned as the block
+	blockHash, block, err = ParseBlockHashFromParams(&mockRPCInput{params: []interface{}{"0x10", false}}, blockParser)
+	require.NoError(t, err)
+	require.Empty(t, blockHash)
+	require.Equal(t, int64(16), block)
+
+	blockHash, block, err = ParseBlockHashFromParams(&mockRPCInput{params: []interface{}{"latest", false}}, blockParser)
+	require.NoError(t, err)
+	require.Empty(t, blockHash)
+	require.Equal(t, spectypes.LATEST_BLOCK, block)
+
+	encodedHash, err := ParseBlockHashByEncoding(hash, spectypes.EncodingHex)
+	require.NoError(t, err)
+	require.Equal(t, "lo7AD9NO7cA7BXfugRb3THUSe313XlHHpyUZ92C4Iag=", encodedHash)
+}
@@ -9,8 +9,10 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"syscall"
 	"time"
 
+	"github.com/btcsuite/btcd/btcec"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/config"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -76,7 +78,9 @@ func (s *strategyValue) Type() string {
 type ConsumerStateTrackerInf interface {
 	RegisterForVersionUpdates(ctx context.Context, version *protocoltypes.Version, versionValidator statetracker.VersionValidationInf)
 	RegisterConsumerSessionManagerForPairingUpdates(ctx context.Context, consumerSessionManager *lavasession.ConsumerSessionManager)
+	UnregisterConsumerSessionManagerForPairingUpdates(ctx context.Context, consumerSessionManager *lavasession.ConsumerSessionManager)
 	RegisterForSpecUpdates(ctx context.Context, specUpdatable statetracker.SpecUpdatable, endpoint lavasession.RPCEndpoint) error
+	UnregisterForSpecUpdates(ctx context.Context, endpoint lavasession.RPCEndpoint)
 	RegisterFinalizationConsensusForUpdates(context.Context, *lavaprotocol.FinalizationConsensus)
 	TxConflictDetection(ctx context.Context, finalizationConflict *conflicttypes.FinalizationConflict, responseConflict *conflicttypes.ResponseConflict, sameProviderConflict *conflicttypes.FinalizationConflict, conflictHandler lavaprotocol.ConflictHandlerInterface) error
 	GetConsumerPolicy(ctx context.Context, consumerAddress, chainID string) (*plantypes.Policy, error)
@@ -84,14 +88,42 @@ type ConsumerStateTrackerInf interface {
 }
 
 type RPCConsumer struct {
-	consumerStateTracker ConsumerStateTrackerInf
+	consumerStateTracker   ConsumerStateTrackerInf
+	requiredResponses      int
+	cache                  *performance.Cache
+	strategy               provideroptimizer.Strategy
+	maxConcurrentProviders uint
+	privKey                *btcec.PrivateKey
+	lavaChainID            string
+	consumerAddr           sdk.AccAddress
+	rpcConsumerMetrics     *metrics.RPCConsumerLogs
+	lock                   sync.Mutex
+	chainMutexes           map[string]*sync.Mutex
+	optimizers             sync.Map
+	activeEndpoints        map[string]*activeConsumerEndpoint
+	reloadLock             sync.Mutex
 }
 
+type activeConsumerEndpoint struct {
+	endpoint               *lavasession.RPCEndpoint
+	consumerSessionManager *lavasession.ConsumerSessionManager
+	cancel                 context.CancelFunc
+}
+
+// EndpointsLoader reads the current endpoints configuration, it is called on every reload
+type EndpointsLoader func() ([]*lavasession.RPCEndpoint, error)
+
 // spawns a new RPCConsumer server with all it's processes and internals ready for communications
-func (rpcc *RPCConsumer) Start(ctx context.Context, txFactory tx.Factory, clientCtx client.Context, rpcEndpoints []*lavasession.RPCEndpoint, requiredResponses int, cache *performance.Cache, strategy provideroptimizer.Strategy, metricsListenAddress string, maxConcurrentProviders uint) (err error) {
+func (rpcc *RPCConsumer) Start(ctx context.Context, txFactory tx.Factory, clientCtx client.Context, rpcEndpoints []*lavasession.RPCEndpoint, requiredResponses int, cache *performance.Cache, strategy provideroptimizer.Strategy, metricsListenAddress string, maxConcurrentProviders uint, endpointsLoader EndpointsLoader) (err error) {
 	if commonlib.IsTestMode(ctx) {
 		testModeWarn("RPCConsumer running tests")
 	}
+	rpcc.requiredResponses = requiredResponses
+	rpcc.cache = cache
+	rpcc.strategy = strategy
+	rpcc.maxConcurrentProviders = maxConcurrentProviders
+	rpcc.chainMutexes = map[string]*sync.Mutex{}
+	rpcc.activeEndpoints = map[string]*activeConsumerEndpoint{}
 	// spawn up ConsumerStateTracker
 	lavaChainFetcher := chainlib.NewLavaChainFetcher(ctx, clientCtx)
 	consumerStateTracker, err := statetracker.NewConsumerStateTracker(ctx, txFactory, clientCtx, lavaChainFetcher)
@@ -100,12 +132,12 @@ func (rpcc *RPCConsumer) Start(ctx context.Context, txFactory tx.Factory, client
 	}
 	rpcc.consumerStateTracker = consumerStateTracker
 
-	lavaChainID := clientCtx.ChainID
+	rpcc.lavaChainID = clientCtx.ChainID
 	keyName, err := sigs.GetKeyName(clientCtx)
 	if err != nil {
 		utils.LavaFormatFatal("failed getting key name from clientCtx", err)
 	}
-	privKey, err := sigs.GetPrivKey(clientCtx, keyName)
+	rpcc.privKey, err = sigs.GetPrivKey(clientCtx, keyName)
 	if err != nil {
 		utils.LavaFormatFatal("failed getting private key from key name", err, utils.Attribute{Key: "keyName", Value: keyName})
 	}
@@ -116,29 +148,18 @@ func (rpcc *RPCConsumer) Start(ctx context.Context, txFactory tx.Factory, client
 		utils.LavaFormatFatal("failed getting public key from key name", err, utils.Attribute{Key: "keyName", Value: keyName})
 	}
 
-	var consumerAddr sdk.AccAddress
-	err = consumerAddr.Unmarshal(pubkey.Address())
+	err = rpcc.consumerAddr.Unmarshal(pubkey.Address())
 	if err != nil {
 		utils.LavaFormatFatal("failed unmarshaling public address", err, utils.Attribute{Key: "keyName", Value: keyName}, utils.Attribute{Key: "pubkey", Value: pubkey.Address()})
 	}
-	// we want one provider optimizer per chain so we will store them for reuse across rpcEndpoints
-	chainMutexes := map[string]*sync.Mutex{}
-	for _, endpoint := range rpcEndpoints {
-		chainMutexes[endpoint.ChainID] = &sync.Mutex{} // create a mutex per chain for shared resources
-	}
-	var optimizers sync.Map
-	var wg sync.WaitGroup
-	parallelJobs := len(rpcEndpoints)
-	wg.Add(parallelJobs)
-	errCh := make(chan error)
 	consumerMetricsManager := metrics.NewConsumerMetricsManager(metricsListenAddress) // start up prometheus metrics
-	rpcConsumerMetrics, err := metrics.NewRPCConsumerLogs(consumerMetricsManager)
+	rpcc.rpcConsumerMetrics, err = metrics.NewRPCConsumerLogs(consumerMetricsManager)
 	if err != nil {
 		utils.LavaFormatFatal("failed creating RPCConsumer logs", err)
 	}
 	consumerStateTracker.RegisterForUpdates(ctx, statetracker.NewMetricsUpdater(consumerMetricsManager))
-	utils.LavaFormatInfo("RPCConsumer pubkey: " + consumerAddr.String())
-	utils.LavaFormatInfo("RPCConsumer setting up endpoints", utils.Attribute{Key: "length", Value: strconv.Itoa(parallelJobs)})
+	utils.LavaFormatInfo("RPCConsumer pubkey: " + rpcc.consumerAddr.String())
+	utils.LavaFormatInfo("RPCConsumer setting up endpoints", utils.Attribute{Key: "length", Value: strconv.Itoa(len(rpcEndpoints))})
 
 	// check version
 	version, err := consumerStateTracker.GetProtocolVersion(ctx)
@@ -147,89 +168,192 @@ func (rpcc *RPCConsumer) Start(ctx context.Context, txFactory tx.Factory, client
 	}
 	consumerStateTracker.RegisterForVersionUpdates(ctx, version, &upgrade.ProtocolVersion{})
 
-	for _, rpcEndpoint := range rpcEndpoints {
-		go func(rpcEndpoint *lavasession.RPCEndpoint) error {
-			defer wg.Done()
-			chainParser, err := chainlib.NewChainParser(rpcEndpoint.ApiInterface)
-			if err != nil {
-				err = utils.LavaFormatError("failed creating chain parser", err, utils.Attribute{Key: "endpoint", Value: rpcEndpoint})
-				errCh <- err
-				return err
-			}
-			chainID := rpcEndpoint.ChainID
-			err = rpcc.consumerStateTracker.RegisterForSpecUpdates(ctx, chainParser, *rpcEndpoint)
-			if err != nil {
-				err = utils.LavaFormatError("failed registering for spec updates", err, utils.Attribute{Key: "endpoint", Value: rpcEndpoint})
-				errCh <- err
-				return err
-			}
-			_, averageBlockTime, _, _ := chainParser.ChainBlockStats()
-			var optimizer *provideroptimizer.ProviderOptimizer
-
-			getOrCreateOptimizer := func() error {
-				// this is locked so we don't race optimizers creation
-				chainMutexes[chainID].Lock()
-				defer chainMutexes[chainID].Unlock()
-				value, exists := optimizers.Load(chainID)
-				if !exists {
-					// doesn't exist for this chain create a new one
-					baseLatency := commonlib.AverageWorldLatency / 2 // we want performance to be half our timeout or better
-					optimizer = provideroptimizer.NewProviderOptimizer(strategy, averageBlockTime, baseLatency, maxConcurrentProviders)
-					optimizers.Store(chainID, optimizer)
-				} else {
-					var ok bool
-					optimizer, ok = value.(*provideroptimizer.ProviderOptimizer)
-					if !ok {
-						err = utils.LavaFormatError("failed loading optimizer, value is of the wrong type", err, utils.Attribute{Key: "endpoint", Value: rpcEndpoint.Key()})
-						return err
-					}
-				}
-				return nil
-			}
-			err = getOrCreateOptimizer()
-			if err != nil {
-				errCh <- err
-				return err
-			}
+	errs := rpcc.setupEndpoints(ctx, rpcEndpoints)
+	for _, err := range errs {
+		return err
+	}
 
-			// Register For Updates
-			consumerSessionManager := lavasession.NewConsumerSessionManager(rpcEndpoint, optimizer)
-			rpcc.consumerStateTracker.RegisterConsumerSessionManagerForPairingUpdates(ctx, consumerSessionManager)
+	utils.LavaFormatInfo("RPCConsumer done setting up all endpoints, ready for requests")
 
-			finalizationConsensus := &lavaprotocol.FinalizationConsensus{}
-			consumerStateTracker.RegisterFinalizationConsensusForUpdates(ctx, finalizationConsensus)
+	signalChan := make(chan os.Signal, 1)
+	signal.Notify(signalChan, os.Interrupt)
+	reloadChan := make(chan os.Signal, 1)
+	signal.Notify(reloadChan, syscall.SIGHUP)
+	defer signal.Stop(reloadChan)
+	for {
+		select {
+		case <-signalChan:
+			return nil
+		case <-reloadChan:
+			if endpointsLoader == nil {
+				utils.LavaFormatWarning("received reload signal but endpoints were not loaded from a config file, ignoring", nil)
+				continue
+			}
+			utils.LavaFormatInfo("RPCConsumer received reload signal")
+			rpcc.ReloadEndpoints(ctx, endpointsLoader)
+		}
+	}
+}
 
-			rpcConsumerServer := &RPCConsumerServer{}
-			utils.LavaFormatInfo("RPCConsumer Listening", utils.Attribute{Key: "endpoints", Value: rpcEndpoint.String()})
-			err = rpcConsumerServer.ServeRPCRequests(ctx, rpcEndpoint, rpcc.consumerStateTracker, chainParser, finalizationConsensus, consumerSessionManager, requiredResponses, privKey, lavaChainID, cache, rpcConsumerMetrics, consumerAddr)
+// setupEndpoints sets up the given endpoints in parallel and returns the errors of the ones that failed
+func (rpcc *RPCConsumer) setupEndpoints(ctx context.Context, rpcEndpoints []*lavasession.RPCEndpoint) (errs []error) {
+	// we want one provider optimizer per chain so we will store them for reuse across rpcEndpoints
+	rpcc.lock.Lock()
+	for _, endpoint := range rpcEndpoints {
+		if _, ok := rpcc.chainMutexes[endpoint.ChainID]; !ok {
+			rpcc.chainMutexes[endpoint.ChainID] = &sync.Mutex{} // create a mutex per chain for shared resources
+		}
+	}
+	rpcc.lock.Unlock()
+	var wg sync.WaitGroup
+	parallelJobs := len(rpcEndpoints)
+	wg.Add(parallelJobs)
+	errCh := make(chan error, parallelJobs)
+
+	for _, rpcEndpoint := range rpcEndpoints {
+		go func(rpcEndpoint *lavasession.RPCEndpoint) {
+			defer wg.Done()
+			err := rpcc.setupEndpoint(ctx, rpcEndpoint)
 			if err != nil {
-				err = utils.LavaFormatError("failed serving rpc requests", err, utils.Attribute{Key: "endpoint", Value: rpcEndpoint})
 				errCh <- err
-				return err
 			}
-			return nil
 		}(rpcEndpoint)
 	}
 
 	wg.Wait()
 	close(errCh)
-
 	for err := range errCh {
+		errs = append(errs, err)
+	}
+	return errs
+}
+
+func (rpcc *RPCConsumer) setupEndpoint(ctx context.Context, rpcEndpoint *lavasession.RPCEndpoint) error {
+	chainParser, err := chainlib.NewChainParser(rpcEndpoint.ApiInterface)
+	if err != nil {
+		return utils.LavaFormatError("failed creating chain parser", err, utils.Attribute{Key: "endpoint", Value: rpcEndpoint})
+	}
+	chainID := rpcEndpoint.ChainID
+	err = rpcc.consumerStateTracker.RegisterForSpecUpdates(ctx, chainParser, *rpcEndpoint)
+	if err != nil {
+		return utils.LavaFormatError("failed registering for spec updates", err, utils.Attribute{Key: "endpoint", Value: rpcEndpoint})
+	}
+	_, averageBlockTime, _, _ := chainParser.ChainBlockStats()
+	var optimizer *provideroptimizer.ProviderOptimizer
+
+	getOrCreateOptimizer := func() error {
+		// this is locked so we don't race optimizers creation
+		rpcc.lock.Lock()
+		chainMutex := rpcc.chainMutexes[chainID]
+		rpcc.lock.Unlock()
+		chainMutex.Lock()
+		defer chainMutex.Unlock()
+		value, exists := rpcc.optimizers.Load(chainID)
+		if !exists {
+			// doesn't exist for this chain create a new one
+			baseLatency := commonlib.AverageWorldLatency / 2 // we want performance to be half our timeout or better
+			optimizer = provideroptimizer.NewProviderOptimizer(rpcc.strategy, averageBlockTime, baseLatency, rpcc.maxConcurrentProviders)
+			rpcc.optimizers.Store(chainID, optimizer)
+		} else {
+			var ok bool
+			optimizer, ok = value.(*provideroptimizer.ProviderOptimizer)
+			if !ok {
+				err = utils.LavaFormatError("failed loading optimizer, value is of the wrong type", err, utils.Attribute{Key: "endpoint", Value: rpcEndpoint.Key()})
+				return err
+			}
+		}
+		return nil
+	}
+	err = getOrCreateOptimizer()
+	if err != nil {
+		rpcc.consumerStateTracker.UnregisterForSpecUpdates(ctx, *rpcEndpoint)
 		return err
 	}
 
-	utils.LavaFormatInfo("RPCConsumer done setting up all endpoints, ready for requests")
+	// every endpoint gets its own context so its listener can be closed on a configuration reload
+	endpointCtx, endpointCancel := context.WithCancel(ctx)
+	// Register For Updates
+	consumerSessionManager := lavasession.NewConsumerSessionManager(rpcEndpoint, optimizer)
+	rpcc.consumerStateTracker.RegisterConsumerSessionManagerForPairingUpdates(ctx, consumerSessionManager)
 
-	signalChan := make(chan os.Signal, 1)
-	signal.Notify(signalChan, os.Interrupt)
-	<-signalChan
+	finalizationConsensus := &lavaprotocol.FinalizationConsensus{}
+	rpcc.consumerStateTracker.RegisterFinalizationConsensusForUpdates(ctx, finalizationConsensus)
+
+	rpcConsumerServer := &RPCConsumerServer{}
+	utils.LavaFormatInfo("RPCConsumer Listening", utils.Attribute{Key: "endpoints", Value: rpcEndpoint.String()})
+	err = rpcConsumerServer.ServeRPCRequests(endpointCtx, rpcEndpoint, rpcc.consumerStateTracker, chainParser, finalizationConsensus, consumerSessionManager, rpcc.requiredResponses, rpcc.privKey, rpcc.lavaChainID, rpcc.cache, rpcc.rpcConsumerMetrics, rpcc.consumerAddr)
+	if err != nil {
+		endpointCancel()
+		rpcc.consumerStateTracker.UnregisterConsumerSessionManagerForPairingUpdates(ctx, consumerSessionManager)
+		rpcc.consumerStateTracker.UnregisterForSpecUpdates(ctx, *rpcEndpoint)
+		return utils.LavaFormatError("failed serving rpc requests", err, utils.Attribute{Key: "endpoint", Value: rpcEndpoint})
+	}
+	rpcc.lock.Lock()
+	rpcc.activeEndpoints[rpcEndpoint.Key()] = &activeConsumerEndpoint{endpoint: rpcEndpoint, consumerSessionManager: consumerSessionManager, cancel: endpointCancel}
+	rpcc.lock.Unlock()
+	return nil
+}
+
+// ReloadEndpoints applies a new endpoints configuration on a running consumer, listeners are opened for new endpoints and closed for removed ones
+func (rpcc *RPCConsumer) ReloadEndpoints(ctx context.Context, endpointsLoader EndpointsLoader) error {
+	rpcc.reloadLock.Lock()
+	defer rpcc.reloadLock.Unlock()
+	newEndpoints, err := endpointsLoader()
+	if err != nil {
+		return utils.LavaFormatError("failed loading endpoints configuration, keeping the current one", err)
+	}
+	if len(newEndpoints) == 0 {
+		return utils.LavaFormatError("endpoints configuration is empty, keeping the current one", nil)
+	}
+	newEndpointsByKey := map[string]*lavasession.RPCEndpoint{}
+	for _, endpoint := range newEndpoints {
+		if _, ok := newEndpointsByKey[endpoint.Key()]; ok {
+			return utils.LavaFormatError("endpoints configuration contains a duplicate endpoint, keeping the current one", nil, utils.Attribute{Key: "endpoint", Value: endpoint.String()})
+		}
+		newEndpointsByKey[endpoint.Key()] = endpoint
+	}
+
+	toRemove := []*activeConsumerEndpoint{}
+	toAdd := []*lavasession.RPCEndpoint{}
+	rpcc.lock.Lock()
+	for key, active := range rpcc.activeEndpoints {
+		newEndpoint, ok := newEndpointsByKey[key]
+		// a modified listen address requires a new listener
+		if !ok || newEndpoint.NetworkAddress != active.endpoint.NetworkAddress {
+			toRemove = append(toRemove, active)
+			delete(rpcc.activeEndpoints, key)
+		}
+	}
+	for key, endpoint := range newEndpointsByKey {
+		if _, ok := rpcc.activeEndpoints[key]; !ok {
+			toAdd = append(toAdd, endpoint)
+		}
+	}
+	rpcc.lock.Unlock()
+
+	for _, active := range toRemove {
+		// closing the listener shuts it down gracefully, requests in flight are allowed to finish
+		active.cancel()
+		rpcc.consumerStateTracker.UnregisterConsumerSessionManagerForPairingUpdates(ctx, active.consumerSessionManager)
+		rpcc.consumerStateTracker.UnregisterForSpecUpdates(ctx, *active.endpoint)
+		utils.LavaFormatInfo("RPCConsumer closed endpoint", utils.Attribute{Key: "endpoint", Value: active.endpoint.String()})
+	}
+	errs := rpcc.setupEndpoints(ctx, toAdd)
+	utils.LavaFormatInfo("RPCConsumer reloaded endpoints configuration",
+		utils.Attribute{Key: "added", Value: len(toAdd) - len(errs)},
+		utils.Attribute{Key: "removed", Value: len(toRemove)},
+		utils.Attribute{Key: "failed", Value: len(errs)},
+	)
+	if len(errs) > 0 {
+		return utils.LavaFormatError("failed setting up some of the reloaded endpoints", errs[0])
+	}
 	return nil
 }
 
 func ParseEndpoints(viper_endpoints *viper.Viper, geolocation uint64) (endpoints []*lavasession.RPCEndpoint, err error) {
 	err = viper_endpoints.UnmarshalKey(commonlib.EndpointsConfigName, &endpoints)
 	if err != nil {
-		utils.LavaFormatFatal("could not unmarshal endpoints", err, utils.Attribute{Key: "viper_endpoints", Value: viper_endpoints.AllSettings()})
+		return nil, utils.LavaFormatError("could not unmarshal endpoints", err, utils.Attribute{Key: "viper_endpoints", Value: viper_endpoints.AllSettings()})
 	}
 	for _, endpoint := range endpoints {
 		endpoint.Geolocation = geolocation
@@ -387,7 +511,18 @@ rpcconsumer 127.0.0.1:3333 COS3 tendermintrpc 127.0.0.1:3334 COS3 rest <flags>`,
 			}
 			prometheusListenAddr := viper.GetString(metrics.MetricsListenFlagName)
 			maxConcurrentProviders := viper.GetUint(commonlib.MaximumConcurrentProvidersFlagName)
-			err = rpcConsumer.Start(ctx, txFactory, clientCtx, rpcEndpoints, requiredResponses, cache, strategyFlag.Strategy, prometheusListenAddr, maxConcurrentProviders)
+			var endpointsLoader EndpointsLoader
+			if len(args) <= 1 {
+				// endpoints from a config file can be reloaded by SIGHUP
+				endpointsLoader = func() ([]*lavasession.RPCEndpoint, error) {
+					err := viper.ReadInConfig()
+					if err != nil {
+						return nil, utils.LavaFormatError("could not load config file", err, utils.Attribute{Key: "expected_config_name", Value: viper.ConfigFileUsed()})
+					}
+					return ParseEndpoints(viper.GetViper(), geolocation)
+				}
+			}
+			err = rpcConsumer.Start(ctx, txFactory, clientCtx, rpcEndpoints, requiredResponses, cache, strategyFlag.Strategy, prometheusListenAddr, maxConcurrentProviders, endpointsLoader)
 			return err
 		},
 	}
@@ -3,6 +3,7 @@ package rpcconsumer
 import (
 	"context"
 	"errors"
+	"fmt"
 	"math/rand"
 	"time"
 
@@ -14,6 +15,7 @@ import (
 	"github.com/lavanet/lava/protocol/lavaprotocol"
 	"github.com/lavanet/lava/protocol/lavasession"
 	"github.com/lavanet/lava/protocol/metrics"
+	"github.com/lavanet/lava/protocol/parser"
 	"github.com/lavanet/lava/protocol/performance"
 	"github.com/lavanet/lava/utils"
 	conflicttypes "github.com/lavanet/lava/x/conflict/types"
@@ -95,6 +97,8 @@ func (rpccs *RPCConsumerServer) ServeRPCRequests(ctx context.Context, listenEndp
 		}
 	}
 	rpccs.chainParser.SetConfiguredExtensions(rpccs.consumerServices) // configure possible extensions as set by the policy
+	// requested block hashes are resolved from the finalized blocks reported by providers, other hashes are fetched with a relay in the background
+	rpccs.chainParser.SetBlockHashResolver(chainlib.NewBlockHashResolver(ctx, finalizationConsensus, rpccs, chainParser))
 	chainListener, err := chainlib.NewChainListener(ctx, listenEndpoint, rpccs, rpcConsumerLogs, chainParser)
 	if err != nil {
 		return err
@@ -155,6 +159,38 @@ func (rpccs *RPCConsumerServer) sendInitialRelays(count int) {
 	}
 }
 
+// FetchBlockNumByHash returns the height of the block with the hash, by relaying the spec GET_BLOCK_BY_HASH function to a provider
+func (rpccs *RPCConsumerServer) FetchBlockNumByHash(ctx context.Context, blockHash string) (int64, error) {
+	parsing, collectionData, ok := rpccs.chainParser.GetParsingByTag(spectypes.FUNCTION_TAG_GET_BLOCK_BY_HASH)
+	tagName := spectypes.FUNCTION_TAG_GET_BLOCK_BY_HASH.String()
+	if !ok {
+		return spectypes.NOT_APPLICABLE, utils.LavaFormatDebug(tagName+" tag function not found", []utils.Attribute{{Key: "chainID", Value: rpccs.listenEndpoint.ChainID}, {Key: "APIInterface", Value: rpccs.listenEndpoint.ApiInterface}}...)
+	}
+	if parsing.FunctionTemplate == "" {
+		return spectypes.NOT_APPLICABLE, utils.LavaFormatError(tagName+" missing function template", nil, []utils.Attribute{{Key: "chainID", Value: rpccs.listenEndpoint.ChainID}, {Key: "APIInterface", Value: rpccs.listenEndpoint.ApiInterface}}...)
+	}
+	path := parsing.ApiName
+	data := []byte(fmt.Sprintf(parsing.FunctionTemplate, blockHash))
+	chainMessage, err := chainlib.CraftChainMessage(parsing, collectionData.Type, rpccs.chainParser, &chainlib.CraftData{Path: path, Data: data, ConnectionType: collectionData.Type}, nil)
+	if err != nil {
+		return spectypes.NOT_APPLICABLE, utils.LavaFormatError(tagName+" failed CraftChainMessage on function template", err, []utils.Attribute{{Key: "chainID", Value: rpccs.listenEndpoint.ChainID}, {Key: "APIInterface", Value: rpccs.listenEndpoint.ApiInterface}}...)
+	}
+	ctx = utils.WithUniqueIdentifier(ctx, utils.GenerateUniqueIdentifier())
+	reply, _, err := rpccs.SendRelay(ctx, path, string(data), collectionData.Type, "-block-hash-", nil, nil)
+	if err != nil {
+		return spectypes.NOT_APPLICABLE, utils.LavaFormatWarning(tagName+" failed sending relay", err, []utils.Attribute{{Key: "chainID", Value: rpccs.listenEndpoint.ChainID}, {Key: "APIInterface", Value: rpccs.listenEndpoint.ApiInterface}}...)
+	}
+	parserInput, err := chainlib.FormatResponseForParsing(reply, chainMessage)
+	if err != nil {
+		return spectypes.NOT_APPLICABLE, utils.LavaFormatWarning(tagName+" Failed formatResponseForParsing", err, utils.Attribute{Key: "Method", Value: parsing.ApiName}, utils.Attribute{Key: "Response", Value: string(reply.Data)})
+	}
+	blockNum, err := parser.ParseBlockFromReply(parserInput, parsing.ResultParsing)
+	if err != nil {
+		return spectypes.NOT_APPLICABLE, utils.LavaFormatWarning(tagName+" Failed to parse Response", err, utils.Attribute{Key: "Method", Value: parsing.ApiName}, utils.Attribute{Key: "Response", Value: string(reply.Data)})
+	}
+	return blockNum, nil
+}
+
 func (rpccs *RPCConsumerServer) getLatestBlock() uint64 {
 	latestKnownBlock, numProviders := rpccs.finalizationConsensus.ExpectedBlockHeight(rpccs.chainParser)
 	if numProviders > 0 && latestKnownBlock > 0 {
@@ -0,0 +1,368 @@
+package rpcprovider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/lavanet/lava/protocol/chainlib"
+	"github.com/lavanet/lava/protocol/common"
+	"github.com/lavanet/lava/protocol/lavasession"
+	"github.com/lavanet/lava/utils"
+	spectypes "github.com/lavanet/lava/x/spec/types"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"golang.org/x/exp/slices"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	DiscoverOutputFlagName = "output-config"
+	discoverProbeTimeout   = 30 * time.Second
+)
+
+// DiscoveredNodeUrl holds the services a node url passed the spec verifications for
+type DiscoveredNodeUrl struct {
+	NodeUrl    common.NodeUrl
+	Addons     []string
+	Extensions []string
+	// services that exist in the spec but have no verification, so their support can't be determined
+	Unverifiable []string
+	// services that were configured but failed verification
+	Removed []string
+	Err     error
+}
+
+// specServices returns the addons and extensions the spec defines for an api interface
+func specServices(spec *spectypes.Spec, apiInterface string) (addons, extensions []string) {
+	addonsSet := map[string]struct{}{}
+	extensionsSet := map[string]struct{}{}
+	for _, apiCollection := range spec.ApiCollections {
+		if !apiCollection.Enabled || apiCollection.CollectionData.ApiInterface != apiInterface {
+			continue
+		}
+		if apiCollection.CollectionData.AddOn != "" {
+			addonsSet[apiCollection.CollectionData.AddOn] = struct{}{}
+		}
+		for _, extension := range apiCollection.Extensions {
+			if extension.Name != "" {
+				extensionsSet[extension.Name] = struct{}{}
+			}
+		}
+	}
+	for addon := range addonsSet {
+		addons = append(addons, addon)
+	}
+	for extension := range extensionsSet {
+		extensions = append(extensions, extension)
+	}
+	sort.Strings(addons)
+	sort.Strings(extensions)
+	return addons, extensions
+}
+
+// verificationsForKey returns only the verifications that check the given addon and extension, without the base ones
+func verificationsForKey(chainParser chainlib.ChainParser, supported []string, key chainlib.VerificationKey) ([]chainlib.VerificationContainer, error) {
+	verifications, err := chainParser.GetVerifications(supported)
+	if err != nil {
+		return nil, err
+	}
+	ret := []chainlib.VerificationContainer{}
+	for _, verification := range verifications {
+		if verification.VerificationKey == key {
+			ret = append(ret, verification)
+		}
+	}
+	return ret, nil
+}
+
+// probeServices connects to the node url as a node serving the given addons and extensions, and runs the verifications on it
+func probeServices(ctx context.Context, chainParser chainlib.ChainParser, endpoint *lavasession.RPCProviderEndpoint, nodeUrl common.NodeUrl, baseServices []string, probedServices []string, verifications []chainlib.VerificationContainer) error {
+	ctx, cancel := context.WithTimeout(ctx, discoverProbeTimeout)
+	defer cancel()
+	baseNodeUrl := nodeUrl
+	baseNodeUrl.Addons = baseServices
+	// the router requires the base services to be supported as well, so the node url is configured for both
+	nodeUrls := []common.NodeUrl{baseNodeUrl}
+	if len(probedServices) > 0 {
+		probedNodeUrl := nodeUrl
+		probedNodeUrl.Addons = append(append([]string{}, baseServices...), probedServices...)
+		nodeUrls = append(nodeUrls, probedNodeUrl)
+	}
+	probeEndpoint := &lavasession.RPCProviderEndpoint{
+		NetworkAddress: endpoint.NetworkAddress,
+		ChainID:        endpoint.ChainID,
+		ApiInterface:   endpoint.ApiInterface,
+		Geolocation:    endpoint.Geolocation,
+		NodeUrls:       nodeUrls,
+	}
+	chainRouter, err := chainlib.GetChainRouter(ctx, 1, probeEndpoint, chainParser)
+	if err != nil {
+		return err
+	}
+	chainFetcher := chainlib.NewChainFetcher(ctx, chainRouter, chainParser, probeEndpoint, nil)
+	latestBlock, err := chainFetcher.FetchLatestBlockNum(ctx)
+	if err != nil {
+		return err
+	}
+	for _, verification := range verifications {
+		err = chainFetcher.Verify(ctx, verification, uint64(latestBlock))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DiscoverNodeUrl runs the spec verifications of every addon and extension against the node url
+// and returns the services the node actually supports
+func DiscoverNodeUrl(ctx context.Context, spec *spectypes.Spec, chainParser chainlib.ChainParser, endpoint *lavasession.RPCProviderEndpoint, nodeUrl common.NodeUrl) *DiscoveredNodeUrl {
+	discovered := &DiscoveredNodeUrl{NodeUrl: nodeUrl}
+	addons, extensions := specServices(spec, endpoint.ApiInterface)
+
+	baseVerifications, err := verificationsForKey(chainParser, nil, chainlib.VerificationKey{})
+	if err != nil {
+		discovered.Err = err
+		return discovered
+	}
+	err = probeServices(ctx, chainParser, endpoint, nodeUrl, nil, nil, baseVerifications)
+	if err != nil {
+		discovered.Err = utils.LavaFormatWarning("node url failed the base verifications", err, utils.Attribute{Key: "url", Value: nodeUrl.String()})
+		return discovered
+	}
+
+	for _, addon := range addons {
+		verifications, err := verificationsForKey(chainParser, []string{addon}, chainlib.VerificationKey{Addon: addon})
+		if err != nil {
+			discovered.Err = err
+			return discovered
+		}
+		if len(verifications) == 0 {
+			discovered.Unverifiable = append(discovered.Unverifiable, addon)
+			continue
+		}
+		err = probeServices(ctx, chainParser, endpoint, nodeUrl, nil, []string{addon}, verifications)
+		if err != nil {
+			utils.LavaFormatDebug("node url doesn't support addon", utils.Attribute{Key: "url", Value: nodeUrl.String()}, utils.Attribute{Key: "addon", Value: addon}, utils.Attribute{Key: "error", Value: err})
+			continue
+		}
+		discovered.Addons = append(discovered.Addons, addon)
+	}
+
+	for _, extension := range extensions {
+		// extensions are verified on top of the base and on top of every supported addon
+		verifications := []chainlib.VerificationContainer{}
+		for _, addon := range append([]string{""}, discovered.Addons...) {
+			addonVerifications, err := verificationsForKey(chainParser, append([]string{extension}, discovered.Addons...), chainlib.VerificationKey{Extension: extension, Addon: addon})
+			if err != nil {
+				discovered.Err = err
+				return discovered
+			}
+			verifications = append(verifications, addonVerifications...)
+		}
+		if len(verifications) == 0 {
+			discovered.Unverifiable = append(discovered.Unverifiable, extension)
+			continue
+		}
+		err = probeServices(ctx, chainParser, endpoint, nodeUrl, discovered.Addons, []string{extension}, verifications)
+		if err != nil {
+			utils.LavaFormatDebug("node url doesn't support extension", utils.Attribute{Key: "url", Value: nodeUrl.String()}, utils.Attribute{Key: "extension", Value: extension}, utils.Attribute{Key: "error", Value: err})
+			continue
+		}
+		discovered.Extensions = append(discovered.Extensions, extension)
+	}
+
+	for _, configured := range nodeUrl.Addons {
+		if !slices.Contains(discovered.Addons, configured) && !slices.Contains(discovered.Extensions, configured) && !slices.Contains(discovered.Unverifiable, configured) {
+			discovered.Removed = append(discovered.Removed, configured)
+		}
+	}
+	return discovered
+}
+
+// Services returns the addons to set on the node url, configured services that can't be verified are kept
+func (dnu *DiscoveredNodeUrl) Services() []string {
+	services := append(append([]string{}, dnu.Addons...), dnu.Extensions...)
+	for _, unverifiable := range dnu.Unverifiable {
+		if slices.Contains(dnu.NodeUrl.Addons, unverifiable) {
+			services = append(services, unverifiable)
+		}
+	}
+	return services
+}
+
+// stakeEndpointArgs builds the endpoint arguments of lavad tx pairing stake-provider for the discovered endpoints of each chain,
+// an endpoint can only be staked with the services all of its node urls are serving combined
+func stakeEndpointArgs(endpoints []*lavasession.RPCProviderEndpoint, geolocation uint64) map[string][]string {
+	type stakeEndpoint struct {
+		apiInterfaces []string
+		services      []string
+	}
+	chainEndpoints := map[string]map[string]*stakeEndpoint{}
+	for _, endpoint := range endpoints {
+		if _, ok := chainEndpoints[endpoint.ChainID]; !ok {
+			chainEndpoints[endpoint.ChainID] = map[string]*stakeEndpoint{}
+		}
+		address := endpoint.NetworkAddress.Address
+		staked, ok := chainEndpoints[endpoint.ChainID][address]
+		if !ok {
+			staked = &stakeEndpoint{}
+			chainEndpoints[endpoint.ChainID][address] = staked
+		}
+		if !slices.Contains(staked.apiInterfaces, endpoint.ApiInterface) {
+			staked.apiInterfaces = append(staked.apiInterfaces, endpoint.ApiInterface)
+		}
+		for _, nodeUrl := range endpoint.NodeUrls {
+			for _, service := range nodeUrl.Addons {
+				if !slices.Contains(staked.services, service) {
+					staked.services = append(staked.services, service)
+				}
+			}
+		}
+	}
+	ret := map[string][]string{}
+	for chainID, addresses := range chainEndpoints {
+		for address, staked := range addresses {
+			sort.Strings(staked.apiInterfaces)
+			sort.Strings(staked.services)
+			arg := strings.Join(append([]string{address, strconv.FormatUint(geolocation, 10)}, append(staked.apiInterfaces, staked.services...)...), ",")
+			ret[chainID] = append(ret[chainID], arg)
+		}
+		sort.Strings(ret[chainID])
+	}
+	return ret
+}
+
+func discoverEndpoints(ctx context.Context, clientCtx client.Context, endpoints []*lavasession.RPCProviderEndpoint) ([]*lavasession.RPCProviderEndpoint, error) {
+	specQuerier := spectypes.NewQueryClient(clientCtx)
+	specs := map[string]*spectypes.Spec{}
+	corrected := make([]*lavasession.RPCProviderEndpoint, 0, len(endpoints))
+	for _, endpoint := range endpoints {
+		spec, ok := specs[endpoint.ChainID]
+		if !ok {
+			specResponse, err := specQuerier.Spec(ctx, &spectypes.QueryGetSpecRequest{ChainID: endpoint.ChainID})
+			if err != nil {
+				return nil, utils.LavaFormatError("failed querying spec for chain", err, utils.Attribute{Key: "chainID", Value: endpoint.ChainID})
+			}
+			spec = &specResponse.Spec
+			specs[endpoint.ChainID] = spec
+		}
+		chainParser, err := chainlib.NewChainParser(endpoint.ApiInterface)
+		if err != nil {
+			return nil, err
+		}
+		chainParser.SetSpec(*spec)
+		correctedEndpoint := *endpoint
+		correctedEndpoint.NodeUrls = nil
+		for _, nodeUrl := range endpoint.NodeUrls {
+			discovered := DiscoverNodeUrl(ctx, spec, chainParser, endpoint, nodeUrl)
+			attributes := []utils.Attribute{{Key: "endpoint", Value: endpoint.Key()}, {Key: "url", Value: nodeUrl.String()}}
+			if discovered.Err != nil {
+				utils.LavaFormatError("node url is not usable, removing it from the config", discovered.Err, attributes...)
+				continue
+			}
+			nodeUrl.Addons = discovered.Services()
+			utils.LavaFormatInfo("discovered node url services", append(attributes,
+				utils.Attribute{Key: "addons", Value: discovered.Addons},
+				utils.Attribute{Key: "extensions", Value: discovered.Extensions},
+				utils.Attribute{Key: "removed", Value: discovered.Removed},
+				utils.Attribute{Key: "unverifiable", Value: discovered.Unverifiable},
+			)...)
+			correctedEndpoint.NodeUrls = append(correctedEndpoint.NodeUrls, nodeUrl)
+		}
+		if len(correctedEndpoint.NodeUrls) == 0 {
+			utils.LavaFormatError("no usable node urls for endpoint, removing it from the config", nil, utils.Attribute{Key: "endpoint", Value: endpoint.Key()})
+			continue
+		}
+		corrected = append(corrected, &correctedEndpoint)
+	}
+	return corrected, nil
+}
+
+func CreateProviderDiscoverCobraCommand() *cobra.Command {
+	cmdDiscover := &cobra.Command{
+		Use:   `discover [config-file] --geolocation <geolocation>`,
+		Short: `discover the addons and extensions the configured nodes support`,
+		Long: `discover runs every spec verification of the addons and extensions against each configured node url,
+and prints a corrected provider config along with the matching endpoint arguments for lavad tx pairing stake-provider.
+if no arguments are passed, assumes default config file: ` + DefaultRPCProviderFileName,
+		Example: `discover --geolocation 1
+discover rpcprovider.yml --geolocation 1 --output-config rpcprovider_discovered.yml`,
+		Args: cobra.RangeArgs(0, 1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			configName := DefaultRPCProviderFileName
+			if len(args) == 1 {
+				configName = args[0]
+			}
+			viperConfig := viper.New()
+			viperConfig.SetConfigName(configName)
+			viperConfig.SetConfigType("yml")
+			viperConfig.AddConfigPath(".")
+			viperConfig.AddConfigPath("./config")
+			err = viperConfig.ReadInConfig()
+			if err != nil {
+				return utils.LavaFormatError("could not load config file", err, utils.Attribute{Key: "expected_config_name", Value: configName})
+			}
+			geolocation, err := cmd.Flags().GetUint64(lavasession.GeolocationFlag)
+			if err != nil {
+				return err
+			}
+			endpoints, err := ParseEndpoints(viperConfig, geolocation)
+			if err != nil || len(endpoints) == 0 {
+				return utils.LavaFormatError("invalid endpoints definition", err)
+			}
+			output, err := cmd.Flags().GetString(DiscoverOutputFlagName)
+			if err != nil {
+				return err
+			}
+
+			corrected, err := discoverEndpoints(context.Background(), clientCtx, endpoints)
+			if err != nil {
+				return err
+			}
+			for _, endpoint := range corrected {
+				// geolocation is given by a flag and not read from the config
+				endpoint.Geolocation = 0
+			}
+			configData, err := yaml.Marshal(map[string][]*lavasession.RPCProviderEndpoint{common.EndpointsConfigName: corrected})
+			if err != nil {
+				return err
+			}
+			if output != "" {
+				err = os.WriteFile(output, configData, 0o644)
+				if err != nil {
+					return err
+				}
+				fmt.Printf("corrected provider config written to %s\n\n", output)
+			} else {
+				fmt.Printf("corrected provider config:\n\n%s\n", configData)
+			}
+			stakeArgs := stakeEndpointArgs(corrected, geolocation)
+			chainIDs := make([]string, 0, len(stakeArgs))
+			for chainID := range stakeArgs {
+				chainIDs = append(chainIDs, chainID)
+			}
+			sort.Strings(chainIDs)
+			fmt.Println("stake-provider endpoint arguments:")
+			for _, chainID := range chainIDs {
+				fmt.Printf("lavad tx pairing stake-provider %q <amount> %q %d --from <wallet> --provider-moniker <moniker>\n", chainID, strings.Join(stakeArgs[chainID], " "), geolocation)
+			}
+			return nil
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmdDiscover)
+	cmdDiscover.Flags().Uint64(common.GeolocationFlag, 0, "geolocation to run from")
+	cmdDiscover.MarkFlagRequired(common.GeolocationFlag)
+	cmdDiscover.Flags().String(DiscoverOutputFlagName, "", "file to write the corrected provider config to, prints it when empty")
+	return cmdDiscover
+}
@@ -0,0 +1,132 @@
+package rpcprovider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/lavanet/lava/protocol/chainlib"
+	"github.com/lavanet/lava/protocol/common"
+	"github.com/lavanet/lava/protocol/lavasession"
+	keepertest "github.com/lavanet/lava/testutil/keeper"
+	spectypes "github.com/lavanet/lava/x/spec/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSpecServices(t *testing.T) {
+	spec := &spectypes.Spec{
+		ApiCollections: []*spectypes.ApiCollection{
+			{Enabled: true, CollectionData: spectypes.CollectionData{ApiInterface: "jsonrpc"}, Extensions: []*spectypes.Extension{{Name: "archive"}}},
+			{Enabled: true, CollectionData: spectypes.CollectionData{ApiInterface: "jsonrpc", AddOn: "debug"}, Extensions: []*spectypes.Extension{{Name: "archive"}}},
+			{Enabled: true, CollectionData: spectypes.CollectionData{ApiInterface: "jsonrpc", AddOn: "trace"}},
+			{Enabled: false, CollectionData: spectypes.CollectionData{ApiInterface: "jsonrpc", AddOn: "disabled"}},
+			{Enabled: true, CollectionData: spectypes.CollectionData{ApiInterface: "rest", AddOn: "other"}},
+		},
+	}
+	addons, extensions := specServices(spec, "jsonrpc")
+	require.Equal(t, []string{"debug", "trace"}, addons)
+	require.Equal(t, []string{"archive"}, extensions)
+}
+
+func TestStakeEndpointArgs(t *testing.T) {
+	endpoints := []*lavasession.RPCProviderEndpoint{
+		{
+			NetworkAddress: lavasession.NetworkAddressData{Address: "provider.com:443"},
+			ChainID:        "LAV1",
+			ApiInterface:   "tendermintrpc",
+			NodeUrls:       []common.NodeUrl{{Url: "ws://node"}, {Url: "http://node", Addons: []string{"archive"}}},
+		},
+		{
+			NetworkAddress: lavasession.NetworkAddressData{Address: "provider.com:443"},
+			ChainID:        "LAV1",
+			ApiInterface:   "rest",
+			NodeUrls:       []common.NodeUrl{{Url: "http://node"}},
+		},
+		{
+			NetworkAddress: lavasession.NetworkAddressData{Address: "provider.com:443"},
+			ChainID:        "ETH1",
+			ApiInterface:   "jsonrpc",
+			NodeUrls:       []common.NodeUrl{{Url: "http://eth", Addons: []string{"debug", "archive"}}},
+		},
+	}
+	args := stakeEndpointArgs(endpoints, 1)
+	require.Equal(t, []string{"provider.com:443,1,rest,tendermintrpc,archive"}, args["LAV1"])
+	require.Equal(t, []string{"provider.com:443,1,jsonrpc,archive,debug"}, args["ETH1"])
+}
+
+// ethNodeHandler mocks an ethereum node, archive nodes return the genesis block as their earliest block
+func ethNodeHandler(chainID string, archive bool, debug bool) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var request struct {
+			ID     json.RawMessage `json:"id"`
+			Method string          `json:"method"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		response := map[string]interface{}{"jsonrpc": "2.0", "id": request.ID}
+		switch {
+		case request.Method == "eth_blockNumber":
+			response["result"] = "0x1000"
+		case request.Method == "eth_chainId":
+			response["result"] = chainID
+		case request.Method == "eth_getBlockByNumber" && archive:
+			response["result"] = map[string]interface{}{"number": "0x0"}
+		case request.Method == "eth_getBlockByNumber":
+			response["result"] = map[string]interface{}{"number": "0x100"}
+		case request.Method == "debug_getRawHeader" && debug:
+			response["result"] = "0xabcd"
+		default:
+			response["error"] = map[string]interface{}{"code": -32601, "message": "the method " + request.Method + " does not exist/is not available"}
+		}
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(response)
+	}
+}
+
+func TestDiscoverNodeUrl(t *testing.T) {
+	ctx := context.Background()
+	spec, err := keepertest.GetASpec("ETH1", "../../", nil, nil)
+	require.NoError(t, err)
+	chainParser, err := chainlib.NewChainParser(spectypes.APIInterfaceJsonRPC)
+	require.NoError(t, err)
+	chainParser.SetSpec(spec)
+	endpoint := &lavasession.RPCProviderEndpoint{ChainID: "ETH1", ApiInterface: spectypes.APIInterfaceJsonRPC, Geolocation: 1}
+
+	playbook := []struct {
+		name       string
+		chainID    string
+		archive    bool
+		debug      bool
+		configured []string
+		addons     []string
+		extensions []string
+		removed    []string
+		fails      bool
+	}{
+		{name: "archive and debug", chainID: "0x1", archive: true, debug: true, addons: []string{"debug"}, extensions: []string{"archive"}},
+		{name: "archive", chainID: "0x1", archive: true, configured: []string{"archive"}, extensions: []string{"archive"}},
+		{name: "pruned", chainID: "0x1", debug: true, configured: []string{"debug", "archive"}, addons: []string{"debug"}, removed: []string{"archive"}},
+		{name: "no services", chainID: "0x1", configured: []string{"debug", "archive"}, removed: []string{"debug", "archive"}},
+		{name: "wrong chain", chainID: "0x5", archive: true, debug: true, fails: true},
+	}
+	for _, play := range playbook {
+		t.Run(play.name, func(t *testing.T) {
+			server := httptest.NewServer(ethNodeHandler(play.chainID, play.archive, play.debug))
+			defer server.Close()
+			discovered := DiscoverNodeUrl(ctx, &spec, chainParser, endpoint, common.NodeUrl{Url: server.URL, Addons: play.configured})
+			if play.fails {
+				require.Error(t, discovered.Err)
+				return
+			}
+			require.NoError(t, discovered.Err)
+			require.Equal(t, play.addons, discovered.Addons)
+			require.Equal(t, play.extensions, discovered.Extensions)
+			require.Empty(t, discovered.Unverifiable)
+			require.Equal(t, play.removed, discovered.Removed)
+		})
+	}
+}
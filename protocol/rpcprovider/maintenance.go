@@ -0,0 +1,175 @@
+package rpcprovider
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/lavanet/lava/utils"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+)
+
+const (
+	UnfreezeOnStartFlagName = "unfreeze-on-start"
+	// time given to relays in flight of the last paired epoch before claiming the rewards and shutting down
+	MaintenanceClaimDelay    = 10 * time.Second
+	maintenanceClaimAttempts = 3
+)
+
+type MaintenanceState int
+
+const (
+	MaintenanceIdle MaintenanceState = iota
+	MaintenanceWaitingForEpoch
+	MaintenanceShuttingDown
+)
+
+func (ms MaintenanceState) String() string {
+	switch ms {
+	case MaintenanceIdle:
+		return "idle"
+	case MaintenanceWaitingForEpoch:
+		return "waiting_for_epoch"
+	case MaintenanceShuttingDown:
+		return "shutting_down"
+	}
+	return "unknown"
+}
+
+type MaintenanceStateTrackerInf interface {
+	TxFreezeProvider(ctx context.Context, chainIDs []string, reason string) error
+	TxUnfreezeProvider(ctx context.Context, chainIDs []string) error
+	IsProviderFrozen(ctx context.Context, providerAddress, chainID string) (bool, error)
+}
+
+type RewardsClaimerInf interface {
+	ForceRewardsClaim(ctx context.Context) ([]*pairingtypes.RelaySession, error)
+}
+
+// ProviderMaintenance takes the provider out of pairing before stopping it:
+// it freezes the provider, keeps serving the current pairing until the freeze is effective on the next epoch,
+// claims all the pending rewards and then shuts down the provider
+type ProviderMaintenance struct {
+	lock           sync.Mutex
+	stateTracker   MaintenanceStateTrackerInf
+	rewardsClaimer RewardsClaimerInf
+	shutdown       func()
+	claimDelay     time.Duration
+	currentEpoch   uint64
+	state          MaintenanceState
+	chainIDs       []string
+	freezeEpoch    uint64
+}
+
+func NewProviderMaintenance(stateTracker MaintenanceStateTrackerInf, rewardsClaimer RewardsClaimerInf, shutdown func()) *ProviderMaintenance {
+	return &ProviderMaintenance{
+		stateTracker:   stateTracker,
+		rewardsClaimer: rewardsClaimer,
+		shutdown:       shutdown,
+		claimDelay:     MaintenanceClaimDelay,
+	}
+}
+
+func (pm *ProviderMaintenance) UpdateEpoch(epoch uint64) {
+	pm.lock.Lock()
+	defer pm.lock.Unlock()
+	pm.currentEpoch = epoch
+	if pm.state == MaintenanceWaitingForEpoch && epoch > pm.freezeEpoch {
+		utils.LavaFormatInfo("maintenance freeze is effective, claiming rewards and shutting down", utils.Attribute{Key: "epoch", Value: epoch}, utils.Attribute{Key: "chainIDs", Value: pm.chainIDs})
+		pm.state = MaintenanceShuttingDown
+		go pm.finish()
+	}
+}
+
+// Start freezes the provider on the given chains, the provider shuts down once the freeze is effective
+func (pm *ProviderMaintenance) Start(ctx context.Context, chainIDs []string, reason string) error {
+	pm.lock.Lock()
+	if pm.state != MaintenanceIdle {
+		state := pm.state
+		pm.lock.Unlock()
+		return utils.LavaFormatWarning("maintenance already started", nil, utils.Attribute{Key: "state", Value: state.String()})
+	}
+	// reserve the state while the transaction is sent so concurrent calls are rejected
+	pm.state = MaintenanceWaitingForEpoch
+	pm.freezeEpoch = ^uint64(0)
+	pm.lock.Unlock()
+
+	err := pm.stateTracker.TxFreezeProvider(ctx, chainIDs, reason)
+	pm.lock.Lock()
+	defer pm.lock.Unlock()
+	if err != nil {
+		pm.state = MaintenanceIdle
+		return err
+	}
+	// the freeze applies to the stake entries of the next epoch, so we keep serving until it starts
+	pm.chainIDs = chainIDs
+	pm.freezeEpoch = pm.currentEpoch
+	utils.LavaFormatInfo("provider frozen for maintenance, serving until the next epoch", utils.Attribute{Key: "chainIDs", Value: chainIDs}, utils.Attribute{Key: "epoch", Value: pm.freezeEpoch})
+	return nil
+}
+
+// Stop cancels a maintenance that didn't take effect yet by unfreezing the provider
+func (pm *ProviderMaintenance) Stop(ctx context.Context) error {
+	pm.lock.Lock()
+	if pm.state != MaintenanceWaitingForEpoch || pm.freezeEpoch == ^uint64(0) {
+		state := pm.state
+		pm.lock.Unlock()
+		return utils.LavaFormatWarning("no maintenance to stop", nil, utils.Attribute{Key: "state", Value: state.String()})
+	}
+	chainIDs := pm.chainIDs
+	pm.lock.Unlock()
+
+	err := pm.stateTracker.TxUnfreezeProvider(ctx, chainIDs)
+	if err != nil {
+		return err
+	}
+	pm.lock.Lock()
+	defer pm.lock.Unlock()
+	if pm.state != MaintenanceWaitingForEpoch {
+		// the epoch changed while unfreezing, the shutdown can't be canceled anymore but the provider will be paired again after restart
+		return utils.LavaFormatWarning("maintenance took effect before it was stopped, provider is unfrozen but shutting down", nil, utils.Attribute{Key: "chainIDs", Value: chainIDs})
+	}
+	pm.state = MaintenanceIdle
+	pm.chainIDs = nil
+	utils.LavaFormatInfo("maintenance stopped, provider unfrozen", utils.Attribute{Key: "chainIDs", Value: chainIDs})
+	return nil
+}
+
+func (pm *ProviderMaintenance) Status() (state MaintenanceState, chainIDs []string, freezeEpoch uint64) {
+	pm.lock.Lock()
+	defer pm.lock.Unlock()
+	return pm.state, pm.chainIDs, pm.freezeEpoch
+}
+
+func (pm *ProviderMaintenance) finish() {
+	time.Sleep(pm.claimDelay)
+	for attempt := 0; attempt < maintenanceClaimAttempts; attempt++ {
+		claimed, err := pm.rewardsClaimer.ForceRewardsClaim(context.Background())
+		if err == nil {
+			utils.LavaFormatInfo("maintenance claimed pending rewards", utils.Attribute{Key: "proofs", Value: len(claimed)})
+			break
+		}
+		utils.LavaFormatError("maintenance failed claiming pending rewards", err, utils.Attribute{Key: "attempt", Value: attempt})
+	}
+	pm.shutdown()
+}
+
+// UnfreezeHealthyChains unfreezes the provider on the chains it serves, it is called after the endpoints passed validation
+func UnfreezeHealthyChains(ctx context.Context, stateTracker MaintenanceStateTrackerInf, providerAddress string, chainIDs []string) error {
+	frozenChains := []string{}
+	for _, chainID := range chainIDs {
+		frozen, err := stateTracker.IsProviderFrozen(ctx, providerAddress, chainID)
+		if err != nil {
+			utils.LavaFormatWarning("failed checking if provider is frozen", err, utils.Attribute{Key: "chainID", Value: chainID})
+			continue
+		}
+		if frozen {
+			frozenChains = append(frozenChains, chainID)
+		}
+	}
+	if len(frozenChains) == 0 {
+		return nil
+	}
+	utils.LavaFormatInfo("endpoints are healthy, unfreezing provider", utils.Attribute{Key: "chainIDs", Value: frozenChains})
+	return stateTracker.TxUnfreezeProvider(ctx, frozenChains)
+}
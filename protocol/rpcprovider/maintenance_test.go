@@ -0,0 +1,113 @@
+package rpcprovider
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	"github.com/stretchr/testify/require"
+)
+
+type maintenanceStateTrackerStub struct {
+	lock     sync.Mutex
+	frozen   map[string]bool
+	freezes  int
+	unfreeze int
+}
+
+func (mst *maintenanceStateTrackerStub) TxFreezeProvider(ctx context.Context, chainIDs []string, reason string) error {
+	mst.lock.Lock()
+	defer mst.lock.Unlock()
+	mst.freezes++
+	for _, chainID := range chainIDs {
+		mst.frozen[chainID] = true
+	}
+	return nil
+}
+
+func (mst *maintenanceStateTrackerStub) TxUnfreezeProvider(ctx context.Context, chainIDs []string) error {
+	mst.lock.Lock()
+	defer mst.lock.Unlock()
+	mst.unfreeze++
+	for _, chainID := range chainIDs {
+		mst.frozen[chainID] = false
+	}
+	return nil
+}
+
+func (mst *maintenanceStateTrackerStub) IsProviderFrozen(ctx context.Context, providerAddress, chainID string) (bool, error) {
+	mst.lock.Lock()
+	defer mst.lock.Unlock()
+	return mst.frozen[chainID], nil
+}
+
+type rewardsClaimerStub struct {
+	claims chan struct{}
+}
+
+func (rcs *rewardsClaimerStub) ForceRewardsClaim(ctx context.Context) ([]*pairingtypes.RelaySession, error) {
+	rcs.claims <- struct{}{}
+	return nil, nil
+}
+
+func TestProviderMaintenance(t *testing.T) {
+	stateTracker := &maintenanceStateTrackerStub{frozen: map[string]bool{}}
+	claimer := &rewardsClaimerStub{claims: make(chan struct{}, 1)}
+	shutdown := make(chan struct{})
+	maintenance := NewProviderMaintenance(stateTracker, claimer, func() { close(shutdown) })
+	maintenance.claimDelay = 0
+	ctx := context.Background()
+	maintenance.UpdateEpoch(20)
+
+	// stop without start fails
+	require.Error(t, maintenance.Stop(ctx))
+
+	// start then stop unfreezes and keeps running
+	require.NoError(t, maintenance.Start(ctx, []string{"LAV1"}, "test"))
+	require.True(t, stateTracker.frozen["LAV1"])
+	require.Error(t, maintenance.Start(ctx, []string{"LAV1"}, "test"))
+	require.NoError(t, maintenance.Stop(ctx))
+	require.False(t, stateTracker.frozen["LAV1"])
+	state, _, _ := maintenance.Status()
+	require.Equal(t, MaintenanceIdle, state)
+	maintenance.UpdateEpoch(40)
+	select {
+	case <-shutdown:
+		require.Fail(t, "provider shut down after maintenance was stopped")
+	case <-time.After(10 * time.Millisecond):
+	}
+
+	// start and wait for the next epoch, the same epoch doesn't trigger the shutdown
+	require.NoError(t, maintenance.Start(ctx, []string{"LAV1", "ETH1"}, "test"))
+	state, chainIDs, freezeEpoch := maintenance.Status()
+	require.Equal(t, MaintenanceWaitingForEpoch, state)
+	require.Equal(t, []string{"LAV1", "ETH1"}, chainIDs)
+	require.Equal(t, uint64(40), freezeEpoch)
+	maintenance.UpdateEpoch(40)
+	state, _, _ = maintenance.Status()
+	require.Equal(t, MaintenanceWaitingForEpoch, state)
+	maintenance.UpdateEpoch(60)
+	select {
+	case <-claimer.claims:
+	case <-time.After(time.Second):
+		require.Fail(t, "rewards were not claimed")
+	}
+	select {
+	case <-shutdown:
+	case <-time.After(time.Second):
+		require.Fail(t, "provider was not shut down")
+	}
+	// too late to stop
+	require.Error(t, maintenance.Stop(ctx))
+
+	// unfreeze on start only unfreezes the frozen chains
+	stateTracker.frozen["OSMO"] = false
+	require.NoError(t, UnfreezeHealthyChains(ctx, stateTracker, "provider", []string{"LAV1", "ETH1", "OSMO"}))
+	require.False(t, stateTracker.frozen["LAV1"])
+	require.False(t, stateTracker.frozen["ETH1"])
+	unfreezes := stateTracker.unfreeze
+	require.NoError(t, UnfreezeHealthyChains(ctx, stateTracker, "provider", []string{"LAV1", "ETH1", "OSMO"}))
+	require.Equal(t, unfreezes, stateTracker.unfreeze)
+}
@@ -0,0 +1,480 @@
+package rpcprovider
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"sort"
+	"sync"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/improbable-eng/grpc-web/go/grpcweb"
+	"github.com/lavanet/lava/protocol/lavasession"
+	"github.com/lavanet/lava/protocol/rpcprovider/rewardserver"
+	"github.com/lavanet/lava/utils"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	"github.com/spf13/cobra"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	AdminListenFlagName    = "admin-listen-address"
+	AdminTokenFlagName     = "admin-token"
+	AdminDisabledOption    = "disabled"
+	adminAuthorizationKey  = "authorization"
+	adminAuthorizationType = "Bearer "
+)
+
+// ProviderAdminServer exposes the provider's internal state to the operator, it is meant to listen on a local address only
+type ProviderAdminServer struct {
+	pairingtypes.UnimplementedProviderAdminServer
+	rewardServer    *rewardserver.RewardServer
+	lock            sync.RWMutex
+	sessionManagers map[string]*lavasession.ProviderSessionManager // key is the endpoint key
+	blockedConsumer map[string]struct{}                            // applied to session managers registered later as well
+	httpServer      http.Server
+	reloadHandler   func(ctx context.Context) (*ReloadResult, error)
+	maintenance     *ProviderMaintenance
+	servedChainIDs  func() []string
+}
+
+func NewProviderAdminServer(rewardServer *rewardserver.RewardServer) *ProviderAdminServer {
+	return &ProviderAdminServer{
+		rewardServer:    rewardServer,
+		sessionManagers: map[string]*lavasession.ProviderSessionManager{},
+		blockedConsumer: map[string]struct{}{},
+	}
+}
+
+func (pas *ProviderAdminServer) RegisterSessionManager(providerSessionManager *lavasession.ProviderSessionManager) {
+	pas.lock.Lock()
+	defer pas.lock.Unlock()
+	for consumer := range pas.blockedConsumer {
+		providerSessionManager.BlockConsumer(consumer)
+	}
+	pas.sessionManagers[providerSessionManager.RPCProviderEndpoint().Key()] = providerSessionManager
+}
+
+// SetReloadHandler enables ReloadConfig, it is not set when the endpoints can't be reloaded
+func (pas *ProviderAdminServer) SetReloadHandler(reloadHandler func(ctx context.Context) (*ReloadResult, error)) {
+	pas.lock.Lock()
+	defer pas.lock.Unlock()
+	pas.reloadHandler = reloadHandler
+}
+
+func (pas *ProviderAdminServer) SetMaintenance(maintenance *ProviderMaintenance, servedChainIDs func() []string) {
+	pas.lock.Lock()
+	defer pas.lock.Unlock()
+	pas.maintenance = maintenance
+	pas.servedChainIDs = servedChainIDs
+}
+
+func (pas *ProviderAdminServer) UnregisterSessionManager(endpoint *lavasession.RPCProviderEndpoint) {
+	pas.lock.Lock()
+	defer pas.lock.Unlock()
+	delete(pas.sessionManagers, endpoint.Key())
+}
+
+func (pas *ProviderAdminServer) getSessionManagers(chainID string) []*lavasession.ProviderSessionManager {
+	pas.lock.RLock()
+	defer pas.lock.RUnlock()
+	keys := make([]string, 0, len(pas.sessionManagers))
+	for key, providerSessionManager := range pas.sessionManagers {
+		if chainID != "" && providerSessionManager.RPCProviderEndpoint().ChainID != chainID {
+			continue
+		}
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	providerSessionManagers := make([]*lavasession.ProviderSessionManager, 0, len(keys))
+	for _, key := range keys {
+		providerSessionManagers = append(providerSessionManagers, pas.sessionManagers[key])
+	}
+	return providerSessionManagers
+}
+
+func (pas *ProviderAdminServer) ActiveSessions(ctx context.Context, req *pairingtypes.AdminActiveSessionsRequest) (*pairingtypes.AdminActiveSessionsResponse, error) {
+	res := &pairingtypes.AdminActiveSessionsResponse{Sessions: []pairingtypes.AdminProjectSessionsInfo{}}
+	for _, providerSessionManager := range pas.getSessionManagers(req.ChainId) {
+		endpoint := providerSessionManager.RPCProviderEndpoint()
+		for _, sessionsInfo := range providerSessionManager.GetSessionsInfo(req.Epoch) {
+			res.Sessions = append(res.Sessions, pairingtypes.AdminProjectSessionsInfo{
+				ChainId:       endpoint.ChainID,
+				ApiInterface:  endpoint.ApiInterface,
+				Epoch:         sessionsInfo.Epoch,
+				ProjectId:     sessionsInfo.ProjectId,
+				Consumers:     sessionsInfo.Consumers,
+				UsedCu:        sessionsInfo.UsedComputeUnits,
+				MaxCu:         sessionsInfo.MaxComputeUnits,
+				MissingCu:     sessionsInfo.MissingComputeUnits,
+				SessionsCount: uint64(sessionsInfo.SessionsCount),
+				Blocked:       sessionsInfo.Blocked,
+			})
+		}
+	}
+	return res, nil
+}
+
+func (pas *ProviderAdminServer) ActiveSubscriptions(ctx context.Context, req *pairingtypes.AdminActiveSubscriptionsRequest) (*pairingtypes.AdminActiveSubscriptionsResponse, error) {
+	res := &pairingtypes.AdminActiveSubscriptionsResponse{Subscriptions: []pairingtypes.AdminSubscriptionInfo{}}
+	for _, providerSessionManager := range pas.getSessionManagers(req.ChainId) {
+		endpoint := providerSessionManager.RPCProviderEndpoint()
+		for _, sessionsInfo := range providerSessionManager.GetSessionsInfo(0) {
+			for _, subscriptionID := range sessionsInfo.SubscriptionIDs {
+				res.Subscriptions = append(res.Subscriptions, pairingtypes.AdminSubscriptionInfo{
+					ChainId:        endpoint.ChainID,
+					ApiInterface:   endpoint.ApiInterface,
+					Epoch:          sessionsInfo.Epoch,
+					ProjectId:      sessionsInfo.ProjectId,
+					SubscriptionId: subscriptionID,
+				})
+			}
+		}
+	}
+	return res, nil
+}
+
+func (pas *ProviderAdminServer) PendingRewards(ctx context.Context, req *pairingtypes.AdminPendingRewardsRequest) (*pairingtypes.AdminPendingRewardsResponse, error) {
+	pendingProofs, expectedPayments, cuServiced, cuPaid := pas.rewardServer.PendingRewards()
+	res := &pairingtypes.AdminPendingRewardsResponse{
+		PendingProofs:    make([]pairingtypes.AdminPendingProof, 0, len(pendingProofs)),
+		ExpectedPayments: make([]pairingtypes.AdminExpectedPayment, 0, len(expectedPayments)),
+		TotalCuServiced:  cuServiced,
+		TotalCuPaid:      cuPaid,
+	}
+	for _, pendingProof := range pendingProofs {
+		res.PendingProofs = append(res.PendingProofs, pairingtypes.AdminPendingProof{Epoch: pendingProof.Epoch, Consumer: pendingProof.Consumer, Proof: pendingProof.Proof})
+	}
+	for _, expectedPayment := range expectedPayments {
+		res.ExpectedPayments = append(res.ExpectedPayments, pairingtypes.AdminExpectedPayment{
+			ChainId:             expectedPayment.ChainID,
+			Consumer:            expectedPayment.Client.String(),
+			Cu:                  expectedPayment.CU,
+			BlockHeightDeadline: expectedPayment.BlockHeightDeadline,
+			UniqueIdentifier:    expectedPayment.UniqueIdentifier,
+		})
+	}
+	return res, nil
+}
+
+func (pas *ProviderAdminServer) ClaimRewards(ctx context.Context, req *pairingtypes.AdminClaimRewardsRequest) (*pairingtypes.AdminClaimRewardsResponse, error) {
+	claimedProofs, err := pas.rewardServer.ForceRewardsClaim(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	res := &pairingtypes.AdminClaimRewardsResponse{ClaimedProofs: uint64(len(claimedProofs))}
+	for _, proof := range claimedProofs {
+		res.ClaimedCu += proof.CuSum
+	}
+	utils.LavaFormatInfo("admin forced rewards claim", utils.Attribute{Key: "proofs", Value: res.ClaimedProofs}, utils.Attribute{Key: "cu", Value: res.ClaimedCu})
+	return res, nil
+}
+
+func (pas *ProviderAdminServer) BlockConsumer(ctx context.Context, req *pairingtypes.AdminBlockConsumerRequest) (*pairingtypes.AdminBlockConsumerResponse, error) {
+	if req.Consumer == "" {
+		return nil, status.Error(codes.InvalidArgument, "consumer address is required")
+	}
+	pas.lock.Lock()
+	if req.Unblock {
+		delete(pas.blockedConsumer, req.Consumer)
+	} else {
+		pas.blockedConsumer[req.Consumer] = struct{}{}
+	}
+	blockedConsumers := make([]string, 0, len(pas.blockedConsumer))
+	for consumer := range pas.blockedConsumer {
+		blockedConsumers = append(blockedConsumers, consumer)
+	}
+	pas.lock.Unlock()
+	sort.Strings(blockedConsumers)
+
+	for _, providerSessionManager := range pas.getSessionManagers("") {
+		if req.Unblock {
+			providerSessionManager.UnblockConsumer(req.Consumer)
+		} else {
+			providerSessionManager.BlockConsumer(req.Consumer)
+		}
+	}
+	utils.LavaFormatInfo("admin updated consumer block list", utils.Attribute{Key: "consumer", Value: req.Consumer}, utils.Attribute{Key: "unblock", Value: req.Unblock})
+	return &pairingtypes.AdminBlockConsumerResponse{BlockedConsumers: blockedConsumers}, nil
+}
+
+func (pas *ProviderAdminServer) ReloadConfig(ctx context.Context, req *pairingtypes.AdminReloadConfigRequest) (*pairingtypes.AdminReloadConfigResponse, error) {
+	pas.lock.RLock()
+	reloadHandler := pas.reloadHandler
+	pas.lock.RUnlock()
+	if reloadHandler == nil {
+		return nil, status.Error(codes.FailedPrecondition, "reload is supported only when the endpoints are read from a config file")
+	}
+	result, err := reloadHandler(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &pairingtypes.AdminReloadConfigResponse{Added: result.Added, Updated: result.Updated, Removed: result.Removed, Failed: result.Failed}, nil
+}
+
+func (pas *ProviderAdminServer) getMaintenance() (*ProviderMaintenance, func() []string, error) {
+	pas.lock.RLock()
+	defer pas.lock.RUnlock()
+	if pas.maintenance == nil {
+		return nil, nil, status.Error(codes.FailedPrecondition, "maintenance is not supported by this provider")
+	}
+	return pas.maintenance, pas.servedChainIDs, nil
+}
+
+func maintenanceResponse(maintenance *ProviderMaintenance) *pairingtypes.AdminMaintenanceResponse {
+	state, chainIDs, freezeEpoch := maintenance.Status()
+	return &pairingtypes.AdminMaintenanceResponse{State: state.String(), ChainIds: chainIDs, FreezeEpoch: freezeEpoch}
+}
+
+func (pas *ProviderAdminServer) StartMaintenance(ctx context.Context, req *pairingtypes.AdminStartMaintenanceRequest) (*pairingtypes.AdminMaintenanceResponse, error) {
+	maintenance, servedChainIDs, err := pas.getMaintenance()
+	if err != nil {
+		return nil, err
+	}
+	chainIDs := req.ChainIds
+	if len(chainIDs) == 0 {
+		chainIDs = servedChainIDs()
+	}
+	if len(chainIDs) == 0 {
+		return nil, status.Error(codes.FailedPrecondition, "provider doesn't serve any chain")
+	}
+	err = maintenance.Start(ctx, chainIDs, req.Reason)
+	if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return maintenanceResponse(maintenance), nil
+}
+
+func (pas *ProviderAdminServer) StopMaintenance(ctx context.Context, req *pairingtypes.AdminStopMaintenanceRequest) (*pairingtypes.AdminMaintenanceResponse, error) {
+	maintenance, _, err := pas.getMaintenance()
+	if err != nil {
+		return nil, err
+	}
+	err = maintenance.Stop(ctx)
+	if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return maintenanceResponse(maintenance), nil
+}
+
+// Serve starts the admin grpc server, reachable over grpc and grpc-web (http), all calls require the token as a bearer authorization
+func (pas *ProviderAdminServer) Serve(ctx context.Context, listenAddress string, token string) error {
+	if token == "" {
+		return utils.LavaFormatError("admin server requires a token", nil, utils.Attribute{Key: "flag", Value: AdminTokenFlagName})
+	}
+	host, _, err := net.SplitHostPort(listenAddress)
+	if err != nil {
+		return utils.LavaFormatError("invalid admin listen address", err, utils.Attribute{Key: "address", Value: listenAddress})
+	}
+	if ip := net.ParseIP(host); host != "localhost" && (ip == nil || !ip.IsLoopback()) {
+		utils.LavaFormatWarning("admin server is listening on a non local address", nil, utils.Attribute{Key: "address", Value: listenAddress})
+	}
+	lis, err := net.Listen("tcp", listenAddress)
+	if err != nil {
+		return utils.LavaFormatError("failed listening on admin address", err, utils.Attribute{Key: "address", Value: listenAddress})
+	}
+
+	grpcServer := grpc.NewServer(grpc.UnaryInterceptor(adminAuthInterceptor(token)))
+	pairingtypes.RegisterProviderAdminServer(grpcServer, pas)
+	wrappedServer := grpcweb.WrapServer(grpcServer)
+	handler := func(resp http.ResponseWriter, req *http.Request) {
+		if wrappedServer.IsGrpcWebRequest(req) {
+			wrappedServer.ServeHTTP(resp, req)
+			return
+		}
+		grpcServer.ServeHTTP(resp, req)
+	}
+	pas.httpServer = http.Server{
+		Handler: h2c.NewHandler(http.HandlerFunc(handler), &http2.Server{}),
+	}
+	go func() {
+		utils.LavaFormatInfo("provider admin server active", utils.Attribute{Key: "address", Value: listenAddress})
+		if err := pas.httpServer.Serve(lis); !errors.Is(err, http.ErrServerClosed) {
+			utils.LavaFormatError("provider admin server failed to serve", err, utils.Attribute{Key: "address", Value: listenAddress})
+		}
+	}()
+	return nil
+}
+
+func (pas *ProviderAdminServer) Shutdown(shutdownCtx context.Context) error {
+	return pas.httpServer.Shutdown(shutdownCtx)
+}
+
+func adminAuthInterceptor(token string) grpc.UnaryServerInterceptor {
+	expected := []byte(adminAuthorizationType + token)
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		md, ok := metadata.FromIncomingContext(ctx)
+		if !ok {
+			return nil, status.Error(codes.Unauthenticated, "missing metadata")
+		}
+		authorization := md.Get(adminAuthorizationKey)
+		if len(authorization) != 1 || subtle.ConstantTimeCompare([]byte(authorization[0]), expected) != 1 {
+			return nil, status.Error(codes.Unauthenticated, "invalid admin token")
+		}
+		return handler(ctx, req)
+	}
+}
+
+func connectProviderAdmin(ctx context.Context, cmd *cobra.Command) (pairingtypes.ProviderAdminClient, context.Context, func(), error) {
+	address, err := cmd.Flags().GetString(AdminListenFlagName)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	token, err := cmd.Flags().GetString(AdminTokenFlagName)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	conn, err := grpc.DialContext(ctx, address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, nil, nil, utils.LavaFormatError("failed connecting to provider admin server", err, utils.Attribute{Key: "address", Value: address})
+	}
+	ctx = metadata.AppendToOutgoingContext(ctx, adminAuthorizationKey, adminAuthorizationType+token)
+	return pairingtypes.NewProviderAdminClient(conn), ctx, func() { conn.Close() }, nil
+}
+
+func runProviderAdminCall(call func(ctx context.Context, adminClient pairingtypes.ProviderAdminClient, args []string) (proto.Message, error)) func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		adminClient, ctx, closeConn, err := connectProviderAdmin(cmd.Context(), cmd)
+		if err != nil {
+			return err
+		}
+		defer closeConn()
+		res, err := call(ctx, adminClient, args)
+		if err != nil {
+			return err
+		}
+		clientCtx := client.GetClientContextFromCmd(cmd)
+		return clientCtx.PrintProto(res)
+	}
+}
+
+func addProviderAdminConnectionFlags(cmd *cobra.Command) {
+	cmd.PersistentFlags().String(AdminListenFlagName, "127.0.0.1:7780", "the address of the provider admin server")
+	cmd.PersistentFlags().String(AdminTokenFlagName, "", "the token the provider admin server was started with")
+}
+
+func CreateProviderAdminCobraCommand() *cobra.Command {
+	cmdAdmin := &cobra.Command{
+		Use:   "admin",
+		Short: "query and control a running rpcprovider through its local admin server",
+		Long: `query and control a running rpcprovider through its local admin server,
+the provider must be started with --` + AdminListenFlagName + ` and --` + AdminTokenFlagName,
+	}
+	cmdSessions := &cobra.Command{
+		Use:   "sessions [chain-id] [epoch]",
+		Short: "list the active sessions per project and epoch, with used cu",
+		Args:  cobra.RangeArgs(0, 2),
+		RunE: runProviderAdminCall(func(ctx context.Context, adminClient pairingtypes.ProviderAdminClient, args []string) (proto.Message, error) {
+			req := &pairingtypes.AdminActiveSessionsRequest{}
+			if len(args) > 0 {
+				req.ChainId = args[0]
+			}
+			if len(args) > 1 {
+				if _, err := fmt.Sscan(args[1], &req.Epoch); err != nil {
+					return nil, utils.LavaFormatError("invalid epoch", err, utils.Attribute{Key: "epoch", Value: args[1]})
+				}
+			}
+			return adminClient.ActiveSessions(ctx, req)
+		}),
+	}
+	cmdSubscriptions := &cobra.Command{
+		Use:   "subscriptions [chain-id]",
+		Short: "list the active subscriptions",
+		Args:  cobra.RangeArgs(0, 1),
+		RunE: runProviderAdminCall(func(ctx context.Context, adminClient pairingtypes.ProviderAdminClient, args []string) (proto.Message, error) {
+			req := &pairingtypes.AdminActiveSubscriptionsRequest{}
+			if len(args) > 0 {
+				req.ChainId = args[0]
+			}
+			return adminClient.ActiveSubscriptions(ctx, req)
+		}),
+	}
+	cmdRewards := &cobra.Command{
+		Use:   "rewards",
+		Short: "show the proofs waiting to be claimed and the expected payments",
+		Args:  cobra.NoArgs,
+		RunE: runProviderAdminCall(func(ctx context.Context, adminClient pairingtypes.ProviderAdminClient, args []string) (proto.Message, error) {
+			return adminClient.PendingRewards(ctx, &pairingtypes.AdminPendingRewardsRequest{})
+		}),
+	}
+	cmdClaim := &cobra.Command{
+		Use:   "claim",
+		Short: "claim the rewards of all past epochs now, later relays on the claimed sessions will not be paid",
+		Args:  cobra.NoArgs,
+		RunE: runProviderAdminCall(func(ctx context.Context, adminClient pairingtypes.ProviderAdminClient, args []string) (proto.Message, error) {
+			return adminClient.ClaimRewards(ctx, &pairingtypes.AdminClaimRewardsRequest{})
+		}),
+	}
+	cmdBlock := &cobra.Command{
+		Use:   "block [consumer-address]",
+		Short: "stop serving a consumer address",
+		Args:  cobra.ExactArgs(1),
+		RunE: runProviderAdminCall(func(ctx context.Context, adminClient pairingtypes.ProviderAdminClient, args []string) (proto.Message, error) {
+			return adminClient.BlockConsumer(ctx, &pairingtypes.AdminBlockConsumerRequest{Consumer: args[0]})
+		}),
+	}
+	cmdUnblock := &cobra.Command{
+		Use:   "unblock [consumer-address]",
+		Short: "resume serving a blocked consumer address",
+		Args:  cobra.ExactArgs(1),
+		RunE: runProviderAdminCall(func(ctx context.Context, adminClient pairingtypes.ProviderAdminClient, args []string) (proto.Message, error) {
+			return adminClient.BlockConsumer(ctx, &pairingtypes.AdminBlockConsumerRequest{Consumer: args[0], Unblock: true})
+		}),
+	}
+	cmdReload := &cobra.Command{
+		Use:   "reload",
+		Short: "re-read the endpoints config file and apply it without restarting, same as sending SIGHUP to the provider",
+		Args:  cobra.NoArgs,
+		RunE: runProviderAdminCall(func(ctx context.Context, adminClient pairingtypes.ProviderAdminClient, args []string) (proto.Message, error) {
+			return adminClient.ReloadConfig(ctx, &pairingtypes.AdminReloadConfigRequest{})
+		}),
+	}
+	addProviderAdminConnectionFlags(cmdAdmin)
+	cmdAdmin.AddCommand(cmdSessions, cmdSubscriptions, cmdRewards, cmdClaim, cmdBlock, cmdUnblock, cmdReload)
+	return cmdAdmin
+}
+
+func CreateProviderMaintenanceCobraCommand() *cobra.Command {
+	const reasonFlagName = "reason"
+	cmdMaintenance := &cobra.Command{
+		Use:   "maintenance",
+		Short: "take a running rpcprovider out of pairing before stopping it for maintenance",
+		Long: `take a running rpcprovider out of pairing before stopping it for maintenance, through its local admin server.
+start freezes the provider and keeps serving the current pairing until the freeze is effective on the next epoch,
+then claims all pending rewards and stops the provider.
+to return from maintenance, start the provider with --` + UnfreezeOnStartFlagName + ` so it unfreezes itself once its endpoints pass validation`,
+	}
+	cmdStart := &cobra.Command{
+		Use:   "start [chain-id...]",
+		Short: "freeze the provider and stop it once the freeze is effective, defaults to all served chains",
+	}
+	cmdStart.RunE = runProviderAdminCall(func(ctx context.Context, adminClient pairingtypes.ProviderAdminClient, args []string) (proto.Message, error) {
+		reason, err := cmdStart.Flags().GetString(reasonFlagName)
+		if err != nil {
+			return nil, err
+		}
+		return adminClient.StartMaintenance(ctx, &pairingtypes.AdminStartMaintenanceRequest{ChainIds: args, Reason: reason})
+	})
+	cmdStart.Flags().String(reasonFlagName, "maintenance", "the reason recorded in the freeze transaction")
+	cmdStop := &cobra.Command{
+		Use:   "stop",
+		Short: "cancel a maintenance that didn't take effect yet by unfreezing the provider",
+		Args:  cobra.NoArgs,
+		RunE: runProviderAdminCall(func(ctx context.Context, adminClient pairingtypes.ProviderAdminClient, args []string) (proto.Message, error) {
+			return adminClient.StopMaintenance(ctx, &pairingtypes.AdminStopMaintenanceRequest{})
+		}),
+	}
+	addProviderAdminConnectionFlags(cmdMaintenance)
+	cmdMaintenance.AddCommand(cmdStart, cmdStop)
+	return cmdMaintenance
+}
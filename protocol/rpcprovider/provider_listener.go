@@ -41,6 +41,16 @@ func (pl *ProviderListener) RegisterReceiver(existingReceiver RelayReceiver, end
 	return nil
 }
 
+// UnregisterReceiver stops routing relays for the endpoint to its receiver, returns the amount of receivers left on this listener
+func (pl *ProviderListener) UnregisterReceiver(endpoint *lavasession.RPCProviderEndpoint) (remaining int) {
+	listen_endpoint := lavasession.RPCEndpoint{ChainID: endpoint.ChainID, ApiInterface: endpoint.ApiInterface}
+	pl.relayServer.lock.Lock()
+	defer pl.relayServer.lock.Unlock()
+	delete(pl.relayServer.relayReceivers, listen_endpoint.Key())
+	utils.LavaFormatInfo("Provider stopped listening on Address", utils.Attribute{Key: "chainID", Value: endpoint.ChainID}, utils.Attribute{Key: "apiInterface", Value: endpoint.ApiInterface}, utils.Attribute{Key: "Address", Value: endpoint.NetworkAddress})
+	return len(pl.relayServer.relayReceivers)
+}
+
 func (pl *ProviderListener) Shutdown(shutdownCtx context.Context) error {
 	if err := pl.httpServer.Shutdown(shutdownCtx); err != nil {
 		utils.LavaFormatFatal("Provider failed to shutdown", err)
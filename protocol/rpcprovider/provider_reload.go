@@ -0,0 +1,247 @@
+package rpcprovider
+
+import (
+	"context"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/lavanet/lava/protocol/chainlib"
+	"github.com/lavanet/lava/protocol/chaintracker"
+	"github.com/lavanet/lava/protocol/lavasession"
+	"github.com/lavanet/lava/utils"
+)
+
+// time given to relays in flight to finish on retired endpoints and node connections before they are closed
+const EndpointRetireGracePeriod = 30 * time.Second
+
+// EndpointsLoader reads the current endpoints configuration, it is called on every reload
+type EndpointsLoader func() ([]*lavasession.RPCProviderEndpoint, error)
+
+// ReloadResult lists the endpoints affected by a configuration reload, by their description
+type ReloadResult struct {
+	Added   []string
+	Updated []string
+	Removed []string
+	Failed  []string
+}
+
+type activeProviderEndpoint struct {
+	endpoint         *lavasession.RPCProviderEndpoint
+	ctx              context.Context
+	chainParser      chainlib.ChainParser
+	chainRouter      *chainlib.SwappableChainRouter
+	cancel           context.CancelFunc // cancels everything the endpoint runs
+	routerCancel     context.CancelFunc // cancels the current node connections only
+	ownsChainTracker bool
+	sessionManager   *lavasession.ProviderSessionManager
+}
+
+// resources shared by all the endpoints of the same chain
+type providerChainResources struct {
+	chainTracker *chaintracker.ChainTracker
+	endpoints    int
+	// contexts of retired endpoints the chain tracker still depends on, released when the last endpoint of the chain is retired
+	retiredCancels []context.CancelFunc
+}
+
+func activeEndpointKey(endpoint *lavasession.RPCProviderEndpoint) string {
+	return endpoint.NetworkAddress.Address + "|" + endpoint.Key()
+}
+
+// handle undefined addresses as the previous endpoint for shared listeners
+func fillEmptyNetworkAddresses(rpcProviderEndpoints []*lavasession.RPCProviderEndpoint) {
+	for idx, endpoint := range rpcProviderEndpoints {
+		if idx > 0 && endpoint.NetworkAddress.Address == "" {
+			endpoint.NetworkAddress = rpcProviderEndpoints[idx-1].NetworkAddress
+		}
+	}
+}
+
+// ReloadEndpoints applies a new endpoints configuration on a running provider:
+// new endpoints are set up, endpoints with modified node urls get new node connections, and removed endpoints are retired gracefully
+func (rpcp *RPCProvider) ReloadEndpoints(ctx context.Context, endpointsLoader EndpointsLoader) (*ReloadResult, error) {
+	rpcp.reloadLock.Lock()
+	defer rpcp.reloadLock.Unlock()
+	newEndpoints, err := endpointsLoader()
+	if err != nil {
+		return nil, utils.LavaFormatError("failed loading endpoints configuration, keeping the current one", err)
+	}
+	if len(newEndpoints) == 0 {
+		return nil, utils.LavaFormatError("endpoints configuration is empty, keeping the current one", nil)
+	}
+	fillEmptyNetworkAddresses(newEndpoints)
+	newEndpointsByKey := map[string]*lavasession.RPCProviderEndpoint{}
+	for _, endpoint := range newEndpoints {
+		key := activeEndpointKey(endpoint)
+		if _, ok := newEndpointsByKey[key]; ok {
+			return nil, utils.LavaFormatError("endpoints configuration contains a duplicate endpoint, keeping the current one", nil, utils.Attribute{Key: "endpoint", Value: endpoint.String()})
+		}
+		newEndpointsByKey[key] = endpoint
+	}
+
+	result := &ReloadResult{}
+	toRemove := []*activeProviderEndpoint{}
+	toUpdate := map[*activeProviderEndpoint]*lavasession.RPCProviderEndpoint{}
+	toAdd := []*lavasession.RPCProviderEndpoint{}
+	rpcp.lock.Lock()
+	for key, active := range rpcp.activeEndpoints {
+		newEndpoint, ok := newEndpointsByKey[key]
+		if !ok {
+			toRemove = append(toRemove, active)
+			continue
+		}
+		if newEndpoint.NetworkAddress != active.endpoint.NetworkAddress {
+			utils.LavaFormatWarning("network address settings changed for a running listener, a restart is required to apply them", nil, utils.Attribute{Key: "endpoint", Value: newEndpoint.String()})
+		}
+		if endpointSettingsChanged(newEndpoint, active.endpoint) {
+			utils.LavaFormatWarning("endpoint settings other than node urls changed, a restart is required to apply them", nil, utils.Attribute{Key: "endpoint", Value: newEndpoint.String()})
+		}
+		if !reflect.DeepEqual(newEndpoint.NodeUrls, active.endpoint.NodeUrls) {
+			toUpdate[active] = newEndpoint
+		}
+	}
+	for key, endpoint := range newEndpointsByKey {
+		if _, ok := rpcp.activeEndpoints[key]; !ok {
+			toAdd = append(toAdd, endpoint)
+		}
+	}
+	rpcp.lock.Unlock()
+
+	// retire first so addresses and registrations are free for the added endpoints
+	for _, active := range toRemove {
+		rpcp.retireEndpoint(ctx, active)
+		result.Removed = append(result.Removed, active.endpoint.String())
+	}
+
+	var wg sync.WaitGroup
+	var resultLock sync.Mutex
+	wg.Add(len(toUpdate))
+	for active, newEndpoint := range toUpdate {
+		go func(active *activeProviderEndpoint, newEndpoint *lavasession.RPCProviderEndpoint) {
+			defer wg.Done()
+			err := rpcp.swapEndpointNodes(active, newEndpoint)
+			resultLock.Lock()
+			defer resultLock.Unlock()
+			if err != nil {
+				result.Failed = append(result.Failed, newEndpoint.String())
+				return
+			}
+			result.Updated = append(result.Updated, newEndpoint.String())
+		}(active, newEndpoint)
+	}
+	wg.Wait()
+
+	if len(toAdd) > 0 {
+		disabledEndpoints := rpcp.setupEndpoints(ctx, toAdd)
+		disabled := map[*lavasession.RPCProviderEndpoint]struct{}{}
+		for _, endpoint := range disabledEndpoints {
+			disabled[endpoint] = struct{}{}
+			result.Failed = append(result.Failed, endpoint.String())
+		}
+		for _, endpoint := range toAdd {
+			if _, ok := disabled[endpoint]; !ok {
+				result.Added = append(result.Added, endpoint.String())
+			}
+		}
+	}
+	utils.LavaFormatInfo("RPCProvider reloaded endpoints configuration",
+		utils.Attribute{Key: "added", Value: result.Added},
+		utils.Attribute{Key: "updated", Value: result.Updated},
+		utils.Attribute{Key: "removed", Value: result.Removed},
+		utils.Attribute{Key: "failed", Value: result.Failed},
+	)
+	return result, nil
+}
+
+// endpointSettingsChanged reports changes a reload doesn't apply, anything but the node urls and the network address (warned about separately)
+func endpointSettingsChanged(newEndpoint *lavasession.RPCProviderEndpoint, activeEndpoint *lavasession.RPCProviderEndpoint) bool {
+	newSettings, activeSettings := *newEndpoint, *activeEndpoint
+	newSettings.NodeUrls, activeSettings.NodeUrls = nil, nil
+	newSettings.NetworkAddress, activeSettings.NetworkAddress = lavasession.NetworkAddressData{}, lavasession.NetworkAddressData{}
+	return !reflect.DeepEqual(newSettings, activeSettings)
+}
+
+// swapEndpointNodes connects to the new node urls and validates them before replacing the node connections of a running endpoint
+func (rpcp *RPCProvider) swapEndpointNodes(active *activeProviderEndpoint, newEndpoint *lavasession.RPCProviderEndpoint) error {
+	err := newEndpoint.Validate()
+	if err != nil {
+		return utils.LavaFormatError("invalid node url definition on reload, keeping the current node urls", err, utils.Attribute{Key: "endpoint", Value: newEndpoint.String()})
+	}
+	routerCtx, routerCancel := context.WithCancel(active.ctx)
+	newChainRouter, err := chainlib.GetChainRouter(routerCtx, rpcp.parallelConnections, newEndpoint, active.chainParser)
+	if err != nil {
+		routerCancel()
+		return utils.LavaFormatError("failed creating chain proxy on reload, keeping the current node urls", err, utils.Attribute{Key: "endpoint", Value: newEndpoint.String()})
+	}
+	chainFetcher := chainlib.NewVerificationsOnlyChainFetcher(routerCtx, newChainRouter, active.chainParser, newEndpoint)
+	err = chainFetcher.Validate(routerCtx)
+	if err != nil {
+		routerCancel()
+		return utils.LavaFormatError("failed validating new node urls on reload, keeping the current node urls", err, utils.Attribute{Key: "endpoint", Value: newEndpoint.String()})
+	}
+	active.chainRouter.Swap(newChainRouter)
+	rpcp.lock.Lock()
+	// only the node urls are applied, keep the running settings so changes to the others are still reported on the next reload
+	updatedEndpoint := *active.endpoint
+	updatedEndpoint.NodeUrls = newEndpoint.NodeUrls
+	previousRouterCancel := active.routerCancel
+	active.routerCancel = routerCancel
+	active.endpoint = &updatedEndpoint
+	rpcp.lock.Unlock()
+	retireAfterGracePeriod(previousRouterCancel)
+	utils.LavaFormatInfo("swapped node urls for endpoint", utils.Attribute{Key: "endpoint", Value: newEndpoint.String()})
+	return nil
+}
+
+// retireEndpoint stops routing new relays to the endpoint and releases its resources once relays in flight had time to finish
+func (rpcp *RPCProvider) retireEndpoint(ctx context.Context, active *activeProviderEndpoint) {
+	endpoint := active.endpoint
+	rpcp.lock.Lock()
+	defer rpcp.lock.Unlock()
+	if listener, ok := rpcp.rpcProviderListeners[endpoint.NetworkAddress.Address]; ok {
+		if listener.UnregisterReceiver(endpoint) == 0 {
+			delete(rpcp.rpcProviderListeners, endpoint.NetworkAddress.Address)
+			go func() {
+				shutdownCtx, shutdownRelease := context.WithTimeout(context.Background(), EndpointRetireGracePeriod)
+				defer shutdownRelease()
+				listener.Shutdown(shutdownCtx)
+			}()
+		}
+	}
+	rpcEndpoint := lavasession.RPCEndpoint{ChainID: endpoint.ChainID, ApiInterface: endpoint.ApiInterface}
+	rpcp.providerStateTracker.UnregisterForSpecUpdates(ctx, rpcEndpoint)
+	rpcp.providerStateTracker.UnregisterReliabilityManagerForVoteUpdates(ctx, endpoint)
+	if active.sessionManager != nil {
+		rpcp.providerStateTracker.UnregisterForEpochUpdates(ctx, active.sessionManager)
+	}
+	rpcp.adminServer.UnregisterSessionManager(endpoint)
+	delete(rpcp.activeEndpoints, activeEndpointKey(endpoint))
+
+	resources, ok := rpcp.chainResources[endpoint.ChainID]
+	if !ok {
+		retireAfterGracePeriod(active.cancel)
+		return
+	}
+	resources.endpoints--
+	if resources.endpoints > 0 {
+		if active.ownsChainTracker {
+			// the remaining endpoints of the chain still use the chain tracker running on this endpoint
+			resources.retiredCancels = append(resources.retiredCancels, active.cancel)
+		} else {
+			retireAfterGracePeriod(active.cancel)
+		}
+		return
+	}
+	// last endpoint of the chain, the chain tracker goes away with it
+	delete(rpcp.chainResources, endpoint.ChainID)
+	retireAfterGracePeriod(active.cancel)
+	for _, cancel := range resources.retiredCancels {
+		retireAfterGracePeriod(cancel)
+	}
+	utils.LavaFormatInfo("retired last endpoint for chain", utils.Attribute{Key: "chainID", Value: endpoint.ChainID})
+}
+
+func retireAfterGracePeriod(cancel context.CancelFunc) {
+	time.AfterFunc(EndpointRetireGracePeriod, cancel)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"math/rand"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -51,6 +52,12 @@ type EpochRewards struct {
 	consumerRewards map[string]*ConsumerRewards // key is consumer
 }
 
+type PendingProof struct {
+	Epoch    uint64
+	Consumer string
+	Proof    *pairingtypes.RelaySession
+}
+
 type RewardServer struct {
 	rewardsTxSender  RewardsTxSender
 	lock             sync.RWMutex
@@ -60,6 +67,8 @@ type RewardServer struct {
 	totalCUServiced  uint64
 	totalCUPaid      uint64
 	providerMetrics  *metrics.ProviderMetricsManager
+	currentEpoch     uint64
+	forceClaimed     map[uint64]map[string]struct{} // epoch -> claimed proof keys, sessions that were claimed before their epoch expired, their later proofs are claimed separately
 }
 
 type RewardsTxSender interface {
@@ -72,6 +81,9 @@ func (rws *RewardServer) SendNewProof(ctx context.Context, proof *pairingtypes.R
 	rws.lock.Lock() // assuming 99% of the time we will need to write the new entry so there's no use in doing the read lock first to check stuff
 	defer rws.lock.Unlock()
 	consumerRewardsKey := getKeyForConsumerRewards(proof.SpecId, apiInterface, consumerAddr)
+	if _, claimed := rws.forceClaimed[epoch][getKeyForForceClaimedProof(consumerRewardsKey, proof.SessionId)]; claimed {
+		utils.LavaFormatInfo("received proof for a session that was already claimed by a forced claim, it will be claimed in a new claim", utils.Attribute{Key: "sessionID", Value: proof.SessionId}, utils.Attribute{Key: "epoch", Value: epoch}, utils.Attribute{Key: "consumer", Value: consumerAddr})
+	}
 	epochRewards, ok := rws.rewards[epoch]
 	if !ok {
 		proofs := map[uint64]*pairingtypes.RelaySession{proof.SessionId: proof}
@@ -104,15 +116,44 @@ func (rws *RewardServer) SendNewProof(ctx context.Context, proof *pairingtypes.R
 
 func (rws *RewardServer) UpdateEpoch(epoch uint64) {
 	ctx := context.Background()
+	atomic.StoreUint64(&rws.currentEpoch, epoch)
 	_ = rws.sendRewardsClaim(ctx, epoch)
 	_, _ = rws.identifyMissingPayments(ctx)
 }
 
 func (rws *RewardServer) sendRewardsClaim(ctx context.Context, epoch uint64) error {
-	rewardsToClaim, err := rws.gatherRewardsForClaim(ctx, epoch)
+	rewardsToClaim, reclaims, err := rws.gatherRewardsForClaim(ctx, epoch)
 	if err != nil {
 		return err
 	}
+	return rws.claimRewardsAndReclaims(ctx, rewardsToClaim, reclaims)
+}
+
+// ForceRewardsClaim claims all the proofs of epochs older than the current one without waiting for them to leave the memory window,
+// proofs that arrive later for the claimed sessions are kept and claimed in a new claim
+func (rws *RewardServer) ForceRewardsClaim(ctx context.Context) (claimedProofs []*pairingtypes.RelaySession, err error) {
+	currentEpoch := atomic.LoadUint64(&rws.currentEpoch)
+	if currentEpoch == 0 {
+		return nil, utils.LavaFormatWarning("ForceRewardsClaim called before receiving an epoch update", nil)
+	}
+	rewardsToClaim, reclaims := rws.gatherRewardsBelowEpoch(currentEpoch, true)
+	return append(rewardsToClaim, reclaims...), rws.claimRewardsAndReclaims(ctx, rewardsToClaim, reclaims)
+}
+
+// claimRewardsAndReclaims sends the proofs of sessions that were already claimed by a forced claim in a claim of their own,
+// the chain rejects a whole claim if one of its sessions was already paid, so they must not fail the rest of the rewards
+func (rws *RewardServer) claimRewardsAndReclaims(ctx context.Context, rewardsToClaim []*pairingtypes.RelaySession, reclaims []*pairingtypes.RelaySession) error {
+	err := rws.claimRewards(ctx, rewardsToClaim)
+	if len(reclaims) > 0 {
+		reclaimErr := rws.claimRewards(ctx, reclaims)
+		if err == nil {
+			err = reclaimErr
+		}
+	}
+	return err
+}
+
+func (rws *RewardServer) claimRewards(ctx context.Context, rewardsToClaim []*pairingtypes.RelaySession) error {
 	for _, relay := range rewardsToClaim {
 		consumerAddr, err := sigs.ExtractSignerAddress(relay)
 		if err != nil {
@@ -124,7 +165,7 @@ func (rws *RewardServer) sendRewardsClaim(ctx context.Context, epoch uint64) err
 		rws.updateCUServiced(relay.CuSum)
 	}
 	if len(rewardsToClaim) > 0 {
-		err = rws.rewardsTxSender.TxRelayPayment(ctx, rewardsToClaim, strconv.FormatUint(rws.serverID, 10))
+		err := rws.rewardsTxSender.TxRelayPayment(ctx, rewardsToClaim, strconv.FormatUint(rws.serverID, 10))
 		if err != nil {
 			return utils.LavaFormatError("failed sending rewards claim", err)
 		}
@@ -201,38 +242,93 @@ func (rws *RewardServer) RemoveExpectedPayment(paidCUToFInd uint64, expectedClie
 	return false
 }
 
-func (rws *RewardServer) gatherRewardsForClaim(ctx context.Context, currentEpoch uint64) (rewardsForClaim []*pairingtypes.RelaySession, errRet error) {
+func (rws *RewardServer) gatherRewardsForClaim(ctx context.Context, currentEpoch uint64) (rewardsForClaim []*pairingtypes.RelaySession, reclaims []*pairingtypes.RelaySession, errRet error) {
 	rws.lock.Lock()
 	defer rws.lock.Unlock()
 	blockDistanceForEpochValidity, err := rws.rewardsTxSender.GetEpochSizeMultipliedByRecommendedEpochNumToCollectPayment(ctx)
 	if err != nil {
-		return nil, utils.LavaFormatError("gatherRewardsForClaim failed to GetEpochSizeMultipliedByRecommendedEpochNumToCollectPayment", err)
+		return nil, nil, utils.LavaFormatError("gatherRewardsForClaim failed to GetEpochSizeMultipliedByRecommendedEpochNumToCollectPayment", err)
 	}
 
 	if blockDistanceForEpochValidity > currentEpoch {
-		return nil, utils.LavaFormatWarning("gatherRewardsForClaim current epoch is too low to claim rewards", nil, utils.Attribute{Key: "current epoch", Value: currentEpoch})
+		return nil, nil, utils.LavaFormatWarning("gatherRewardsForClaim current epoch is too low to claim rewards", nil, utils.Attribute{Key: "current epoch", Value: currentEpoch})
 	}
 	activeEpochThreshold := currentEpoch - blockDistanceForEpochValidity
+	rewardsForClaim, reclaims = rws.gatherRewardsBelowEpochLocked(activeEpochThreshold+1, false)
+	for epoch := range rws.forceClaimed {
+		if !lavasession.IsEpochValidForUse(epoch, activeEpochThreshold) {
+			// no more relays can arrive for this epoch so there is nothing left to tell apart
+			delete(rws.forceClaimed, epoch)
+		}
+	}
+	return rewardsForClaim, reclaims, errRet
+}
+
+// gathers the rewards of all epochs lower than epochLimit and removes them from the server
+func (rws *RewardServer) gatherRewardsBelowEpoch(epochLimit uint64, forced bool) (rewardsForClaim []*pairingtypes.RelaySession, reclaims []*pairingtypes.RelaySession) {
+	rws.lock.Lock()
+	defer rws.lock.Unlock()
+	return rws.gatherRewardsBelowEpochLocked(epochLimit, forced)
+}
+
+// this function assumes rws.lock is held
+// reclaims are the proofs of sessions that were already claimed by a forced claim
+func (rws *RewardServer) gatherRewardsBelowEpochLocked(epochLimit uint64, forced bool) (rewardsForClaim []*pairingtypes.RelaySession, reclaims []*pairingtypes.RelaySession) {
 	for epoch, epochRewards := range rws.rewards {
-		if lavasession.IsEpochValidForUse(epoch, activeEpochThreshold) {
+		if epoch >= epochLimit {
 			// Epoch is still active so we don't claim the rewards yet.
 			continue
 		}
 
-		for consumerAddr, rewards := range epochRewards.consumerRewards {
+		for consumerRewardsKey, rewards := range epochRewards.consumerRewards {
 			claimables, err := rewards.PrepareRewardsForClaim()
 			if err != nil {
 				// can't claim this now
 				continue
 			}
-			rewardsForClaim = append(rewardsForClaim, claimables...)
-			delete(epochRewards.consumerRewards, consumerAddr)
+			delete(epochRewards.consumerRewards, consumerRewardsKey)
+			for _, claimable := range claimables {
+				forceClaimedKey := getKeyForForceClaimedProof(consumerRewardsKey, claimable.SessionId)
+				if _, claimed := rws.forceClaimed[epoch][forceClaimedKey]; claimed {
+					reclaims = append(reclaims, claimable)
+					continue
+				}
+				rewardsForClaim = append(rewardsForClaim, claimable)
+				if forced {
+					if _, ok := rws.forceClaimed[epoch]; !ok {
+						rws.forceClaimed[epoch] = map[string]struct{}{}
+					}
+					rws.forceClaimed[epoch][forceClaimedKey] = struct{}{}
+				}
+			}
 		}
 		if len(epochRewards.consumerRewards) == 0 {
 			delete(rws.rewards, epoch)
 		}
 	}
-	return rewardsForClaim, errRet
+	return rewardsForClaim, reclaims
+}
+
+// PendingRewards returns a snapshot of the proofs waiting to be claimed and the payments expected from already sent claims
+func (rws *RewardServer) PendingRewards() (pendingProofs []PendingProof, expectedPayments []PaymentRequest, cuServiced uint64, cuPaid uint64) {
+	rws.lock.RLock()
+	defer rws.lock.RUnlock()
+	for epoch, epochRewards := range rws.rewards {
+		for _, consumerRewards := range epochRewards.consumerRewards {
+			for _, proof := range consumerRewards.proofs {
+				pendingProofs = append(pendingProofs, PendingProof{Epoch: epoch, Consumer: consumerRewards.consumer, Proof: proof})
+			}
+		}
+	}
+	sort.Slice(pendingProofs, func(i, j int) bool {
+		if pendingProofs[i].Epoch != pendingProofs[j].Epoch {
+			return pendingProofs[i].Epoch < pendingProofs[j].Epoch
+		}
+		return pendingProofs[i].Proof.SessionId < pendingProofs[j].Proof.SessionId
+	})
+	expectedPayments = make([]PaymentRequest, len(rws.expectedPayments))
+	copy(expectedPayments, rws.expectedPayments)
+	return pendingProofs, expectedPayments, rws.cUServiced(), rws.paidCU()
 }
 
 func (rws *RewardServer) SubscribeStarted(consumer string, epoch uint64, subscribeID string) {
@@ -285,6 +381,7 @@ func NewRewardServer(rewardsTxSender RewardsTxSender, providerMetrics *metrics.P
 	rws.expectedPayments = []PaymentRequest{}
 	// TODO: load this from persistency
 	rws.rewards = map[uint64]*EpochRewards{}
+	rws.forceClaimed = map[uint64]map[string]struct{}{}
 	rws.providerMetrics = providerMetrics
 	return rws
 }
@@ -391,3 +488,7 @@ func BuildPaymentFromRelayPaymentEvent(event terderminttypes.Event, block int64)
 func getKeyForConsumerRewards(specId, apiInterface, consumerAddress string) string {
 	return specId + apiInterface + consumerAddress
 }
+
+func getKeyForForceClaimedProof(consumerRewardsKey string, sessionId uint64) string {
+	return consumerRewardsKey + strconv.FormatUint(sessionId, 10)
+}
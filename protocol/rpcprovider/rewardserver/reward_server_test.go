@@ -1,6 +1,7 @@
 package rewardserver_test
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 	"testing"
@@ -77,3 +78,67 @@ func TestPayments(t *testing.T) {
 	require.Nil(t, err)
 	require.Equal(t, internalRelays, len(paymentRequests))
 }
+
+type rewardsTxSenderStub struct {
+	claims [][]*pairingtypes.RelaySession
+}
+
+func (rts *rewardsTxSenderStub) TxRelayPayment(_ context.Context, relayRequests []*pairingtypes.RelaySession, _ string) error {
+	rts.claims = append(rts.claims, relayRequests)
+	return nil
+}
+
+func (rts *rewardsTxSenderStub) GetEpochSizeMultipliedByRecommendedEpochNumToCollectPayment(_ context.Context) (uint64, error) {
+	return 100, nil
+}
+
+func (rts *rewardsTxSenderStub) EarliestBlockInMemory(_ context.Context) (uint64, error) {
+	return 0, nil
+}
+
+func TestForceRewardsClaim(t *testing.T) {
+	ctx := context.Background()
+	stub := &rewardsTxSenderStub{}
+	rws := rewardserver.NewRewardServer(stub, nil)
+	consumer := sdk.AccAddress{1, 2, 3, 4}.String()
+
+	_, updated := rws.SendNewProof(ctx, &pairingtypes.RelaySession{SpecId: "spec", SessionId: 1, CuSum: 10, Epoch: 10}, 10, consumer, "jsonrpc")
+	require.True(t, updated)
+	_, updated = rws.SendNewProof(ctx, &pairingtypes.RelaySession{SpecId: "spec", SessionId: 2, CuSum: 20, Epoch: 20}, 20, consumer, "jsonrpc")
+	require.True(t, updated)
+
+	// no epoch update yet, nothing to force
+	_, err := rws.ForceRewardsClaim(ctx)
+	require.Error(t, err)
+
+	rws.UpdateEpoch(20)
+	require.Empty(t, stub.claims) // both epochs are still in the memory window
+
+	pendingProofs, _, _, _ := rws.PendingRewards()
+	require.Len(t, pendingProofs, 2)
+	require.Equal(t, uint64(10), pendingProofs[0].Epoch)
+
+	claimed, err := rws.ForceRewardsClaim(ctx)
+	require.NoError(t, err)
+	require.Len(t, claimed, 1)
+	require.Equal(t, uint64(1), claimed[0].SessionId)
+	require.Len(t, stub.claims, 1)
+
+	pendingProofs, _, _, _ = rws.PendingRewards()
+	require.Len(t, pendingProofs, 1)
+	require.Equal(t, uint64(20), pendingProofs[0].Epoch)
+
+	// a late proof for the claimed session is kept
+	_, updated = rws.SendNewProof(ctx, &pairingtypes.RelaySession{SpecId: "spec", SessionId: 1, CuSum: 30, Epoch: 10}, 10, consumer, "jsonrpc")
+	require.True(t, updated)
+	_, updated = rws.SendNewProof(ctx, &pairingtypes.RelaySession{SpecId: "spec", SessionId: 3, CuSum: 30, Epoch: 10}, 10, consumer, "jsonrpc")
+	require.True(t, updated)
+
+	// the late proof is claimed in a new claim of its own
+	rws.UpdateEpoch(120)
+	require.Len(t, stub.claims, 3)
+	require.Len(t, stub.claims[1], 2)
+	require.Len(t, stub.claims[2], 1)
+	require.Equal(t, uint64(1), stub.claims[2][0].SessionId)
+	require.Equal(t, uint64(30), stub.claims[2][0].CuSum)
+}
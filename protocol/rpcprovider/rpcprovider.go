@@ -6,11 +6,14 @@ import (
 	"math/rand"
 	"os"
 	"os/signal"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
+	"syscall"
 	"time"
 
+	"github.com/btcsuite/btcd/btcec"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/config"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -19,6 +22,7 @@ import (
 	"github.com/lavanet/lava/app"
 	"github.com/lavanet/lava/protocol/chainlib"
 	"github.com/lavanet/lava/protocol/chainlib/chainproxy"
+	"github.com/lavanet/lava/protocol/chainlib/nodefixtures"
 	"github.com/lavanet/lava/protocol/chaintracker"
 	"github.com/lavanet/lava/protocol/common"
 	"github.com/lavanet/lava/protocol/lavasession"
@@ -49,8 +53,11 @@ var (
 type ProviderStateTrackerInf interface {
 	RegisterForVersionUpdates(ctx context.Context, version *protocoltypes.Version, versionValidator statetracker.VersionValidationInf)
 	RegisterForSpecUpdates(ctx context.Context, specUpdatable statetracker.SpecUpdatable, endpoint lavasession.RPCEndpoint) error
+	UnregisterForSpecUpdates(ctx context.Context, endpoint lavasession.RPCEndpoint)
 	RegisterReliabilityManagerForVoteUpdates(ctx context.Context, voteUpdatable statetracker.VoteUpdatable, endpointP *lavasession.RPCProviderEndpoint)
+	UnregisterReliabilityManagerForVoteUpdates(ctx context.Context, endpointP *lavasession.RPCProviderEndpoint)
 	RegisterForEpochUpdates(ctx context.Context, epochUpdatable statetracker.EpochUpdatable)
+	UnregisterForEpochUpdates(ctx context.Context, epochUpdatable statetracker.EpochUpdatable)
 	TxRelayPayment(ctx context.Context, relayRequests []*pairingtypes.RelaySession, description string) error
 	SendVoteReveal(voteID string, vote *reliabilitymanager.VoteData) error
 	SendVoteCommitment(voteID string, vote *reliabilitymanager.VoteData) error
@@ -63,24 +70,51 @@ type ProviderStateTrackerInf interface {
 	GetRecommendedEpochNumToCollectPayment(ctx context.Context) (uint64, error)
 	GetEpochSizeMultipliedByRecommendedEpochNumToCollectPayment(ctx context.Context) (uint64, error)
 	GetProtocolVersion(ctx context.Context) (*protocoltypes.Version, error)
+	TxFreezeProvider(ctx context.Context, chainIDs []string, reason string) error
+	TxUnfreezeProvider(ctx context.Context, chainIDs []string) error
+	IsProviderFrozen(ctx context.Context, providerAddress, chainID string) (bool, error)
 }
 
 type RPCProvider struct {
-	providerStateTracker ProviderStateTrackerInf
-	rpcProviderListeners map[string]*ProviderListener
-	lock                 sync.Mutex
+	providerStateTracker   ProviderStateTrackerInf
+	rpcProviderListeners   map[string]*ProviderListener
+	adminServer            *ProviderAdminServer
+	maintenance            *ProviderMaintenance
+	lock                   sync.Mutex
+	providerMetricsManager *metrics.ProviderMetricsManager
+	rewardServer           *rewardserver.RewardServer
+	privKey                *btcec.PrivateKey
+	addr                   sdk.AccAddress
+	lavaChainID            string
+	blockMemorySize        uint64
+	parallelConnections    uint
+	cache                  *performance.Cache
+	chainMutexes           map[string]*sync.Mutex
+	chainResources         map[string]*providerChainResources
+	activeEndpoints        map[string]*activeProviderEndpoint
+	reloadLock             sync.Mutex
+	recordFixturesDir      string
 }
 
-func (rpcp *RPCProvider) Start(ctx context.Context, txFactory tx.Factory, clientCtx client.Context, rpcProviderEndpoints []*lavasession.RPCProviderEndpoint, cache *performance.Cache, parallelConnections uint, metricsListenAddress string) (err error) {
+func (rpcp *RPCProvider) Start(ctx context.Context, txFactory tx.Factory, clientCtx client.Context, rpcProviderEndpoints []*lavasession.RPCProviderEndpoint, cache *performance.Cache, parallelConnections uint, metricsListenAddress string, adminListenAddress string, adminToken string, endpointsLoader EndpointsLoader, unfreezeOnStart bool, authzGrantee string, recordFixturesDir string) (err error) {
 	ctx, cancel := context.WithCancel(ctx)
 	signalChan := make(chan os.Signal, 1)
 	signal.Notify(signalChan, os.Interrupt)
+	reloadChan := make(chan os.Signal, 1)
+	signal.Notify(reloadChan, syscall.SIGHUP)
 	defer func() {
 		signal.Stop(signalChan)
+		signal.Stop(reloadChan)
 		cancel()
 	}()
-	providerMetricsManager := metrics.NewProviderMetricsManager(metricsListenAddress) // start up prometheus metrics
+	rpcp.providerMetricsManager = metrics.NewProviderMetricsManager(metricsListenAddress) // start up prometheus metrics
 	rpcp.rpcProviderListeners = make(map[string]*ProviderListener)
+	rpcp.chainMutexes = map[string]*sync.Mutex{}
+	rpcp.chainResources = map[string]*providerChainResources{}
+	rpcp.activeEndpoints = map[string]*activeProviderEndpoint{}
+	rpcp.parallelConnections = parallelConnections
+	rpcp.cache = cache
+	rpcp.recordFixturesDir = recordFixturesDir
 	// single state tracker
 	lavaChainFetcher := chainlib.NewLavaChainFetcher(ctx, clientCtx)
 	providerStateTracker, err := statetracker.NewProviderStateTracker(ctx, txFactory, clientCtx, lavaChainFetcher)
@@ -88,7 +122,13 @@ func (rpcp *RPCProvider) Start(ctx context.Context, txFactory tx.Factory, client
 		return err
 	}
 	rpcp.providerStateTracker = providerStateTracker
-	providerStateTracker.RegisterForUpdates(ctx, statetracker.NewMetricsUpdater(providerMetricsManager))
+	if authzGrantee != "" {
+		err = providerStateTracker.SetAuthzGrantee(ctx, authzGrantee)
+		if err != nil {
+			return err
+		}
+	}
+	providerStateTracker.RegisterForUpdates(ctx, statetracker.NewMetricsUpdater(rpcp.providerMetricsManager))
 	// check version
 	version, err := rpcp.providerStateTracker.GetProtocolVersion(ctx)
 	if err != nil {
@@ -97,201 +137,333 @@ func (rpcp *RPCProvider) Start(ctx context.Context, txFactory tx.Factory, client
 	rpcp.providerStateTracker.RegisterForVersionUpdates(ctx, version, &upgrade.ProtocolVersion{})
 
 	// single reward server
-	rewardServer := rewardserver.NewRewardServer(providerStateTracker, providerMetricsManager)
-	rpcp.providerStateTracker.RegisterForEpochUpdates(ctx, rewardServer)
-	rpcp.providerStateTracker.RegisterPaymentUpdatableForPayments(ctx, rewardServer)
+	rpcp.rewardServer = rewardserver.NewRewardServer(providerStateTracker, rpcp.providerMetricsManager)
+	rpcp.providerStateTracker.RegisterForEpochUpdates(ctx, rpcp.rewardServer)
+	rpcp.providerStateTracker.RegisterPaymentUpdatableForPayments(ctx, rpcp.rewardServer)
+
+	// maintenance mode shuts the provider down through the main context once it is out of pairing
+	rpcp.maintenance = NewProviderMaintenance(providerStateTracker, rpcp.rewardServer, cancel)
+	rpcp.providerStateTracker.RegisterForEpochUpdates(ctx, rpcp.maintenance)
+
+	// local admin server for operators
+	rpcp.adminServer = NewProviderAdminServer(rpcp.rewardServer)
+	rpcp.adminServer.SetMaintenance(rpcp.maintenance, rpcp.servedChainIDs)
+	if endpointsLoader != nil {
+		rpcp.adminServer.SetReloadHandler(func(reloadCtx context.Context) (*ReloadResult, error) {
+			return rpcp.ReloadEndpoints(ctx, endpointsLoader)
+		})
+	}
+	if adminListenAddress != AdminDisabledOption {
+		err = rpcp.adminServer.Serve(ctx, adminListenAddress, adminToken)
+		if err != nil {
+			return err
+		}
+	}
 
 	keyName, err := sigs.GetKeyName(clientCtx)
 	if err != nil {
 		utils.LavaFormatFatal("failed getting key name from clientCtx", err)
 	}
-	privKey, err := sigs.GetPrivKey(clientCtx, keyName)
+	rpcp.privKey, err = sigs.GetPrivKey(clientCtx, keyName)
 	if err != nil {
 		utils.LavaFormatFatal("failed getting private key from key name", err, utils.Attribute{Key: "keyName", Value: keyName})
 	}
 	clientKey, _ := clientCtx.Keyring.Key(keyName)
-	lavaChainID := clientCtx.ChainID
+	rpcp.lavaChainID = clientCtx.ChainID
 
 	pubKey, err := clientKey.GetPubKey()
 	if err != nil {
 		return err
 	}
 
-	var addr sdk.AccAddress
-	err = addr.Unmarshal(pubKey.Address())
+	err = rpcp.addr.Unmarshal(pubKey.Address())
 	if err != nil {
 		utils.LavaFormatFatal("failed unmarshaling public address", err, utils.Attribute{Key: "keyName", Value: keyName}, utils.Attribute{Key: "pubkey", Value: pubKey.Address()})
 	}
-	utils.LavaFormatInfo("RPCProvider pubkey: " + addr.String())
+	utils.LavaFormatInfo("RPCProvider pubkey: " + rpcp.addr.String())
 	utils.LavaFormatInfo("RPCProvider setting up endpoints", utils.Attribute{Key: "count", Value: strconv.Itoa(len(rpcProviderEndpoints))})
-	blockMemorySize, err := rpcp.providerStateTracker.GetEpochSizeMultipliedByRecommendedEpochNumToCollectPayment(ctx) // get the number of blocks to keep in PSM.
+	rpcp.blockMemorySize, err = rpcp.providerStateTracker.GetEpochSizeMultipliedByRecommendedEpochNumToCollectPayment(ctx) // get the number of blocks to keep in PSM.
 	if err != nil {
 		utils.LavaFormatFatal("Failed fetching GetEpochSizeMultipliedByRecommendedEpochNumToCollectPayment in RPCProvider Start", err)
 	}
 
+	fillEmptyNetworkAddresses(rpcProviderEndpoints)
+	disabledEndpointsList := rpcp.setupEndpoints(ctx, rpcProviderEndpoints)
+	utils.LavaFormatInfo("RPCProvider done setting up endpoints, ready for service")
+	if len(disabledEndpointsList) > 0 {
+		utils.LavaFormatError(utils.FormatStringerList("RPCProvider running with disabled endpoints:", disabledEndpointsList), nil)
+		if len(disabledEndpointsList) == len(rpcProviderEndpoints) {
+			utils.LavaFormatFatal("all endpoints are disabled", nil)
+		}
+	}
+	if unfreezeOnStart {
+		// only chains with endpoints that passed validation are unfrozen
+		go UnfreezeHealthyChains(ctx, rpcp.providerStateTracker, rpcp.addr.String(), rpcp.servedChainIDs())
+	}
+	// tearing down
+	for {
+		select {
+		case <-ctx.Done():
+			utils.LavaFormatInfo("Provider Server ctx.Done")
+		case <-signalChan:
+			utils.LavaFormatInfo("Provider Server signalChan")
+		case <-reloadChan:
+			if endpointsLoader == nil {
+				utils.LavaFormatWarning("received reload signal but endpoints were not loaded from a config file, ignoring", nil)
+				continue
+			}
+			utils.LavaFormatInfo("Provider Server received reload signal")
+			rpcp.ReloadEndpoints(ctx, endpointsLoader)
+			continue
+		}
+		break
+	}
+
+	rpcp.lock.Lock()
+	for _, listener := range rpcp.rpcProviderListeners {
+		shutdownCtx, shutdownRelease := context.WithTimeout(context.Background(), 10*time.Second)
+		listener.Shutdown(shutdownCtx)
+		defer shutdownRelease()
+	}
+	rpcp.lock.Unlock()
+	if adminListenAddress != AdminDisabledOption {
+		shutdownCtx, shutdownRelease := context.WithTimeout(context.Background(), 10*time.Second)
+		rpcp.adminServer.Shutdown(shutdownCtx)
+		defer shutdownRelease()
+	}
+
+	return nil
+}
+
+func (rpcp *RPCProvider) servedChainIDs() []string {
+	rpcp.lock.Lock()
+	defer rpcp.lock.Unlock()
+	chainIDs := make([]string, 0, len(rpcp.chainResources))
+	for chainID := range rpcp.chainResources {
+		chainIDs = append(chainIDs, chainID)
+	}
+	sort.Strings(chainIDs)
+	return chainIDs
+}
+
+// setupEndpoints sets up the given endpoints in parallel and returns the ones that failed
+func (rpcp *RPCProvider) setupEndpoints(ctx context.Context, rpcProviderEndpoints []*lavasession.RPCProviderEndpoint) (disabledEndpointsList []*lavasession.RPCProviderEndpoint) {
 	// pre loop to handle synchronous actions
-	chainMutexes := map[string]*sync.Mutex{}
-	for idx, endpoint := range rpcProviderEndpoints {
-		chainMutexes[endpoint.ChainID] = &sync.Mutex{}        // create a mutex per chain for shared resources
-		if idx > 0 && endpoint.NetworkAddress.Address == "" { // handle undefined addresses as the previous endpoint for shared listeners
-			endpoint.NetworkAddress = rpcProviderEndpoints[idx-1].NetworkAddress
+	rpcp.lock.Lock()
+	for _, endpoint := range rpcProviderEndpoints {
+		if _, ok := rpcp.chainMutexes[endpoint.ChainID]; !ok {
+			rpcp.chainMutexes[endpoint.ChainID] = &sync.Mutex{} // create a mutex per chain for shared resources
 		}
 	}
-	var stateTrackersPerChain sync.Map
+	rpcp.lock.Unlock()
 	var wg sync.WaitGroup
 	parallelJobs := len(rpcProviderEndpoints)
 	wg.Add(parallelJobs)
 	disabledEndpoints := make(chan *lavasession.RPCProviderEndpoint, parallelJobs)
 
 	for _, rpcProviderEndpoint := range rpcProviderEndpoints {
-		go func(rpcProviderEndpoint *lavasession.RPCProviderEndpoint) error {
+		go func(rpcProviderEndpoint *lavasession.RPCProviderEndpoint) {
 			defer wg.Done()
-			err := rpcProviderEndpoint.Validate()
-			if err != nil {
-				disabledEndpoints <- rpcProviderEndpoint
-				return utils.LavaFormatError("panic severity critical error, aborting support for chain api due to invalid node url definition, continuing with others", err, utils.Attribute{Key: "endpoint", Value: rpcProviderEndpoint.String()})
-			}
-			chainID := rpcProviderEndpoint.ChainID
-			providerSessionManager := lavasession.NewProviderSessionManager(rpcProviderEndpoint, blockMemorySize)
-			rpcp.providerStateTracker.RegisterForEpochUpdates(ctx, providerSessionManager)
-			chainParser, err := chainlib.NewChainParser(rpcProviderEndpoint.ApiInterface)
+			err := rpcp.setupEndpoint(ctx, rpcProviderEndpoint)
 			if err != nil {
 				disabledEndpoints <- rpcProviderEndpoint
-				return utils.LavaFormatError("panic severity critical error, aborting support for chain api due to invalid chain parser, continuing with others", err, utils.Attribute{Key: "endpoint", Value: rpcProviderEndpoint.String()})
-			}
-
-			err = providerStateTracker.RegisterForSpecUpdates(ctx, chainParser, lavasession.RPCEndpoint{ChainID: chainID, ApiInterface: rpcProviderEndpoint.ApiInterface})
-			if err != nil {
-				disabledEndpoints <- rpcProviderEndpoint
-				return utils.LavaFormatError("failed to RegisterForSpecUpdates, panic severity critical error, aborting support for chain api due to invalid chain parser, continuing with others", err, utils.Attribute{Key: "endpoint", Value: rpcProviderEndpoint.String()})
-			}
-
-			chainRouter, err := chainlib.GetChainRouter(ctx, parallelConnections, rpcProviderEndpoint, chainParser)
-			if err != nil {
-				disabledEndpoints <- rpcProviderEndpoint
-				return utils.LavaFormatError("panic severity critical error, failed creating chain proxy, continuing with others endpoints", err, utils.Attribute{Key: "parallelConnections", Value: uint64(parallelConnections)}, utils.Attribute{Key: "rpcProviderEndpoint", Value: rpcProviderEndpoint})
 			}
+		}(rpcProviderEndpoint) // continue on error
+	}
+	wg.Wait()
+	close(disabledEndpoints)
+	for disabledEndpoint := range disabledEndpoints {
+		disabledEndpointsList = append(disabledEndpointsList, disabledEndpoint)
+	}
+	return disabledEndpointsList
+}
 
-			_, averageBlockTime, blocksToFinalization, blocksInFinalizationData := chainParser.ChainBlockStats()
-			var chainTracker *chaintracker.ChainTracker
-			// chainTracker accepts a callback to be called on new blocks, we use this to call metrics update on a new block
-			recordMetricsOnNewBlock := func(block int64, hash string) {
-				providerMetricsManager.SetLatestBlock(chainID, uint64(block))
-			}
+func (rpcp *RPCProvider) setupEndpoint(ctx context.Context, rpcProviderEndpoint *lavasession.RPCProviderEndpoint) error {
+	err := rpcProviderEndpoint.Validate()
+	if err != nil {
+		return utils.LavaFormatError("panic severity critical error, aborting support for chain api due to invalid node url definition, continuing with others", err, utils.Attribute{Key: "endpoint", Value: rpcProviderEndpoint.String()})
+	}
+	rpcp.lock.Lock()
+	_, alreadyActive := rpcp.activeEndpoints[activeEndpointKey(rpcProviderEndpoint)]
+	rpcp.lock.Unlock()
+	if alreadyActive {
+		return utils.LavaFormatError("endpoint is already active, aborting duplicate setup", nil, utils.Attribute{Key: "endpoint", Value: rpcProviderEndpoint.String()})
+	}
+	// every endpoint gets its own context so it can be retired on a configuration reload without affecting the others
+	endpointCtx, endpointCancel := context.WithCancel(ctx)
+	chainID := rpcProviderEndpoint.ChainID
+	chainParser, err := chainlib.NewChainParser(rpcProviderEndpoint.ApiInterface)
+	if err != nil {
+		endpointCancel()
+		return utils.LavaFormatError("panic severity critical error, aborting support for chain api due to invalid chain parser, continuing with others", err, utils.Attribute{Key: "endpoint", Value: rpcProviderEndpoint.String()})
+	}
 
-			// in order to utilize shared resources between chains we need go routines with the same chain to wait for one another here
-			chainCommonSetup := func() error {
-				chainMutexes[chainID].Lock()
-				defer chainMutexes[chainID].Unlock()
+	rpcEndpoint := lavasession.RPCEndpoint{ChainID: chainID, ApiInterface: rpcProviderEndpoint.ApiInterface}
+	err = rpcp.providerStateTracker.RegisterForSpecUpdates(ctx, chainParser, rpcEndpoint)
+	if err != nil {
+		endpointCancel()
+		return utils.LavaFormatError("failed to RegisterForSpecUpdates, panic severity critical error, aborting support for chain api due to invalid chain parser, continuing with others", err, utils.Attribute{Key: "endpoint", Value: rpcProviderEndpoint.String()})
+	}
+	// undo the spec registration if we fail later on, so the endpoint can be set up again on a reload
+	registeredForSpec := true
+	defer func() {
+		if err != nil && registeredForSpec {
+			rpcp.providerStateTracker.UnregisterForSpecUpdates(ctx, rpcEndpoint)
+		}
+	}()
 
-				var chainFetcher chainlib.ChainFetcherIf
-				if enabled, _ := chainParser.DataReliabilityParams(); enabled {
-					chainFetcher = chainlib.NewChainFetcher(ctx, chainRouter, chainParser, rpcProviderEndpoint, cache)
-				} else {
-					chainFetcher = chainlib.NewVerificationsOnlyChainFetcher(ctx, chainRouter, chainParser, rpcProviderEndpoint)
-				}
+	// node connections get a context of their own so they can be replaced on a reload while the endpoint keeps running
+	routerCtx, routerCancel := context.WithCancel(endpointCtx)
+	innerChainRouter, err := chainlib.GetChainRouter(routerCtx, rpcp.parallelConnections, rpcProviderEndpoint, chainParser)
+	if err != nil {
+		routerCancel()
+		endpointCancel()
+		return utils.LavaFormatError("panic severity critical error, failed creating chain proxy, continuing with others endpoints", err, utils.Attribute{Key: "parallelConnections", Value: uint64(rpcp.parallelConnections)}, utils.Attribute{Key: "rpcProviderEndpoint", Value: rpcProviderEndpoint})
+	}
+	chainRouter := chainlib.NewSwappableChainRouter(innerChainRouter)
+	var nodeRouter chainlib.ChainRouter = chainRouter
+	if rpcp.recordFixturesDir != "" {
+		recordingRouter, err := nodefixtures.NewRecordingChainRouter(endpointCtx, chainRouter, rpcp.recordFixturesDir, chainID, rpcProviderEndpoint.ApiInterface)
+		if err != nil {
+			utils.LavaFormatWarning("not recording node fixtures for endpoint", err, utils.Attribute{Key: "endpoint", Value: rpcProviderEndpoint.Key()})
+		} else {
+			nodeRouter = recordingRouter
+		}
+	}
 
-				// Fetch and validate all verifications
-				err = chainFetcher.Validate(ctx)
-				if err != nil {
-					return utils.LavaFormatError("panic severity critical error, aborting support for chain api due to failing to validate, continuing with other endpoints", err, utils.Attribute{Key: "endpoint", Value: rpcProviderEndpoint})
-				}
+	_, averageBlockTime, blocksToFinalization, blocksInFinalizationData := chainParser.ChainBlockStats()
+	var chainTracker *chaintracker.ChainTracker
+	var chainFetcher chainlib.ChainFetcherIf
+	ownsChainTracker := false
+	// chainTracker accepts a callback to be called on new blocks, we use this to call metrics update on a new block
+	recordMetricsOnNewBlock := func(block int64, hash string) {
+		rpcp.providerMetricsManager.SetLatestBlock(chainID, uint64(block))
+	}
+	recordMetricsOnReorg := func(reorg *chaintracker.Reorg) {
+		rpcp.providerMetricsManager.AddReorg(chainID, reorg.Depth)
+	}
 
-				chainTrackerInf, found := stateTrackersPerChain.Load(chainID)
-				if !found {
-					blocksToSaveChainTracker := uint64(blocksToFinalization + blocksInFinalizationData)
-					chainTrackerConfig := chaintracker.ChainTrackerConfig{
-						BlocksToSave:      blocksToSaveChainTracker,
-						AverageBlockTime:  averageBlockTime,
-						ServerBlockMemory: ChainTrackerDefaultMemory + blocksToSaveChainTracker,
-						NewLatestCallback: recordMetricsOnNewBlock,
-					}
+	// in order to utilize shared resources between chains we need go routines with the same chain to wait for one another here
+	chainCommonSetup := func() error {
+		rpcp.lock.Lock()
+		chainMutex := rpcp.chainMutexes[chainID]
+		rpcp.lock.Unlock()
+		chainMutex.Lock()
+		defer chainMutex.Unlock()
+
+		if enabled, _ := chainParser.DataReliabilityParams(); enabled {
+			chainFetcher = chainlib.NewChainFetcher(endpointCtx, nodeRouter, chainParser, rpcProviderEndpoint, rpcp.cache)
+		} else {
+			chainFetcher = chainlib.NewVerificationsOnlyChainFetcher(endpointCtx, nodeRouter, chainParser, rpcProviderEndpoint)
+		}
 
-					chainTracker, err = chaintracker.NewChainTracker(ctx, chainFetcher, chainTrackerConfig)
-					if err != nil {
-						return utils.LavaFormatError("panic severity critical error, aborting support for chain api due to node access, continuing with other endpoints", err, utils.Attribute{Key: "chainTrackerConfig", Value: chainTrackerConfig}, utils.Attribute{Key: "endpoint", Value: rpcProviderEndpoint})
-					}
-					// Any validation needs to be before we store chain tracker for given chain id
-					stateTrackersPerChain.Store(rpcProviderEndpoint.ChainID, chainTracker)
-				} else {
-					var ok bool
-					chainTracker, ok = chainTrackerInf.(*chaintracker.ChainTracker)
-					if !ok {
-						utils.LavaFormatFatal("invalid usage of syncmap, could not cast result into a chaintracker", nil)
-					}
-					utils.LavaFormatDebug("reusing chain tracker", utils.Attribute{Key: "chain", Value: rpcProviderEndpoint.ChainID})
-				}
+		// Fetch and validate all verifications
+		err := chainFetcher.Validate(endpointCtx)
+		if err != nil {
+			return utils.LavaFormatError("panic severity critical error, aborting support for chain api due to failing to validate, continuing with other endpoints", err, utils.Attribute{Key: "endpoint", Value: rpcProviderEndpoint})
+		}
 
-				return nil
+		rpcp.lock.Lock()
+		resources, found := rpcp.chainResources[chainID]
+		rpcp.lock.Unlock()
+		if !found {
+			blocksToSaveChainTracker := uint64(blocksToFinalization + blocksInFinalizationData)
+			chainTrackerConfig := chaintracker.ChainTrackerConfig{
+				BlocksToSave:      blocksToSaveChainTracker,
+				AverageBlockTime:  averageBlockTime,
+				ServerBlockMemory: ChainTrackerDefaultMemory + blocksToSaveChainTracker,
+				NewLatestCallback: recordMetricsOnNewBlock,
+				ReorgCallback:     recordMetricsOnReorg,
 			}
-			err = chainCommonSetup()
+
+			chainTracker, err = chaintracker.NewChainTracker(endpointCtx, chainFetcher, chainTrackerConfig)
 			if err != nil {
-				disabledEndpoints <- rpcProviderEndpoint
-				return err
+				return utils.LavaFormatError("panic severity critical error, aborting support for chain api due to node access, continuing with other endpoints", err, utils.Attribute{Key: "chainTrackerConfig", Value: chainTrackerConfig}, utils.Attribute{Key: "endpoint", Value: rpcProviderEndpoint})
 			}
+			// Any validation needs to be before we store chain tracker for given chain id
+			rpcp.lock.Lock()
+			rpcp.chainResources[chainID] = &providerChainResources{chainTracker: chainTracker}
+			rpcp.lock.Unlock()
+			ownsChainTracker = true
+		} else {
+			chainTracker = resources.chainTracker
+			utils.LavaFormatDebug("reusing chain tracker", utils.Attribute{Key: "chain", Value: rpcProviderEndpoint.ChainID})
+		}
 
-			providerMetrics := providerMetricsManager.AddProviderMetrics(chainID, rpcProviderEndpoint.ApiInterface)
-
-			reliabilityManager := reliabilitymanager.NewReliabilityManager(chainTracker, providerStateTracker, addr.String(), chainRouter, chainParser)
-			providerStateTracker.RegisterReliabilityManagerForVoteUpdates(ctx, reliabilityManager, rpcProviderEndpoint)
-
-			rpcProviderServer := &RPCProviderServer{}
-			rpcProviderServer.ServeRPCRequests(ctx, rpcProviderEndpoint, chainParser, rewardServer, providerSessionManager, reliabilityManager, privKey, cache, chainRouter, providerStateTracker, addr, lavaChainID, DEFAULT_ALLOWED_MISSING_CU, providerMetrics)
-			// set up grpc listener
-			var listener *ProviderListener
-			func() {
-				rpcp.lock.Lock()
-				defer rpcp.lock.Unlock()
-				var ok bool
-				listener, ok = rpcp.rpcProviderListeners[rpcProviderEndpoint.NetworkAddress.Address]
-				if !ok {
-					utils.LavaFormatDebug("creating new listener", utils.Attribute{Key: "NetworkAddress", Value: rpcProviderEndpoint.NetworkAddress})
-					listener = NewProviderListener(ctx, rpcProviderEndpoint.NetworkAddress)
-					rpcp.rpcProviderListeners[rpcProviderEndpoint.NetworkAddress.Address] = listener
-				}
-			}()
-			if listener == nil {
-				utils.LavaFormatFatal("listener not defined, cant register RPCProviderServer", nil, utils.Attribute{Key: "RPCProviderEndpoint", Value: rpcProviderEndpoint.String()})
-			}
-			listener.RegisterReceiver(rpcProviderServer, rpcProviderEndpoint)
-			utils.LavaFormatDebug("provider finished setting up endpoint", utils.Attribute{Key: "endpoint", Value: rpcProviderEndpoint.Key()})
-			return nil
-		}(rpcProviderEndpoint) // continue on error
+		return nil
 	}
-	wg.Wait()
-	close(disabledEndpoints)
-	utils.LavaFormatInfo("RPCProvider done setting up endpoints, ready for service")
-	disabledEndpointsList := []*lavasession.RPCProviderEndpoint{}
-	for disabledEndpoint := range disabledEndpoints {
-		disabledEndpointsList = append(disabledEndpointsList, disabledEndpoint)
+	err = chainCommonSetup()
+	if err != nil {
+		routerCancel()
+		endpointCancel()
+		return err
 	}
-	if len(disabledEndpointsList) > 0 {
-		utils.LavaFormatError(utils.FormatStringerList("RPCProvider running with disabled endpoints:", disabledEndpointsList), nil)
-		if len(disabledEndpointsList) == parallelJobs {
-			utils.LavaFormatFatal("all endpoints are disabled", nil)
+	// requested block hashes are resolved from the tracked blocks, older ones are fetched from the node in the background
+	blockNumByHashFetcher, _ := chainFetcher.(chainlib.BlockNumByHashFetcher)
+	chainParser.SetBlockHashResolver(chainlib.NewBlockHashResolver(endpointCtx, chainTracker, blockNumByHashFetcher, chainParser))
+
+	providerSessionManager := lavasession.NewProviderSessionManager(rpcProviderEndpoint, rpcp.blockMemorySize)
+	rpcp.providerStateTracker.RegisterForEpochUpdates(ctx, providerSessionManager)
+	rpcp.adminServer.RegisterSessionManager(providerSessionManager)
+	providerMetrics := rpcp.providerMetricsManager.AddProviderMetrics(chainID, rpcProviderEndpoint.ApiInterface)
+
+	reliabilityManager := reliabilitymanager.NewReliabilityManager(chainTracker, rpcp.providerStateTracker, rpcp.addr.String(), nodeRouter, chainParser)
+	rpcp.providerStateTracker.RegisterReliabilityManagerForVoteUpdates(ctx, reliabilityManager, rpcProviderEndpoint)
+
+	rpcProviderServer := &RPCProviderServer{}
+	rpcProviderServer.ServeRPCRequests(endpointCtx, rpcProviderEndpoint, chainParser, rpcp.rewardServer, providerSessionManager, reliabilityManager, rpcp.privKey, rpcp.cache, nodeRouter, rpcp.providerStateTracker, rpcp.addr, rpcp.lavaChainID, DEFAULT_ALLOWED_MISSING_CU, providerMetrics)
+	// set up grpc listener
+	var listener *ProviderListener
+	func() {
+		rpcp.lock.Lock()
+		defer rpcp.lock.Unlock()
+		var ok bool
+		listener, ok = rpcp.rpcProviderListeners[rpcProviderEndpoint.NetworkAddress.Address]
+		if !ok {
+			utils.LavaFormatDebug("creating new listener", utils.Attribute{Key: "NetworkAddress", Value: rpcProviderEndpoint.NetworkAddress})
+			listener = NewProviderListener(ctx, rpcProviderEndpoint.NetworkAddress)
+			rpcp.rpcProviderListeners[rpcProviderEndpoint.NetworkAddress.Address] = listener
 		}
+	}()
+	if listener == nil {
+		utils.LavaFormatFatal("listener not defined, cant register RPCProviderServer", nil, utils.Attribute{Key: "RPCProviderEndpoint", Value: rpcProviderEndpoint.String()})
 	}
-	// tearing down
-	select {
-	case <-ctx.Done():
-		utils.LavaFormatInfo("Provider Server ctx.Done")
-	case <-signalChan:
-		utils.LavaFormatInfo("Provider Server signalChan")
+	err = listener.RegisterReceiver(rpcProviderServer, rpcProviderEndpoint)
+	if err != nil {
+		rpcp.providerStateTracker.UnregisterForEpochUpdates(ctx, providerSessionManager)
+		rpcp.lock.Lock()
+		if ownsChainTracker {
+			// other endpoints might already be using the chain tracker, so its context is kept until the chain is retired
+			rpcp.chainResources[chainID].retiredCancels = append(rpcp.chainResources[chainID].retiredCancels, routerCancel, endpointCancel)
+		} else {
+			routerCancel()
+			endpointCancel()
+		}
+		rpcp.lock.Unlock()
+		return err
 	}
-
-	for _, listener := range rpcp.rpcProviderListeners {
-		shutdownCtx, shutdownRelease := context.WithTimeout(context.Background(), 10*time.Second)
-		listener.Shutdown(shutdownCtx)
-		defer shutdownRelease()
+	registeredForSpec = false // from here on the endpoint is active and owns its registrations
+	rpcp.lock.Lock()
+	rpcp.activeEndpoints[activeEndpointKey(rpcProviderEndpoint)] = &activeProviderEndpoint{
+		endpoint:         rpcProviderEndpoint,
+		ctx:              endpointCtx,
+		chainParser:      chainParser,
+		chainRouter:      chainRouter,
+		cancel:           endpointCancel,
+		routerCancel:     routerCancel,
+		ownsChainTracker: ownsChainTracker,
+		sessionManager:   providerSessionManager,
 	}
-
+	rpcp.chainResources[chainID].endpoints++
+	rpcp.lock.Unlock()
+	utils.LavaFormatDebug("provider finished setting up endpoint", utils.Attribute{Key: "endpoint", Value: rpcProviderEndpoint.Key()})
 	return nil
 }
 
 func ParseEndpoints(viper_endpoints *viper.Viper, geolocation uint64) (endpoints []*lavasession.RPCProviderEndpoint, err error) {
 	err = viper_endpoints.UnmarshalKey(common.EndpointsConfigName, &endpoints)
 	if err != nil {
-		utils.LavaFormatFatal("could not unmarshal endpoints", err, utils.Attribute{Key: "viper_endpoints", Value: viper_endpoints.AllSettings()})
+		return nil, utils.LavaFormatError("could not unmarshal endpoints", err, utils.Attribute{Key: "viper_endpoints", Value: viper_endpoints.AllSettings()})
 	}
 	for _, endpoint := range endpoints {
 		endpoint.Geolocation = geolocation
@@ -454,8 +626,24 @@ rpcprovider 127.0.0.1:3333 COS3 tendermintrpc "wss://www.node-path.com:80,https:
 				utils.LavaFormatDebug("endpoint description", utils.Attribute{Key: "endpoint", Value: endpoint})
 			}
 			prometheusListenAddr := viper.GetString(metrics.MetricsListenFlagName)
+			adminListenAddr := viper.GetString(AdminListenFlagName)
+			adminToken := viper.GetString(AdminTokenFlagName)
+			var endpointsLoader EndpointsLoader
+			if len(args) <= 1 {
+				// endpoints from a config file can be reloaded by SIGHUP or the admin server
+				endpointsLoader = func() ([]*lavasession.RPCProviderEndpoint, error) {
+					err := viper.ReadInConfig()
+					if err != nil {
+						return nil, utils.LavaFormatError("could not load config file", err, utils.Attribute{Key: "expected_config_name", Value: viper.ConfigFileUsed()})
+					}
+					return ParseEndpoints(viper.GetViper(), geolocation)
+				}
+			}
+			unfreezeOnStart := viper.GetBool(UnfreezeOnStartFlagName)
+			authzGrantee := viper.GetString(statetracker.AuthzGranteeFlagName)
+			recordFixturesDir := viper.GetString(nodefixtures.RecordFixturesFlagName)
 			rpcProvider := RPCProvider{}
-			err = rpcProvider.Start(ctx, txFactory, clientCtx, rpcProviderEndpoints, cache, numberOfNodeParallelConnections, prometheusListenAddr)
+			err = rpcProvider.Start(ctx, txFactory, clientCtx, rpcProviderEndpoints, cache, numberOfNodeParallelConnections, prometheusListenAddr, adminListenAddr, adminToken, endpointsLoader, unfreezeOnStart, authzGrantee, recordFixturesDir)
 			return err
 		},
 	}
@@ -471,6 +659,14 @@ rpcprovider 127.0.0.1:3333 COS3 tendermintrpc "wss://www.node-path.com:80,https:
 	cmdRPCProvider.Flags().Uint(chainproxy.ParallelConnectionsFlag, chainproxy.NumberOfParallelConnections, "parallel connections")
 	cmdRPCProvider.Flags().String(flags.FlagLogLevel, "debug", "log level")
 	cmdRPCProvider.Flags().String(metrics.MetricsListenFlagName, metrics.DisabledFlagOption, "the address to expose prometheus metrics (such as localhost:7779)")
+	cmdRPCProvider.Flags().String(AdminListenFlagName, AdminDisabledOption, "the local address to expose the admin grpc/grpc-web server (such as 127.0.0.1:7780)")
+	cmdRPCProvider.Flags().String(AdminTokenFlagName, "", "the bearer token required by the admin server, required when the admin server is enabled")
+	cmdRPCProvider.Flags().Bool(UnfreezeOnStartFlagName, false, "unfreeze the provider on the served chains once their endpoints pass validation, used to return from maintenance")
+	cmdRPCProvider.Flags().String(statetracker.AuthzGranteeFlagName, "", "key name of an authz grantee that signs the provider transactions (relay payments, freeze, conflict votes) as an authz MsgExec on behalf of --from, use with --fee-granter so the grantee needs no funds. relay responses to consumers are still signed by --from")
+	cmdRPCProvider.Flags().String(nodefixtures.RecordFixturesFlagName, "", "directory to record node requests and responses to, used to verify spec changes offline with lavad spec verify-fixtures")
+	cmdRPCProvider.AddCommand(CreateProviderAdminCobraCommand())
+	cmdRPCProvider.AddCommand(CreateProviderMaintenanceCobraCommand())
+	cmdRPCProvider.AddCommand(CreateProviderDiscoverCobraCommand())
 
 	return cmdRPCProvider
 }
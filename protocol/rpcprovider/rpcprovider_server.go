@@ -231,7 +231,13 @@ func (rpcps *RPCProviderServer) ValidateRequest(chainMessage chainlib.ChainMessa
 		// the consumer either configured an invalid value or is modifying the requested block as part of a data reliability message
 		// see if this modification is supported
 		providerRequestedBlockPreUpdate := reqBlock
-		chainMessage.UpdateLatestBlockInMessage(request.RelayData.RequestBlock, true)
+		if !chainMessage.UpdateLatestBlockInMessage(request.RelayData.RequestBlock, true) {
+			// a requested block hash is resolved in the background, so only one of the consumer and provider might have resolved it yet.
+			// the chain tracker holds the blocks in the finalization range, so the consumer's block is accepted only if it's older than them
+			_, _, blockDistanceForFinalizedData, _ := rpcps.chainParser.ChainBlockStats()
+			maxUnresolvedBlock := rpcps.reliabilityManager.GetLatestBlockNum() - int64(blockDistanceForFinalizedData)
+			chainMessage.UpdateBlockHashRequestedBlock(request.RelayData.RequestBlock, maxUnresolvedBlock)
+		}
 		// if after UpdateLatestBlockInMessage it's not aligned we have a problem
 		reqBlock, _ = chainMessage.RequestedBlock()
 		if reqBlock != request.RelayData.RequestBlock {
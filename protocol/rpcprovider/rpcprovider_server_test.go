@@ -0,0 +1,144 @@
+package rpcprovider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/lavanet/lava/protocol/chainlib"
+	"github.com/lavanet/lava/protocol/chaintracker"
+	"github.com/lavanet/lava/protocol/common"
+	"github.com/lavanet/lava/protocol/lavaprotocol"
+	"github.com/lavanet/lava/protocol/lavasession"
+	"github.com/lavanet/lava/protocol/parser"
+	keepertest "github.com/lavanet/lava/testutil/keeper"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	spectypes "github.com/lavanet/lava/x/spec/types"
+	"github.com/stretchr/testify/require"
+)
+
+type blockHashLookupStub map[string]int64
+
+func (bhls blockHashLookupStub) GetBlockNumByHash(blockHash string) (int64, bool) {
+	block, ok := bhls[blockHash]
+	return block, ok
+}
+
+type reliabilityManagerStub struct {
+	latestBlock int64
+}
+
+func (rms *reliabilityManagerStub) GetLatestBlockData(fromBlock, toBlock, specificBlock int64) (latestBlock int64, requestedHashes []*chaintracker.BlockStore, err error) {
+	return rms.latestBlock, nil, nil
+}
+
+func (rms *reliabilityManagerStub) GetLatestBlockNum() int64 {
+	return rms.latestBlock
+}
+
+// a block hash resolved by the consumer sets the requested block of the relay, so old blocks are routed to archive nodes
+// and get data reliability, and the provider accepts the consumer's block while it didn't resolve the hash itself
+func TestBlockHashRequestedBlock(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	const (
+		blockHash   = "0x968ec00fd34eedc03b0577ee8116f74c75127b7d775e51c7a72519f760b821a8"
+		hashBlock   = 100
+		latestBlock = 1000
+	)
+	spec, err := keepertest.GetASpec("ETH1", "../../", nil, nil)
+	require.NoError(t, err)
+	newChainParser := func() chainlib.ChainParser {
+		chainParser, err := chainlib.NewChainParser(spectypes.APIInterfaceJsonRPC)
+		require.NoError(t, err)
+		chainParser.SetSpec(spec)
+		return chainParser
+	}
+	data := []byte(fmt.Sprintf(`{"jsonrpc":"2.0","id":1,"method":"eth_getBlockByHash","params":["%s", false]}`, blockHash))
+	// the consumer and provider know blocks by the hashes encoded like the GET_BLOCK_BY_NUM result
+	encodedHash, err := parser.ParseBlockHashByEncoding(blockHash, spectypes.EncodingHex)
+	require.NoError(t, err)
+
+	// the consumer resolves the hash from the finalized blocks reported by providers
+	finalizationConsensus := &lavaprotocol.FinalizationConsensus{}
+	_, _, blockDistanceForFinalizedData, _ := newChainParser().ChainBlockStats()
+	_, err = finalizationConsensus.UpdateFinalizedHashes(int64(blockDistanceForFinalizedData), "provider", map[int64]string{hashBlock: encodedHash}, &pairingtypes.RelaySession{}, &pairingtypes.RelayReply{LatestBlock: latestBlock})
+	require.NoError(t, err)
+	consumerChainParser := newChainParser()
+	require.NoError(t, consumerChainParser.SetConfiguredExtensions(map[string]struct{}{"archive": {}}))
+	consumerChainParser.SetBlockHashResolver(chainlib.NewBlockHashResolver(ctx, finalizationConsensus, nil, consumerChainParser))
+	chainMessage, err := consumerChainParser.ParseMsg("", data, http.MethodPost, nil, latestBlock)
+	require.NoError(t, err)
+	reqBlock, _ := chainMessage.RequestedBlock()
+	require.Equal(t, int64(hashBlock), reqBlock)
+	extensions := common.GetExtensionNames(chainMessage.GetExtensions())
+	require.Equal(t, []string{"archive"}, extensions)
+	// a deterministic api with a finalized requested block gets data reliability
+	require.True(t, chainMessage.GetApi().Category.Deterministic)
+	require.True(t, spectypes.IsFinalizedBlock(reqBlock, latestBlock, blockDistanceForFinalizedData))
+	relayData := lavaprotocol.NewRelayData(ctx, http.MethodPost, "", data, reqBlock, spectypes.APIInterfaceJsonRPC, nil, "", extensions)
+	request := &pairingtypes.RelayRequest{RelayData: relayData}
+
+	// the provider routes the relay to the archive node
+	var regularCalls, archiveCalls int32
+	newNode := func(calls *int32) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(calls, 1)
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprintf(w, `{"jsonrpc":"2.0","id":1,"result":{"number":"0x%x","hash":"%s"}}`, hashBlock, blockHash)
+		}))
+	}
+	regularNode := newNode(&regularCalls)
+	defer regularNode.Close()
+	archiveNode := newNode(&archiveCalls)
+	defer archiveNode.Close()
+	providerChainParser := newChainParser()
+	endpoint := &lavasession.RPCProviderEndpoint{
+		ChainID:      spec.Index,
+		ApiInterface: spectypes.APIInterfaceJsonRPC,
+		Geolocation:  1,
+		NodeUrls:     []common.NodeUrl{{Url: regularNode.URL}, {Url: archiveNode.URL, Addons: []string{"archive"}}},
+	}
+	chainRouter, err := chainlib.GetChainRouter(ctx, 1, endpoint, providerChainParser)
+	require.NoError(t, err)
+	require.True(t, chainRouter.ExtensionsSupported(relayData.Extensions))
+
+	// the provider didn't resolve the hash yet, and its chain tracker holds the blocks in the finalization range,
+	// so it rejects a consumer's block in that range
+	providerChainParser.SetBlockHashResolver(chainlib.NewBlockHashResolver(ctx, blockHashLookupStub{}, nil, providerChainParser))
+	reliabilityManager := &reliabilityManagerStub{latestBlock: hashBlock + int64(blockDistanceForFinalizedData) - 1}
+	rpcps := &RPCProviderServer{chainParser: providerChainParser, reliabilityManager: reliabilityManager}
+	providerChainMessage, err := providerChainParser.ParseMsg("", data, http.MethodPost, nil, 0)
+	require.NoError(t, err)
+	require.Error(t, rpcps.ValidateRequest(providerChainMessage, request, ctx))
+
+	// an older block is accepted as the consumer's block
+	reliabilityManager.latestBlock = latestBlock
+	providerChainMessage, err = providerChainParser.ParseMsg("", data, http.MethodPost, nil, 0)
+	require.NoError(t, err)
+	require.NoError(t, rpcps.ValidateRequest(providerChainMessage, request, ctx))
+	providerReqBlock, _ := providerChainMessage.RequestedBlock()
+	require.Equal(t, int64(hashBlock), providerReqBlock)
+	_, _, _, err = chainRouter.SendNodeMsg(ctx, nil, providerChainMessage, relayData.Extensions)
+	require.NoError(t, err)
+	require.Equal(t, int32(1), atomic.LoadInt32(&archiveCalls))
+	require.Zero(t, atomic.LoadInt32(&regularCalls))
+
+	// a provider that resolved the hash takes the default block of a consumer that didn't resolve it yet
+	providerChainParser.SetBlockHashResolver(chainlib.NewBlockHashResolver(ctx, blockHashLookupStub{encodedHash: hashBlock}, nil, providerChainParser))
+	providerChainMessage, err = providerChainParser.ParseMsg("", data, http.MethodPost, nil, 0)
+	require.NoError(t, err)
+	unresolvedRequest := &pairingtypes.RelayRequest{RelayData: lavaprotocol.NewRelayData(ctx, http.MethodPost, "", data, spectypes.LATEST_BLOCK, spectypes.APIInterfaceJsonRPC, nil, "", nil)}
+	require.NoError(t, rpcps.ValidateRequest(providerChainMessage, unresolvedRequest, ctx))
+	providerReqBlock, _ = providerChainMessage.RequestedBlock()
+	require.Equal(t, spectypes.LATEST_BLOCK, providerReqBlock)
+
+	// a provider that resolved the hash to another block rejects the relay
+	providerChainParser.SetBlockHashResolver(chainlib.NewBlockHashResolver(ctx, blockHashLookupStub{encodedHash: hashBlock + 1}, nil, providerChainParser))
+	providerChainMessage, err = providerChainParser.ParseMsg("", data, http.MethodPost, nil, 0)
+	require.NoError(t, err)
+	require.Error(t, rpcps.ValidateRequest(providerChainMessage, request, ctx))
+}
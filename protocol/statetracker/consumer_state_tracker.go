@@ -53,6 +53,18 @@ func (cst *ConsumerStateTracker) RegisterConsumerSessionManagerForPairingUpdates
 	}
 }
 
+func (cst *ConsumerStateTracker) UnregisterConsumerSessionManagerForPairingUpdates(ctx context.Context, consumerSessionManager *lavasession.ConsumerSessionManager) {
+	pairingUpdaterRaw, ok := cst.StateTracker.getRegisteredUpdater(CallbackKeyForPairingUpdate)
+	if !ok {
+		return
+	}
+	pairingUpdater, ok := pairingUpdaterRaw.(*PairingUpdater)
+	if !ok {
+		utils.LavaFormatFatal("invalid updater type registered for unregistering", nil, utils.Attribute{Key: "updater", Value: pairingUpdaterRaw})
+	}
+	pairingUpdater.UnregisterPairing(consumerSessionManager)
+}
+
 func (cst *ConsumerStateTracker) RegisterFinalizationConsensusForUpdates(ctx context.Context, finalizationConsensus *lavaprotocol.FinalizationConsensus) {
 	finalizationConsensusUpdater := NewFinalizationConsensusUpdater(cst.stateQuery)
 	finalizationConsensusUpdaterRaw := cst.StateTracker.RegisterForUpdates(ctx, finalizationConsensusUpdater)
@@ -89,6 +101,18 @@ func (cst *ConsumerStateTracker) GetConsumerPolicy(ctx context.Context, consumer
 	return cst.stateQuery.GetEffectivePolicy(ctx, consumerAddress, chainID)
 }
 
+func (cst *ConsumerStateTracker) UnregisterForSpecUpdates(ctx context.Context, endpoint lavasession.RPCEndpoint) {
+	specUpdaterRaw, ok := cst.StateTracker.getRegisteredUpdater(CallbackKeyForSpecUpdate + endpoint.ChainID)
+	if !ok {
+		return
+	}
+	specUpdater, ok := specUpdaterRaw.(*SpecUpdater)
+	if !ok {
+		utils.LavaFormatFatal("invalid updater type registered for unregistering", nil, utils.Attribute{Key: "updater", Value: specUpdaterRaw})
+	}
+	specUpdater.UnregisterSpecUpdatable(endpoint)
+}
+
 func (cst *ConsumerStateTracker) RegisterForVersionUpdates(ctx context.Context, version *protocoltypes.Version, versionValidator VersionValidationInf) {
 	versionUpdater := NewVersionUpdater(cst.stateQuery, cst.EventTracker, version, versionValidator)
 	versionUpdaterRaw := cst.StateTracker.RegisterForUpdates(ctx, versionUpdater)
@@ -38,6 +38,19 @@ func (eu *EpochUpdater) RegisterEpochUpdatable(ctx context.Context, epochUpdatab
 	eu.epochUpdatables = append(eu.epochUpdatables, &epochUpdatable)
 }
 
+func (eu *EpochUpdater) UnregisterEpochUpdatable(epochUpdatable EpochUpdatable) {
+	eu.lock.Lock()
+	defer eu.lock.Unlock()
+	epochUpdatables := make([]*EpochUpdatable, 0, len(eu.epochUpdatables))
+	for _, registered := range eu.epochUpdatables {
+		if registered != nil && *registered == epochUpdatable {
+			continue
+		}
+		epochUpdatables = append(epochUpdatables, registered)
+	}
+	eu.epochUpdatables = epochUpdatables
+}
+
 func (eu *EpochUpdater) UpdaterKey() string {
 	return CallbackKeyForEpochUpdate
 }
@@ -46,6 +46,22 @@ func (pu *PairingUpdater) RegisterPairing(ctx context.Context, consumerSessionMa
 	return nil
 }
 
+func (pu *PairingUpdater) UnregisterPairing(consumerSessionManager *lavasession.ConsumerSessionManager) {
+	chainID := consumerSessionManager.RPCEndpoint().ChainID
+	pu.lock.Lock()
+	defer pu.lock.Unlock()
+	consumerSessionsManagersList := pu.consumerSessionManagersMap[chainID]
+	for idx, registered := range consumerSessionsManagersList {
+		if registered == consumerSessionManager {
+			pu.consumerSessionManagersMap[chainID] = append(consumerSessionsManagersList[:idx], consumerSessionsManagersList[idx+1:]...)
+			break
+		}
+	}
+	if len(pu.consumerSessionManagersMap[chainID]) == 0 {
+		delete(pu.consumerSessionManagersMap, chainID)
+	}
+}
+
 func (pu *PairingUpdater) UpdaterKey() string {
 	return CallbackKeyForPairingUpdate
 }
@@ -44,6 +44,18 @@ func (pst *ProviderStateTracker) RegisterForEpochUpdates(ctx context.Context, ep
 	epochUpdater.RegisterEpochUpdatable(ctx, epochUpdatable)
 }
 
+func (pst *ProviderStateTracker) UnregisterForEpochUpdates(ctx context.Context, epochUpdatable EpochUpdatable) {
+	epochUpdaterRaw, ok := pst.StateTracker.getRegisteredUpdater(CallbackKeyForEpochUpdate)
+	if !ok {
+		return
+	}
+	epochUpdater, ok := epochUpdaterRaw.(*EpochUpdater)
+	if !ok {
+		utils.LavaFormatFatal("invalid updater type registered for unregistering", nil, utils.Attribute{Key: "updater", Value: epochUpdaterRaw})
+	}
+	epochUpdater.UnregisterEpochUpdatable(epochUpdatable)
+}
+
 func (pst *ProviderStateTracker) RegisterForSpecUpdates(ctx context.Context, specUpdatable SpecUpdatable, endpoint lavasession.RPCEndpoint) error {
 	// register for spec updates sets spec and updates when a spec has been modified
 	specUpdater := NewSpecUpdater(endpoint.ChainID, pst.stateQuery, pst.EventTracker)
@@ -55,6 +67,18 @@ func (pst *ProviderStateTracker) RegisterForSpecUpdates(ctx context.Context, spe
 	return specUpdater.RegisterSpecUpdatable(ctx, &specUpdatable, endpoint)
 }
 
+func (pst *ProviderStateTracker) UnregisterForSpecUpdates(ctx context.Context, endpoint lavasession.RPCEndpoint) {
+	specUpdaterRaw, ok := pst.StateTracker.getRegisteredUpdater(CallbackKeyForSpecUpdate + endpoint.ChainID)
+	if !ok {
+		return
+	}
+	specUpdater, ok := specUpdaterRaw.(*SpecUpdater)
+	if !ok {
+		utils.LavaFormatFatal("invalid updater type registered for unregistering", nil, utils.Attribute{Key: "updater", Value: specUpdaterRaw})
+	}
+	specUpdater.UnregisterSpecUpdatable(endpoint)
+}
+
 func (pst *ProviderStateTracker) RegisterForVersionUpdates(ctx context.Context, version *protocoltypes.Version, versionValidator VersionValidationInf) {
 	versionUpdater := NewVersionUpdater(pst.stateQuery, pst.EventTracker, version, versionValidator)
 	versionUpdaterRaw := pst.StateTracker.RegisterForUpdates(ctx, versionUpdater)
@@ -76,6 +100,18 @@ func (pst *ProviderStateTracker) RegisterReliabilityManagerForVoteUpdates(ctx co
 	voteUpdater.RegisterVoteUpdatable(ctx, &voteUpdatable, endpoint)
 }
 
+func (pst *ProviderStateTracker) UnregisterReliabilityManagerForVoteUpdates(ctx context.Context, endpointP *lavasession.RPCProviderEndpoint) {
+	voteUpdaterRaw, ok := pst.StateTracker.getRegisteredUpdater(CallbackKeyForVoteUpdate)
+	if !ok {
+		return
+	}
+	voteUpdater, ok := voteUpdaterRaw.(*VoteUpdater)
+	if !ok {
+		utils.LavaFormatFatal("invalid updater type registered for unregistering", nil, utils.Attribute{Key: "updater", Value: voteUpdaterRaw})
+	}
+	voteUpdater.UnregisterVoteUpdatable(lavasession.RPCEndpoint{ChainID: endpointP.ChainID, ApiInterface: endpointP.ApiInterface})
+}
+
 func (pst *ProviderStateTracker) RegisterPaymentUpdatableForPayments(ctx context.Context, paymentUpdatable PaymentUpdatable) {
 	payemntUpdater := NewPaymentUpdater(pst.EventTracker)
 	payemntUpdaterRaw := pst.StateTracker.RegisterForUpdates(ctx, payemntUpdater)
@@ -91,6 +127,29 @@ func (pst *ProviderStateTracker) TxRelayPayment(ctx context.Context, relayReques
 	return pst.txSender.TxRelayPayment(ctx, relayRequests, description)
 }
 
+// SetAuthzGrantee sends the provider transactions with the grantee key, see TxSender.SetAuthzGrantee
+func (pst *ProviderStateTracker) SetAuthzGrantee(ctx context.Context, granteeKeyName string) error {
+	err := pst.txSender.SetAuthzGrantee(granteeKeyName)
+	if err != nil {
+		return err
+	}
+	// missing grants are only logged since they can be granted while the provider is running
+	pst.txSender.VerifyAuthzGrants(ctx)
+	return nil
+}
+
+func (pst *ProviderStateTracker) TxFreezeProvider(ctx context.Context, chainIDs []string, reason string) error {
+	return pst.txSender.TxFreezeProvider(ctx, chainIDs, reason)
+}
+
+func (pst *ProviderStateTracker) TxUnfreezeProvider(ctx context.Context, chainIDs []string) error {
+	return pst.txSender.TxUnfreezeProvider(ctx, chainIDs)
+}
+
+func (pst *ProviderStateTracker) IsProviderFrozen(ctx context.Context, providerAddress, chainID string) (bool, error) {
+	return pst.stateQuery.IsProviderFrozen(ctx, providerAddress, chainID)
+}
+
 func (pst *ProviderStateTracker) SendVoteReveal(voteID string, vote *reliabilitymanager.VoteData) error {
 	return pst.txSender.SendVoteReveal(voteID, vote)
 }
@@ -2,6 +2,7 @@ package statetracker
 
 import (
 	"context"
+	"strings"
 	"sync"
 
 	"github.com/lavanet/lava/protocol/lavasession"
@@ -61,15 +62,23 @@ func (su *SpecUpdater) RegisterSpecUpdatable(ctx context.Context, specUpdatable
 		if err != nil {
 			return utils.LavaFormatError("panic level error could not get chain spec failed registering", err, utils.Attribute{Key: "chainID", Value: su.chainId})
 		}
+		su.spec = spec
 	}
 	(*specUpdatable).SetSpec(*spec)
 	su.specUpdatables[endpoint.Key()] = specUpdatable
 	return nil
 }
 
+// UnregisterSpecUpdatable stops spec updates for an endpoint, so it can be registered again when re-added on a configuration reload
+func (su *SpecUpdater) UnregisterSpecUpdatable(endpoint lavasession.RPCEndpoint) {
+	su.lock.Lock()
+	defer su.lock.Unlock()
+	delete(su.specUpdatables, endpoint.Key())
+}
+
 func (su *SpecUpdater) Update(latestBlock int64) {
-	su.lock.RLock()
-	defer su.lock.RUnlock()
+	su.lock.Lock()
+	defer su.lock.Unlock()
 	specUpdated := su.eventTracker.getLatestSpecModifyEvents()
 	if specUpdated {
 		spec, err := su.specGetter.GetSpec(context.Background(), su.chainId)
@@ -80,6 +89,13 @@ func (su *SpecUpdater) Update(latestBlock int64) {
 		if spec.BlockLastUpdated > su.blockLastUpdated {
 			su.blockLastUpdated = spec.BlockLastUpdated
 		}
+		if su.spec != nil {
+			diff := spectypes.DiffSpecs(*su.spec, *spec)
+			if !diff.IsEmpty() {
+				utils.LavaFormatInfo("spec updated", utils.Attribute{Key: "chainID", Value: su.chainId}, utils.Attribute{Key: "fromBlock", Value: diff.FromBlock}, utils.Attribute{Key: "toBlock", Value: diff.ToBlock}, utils.Attribute{Key: "changes", Value: strings.Join(diff.Changes(), "; ")})
+			}
+		}
+		su.spec = spec
 		for _, specUpdatable := range su.specUpdatables {
 			(*specUpdatable).SetSpec(*spec)
 		}
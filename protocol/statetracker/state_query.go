@@ -195,6 +195,20 @@ func (psq *ProviderStateQuery) GetMaxCuForUser(ctx context.Context, consumerAddr
 	return userEntryRes.GetMaxCU(), err
 }
 
+// IsProviderFrozen checks the current stake entry of the provider, frozen providers are excluded from pairing starting the next epoch
+func (psq *ProviderStateQuery) IsProviderFrozen(ctx context.Context, providerAddress, chainID string) (bool, error) {
+	providersRes, err := psq.PairingQueryClient.Providers(ctx, &pairingtypes.QueryProvidersRequest{ChainID: chainID, ShowFrozen: true})
+	if err != nil {
+		return false, utils.LavaFormatError("Providers query failed", err, utils.Attribute{Key: "chainID", Value: chainID})
+	}
+	for _, stakeEntry := range providersRes.StakeEntry {
+		if stakeEntry.Address == providerAddress {
+			return stakeEntry.StakeAppliedBlock == pairingtypes.FROZEN_BLOCK, nil
+		}
+	}
+	return false, utils.LavaFormatError("provider is not staked on chain", nil, utils.Attribute{Key: "chainID", Value: chainID}, utils.Attribute{Key: "provider", Value: providerAddress})
+}
+
 func (psq *ProviderStateQuery) entryKey(consumerAddress, chainID string, epoch uint64, providerAddress string) string {
 	return consumerAddress + chainID + strconv.FormatUint(epoch, 10) + providerAddress
 }
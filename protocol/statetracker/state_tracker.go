@@ -90,6 +90,14 @@ func (st *StateTracker) RegisterForUpdates(ctx context.Context, updater Updater)
 	return existingUpdater
 }
 
+// getRegisteredUpdater returns the updater registered under the key, unlike RegisterForUpdates it never registers a new one
+func (st *StateTracker) getRegisteredUpdater(updaterKey string) (Updater, bool) {
+	st.registrationLock.RLock()
+	defer st.registrationLock.RUnlock()
+	updater, ok := st.newLavaBlockUpdaters[updaterKey]
+	return updater, ok
+}
+
 // For lavavisor access
 func (s *StateTracker) GetEventTracker() *EventTracker {
 	return s.EventTracker
@@ -14,6 +14,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	typestx "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 	"github.com/lavanet/lava/protocol/common"
 	"github.com/lavanet/lava/protocol/rpcprovider/reliabilitymanager"
 	"github.com/lavanet/lava/utils"
@@ -29,11 +30,14 @@ const (
 	// for example if you have a provider staked at 20 chains you will ask for 20 payments per epoch.
 	// therefore currently our best solution is to continue retrying increasing sequence number until successful
 	RETRY_INCORRECT_SEQUENCE = 100
+	AuthzGranteeFlagName     = "authz-grantee"
 )
 
 type TxSender struct {
 	txFactory tx.Factory
 	clientCtx client.Context
+	// when set, transactions are signed by the grantee and the messages are executed on behalf of clientCtx.FromAddress using authz
+	granteeCtx *client.Context
 }
 
 func NewTxSender(ctx context.Context, clientCtx client.Context, txFactory tx.Factory) (ret *TxSender, err error) {
@@ -43,6 +47,41 @@ func NewTxSender(ctx context.Context, clientCtx client.Context, txFactory tx.Fac
 	return ts, nil
 }
 
+// SetAuthzGrantee makes the sender sign and pay for transactions with the grantee key from the keyring,
+// the messages are wrapped in an authz MsgExec so they are still executed as the original --from address.
+// the grantee gas can be covered by a feegrant allowance using --fee-granter
+func (ts *TxSender) SetAuthzGrantee(granteeKeyName string) error {
+	granteeAddress, granteeName, _, err := client.GetFromFields(ts.clientCtx, ts.clientCtx.Keyring, granteeKeyName)
+	if err != nil {
+		return utils.LavaFormatError("failed getting authz grantee key", err, utils.Attribute{Key: "grantee", Value: granteeKeyName})
+	}
+	if granteeAddress.Equals(ts.clientCtx.GetFromAddress()) {
+		return utils.LavaFormatError("authz grantee can't be the same as the granter", nil, utils.Attribute{Key: "grantee", Value: granteeAddress})
+	}
+	granteeCtx := ts.clientCtx.WithFrom(granteeName).WithFromName(granteeName).WithFromAddress(granteeAddress)
+	ts.granteeCtx = &granteeCtx
+	utils.LavaFormatInfo("sending transactions with authz grantee", utils.Attribute{Key: "grantee", Value: granteeAddress}, utils.Attribute{Key: "granter", Value: ts.clientCtx.GetFromAddress()})
+	return nil
+}
+
+// signerClientCtx returns the client context of the account signing and paying for the transactions
+func (ts *TxSender) signerClientCtx() client.Context {
+	if ts.granteeCtx != nil {
+		return *ts.granteeCtx
+	}
+	return ts.clientCtx
+}
+
+func (ts *TxSender) wrapMsg(msg sdk.Msg) sdk.Msg {
+	if ts.granteeCtx == nil {
+		return msg
+	}
+	msgExec := authz.NewMsgExec(ts.granteeCtx.GetFromAddress(), []sdk.Msg{msg})
+	return &msgExec
+}
+
+// checkProfitability returns an error when the fee of a simulated relay payment transaction is not covered by the rewards
+// it claims (the BasePay of its relay payment events)
 func (ts *TxSender) checkProfitability(simResult *typestx.SimulateResponse, gasUsed uint64, txFactory tx.Factory) error {
 	txEvents := simResult.GetResult().Events
 	lavaReward := sdk.NewCoin("ulava", sdk.NewInt(0))
@@ -82,7 +121,8 @@ func (ts *TxSender) SimulateAndBroadCastTxWithRetryOnSeqMismatch(msg sdk.Msg, ch
 	if err := msg.ValidateBasic(); err != nil {
 		return err
 	}
-	clientCtx := ts.clientCtx
+	msg = ts.wrapMsg(msg)
+	clientCtx := ts.signerClientCtx()
 	txfactory, err := ts.prepareFactory(txfactory)
 	if err != nil {
 		return err
@@ -116,10 +156,17 @@ func (ts *TxSender) SimulateAndBroadCastTxWithRetryOnSeqMismatch(msg sdk.Msg, ch
 			sequenceNumberParsed = 0
 		}
 
-		_, gasUsed, err := tx.CalculateGas(clientCtx, txfactory, msg)
+		simResult, gasUsed, err := tx.CalculateGas(clientCtx, txfactory, msg)
 		if err != nil {
 			return err
 		}
+		// a fee granter pays for the transaction, so it doesn't cost the signer anything
+		if checkProfitability && clientCtx.FeeGranter.Empty() {
+			err = ts.checkProfitability(simResult, gasUsed, txfactory)
+			if err != nil {
+				return err
+			}
+		}
 		txfactory = txfactory.WithGas(gasUsed)
 
 		// incase we got an error the tx result is basically the error
@@ -154,7 +201,7 @@ func (ts *TxSender) SimulateAndBroadCastTxWithRetryOnSeqMismatch(msg sdk.Msg, ch
 
 func (ts *TxSender) SendTxAndVerifyCommit(txfactory tx.Factory, msg sdk.Msg) (parsedResult common.TxResultData, err error) {
 	myWriter := bytes.Buffer{}
-	clientCtx := ts.clientCtx
+	clientCtx := ts.signerClientCtx()
 	clientCtx.Output = &myWriter
 	clientCtx.OutputFormat = "json"
 	err = tx.GenerateOrBroadcastTxWithFactory(clientCtx, txfactory, msg)
@@ -223,7 +270,7 @@ func (ts *TxSender) waitForTxCommit(resultData common.TxResultData) (common.TxRe
 
 // this function is extracted from the tx package so that we can use it locally to set the tx factory correctly
 func (ts *TxSender) prepareFactory(txf tx.Factory) (tx.Factory, error) {
-	clientCtx := ts.clientCtx
+	clientCtx := ts.signerClientCtx()
 	from := clientCtx.GetFromAddress()
 
 	if err := clientCtx.AccountRetriever.EnsureExists(clientCtx, from); err != nil {
@@ -284,6 +331,34 @@ func NewProviderTxSender(ctx context.Context, clientCtx client.Context, txFactor
 	return ts, nil
 }
 
+// VerifyAuthzGrants warns about provider messages the authz grantee isn't allowed to execute for the provider
+func (pts *ProviderTxSender) VerifyAuthzGrants(ctx context.Context) error {
+	if pts.granteeCtx == nil {
+		return nil
+	}
+	authzQueryClient := authz.NewQueryClient(pts.clientCtx)
+	providerMsgs := []sdk.Msg{&pairingtypes.MsgRelayPayment{}, &pairingtypes.MsgFreezeProvider{}, &pairingtypes.MsgUnfreezeProvider{}, &conflicttypes.MsgConflictVoteCommit{}, &conflicttypes.MsgConflictVoteReveal{}}
+	missingGrants := []string{}
+	for _, providerMsg := range providerMsgs {
+		msgTypeURL := sdk.MsgTypeURL(providerMsg)
+		_, err := authzQueryClient.Grants(ctx, &authz.QueryGrantsRequest{
+			Granter:    pts.clientCtx.GetFromAddress().String(),
+			Grantee:    pts.granteeCtx.GetFromAddress().String(),
+			MsgTypeUrl: msgTypeURL,
+		})
+		if err != nil {
+			missingGrants = append(missingGrants, msgTypeURL)
+		}
+	}
+	if len(missingGrants) > 0 {
+		return utils.LavaFormatWarning("authz grantee is missing grants, these transactions will fail", nil,
+			utils.Attribute{Key: "missing", Value: strings.Join(missingGrants, ",")},
+			utils.Attribute{Key: "grantee", Value: pts.granteeCtx.GetFromAddress()},
+		)
+	}
+	return nil
+}
+
 func (pts *ProviderTxSender) TxRelayPayment(ctx context.Context, relayRequests []*pairingtypes.RelaySession, description string) error {
 	msg := pairingtypes.NewMsgRelayPayment(pts.clientCtx.FromAddress.String(), relayRequests, description)
 	err := pts.SimulateAndBroadCastTxWithRetryOnSeqMismatch(msg, true)
@@ -293,6 +368,24 @@ func (pts *ProviderTxSender) TxRelayPayment(ctx context.Context, relayRequests [
 	return nil
 }
 
+func (pts *ProviderTxSender) TxFreezeProvider(ctx context.Context, chainIDs []string, reason string) error {
+	msg := pairingtypes.NewMsgFreeze(pts.clientCtx.FromAddress.String(), chainIDs, reason)
+	err := pts.SimulateAndBroadCastTxWithRetryOnSeqMismatch(msg, false)
+	if err != nil {
+		return utils.LavaFormatError("freeze_provider - sending Tx Failed", err, utils.Attribute{Key: "chainIDs", Value: chainIDs})
+	}
+	return nil
+}
+
+func (pts *ProviderTxSender) TxUnfreezeProvider(ctx context.Context, chainIDs []string) error {
+	msg := pairingtypes.NewMsgUnfreeze(pts.clientCtx.FromAddress.String(), chainIDs)
+	err := pts.SimulateAndBroadCastTxWithRetryOnSeqMismatch(msg, false)
+	if err != nil {
+		return utils.LavaFormatError("unfreeze_provider - sending Tx Failed", err, utils.Attribute{Key: "chainIDs", Value: chainIDs})
+	}
+	return nil
+}
+
 func (pts *ProviderTxSender) SendVoteReveal(voteID string, vote *reliabilitymanager.VoteData) error {
 	msg := conflicttypes.NewMsgConflictVoteReveal(pts.clientCtx.FromAddress.String(), voteID, vote.Nonce, vote.RelayDataHash)
 	err := pts.SimulateAndBroadCastTxWithRetryOnSeqMismatch(msg, false)
@@ -0,0 +1,105 @@
+package statetracker
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	typestx "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/lavanet/lava/app"
+	"github.com/lavanet/lava/utils"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestKey(t *testing.T, kr keyring.Keyring, name string) *keyring.Record {
+	record, _, err := kr.NewMnemonic(name, keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+	return record
+}
+
+// TestTxSenderAuthzGrantee checks that with an authz grantee the provider transactions are signed by the
+// grantee key, and that the messages are wrapped in a MsgExec executed on behalf of the --from address
+func TestTxSenderAuthzGrantee(t *testing.T) {
+	encodingConfig := app.MakeEncodingConfig()
+	kr := keyring.NewInMemory(encodingConfig.Marshaler)
+	providerRecord := newTestKey(t, kr, "provider")
+	granteeRecord := newTestKey(t, kr, "grantee")
+	providerAddr, err := providerRecord.GetAddress()
+	require.NoError(t, err)
+	granteeAddr, err := granteeRecord.GetAddress()
+	require.NoError(t, err)
+
+	clientCtx := client.Context{}.
+		WithKeyring(kr).
+		WithTxConfig(encodingConfig.TxConfig).
+		WithChainID("lava").
+		WithFrom("provider").
+		WithFromName("provider").
+		WithFromAddress(providerAddr)
+	txFactory := tx.Factory{}.
+		WithKeybase(kr).
+		WithTxConfig(encodingConfig.TxConfig).
+		WithChainID("lava").
+		WithSignMode(signing.SignMode_SIGN_MODE_DIRECT)
+
+	txSender, err := NewTxSender(context.Background(), clientCtx, txFactory)
+	require.NoError(t, err)
+
+	// the grantee must be a different key than --from
+	require.Error(t, txSender.SetAuthzGrantee("provider"))
+	require.NoError(t, txSender.SetAuthzGrantee("grantee"))
+
+	relayPayment := pairingtypes.NewMsgRelayPayment(providerAddr.String(), []*pairingtypes.RelaySession{}, "")
+	msg := txSender.wrapMsg(relayPayment)
+	msgExec, ok := msg.(*authz.MsgExec)
+	require.True(t, ok)
+	require.Equal(t, granteeAddr.String(), msgExec.Grantee)
+	require.Equal(t, []sdk.AccAddress{granteeAddr}, msgExec.GetSigners())
+	innerMsgs, err := msgExec.GetMessages()
+	require.NoError(t, err)
+	require.Len(t, innerMsgs, 1)
+	require.Equal(t, []sdk.AccAddress{providerAddr}, innerMsgs[0].GetSigners())
+
+	// sign the same way the transaction is broadcast: with the key of the signer client context
+	signerCtx := txSender.signerClientCtx()
+	require.Equal(t, granteeAddr, signerCtx.GetFromAddress())
+	txBuilder, err := txFactory.BuildUnsignedTx(msg)
+	require.NoError(t, err)
+	require.NoError(t, tx.Sign(txFactory, signerCtx.GetFromName(), txBuilder, true))
+
+	sigs, err := txBuilder.GetTx().GetSignaturesV2()
+	require.NoError(t, err)
+	require.Len(t, sigs, 1)
+	granteePubKey, err := granteeRecord.GetPubKey()
+	require.NoError(t, err)
+	require.True(t, granteePubKey.Equals(sigs[0].PubKey))
+}
+
+// TestCheckProfitability checks that a relay payment is profitable only when its rewards cover the transaction fee
+func TestCheckProfitability(t *testing.T) {
+	ts := &TxSender{}
+	txFactory := tx.Factory{}.WithGasPrices(defaultGasPrice)
+	simResult := func(basePays ...string) *typestx.SimulateResponse {
+		events := []abci.Event{}
+		for idx, basePay := range basePays {
+			event := sdk.NewEvent(utils.EventPrefix+pairingtypes.RelayPaymentEventName, sdk.NewAttribute("BasePay."+strconv.Itoa(idx), basePay))
+			events = append(events, abci.Event(event))
+		}
+		return &typestx.SimulateResponse{Result: &sdk.Result{Events: events}}
+	}
+
+	require.NoError(t, ts.checkProfitability(simResult("1000ulava"), 100000, txFactory))
+	// the rewards of all the relays cover the fee
+	require.NoError(t, ts.checkProfitability(simResult("1000ulava", "1000ulava"), 1500000000000, txFactory))
+	require.Error(t, ts.checkProfitability(simResult("1000ulava"), 1500000000000, txFactory))
+	require.Error(t, ts.checkProfitability(simResult(), 100000, txFactory))
+}
@@ -32,6 +32,12 @@ func (vu *VoteUpdater) RegisterVoteUpdatable(ctx context.Context, voteUpdatable
 	vu.voteUpdatables[endpoint.Key()] = voteUpdatable
 }
 
+func (vu *VoteUpdater) UnregisterVoteUpdatable(endpoint lavasession.RPCEndpoint) {
+	vu.lock.Lock()
+	defer vu.lock.Unlock()
+	delete(vu.voteUpdatables, endpoint.Key())
+}
+
 func (vu *VoteUpdater) UpdaterKey() string {
 	return CallbackKeyForVoteUpdate
 }
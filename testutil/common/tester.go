@@ -9,6 +9,7 @@ import (
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/lavanet/lava/common/types"
 	testkeeper "github.com/lavanet/lava/testutil/keeper"
 	"github.com/lavanet/lava/utils/slices"
@@ -356,6 +357,17 @@ func (ts *Tester) TxDualstakingClaimRewards(
 	return ts.Servers.DualstakingServer.ClaimRewards(ts.GoCtx, msg)
 }
 
+// TxDualstakingSetAutoCompound: implement 'tx dualstaking set-auto-compound'
+func (ts *Tester) TxDualstakingSetAutoCompound(
+	creator string,
+	provider string,
+	chainID string,
+	enable bool,
+) (*dualstakingtypes.MsgSetAutoCompoundResponse, error) {
+	msg := dualstakingtypes.NewMsgSetAutoCompound(creator, provider, chainID, enable)
+	return ts.Servers.DualstakingServer.SetAutoCompound(ts.GoCtx, msg)
+}
+
 // TxSubscriptionBuy: implement 'tx subscription buy'
 func (ts *Tester) TxSubscriptionBuy(creator, consumer, plan string, months int) (*subscriptiontypes.MsgBuyResponse, error) {
 	msg := &subscriptiontypes.MsgBuy{
@@ -437,6 +449,21 @@ func (ts *Tester) TxPairingStakeProvider(
 	endpoints []epochstoragetypes.Endpoint,
 	geoloc int32,
 	moniker string,
+) (*pairingtypes.MsgStakeProviderResponse, error) {
+	return ts.TxPairingStakeProviderFull(addr, chainID, amount, endpoints, geoloc, moniker,
+		100, sdk.NewCoin(epochstoragetypes.TokenDenom, sdk.ZeroInt()))
+}
+
+// TxPairingStakeProviderFull: implement 'tx pairing stake-provider' with delegation terms
+func (ts *Tester) TxPairingStakeProviderFull(
+	addr string,
+	chainID string,
+	amount sdk.Coin,
+	endpoints []epochstoragetypes.Endpoint,
+	geoloc int32,
+	moniker string,
+	commission uint64,
+	delegateLimit sdk.Coin,
 ) (*pairingtypes.MsgStakeProviderResponse, error) {
 	msg := &pairingtypes.MsgStakeProvider{
 		Creator:            addr,
@@ -445,8 +472,8 @@ func (ts *Tester) TxPairingStakeProvider(
 		Geolocation:        geoloc,
 		Endpoints:          endpoints,
 		Moniker:            moniker,
-		DelegateLimit:      sdk.NewCoin(epochstoragetypes.TokenDenom, sdk.ZeroInt()),
-		DelegateCommission: 100,
+		DelegateLimit:      delegateLimit,
+		DelegateCommission: commission,
 	}
 	return ts.Servers.PairingServer.StakeProvider(ts.GoCtx, msg)
 }
@@ -490,6 +517,15 @@ func (ts *Tester) TxPairingUnfreezeProvider(addr, chainID string) (*pairingtypes
 	return ts.Servers.PairingServer.UnfreezeProvider(ts.GoCtx, msg)
 }
 
+// TxPairingUnjail: implement 'tx pairing unjail'
+func (ts *Tester) TxPairingUnjail(addr, chainID string) (*pairingtypes.MsgUnjailResponse, error) {
+	msg := &pairingtypes.MsgUnjail{
+		Creator:  addr,
+		ChainIds: slices.Slice(chainID),
+	}
+	return ts.Servers.PairingServer.Unjail(ts.GoCtx, msg)
+}
+
 // QuerySubscriptionCurrent: implement 'q subscription current'
 func (ts *Tester) QuerySubscriptionCurrent(subkey string) (*subscriptiontypes.QueryCurrentResponse, error) {
 	msg := &subscriptiontypes.QueryCurrentRequest{
@@ -527,6 +563,36 @@ func (ts *Tester) QueryPairingGetPairing(chainID, client string) (*pairingtypes.
 	return ts.Keepers.Pairing.GetPairing(ts.GoCtx, msg)
 }
 
+// QueryPairingSimulatePairing implements 'q pairing simulate-pairing'
+func (ts *Tester) QueryPairingSimulatePairing(chainID, client string, epoch uint64, epochHash []byte) (*pairingtypes.QuerySimulatePairingResponse, error) {
+	msg := &pairingtypes.QuerySimulatePairingRequest{
+		ChainID:   chainID,
+		Client:    client,
+		Epoch:     epoch,
+		EpochHash: epochHash,
+	}
+	return ts.Keepers.Pairing.SimulatePairing(ts.GoCtx, msg)
+}
+
+// QueryPairingProviderEarnings implements 'q pairing provider-earnings'
+func (ts *Tester) QueryPairingProviderEarnings(provider, chainID string, pagination *query.PageRequest) (*pairingtypes.QueryProviderEarningsResponse, error) {
+	msg := &pairingtypes.QueryProviderEarningsRequest{
+		Provider:   provider,
+		ChainID:    chainID,
+		Pagination: pagination,
+	}
+	return ts.Keepers.Pairing.ProviderEarnings(ts.GoCtx, msg)
+}
+
+// QueryPairingUpcomingDelegationChanges implements 'q pairing upcoming-delegation-changes'
+func (ts *Tester) QueryPairingUpcomingDelegationChanges(chainID, provider string) (*pairingtypes.QueryUpcomingDelegationChangesResponse, error) {
+	msg := &pairingtypes.QueryUpcomingDelegationChangesRequest{
+		ChainID:  chainID,
+		Provider: provider,
+	}
+	return ts.Keepers.Pairing.UpcomingDelegationChanges(ts.GoCtx, msg)
+}
+
 // QueryPairingListEpochPayments implements 'q pairing list-epoch-payments'
 func (ts *Tester) QueryPairingListEpochPayments() (*pairingtypes.QueryAllEpochPaymentsResponse, error) {
 	msg := &pairingtypes.QueryAllEpochPaymentsRequest{}
@@ -27,6 +27,8 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	cmd.AddCommand(CmdQueryParams())
 	cmd.AddCommand(CmdListConflictVote())
 	cmd.AddCommand(CmdShowConflictVote())
+	cmd.AddCommand(CmdConsumerReports())
+	cmd.AddCommand(CmdConflictRecords())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
@@ -0,0 +1,57 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/lavanet/lava/x/conflict/types"
+	"github.com/spf13/cobra"
+)
+
+const ProviderFlagName = "provider"
+
+func CmdConflictRecords() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "conflict-records [chain-id]",
+		Short: "Query the records of closed conflict votes, optionally only on a specific chain and/or of a specific (reported) provider",
+		Example: `lavad q conflict conflict-records ETH1
+lavad q conflict conflict-records --provider <provider-address>
+lavad q conflict conflict-records ETH1 --provider <provider-address>`,
+		Args: cobra.RangeArgs(0, 1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			provider, err := cmd.Flags().GetString(ProviderFlagName)
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryConflictRecordsRequest{
+				Provider:   provider,
+				Pagination: pageReq,
+			}
+			if len(args) > 0 {
+				params.ChainID = args[0]
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ConflictRecords(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(ProviderFlagName, "", "show only the records of conflicts that the provider was reported in")
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
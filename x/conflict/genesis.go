@@ -13,6 +13,18 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	for _, elem := range genState.ConflictVoteList {
 		k.SetConflictVote(ctx, elem)
 	}
+	// Set all the conflictReport
+	for _, elem := range genState.ConflictReportList {
+		k.SetConflictReport(ctx, elem)
+	}
+	// Set all the consumerCooldown
+	for _, elem := range genState.ConsumerCooldownList {
+		k.SetConsumerCooldown(ctx, elem)
+	}
+	// Set all the conflictRecord
+	for _, elem := range genState.ConflictRecordList {
+		k.SetConflictRecord(ctx, elem)
+	}
 	// this line is used by starport scaffolding # genesis/module/init
 	k.SetParams(ctx, genState.Params)
 
@@ -25,6 +37,9 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis.Params = k.GetParams(ctx)
 
 	genesis.ConflictVoteList = k.GetAllConflictVote(ctx)
+	genesis.ConflictReportList = k.GetAllConflictReport(ctx)
+	genesis.ConsumerCooldownList = k.GetAllConsumerCooldown(ctx)
+	genesis.ConflictRecordList = k.GetAllConflictRecord(ctx)
 	// this line is used by starport scaffolding # genesis/module/export
 
 	return genesis
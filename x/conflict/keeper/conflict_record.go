@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/utils/sigs"
+	"github.com/lavanet/lava/x/conflict/types"
+)
+
+// SetConflictRecord set a specific conflictRecord in the store from its index
+// (and indexes it by its reported providers)
+func (k Keeper) SetConflictRecord(ctx sdk.Context, conflictRecord types.ConflictRecord) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ConflictRecordKeyPrefix))
+	key := types.ConflictRecordKey(conflictRecord.ChainID, conflictRecord.CloseBlock, conflictRecord.VoteID)
+	b := k.cdc.MustMarshal(&conflictRecord)
+	store.Set(key, b)
+
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ConflictRecordProviderIndexPrefix))
+	for _, provider := range []string{conflictRecord.FirstProvider.Account, conflictRecord.SecondProvider.Account} {
+		indexStore.Set(types.ConflictRecordProviderKey(provider, conflictRecord.ChainID, conflictRecord.CloseBlock, conflictRecord.VoteID), key)
+	}
+}
+
+// GetConflictRecordByKey returns a conflictRecord from its store key
+func (k Keeper) GetConflictRecordByKey(ctx sdk.Context, key []byte) (val types.ConflictRecord, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ConflictRecordKeyPrefix))
+
+	b := store.Get(key)
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllConflictRecord returns all conflictRecord
+func (k Keeper) GetAllConflictRecord(ctx sdk.Context) (list []types.ConflictRecord) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ConflictRecordKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.ConflictRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// archiveConflictVote keeps the record of a conflict vote that was closed
+func (k Keeper) archiveConflictVote(ctx sdk.Context, conflictVote types.ConflictVote, status string, result int64, slashed, rewarded []types.ConflictAmount) {
+	k.SetConflictRecord(ctx, types.ConflictRecord{
+		VoteID:          conflictVote.Index,
+		ChainID:         conflictVote.ChainID,
+		Consumer:        conflictVote.ClientAddress,
+		FirstProvider:   conflictVote.FirstProvider,
+		SecondProvider:  conflictVote.SecondProvider,
+		ApiUrl:          conflictVote.ApiUrl,
+		RequestDataHash: sigs.HashMsg(conflictVote.RequestData),
+		RequestBlock:    conflictVote.RequestBlock,
+		VoteStartBlock:  conflictVote.VoteStartBlock,
+		CloseBlock:      uint64(ctx.BlockHeight()),
+		Status:          status,
+		Result:          result,
+		Votes:           conflictVote.Votes,
+		Slashed:         slashed,
+		Rewarded:        rewarded,
+	})
+}
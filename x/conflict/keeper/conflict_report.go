@@ -0,0 +1,143 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/utils"
+	"github.com/lavanet/lava/x/conflict/types"
+	epochstoragetypes "github.com/lavanet/lava/x/epochstorage/types"
+)
+
+// SetConflictReport set a specific conflictReport in the store from its index
+func (k Keeper) SetConflictReport(ctx sdk.Context, conflictReport types.ConflictReport) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ConflictReportKeyPrefix))
+	b := k.cdc.MustMarshal(&conflictReport)
+	store.Set(types.ConflictReportKey(conflictReport.Consumer, conflictReport.VoteID), b)
+}
+
+// GetConflictReport returns a conflictReport from its index
+func (k Keeper) GetConflictReport(ctx sdk.Context, consumer, voteID string) (val types.ConflictReport, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ConflictReportKeyPrefix))
+
+	b := store.Get(types.ConflictReportKey(consumer, voteID))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllConflictReport returns all conflictReport
+func (k Keeper) GetAllConflictReport(ctx sdk.Context) (list []types.ConflictReport) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ConflictReportKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.ConflictReport
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// SetConsumerCooldown set a specific consumerCooldown in the store from its index
+func (k Keeper) SetConsumerCooldown(ctx sdk.Context, consumerCooldown types.ConsumerCooldown) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ConsumerCooldownKeyPrefix))
+	b := k.cdc.MustMarshal(&consumerCooldown)
+	store.Set(types.ConsumerCooldownKey(consumerCooldown.Consumer), b)
+}
+
+// GetConsumerCooldown returns a consumerCooldown from its index
+func (k Keeper) GetConsumerCooldown(ctx sdk.Context, consumer string) (val types.ConsumerCooldown, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ConsumerCooldownKeyPrefix))
+
+	b := store.Get(types.ConsumerCooldownKey(consumer))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllConsumerCooldown returns all consumerCooldown
+func (k Keeper) GetAllConsumerCooldown(ctx sdk.Context) (list []types.ConsumerCooldown) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ConsumerCooldownKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.ConsumerCooldown
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// IsConsumerInCooldown checks whether a consumer is not allowed to report conflicts in the current block
+func (k Keeper) IsConsumerInCooldown(ctx sdk.Context, consumer string) (bool, uint64) {
+	cooldown, found := k.GetConsumerCooldown(ctx, consumer)
+	if !found {
+		return false, 0
+	}
+	return cooldown.UntilBlock > uint64(ctx.BlockHeight()), cooldown.UntilBlock
+}
+
+// openConflictReport creates the report of a consumer for a conflict vote that was started
+func (k Keeper) openConflictReport(ctx sdk.Context, conflictVote types.ConflictVote) {
+	k.SetConflictReport(ctx, types.ConflictReport{
+		Consumer:       conflictVote.ClientAddress,
+		VoteID:         conflictVote.Index,
+		ChainID:        conflictVote.ChainID,
+		FirstProvider:  conflictVote.FirstProvider.Account,
+		SecondProvider: conflictVote.SecondProvider.Account,
+		ReportBlock:    uint64(ctx.BlockHeight()),
+		Status:         types.ReportStatusOpen,
+		Result:         types.NoVote,
+		Reward:         sdk.NewCoin(epochstoragetypes.TokenDenom, sdk.ZeroInt()),
+	})
+}
+
+// closeConflictReport sets the outcome of the consumer's report of a conflict vote that was closed.
+// A consumer whose report was rejected can't report conflicts for DETECTION_COOLDOWN_EPOCHS epochs
+// (to prevent spamming the providers with votes).
+func (k Keeper) closeConflictReport(ctx sdk.Context, conflictVote types.ConflictVote, status string, result int64, severity uint64, reward sdk.Coin, cooldown bool) {
+	report, found := k.GetConflictReport(ctx, conflictVote.ClientAddress, conflictVote.Index)
+	if !found {
+		// votes that were opened before the reports were kept
+		report = types.ConflictReport{
+			Consumer:       conflictVote.ClientAddress,
+			VoteID:         conflictVote.Index,
+			ChainID:        conflictVote.ChainID,
+			FirstProvider:  conflictVote.FirstProvider.Account,
+			SecondProvider: conflictVote.SecondProvider.Account,
+		}
+	}
+
+	report.Status = status
+	report.CloseBlock = uint64(ctx.BlockHeight())
+	report.Result = result
+	report.Severity = severity
+	report.Reward = reward
+	k.SetConflictReport(ctx, report)
+
+	if cooldown {
+		epochBlocks, err := k.epochstorageKeeper.EpochBlocks(ctx, uint64(ctx.BlockHeight()))
+		if err != nil {
+			utils.LavaFormatError("failed to get epoch blocks for consumer cooldown", err,
+				utils.Attribute{Key: "consumer", Value: conflictVote.ClientAddress},
+			)
+			return
+		}
+		k.SetConsumerCooldown(ctx, types.ConsumerCooldown{
+			Consumer:   conflictVote.ClientAddress,
+			UntilBlock: uint64(ctx.BlockHeight()) + types.DETECTION_COOLDOWN_EPOCHS*epochBlocks,
+		})
+	}
+}
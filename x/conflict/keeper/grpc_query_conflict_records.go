@@ -0,0 +1,64 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/lavanet/lava/x/conflict/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) ConflictRecords(c context.Context, req *types.QueryConflictRecordsRequest) (*types.QueryConflictRecordsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var records []types.ConflictRecord
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(k.storeKey)
+
+	var pageRes *query.PageResponse
+	var err error
+	if req.Provider != "" {
+		if _, err := sdk.AccAddressFromBech32(req.Provider); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid provider address")
+		}
+
+		// iterate the provider index (its values are the records' keys)
+		indexStore := prefix.NewStore(store, types.KeyPrefix(types.ConflictRecordProviderIndexPrefix))
+		indexStore = prefix.NewStore(indexStore, types.ConflictRecordProviderPrefix(req.Provider, req.ChainID))
+
+		pageRes, err = query.Paginate(indexStore, req.Pagination, func(key, value []byte) error {
+			record, found := k.GetConflictRecordByKey(ctx, value)
+			if !found {
+				return status.Error(codes.Internal, "conflict record of provider index not found")
+			}
+
+			records = append(records, record)
+			return nil
+		})
+	} else {
+		recordStore := prefix.NewStore(store, types.KeyPrefix(types.ConflictRecordKeyPrefix))
+		if req.ChainID != "" {
+			recordStore = prefix.NewStore(recordStore, types.ConflictRecordPrefix(req.ChainID))
+		}
+
+		pageRes, err = query.Paginate(recordStore, req.Pagination, func(key, value []byte) error {
+			var record types.ConflictRecord
+			if err := k.cdc.Unmarshal(value, &record); err != nil {
+				return err
+			}
+
+			records = append(records, record)
+			return nil
+		})
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryConflictRecordsResponse{Records: records, Pagination: pageRes}, nil
+}
@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/lavanet/lava/x/conflict/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) ConsumerReports(c context.Context, req *types.QueryConsumerReportsRequest) (*types.QueryConsumerReportsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(req.Consumer); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid consumer address")
+	}
+
+	var reports []types.ConflictReport
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	reportStore := prefix.NewStore(store, types.KeyPrefix(types.ConflictReportKeyPrefix))
+	reportStore = prefix.NewStore(reportStore, types.ConflictReportPrefix(req.Consumer))
+
+	pageRes, err := query.Paginate(reportStore, req.Pagination, func(key, value []byte) error {
+		var report types.ConflictReport
+		if err := k.cdc.Unmarshal(value, &report); err != nil {
+			return err
+		}
+
+		reports = append(reports, report)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	var cooldownUntil uint64
+	if inCooldown, untilBlock := k.IsConsumerInCooldown(ctx, req.Consumer); inCooldown {
+		cooldownUntil = untilBlock
+	}
+
+	return &types.QueryConsumerReportsResponse{Reports: reports, CooldownUntilBlock: cooldownUntil, Pagination: pageRes}, nil
+}
@@ -40,6 +40,14 @@ func (k msgServer) Detection(goCtx context.Context, msg *types.MsgDetection) (*t
 			)
 		}
 	} else if msg.FinalizationConflict == nil && msg.ResponseConflict != nil && msg.SameProviderConflict == nil {
+		// a consumer whose previous report was rejected can't start a new vote for a while (see closeConflictReport)
+		if inCooldown, untilBlock := k.Keeper.IsConsumerInCooldown(ctx, msg.Creator); inCooldown {
+			return nil, utils.LavaFormatWarning("Simulation: consumer can't report conflicts during its cooldown", types.ErrConsumerInCooldown,
+				utils.Attribute{Key: "client", Value: msg.Creator},
+				utils.Attribute{Key: "cooldownUntilBlock", Value: untilBlock},
+			)
+		}
+
 		err := k.Keeper.ValidateResponseConflict(ctx, msg.ResponseConflict, clientAddr)
 		if err != nil {
 			return nil, utils.LavaFormatWarning("Simulation: invalid response conflict detection", err,
@@ -117,6 +125,7 @@ func (k msgServer) Detection(goCtx context.Context, msg *types.MsgDetection) (*t
 		}
 
 		k.SetConflictVote(ctx, conflictVote)
+		k.Keeper.openConflictReport(ctx, conflictVote)
 
 		eventData := map[string]string{"client": msg.Creator}
 		eventData["voteID"] = conflictVote.Index
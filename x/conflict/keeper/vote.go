@@ -50,8 +50,9 @@ func (k Keeper) HandleAndCloseVote(ctx sdk.Context, conflictVote types.ConflictV
 	// valid only if one of the votes is bigger than 50% from total
 	// punish providers that didnt vote - discipline/jail + bail = 20%stake + slash 5%stake
 	// (dont add jailed providers to voters)
-	// if strong majority punish wrong providers - jail from start of memory to end + slash 100%stake
-	// reward pool is the slashed amount from all punished providers
+	// if strong majority punish wrong providers - slash 100%stake (delegations are slashed up to the pairing's DelegationsSlashCap) + unstake
+	// if strong majority punish the faulty reported providers (the severity of the report) - slash 5%stake
+	// reward pool is the slashed amount from all punished providers, held by the conflict module. what isn't paid is burned
 	// reward to stake - client 50%, the original provider 10%, 20% the voters
 	totalVotes := sdk.ZeroInt()
 	firstProviderVotes := sdk.ZeroInt()
@@ -68,10 +69,24 @@ func (k Keeper) HandleAndCloseVote(ctx sdk.Context, conflictVote types.ConflictV
 	var winnersAddr string
 	var winnerVotersStake math.Int
 
+	// the vote's outcome for the consumer's report and the vote's record (see closeConflictReport, archiveConflictVote)
+	reportStatus := types.ReportStatusUnresolved
+	reportResult := int64(types.NoVote)
+	var severity uint64 // number of the reported providers that were found faulty
+	clientRewardPaid := sdk.NewCoin(epochstoragetypes.TokenDenom, sdk.ZeroInt())
+	slashedAmounts := []types.ConflictAmount{}
+	rewardedAmounts := []types.ConflictAmount{}
+	closeVote := func(cooldown bool) {
+		k.closeConflictReport(ctx, conflictVote, reportStatus, reportResult, severity, clientRewardPaid, cooldown)
+		k.archiveConflictVote(ctx, conflictVote, reportStatus, reportResult, slashedAmounts, rewardedAmounts)
+		k.burnRewardPoolRemainder(ctx, rewardPool, rewardedAmounts)
+	}
+
 	// count votes and punish jury that didnt vote
 	epochVoteStart, _, err := k.epochstorageKeeper.GetEpochStartForBlock(ctx, conflictVote.VoteStartBlock) // TODO check if we need to check for overlap
 	if err != nil {
 		k.CleanUpVote(ctx, conflictVote.Index)
+		closeVote(false)
 		utils.LavaFormatWarning("failed to get epoch start", err,
 			utils.Attribute{Key: "voteID", Value: conflictVote.Index},
 			utils.Attribute{Key: "voteStartBlock", Value: conflictVote.VoteStartBlock},
@@ -82,6 +97,7 @@ func (k Keeper) HandleAndCloseVote(ctx sdk.Context, conflictVote types.ConflictV
 	blocksToSave, err := k.epochstorageKeeper.BlocksToSave(ctx, conflictVote.VoteStartBlock)
 	if err != nil {
 		k.CleanUpVote(ctx, conflictVote.Index)
+		closeVote(false)
 		utils.LavaFormatWarning("failed to get blocks to save", err,
 			utils.Attribute{Key: "voteID", Value: conflictVote.Index},
 			utils.Attribute{Key: "voteStartBlock", Value: conflictVote.VoteStartBlock},
@@ -124,8 +140,9 @@ func (k Keeper) HandleAndCloseVote(ctx sdk.Context, conflictVote types.ConflictV
 			bail := stake
 			bail.Quo(sdk.NewIntFromUint64(BailStakeDiv))
 			k.pairingKeeper.JailEntry(ctx, accAddress, conflictVote.ChainID, conflictVote.VoteStartBlock, blocksToSave, sdk.NewCoin(epochstoragetypes.TokenDenom, bail))
-			slashed, err := k.pairingKeeper.SlashEntry(ctx, accAddress, conflictVote.ChainID, SlashStakePercent)
+			slashed, err := k.pairingKeeper.SlashEntry(ctx, accAddress, conflictVote.ChainID, SlashStakePercent, types.ModuleName)
 			rewardPool = rewardPool.Add(slashed)
+			slashedAmounts = append(slashedAmounts, types.ConflictAmount{Address: vote.Address, Amount: slashed})
 			if err != nil {
 				utils.LavaFormatWarning("slashing failed at vote conflict", err)
 				continue
@@ -162,9 +179,37 @@ func (k Keeper) HandleAndCloseVote(ctx sdk.Context, conflictVote types.ConflictV
 		eventData = append(eventData, utils.Attribute{Key: "winner", Value: winnersAddr})
 		eventData = append(eventData, utils.Attribute{Key: "winnerVotes%", Value: sdk.NewDecFromInt(winnerVotersStake).QuoInt(totalVotes)})
 
-		// punish the frauds(the provider that was found lying and all the voters that voted for him) and fill the reward pool
+		// the reported providers whose responses differ from the majority's were faulty (both of them
+		// when the majority reproduced none of the reported responses)
+		var faultyProviders []string
+		switch winner {
+		case types.Provider0:
+			faultyProviders = []string{conflictVote.SecondProvider.Account}
+		case types.Provider1:
+			faultyProviders = []string{conflictVote.FirstProvider.Account}
+		default:
+			faultyProviders = []string{conflictVote.FirstProvider.Account, conflictVote.SecondProvider.Account}
+		}
+
+		// punish the frauds(the providers that were found lying and all the voters that voted for them) and fill the reward pool
 		// we need to finish the punishment before rewarding to fill up the reward pool
 		if ConsensusVote && sdk.NewDecFromInt(winnerVotersStake).QuoInt(totalVotes).GTE(k.MajorityPercent(ctx)) {
+			for _, faultyProvider := range faultyProviders {
+				accAddress, err := sdk.AccAddressFromBech32(faultyProvider)
+				if err != nil {
+					utils.LavaFormatWarning("invalid faulty provider address", err,
+						utils.Attribute{Key: "provider", Value: faultyProvider},
+					)
+					continue
+				}
+				slashed, err := k.pairingKeeper.SlashEntry(ctx, accAddress, conflictVote.ChainID, SlashStakePercent, types.ModuleName)
+				rewardPool = rewardPool.Add(slashed)
+				slashedAmounts = append(slashedAmounts, types.ConflictAmount{Address: faultyProvider, Amount: slashed})
+				if err != nil {
+					utils.LavaFormatWarning("slashing faulty provider failed at vote conflict", err)
+				}
+			}
+
 			for _, vote := range conflictVote.Votes {
 				if vote.Result != winner && !slices.Contains(providersWithoutVote, vote.Address) { // punish those who voted wrong, voters that didnt vote already got punished
 					accAddress, err := sdk.AccAddressFromBech32(vote.Address)
@@ -174,8 +219,9 @@ func (k Keeper) HandleAndCloseVote(ctx sdk.Context, conflictVote types.ConflictV
 						)
 						continue
 					}
-					slashed, err := k.pairingKeeper.SlashEntry(ctx, accAddress, conflictVote.ChainID, sdk.NewDecWithPrec(1, 0))
+					slashed, err := k.pairingKeeper.SlashEntry(ctx, accAddress, conflictVote.ChainID, sdk.OneDec(), types.ModuleName)
 					rewardPool = rewardPool.Add(slashed)
+					slashedAmounts = append(slashedAmounts, types.ConflictAmount{Address: vote.Address, Amount: slashed})
 					if err != nil {
 						utils.LavaFormatWarning("slashing failed at vote conflict", err)
 					}
@@ -188,22 +234,47 @@ func (k Keeper) HandleAndCloseVote(ctx sdk.Context, conflictVote types.ConflictV
 				}
 			}
 		}
+
+		reportStatus = types.ReportStatusResolved
+		severity = uint64(len(faultyProviders))
+		reportResult = winner
 	} else {
 		eventName = types.ConflictVoteUnresolvedEventName
-		eventData = append(eventData, utils.Attribute{Key: "voteFailed", Value: "not_enough_voters"})
+		if len(providersWithoutVote) < len(conflictVote.Votes) {
+			// the jury voted but couldn't reproduce any response by a majority: the reported
+			// responses don't hold up, so the report is rejected
+			reportStatus = types.ReportStatusRejected
+			eventData = append(eventData, utils.Attribute{Key: "voteFailed", Value: "no_majority"})
+		} else {
+			eventData = append(eventData, utils.Attribute{Key: "voteFailed", Value: "not_enough_voters"})
+		}
 	}
 
-	// reward client
+	// reward client (scaled by the severity of the conflict)
 	clientRewardPoolPercentage := k.Rewards(ctx).ClientRewardPercent
-	clientReward := clientRewardPoolPercentage.MulInt(rewardPool.Amount)
+	clientReward := clientRewardPoolPercentage.MulInt(rewardPool.Amount).MulInt64(int64(severity))
 	rewardCount = rewardCount.Add(clientReward.TruncateInt())
 	if rewardCount.GT(rewardPool.Amount) {
 		utils.LavaFormatError("Reward overflow from the reward pool", err, eventData...)
 		k.RemoveConflictVote(ctx, conflictVote.Index)
+		closeVote(false)
 		return
 	}
 
-	// TODO: add consumer rewards
+	if clientReward.TruncateInt().IsPositive() {
+		clientRewardCoin := sdk.NewCoin(epochstoragetypes.TokenDenom, clientReward.TruncateInt())
+		err = k.rewardConsumer(ctx, conflictVote.ClientAddress, clientRewardCoin)
+		if err != nil {
+			utils.LavaFormatWarning("failed to reward client", err,
+				utils.Attribute{Key: "client", Value: conflictVote.ClientAddress},
+			)
+		} else {
+			clientRewardPaid = clientRewardCoin
+			rewardedAmounts = append(rewardedAmounts, types.ConflictAmount{Address: conflictVote.ClientAddress, Amount: clientRewardCoin})
+		}
+	}
+	eventData = append(eventData, utils.Attribute{Key: "severity", Value: severity})
+	eventData = append(eventData, utils.Attribute{Key: "clientReward", Value: clientRewardPaid})
 
 	if majorityMet {
 		// reward winner provider
@@ -214,6 +285,7 @@ func (k Keeper) HandleAndCloseVote(ctx sdk.Context, conflictVote types.ConflictV
 			if rewardCount.GT(rewardPool.Amount) {
 				utils.LavaFormatError("Reward overflow from the reward pool", err, eventData...)
 				k.RemoveConflictVote(ctx, conflictVote.Index)
+				closeVote(false)
 				return
 			}
 			accWinnerAddress, err := sdk.AccAddressFromBech32(winnersAddr)
@@ -222,9 +294,12 @@ func (k Keeper) HandleAndCloseVote(ctx sdk.Context, conflictVote types.ConflictV
 					utils.Attribute{Key: "voteAddress", Value: winnersAddr},
 				)
 			} else {
-				ok, err := k.pairingKeeper.CreditStakeEntry(ctx, conflictVote.ChainID, accWinnerAddress, sdk.NewCoin(epochstoragetypes.TokenDenom, winnerReward.TruncateInt()))
+				winnerRewardCoin := sdk.NewCoin(epochstoragetypes.TokenDenom, winnerReward.TruncateInt())
+				ok, err := k.pairingKeeper.CreditStakeEntry(ctx, conflictVote.ChainID, accWinnerAddress, winnerRewardCoin, types.ModuleName)
 				if !ok {
 					utils.LavaFormatWarning("failed to credit client", err)
+				} else {
+					rewardedAmounts = append(rewardedAmounts, types.ConflictAmount{Address: winnersAddr, Amount: winnerRewardCoin})
 				}
 			}
 		}
@@ -240,6 +315,7 @@ func (k Keeper) HandleAndCloseVote(ctx sdk.Context, conflictVote types.ConflictV
 				if rewardCount.GT(rewardPool.Amount) {
 					utils.LavaFormatError("Reward overflow from the reward pool", err, eventData...)
 					k.RemoveConflictVote(ctx, conflictVote.Index)
+					closeVote(false)
 					return
 				}
 				accAddress, err := sdk.AccAddressFromBech32(vote.Address)
@@ -249,7 +325,8 @@ func (k Keeper) HandleAndCloseVote(ctx sdk.Context, conflictVote types.ConflictV
 					)
 					continue
 				}
-				ok, err := k.pairingKeeper.CreditStakeEntry(ctx, conflictVote.ChainID, accAddress, sdk.NewCoin(epochstoragetypes.TokenDenom, rewardVoter.TruncateInt()))
+				voterRewardCoin := sdk.NewCoin(epochstoragetypes.TokenDenom, rewardVoter.TruncateInt())
+				ok, err := k.pairingKeeper.CreditStakeEntry(ctx, conflictVote.ChainID, accAddress, voterRewardCoin, types.ModuleName)
 				if !ok {
 					details := map[string]string{}
 					if err != nil {
@@ -258,6 +335,7 @@ func (k Keeper) HandleAndCloseVote(ctx sdk.Context, conflictVote types.ConflictV
 					utils.LavaFormatWarning("failed to credit client", err)
 					continue
 				}
+				rewardedAmounts = append(rewardedAmounts, types.ConflictAmount{Address: vote.Address, Amount: voterRewardCoin})
 			}
 		}
 	}
@@ -265,6 +343,8 @@ func (k Keeper) HandleAndCloseVote(ctx sdk.Context, conflictVote types.ConflictV
 	eventData = append(eventData, utils.Attribute{Key: "RewardPool", Value: rewardPool.Amount})
 
 	k.RemoveConflictVote(ctx, conflictVote.Index)
+	// a rejected report puts the consumer in cooldown
+	closeVote(reportStatus == types.ReportStatusRejected)
 
 	eventDataMap := map[string]string{}
 	for _, attribute := range eventData {
@@ -273,6 +353,36 @@ func (k Keeper) HandleAndCloseVote(ctx sdk.Context, conflictVote types.ConflictV
 	utils.LogLavaEvent(ctx, logger, eventName, eventDataMap, "conflict detection resolved")
 }
 
+// rewardConsumer sends the consumer's reward for a conflict report from the reward pool
+func (k Keeper) rewardConsumer(ctx sdk.Context, consumer string, reward sdk.Coin) error {
+	consumerAddr, err := sdk.AccAddressFromBech32(consumer)
+	if err != nil {
+		return err
+	}
+
+	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, consumerAddr, sdk.NewCoins(reward))
+}
+
+// burnRewardPoolRemainder burns the part of a vote's reward pool (the slashed coins the
+// conflict module holds) that wasn't paid as rewards
+func (k Keeper) burnRewardPoolRemainder(ctx sdk.Context, rewardPool sdk.Coin, rewardedAmounts []types.ConflictAmount) {
+	remainder := rewardPool.Amount
+	for _, rewarded := range rewardedAmounts {
+		remainder = remainder.Sub(rewarded.Amount.Amount)
+	}
+	if !remainder.IsPositive() {
+		return
+	}
+
+	err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(sdk.NewCoin(rewardPool.Denom, remainder)))
+	if err != nil {
+		utils.LavaFormatError("critical: failed to burn the conflict reward pool remainder", err,
+			utils.Attribute{Key: "rewardPool", Value: rewardPool},
+			utils.Attribute{Key: "remainder", Value: remainder},
+		)
+	}
+}
+
 func (k Keeper) TransitionVoteToReveal(ctx sdk.Context, conflictVote types.ConflictVote) {
 	logger := k.Logger(ctx)
 	conflictVote.VoteState = types.StateReveal
@@ -4,8 +4,11 @@ import (
 	"math/rand"
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/lavanet/lava/testutil/common"
 	"github.com/lavanet/lava/utils/sigs"
+	"github.com/lavanet/lava/x/conflict/keeper"
 	conflicttypes "github.com/lavanet/lava/x/conflict/types"
 	pairingtypes "github.com/lavanet/lava/x/pairing/types"
 	"github.com/stretchr/testify/require"
@@ -45,6 +48,30 @@ func (ts *tester) setupForCommit() (string, conflicttypes.MsgDetection, *pairing
 	return voteID, *msg, reply1, reply2
 }
 
+// commitAndRevealVotes makes the jury (the providers that weren't reported) vote, and
+// advances to the end of the vote
+func (ts *tester) commitAndRevealVotes(voteID string, voteHash func(voter int) []byte) {
+	nonce := rand.Int63()
+	for i := 2; i < ProvidersCount; i++ {
+		msg := conflicttypes.MsgConflictVoteCommit{VoteID: voteID, Creator: ts.providers[i].Addr.String()}
+		msg.Hash = conflicttypes.CommitVoteData(nonce, voteHash(i), msg.Creator)
+		_, err := ts.txConflictVoteCommit(&msg)
+		require.Nil(ts.T, err)
+	}
+
+	ts.AdvanceEpochs(ts.VotePeriod() + 1)
+
+	for i := 2; i < ProvidersCount; i++ {
+		msgReveal := conflicttypes.MsgConflictVoteReveal{VoteID: voteID, Creator: ts.providers[i].Addr.String(), Hash: voteHash(i), Nonce: nonce}
+		_, err := ts.txConflictVoteReveal(&msgReveal)
+		require.Nil(ts.T, err)
+	}
+
+	ts.AdvanceEpochs(ts.VotePeriod())
+	_, found := ts.Keepers.Conflict.GetConflictVote(ts.Ctx, voteID)
+	require.False(ts.T, found)
+}
+
 func TestCommit(t *testing.T) {
 	ts := newTester(t)
 	voteID, detection, relay0, _ := ts.setupForCommit()
@@ -410,6 +437,68 @@ func TestFullStrongMajorityVote(t *testing.T) {
 	require.Equal(t, LastEvent.Type, "lava_"+conflicttypes.ConflictVoteResolvedEventName)
 }
 
+// a juror that votes against a strong majority loses its whole self-stake and is
+// unstaked, while its delegators only lose up to the delegations slash cap. The
+// slashed coins fill the reward pool held by the conflict module: what isn't paid
+// as rewards is burned
+func TestFraudVoterSlash(t *testing.T) {
+	ts := newTester(t)
+	voteID, detection, relay0, relay1 := ts.setupForCommit()
+
+	// 2 of the 3 voters are a strong enough majority
+	params := ts.Keepers.Conflict.GetParams(ts.Ctx)
+	params.MajorityPercent = sdk.NewDecWithPrec(6, 1)
+	ts.Keepers.Conflict.SetParams(ts.Ctx, params)
+
+	fraudVoter := ts.providers[ProvidersCount-1]
+	_, delegator := ts.AddAccount(common.CONSUMER, 1, 100000)
+	delegated := int64(1000)
+	_, err := ts.TxDualstakingDelegate(delegator, fraudVoter.Addr.String(), ts.spec.Index, common.NewCoin(delegated))
+	require.Nil(t, err)
+
+	fraudEntry, found := ts.Keepers.Epochstorage.GetStakeEntryByAddressCurrent(ts.Ctx, ts.spec.Index, fraudVoter.Addr)
+	require.True(t, found)
+	conflictModule := ts.Keepers.AccountKeeper.GetModuleAddress(conflicttypes.ModuleName)
+	conflictModuleBalance := ts.GetBalance(conflictModule)
+
+	relayExchange0 := pairingtypes.NewRelayExchange(*detection.ResponseConflict.ConflictRelayData0.Request, *relay0)
+	relayExchange1 := pairingtypes.NewRelayExchange(*detection.ResponseConflict.ConflictRelayData1.Request, *relay1)
+	ts.commitAndRevealVotes(voteID, func(voter int) []byte {
+		if voter == ProvidersCount-1 {
+			return sigs.HashMsg(relayExchange1.DataToSign())
+		}
+		return sigs.HashMsg(relayExchange0.DataToSign())
+	})
+
+	// the fraud voter is unstaked
+	_, found = ts.Keepers.Epochstorage.GetStakeEntryByAddressCurrent(ts.Ctx, ts.spec.Index, fraudVoter.Addr)
+	require.False(t, found)
+
+	records, err := ts.Keepers.Conflict.ConflictRecords(ts.GoCtx, &conflicttypes.QueryConflictRecordsRequest{ChainID: ts.spec.Index})
+	require.Nil(t, err)
+	require.Len(t, records.Records, 1)
+	record := records.Records[0]
+	// the faulty provider and the fraud voter are slashed
+	require.Len(t, record.Slashed, 2)
+	require.Equal(t, ts.providers[1].Addr.String(), record.Slashed[0].Address)
+	require.Equal(t, fraudVoter.Addr.String(), record.Slashed[1].Address)
+	delegationsSlashed := pairingtypes.DelegationsSlashCap.MulInt64(delegated).TruncateInt64()
+	require.Equal(t, fraudEntry.Stake.Amount.Int64()+delegationsSlashed, record.Slashed[1].Amount.Amount.Int64())
+
+	// the delegator keeps most of its delegation
+	res, err := ts.QueryDualstakingDelegatorProviders(delegator, true)
+	require.Nil(t, err)
+	for _, delegation := range res.Delegations {
+		if delegation.Provider == fraudVoter.Addr.String() && delegation.ChainID == ts.spec.Index {
+			require.Equal(t, delegated-delegationsSlashed, delegation.Amount.Amount.Int64())
+		}
+	}
+
+	// the reward pool is paid from the conflict module and the rest is burned
+	require.NotEmpty(t, record.Rewarded)
+	require.Equal(t, conflictModuleBalance, ts.GetBalance(conflictModule))
+}
+
 func TestNoVotersConflict(t *testing.T) {
 	ts := newTester(t)
 	voteID, _, _, _ := ts.setupForCommit()
@@ -495,3 +584,239 @@ func TestNoDecisionVote(t *testing.T) {
 	LastEvent := events[len(events)-1]
 	require.Equal(t, "lava_"+conflicttypes.ConflictVoteUnresolvedEventName, LastEvent.Type)
 }
+
+func TestConsumerReportReward(t *testing.T) {
+	ts := newTester(t)
+	voteID, detection, relay0, _ := ts.setupForCommit()
+	consumer := ts.consumer.Addr.String()
+
+	res, err := ts.Keepers.Conflict.ConsumerReports(ts.GoCtx, &conflicttypes.QueryConsumerReportsRequest{Consumer: consumer})
+	require.Nil(t, err)
+	require.Len(t, res.Reports, 1)
+	require.Equal(t, voteID, res.Reports[0].VoteID)
+	require.Equal(t, conflicttypes.ReportStatusOpen, res.Reports[0].Status)
+
+	// the voters vote for provider0 (so provider1 is found faulty), except for the last voter
+	// that doesn't vote and is slashed (filling the reward pool)
+	nonce := rand.Int63()
+	relayExchange := pairingtypes.NewRelayExchange(*detection.ResponseConflict.ConflictRelayData0.Request, *relay0)
+	replyDataHash := sigs.HashMsg(relayExchange.DataToSign())
+	for i := 2; i < ProvidersCount-1; i++ {
+		msg := conflicttypes.MsgConflictVoteCommit{VoteID: voteID, Creator: ts.providers[i].Addr.String()}
+		msg.Hash = conflicttypes.CommitVoteData(nonce, replyDataHash, msg.Creator)
+		_, err := ts.txConflictVoteCommit(&msg)
+		require.Nil(t, err)
+	}
+
+	ts.AdvanceEpochs(ts.VotePeriod() + 1)
+
+	for i := 2; i < ProvidersCount-1; i++ {
+		msgReveal := conflicttypes.MsgConflictVoteReveal{VoteID: voteID, Creator: ts.providers[i].Addr.String(), Hash: replyDataHash, Nonce: nonce}
+		_, err := ts.txConflictVoteReveal(&msgReveal)
+		require.Nil(t, err)
+	}
+
+	nonVoter := ts.providers[ProvidersCount-1].Addr
+	balance := ts.GetBalance(ts.consumer.Addr)
+
+	ts.AdvanceEpochs(ts.VotePeriod())
+
+	// the non voter is jailed, but the jail doesn't count as an offense that escalates its
+	// later unresponsiveness jails
+	nonVoterEntry, found := ts.Keepers.Epochstorage.GetStakeEntryByAddressCurrent(ts.Ctx, ts.spec.Index, nonVoter)
+	require.True(t, found)
+	require.True(t, nonVoterEntry.IsJailed())
+	require.Equal(t, uint64(0), nonVoterEntry.Jails)
+
+	// without a strong majority (the non voter's stake counts too) the faulty provider is not slashed by the vote
+	faultyStake, found := ts.Keepers.Epochstorage.GetStakeEntryByAddressCurrent(ts.Ctx, ts.spec.Index, ts.providers[1].Addr)
+	require.True(t, found)
+	require.Equal(t, ts.providers[1].Addr.String(), faultyStake.Address)
+
+	// the consumer is rewarded from the pool according to the severity (a single faulty provider)
+	records, err := ts.Keepers.Conflict.ConflictRecords(ts.GoCtx, &conflicttypes.QueryConflictRecordsRequest{ChainID: ts.spec.Index})
+	require.Nil(t, err)
+	require.Len(t, records.Records, 1)
+	require.Len(t, records.Records[0].Slashed, 1)
+	require.Equal(t, nonVoter.String(), records.Records[0].Slashed[0].Address)
+	slashed := records.Records[0].Slashed[0].Amount.Amount
+	expectedReward := ts.Keepers.Conflict.Rewards(ts.Ctx).ClientRewardPercent.MulInt(slashed).TruncateInt64()
+	require.Positive(t, expectedReward)
+	require.Equal(t, balance+expectedReward, ts.GetBalance(ts.consumer.Addr))
+
+	res, err = ts.Keepers.Conflict.ConsumerReports(ts.GoCtx, &conflicttypes.QueryConsumerReportsRequest{Consumer: consumer})
+	require.Nil(t, err)
+	require.Len(t, res.Reports, 1)
+	report := res.Reports[0]
+	require.Equal(t, conflicttypes.ReportStatusResolved, report.Status)
+	require.Equal(t, int64(conflicttypes.Provider0), report.Result)
+	require.Equal(t, uint64(1), report.Severity)
+	require.Equal(t, expectedReward, report.Reward.Amount.Int64())
+	require.Equal(t, uint64(0), res.CooldownUntilBlock)
+
+	// the closed vote is archived, and can be queried by the reported providers and by chain
+	for _, req := range []*conflicttypes.QueryConflictRecordsRequest{
+		{Provider: ts.providers[0].Addr.String()},
+		{Provider: ts.providers[1].Addr.String(), ChainID: ts.spec.Index},
+		{ChainID: ts.spec.Index},
+	} {
+		records, err := ts.Keepers.Conflict.ConflictRecords(ts.GoCtx, req)
+		require.Nil(t, err)
+		require.Len(t, records.Records, 1)
+		record := records.Records[0]
+		require.Equal(t, voteID, record.VoteID)
+		require.Equal(t, conflicttypes.ReportStatusResolved, record.Status)
+		require.Equal(t, int64(conflicttypes.Provider0), record.Result)
+		require.Equal(t, detection.ResponseConflict.ConflictRelayData0.Reply.HashAllDataHash, record.FirstProvider.Response)
+		require.Len(t, record.Votes, ProvidersCount-2)
+		for _, vote := range record.Votes {
+			if vote.Address == nonVoter.String() {
+				require.Equal(t, int64(conflicttypes.NoVote), vote.Result)
+			} else {
+				require.Equal(t, int64(conflicttypes.Provider0), vote.Result)
+			}
+		}
+		require.Len(t, record.Slashed, 1)
+		require.Equal(t, nonVoter.String(), record.Slashed[0].Address)
+		require.Equal(t, consumer, record.Rewarded[0].Address)
+		require.Equal(t, expectedReward, record.Rewarded[0].Amount.Amount.Int64())
+	}
+
+	// no records of other providers or chains
+	records, err = ts.Keepers.Conflict.ConflictRecords(ts.GoCtx, &conflicttypes.QueryConflictRecordsRequest{Provider: ts.providers[2].Addr.String()})
+	require.Nil(t, err)
+	require.Len(t, records.Records, 0)
+	records, err = ts.Keepers.Conflict.ConflictRecords(ts.GoCtx, &conflicttypes.QueryConflictRecordsRequest{ChainID: "NOTCHAIN"})
+	require.Nil(t, err)
+	require.Len(t, records.Records, 0)
+}
+
+// when the whole jury votes correctly nobody is slashed but the faulty providers, and
+// the reward pool is filled by their slash
+func TestHonestJuryReward(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		result   int64
+		faulty   []int
+		severity uint64
+	}{
+		{"provider0 is right", conflicttypes.Provider0, []int{1}, 1},
+		{"none of the providers is right", conflicttypes.NoneOfTheProviders, []int{0, 1}, 2},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ts := newTester(t)
+			voteID, detection, relay0, _ := ts.setupForCommit()
+			consumer := ts.consumer.Addr.String()
+
+			voteHash := sigs.HashMsg([]byte("FAKE"))
+			if tt.result == conflicttypes.Provider0 {
+				relayExchange := pairingtypes.NewRelayExchange(*detection.ResponseConflict.ConflictRelayData0.Request, *relay0)
+				voteHash = sigs.HashMsg(relayExchange.DataToSign())
+			}
+
+			stakes := map[string]int64{}
+			for _, i := range tt.faulty {
+				entry, found := ts.Keepers.Epochstorage.GetStakeEntryByAddressCurrent(ts.Ctx, ts.spec.Index, ts.providers[i].Addr)
+				require.True(t, found)
+				stakes[entry.Address] = entry.Stake.Amount.Int64()
+			}
+			balance := ts.GetBalance(ts.consumer.Addr)
+
+			ts.commitAndRevealVotes(voteID, func(int) []byte { return voteHash })
+
+			// only the faulty providers are slashed
+			records, err := ts.Keepers.Conflict.ConflictRecords(ts.GoCtx, &conflicttypes.QueryConflictRecordsRequest{ChainID: ts.spec.Index})
+			require.Nil(t, err)
+			require.Len(t, records.Records, 1)
+			require.Len(t, records.Records[0].Slashed, len(tt.faulty))
+			pool := int64(0)
+			for _, slashed := range records.Records[0].Slashed {
+				stake, ok := stakes[slashed.Address]
+				require.True(t, ok)
+				require.Equal(t, keeper.SlashStakePercent.MulInt64(stake).TruncateInt64(), slashed.Amount.Amount.Int64())
+				pool += slashed.Amount.Amount.Int64()
+			}
+
+			// the consumer is rewarded according to the severity, and isn't in cooldown
+			expectedReward := ts.Keepers.Conflict.Rewards(ts.Ctx).ClientRewardPercent.MulInt64(pool).MulInt64(int64(tt.severity)).TruncateInt64()
+			require.Positive(t, expectedReward)
+			require.Equal(t, balance+expectedReward, ts.GetBalance(ts.consumer.Addr))
+
+			res, err := ts.Keepers.Conflict.ConsumerReports(ts.GoCtx, &conflicttypes.QueryConsumerReportsRequest{Consumer: consumer})
+			require.Nil(t, err)
+			require.Len(t, res.Reports, 1)
+			require.Equal(t, conflicttypes.ReportStatusResolved, res.Reports[0].Status)
+			require.Equal(t, tt.result, res.Reports[0].Result)
+			require.Equal(t, tt.severity, res.Reports[0].Severity)
+			require.Equal(t, expectedReward, res.Reports[0].Reward.Amount.Int64())
+			require.Equal(t, uint64(0), res.CooldownUntilBlock)
+		})
+	}
+}
+
+func TestConsumerReportCooldown(t *testing.T) {
+	ts := newTester(t)
+	voteID, detection, relay0, relay1 := ts.setupForCommit()
+	consumer := ts.consumer.Addr.String()
+
+	// the jury splits between the reported responses and none of them: no response is
+	// reproduced by a majority, so the report is rejected
+	relayExchange0 := pairingtypes.NewRelayExchange(*detection.ResponseConflict.ConflictRelayData0.Request, *relay0)
+	relayExchange1 := pairingtypes.NewRelayExchange(*detection.ResponseConflict.ConflictRelayData1.Request, *relay1)
+	ts.commitAndRevealVotes(voteID, func(voter int) []byte {
+		switch voter {
+		case 2:
+			return sigs.HashMsg(relayExchange0.DataToSign())
+		case 3:
+			return sigs.HashMsg(relayExchange1.DataToSign())
+		default:
+			return sigs.HashMsg([]byte("FAKE"))
+		}
+	})
+
+	res, err := ts.Keepers.Conflict.ConsumerReports(ts.GoCtx, &conflicttypes.QueryConsumerReportsRequest{Consumer: consumer})
+	require.Nil(t, err)
+	require.Len(t, res.Reports, 1)
+	require.Equal(t, conflicttypes.ReportStatusRejected, res.Reports[0].Status)
+	require.Equal(t, int64(conflicttypes.NoVote), res.Reports[0].Result)
+	require.Equal(t, uint64(0), res.Reports[0].Severity)
+	require.True(t, res.Reports[0].Reward.IsZero())
+	require.Greater(t, res.CooldownUntilBlock, ts.BlockHeight())
+
+	// the consumer can't report during the cooldown
+	msg, _, _, err := common.CreateMsgDetectionTest(ts.GoCtx, ts.consumer, ts.providers[0], ts.providers[1], ts.spec)
+	require.Nil(t, err)
+	_, err = ts.txConflictDetection(msg)
+	require.ErrorIs(t, err, conflicttypes.ErrConsumerInCooldown)
+
+	// after the cooldown it can
+	ts.AdvanceEpochs(conflicttypes.DETECTION_COOLDOWN_EPOCHS)
+	msg, _, _, err = common.CreateMsgDetectionTest(ts.GoCtx, ts.consumer, ts.providers[0], ts.providers[1], ts.spec)
+	require.Nil(t, err)
+	_, err = ts.txConflictDetection(msg)
+	require.Nil(t, err)
+
+	// nobody votes: the report is unresolved, which doesn't put the consumer in cooldown
+	ts.AdvanceEpochs(ts.VotePeriod() + 1)
+	ts.AdvanceEpochs(ts.VotePeriod())
+
+	res, err = ts.Keepers.Conflict.ConsumerReports(ts.GoCtx, &conflicttypes.QueryConsumerReportsRequest{Consumer: consumer})
+	require.Nil(t, err)
+	require.Len(t, res.Reports, 2)
+	for _, report := range res.Reports {
+		if report.VoteID != voteID {
+			require.Equal(t, conflicttypes.ReportStatusUnresolved, report.Status)
+			require.True(t, report.Reward.IsZero())
+		}
+	}
+	require.Equal(t, uint64(0), res.CooldownUntilBlock)
+
+	msg, _, _, err = common.CreateMsgDetectionTest(ts.GoCtx, ts.consumer, ts.providers[0], ts.providers[1], ts.spec)
+	require.Nil(t, err)
+	_, err = ts.txConflictDetection(msg)
+	require.Nil(t, err)
+
+	res, err = ts.Keepers.Conflict.ConsumerReports(ts.GoCtx, &conflicttypes.QueryConsumerReportsRequest{Consumer: consumer})
+	require.Nil(t, err)
+	require.Len(t, res.Reports, 3)
+}
@@ -0,0 +1,1256 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: lavanet/lava/conflict/conflict_record.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ConflictRecord archives a closed conflict vote: what was disputed, how the voters voted and its outcome.
+type ConflictRecord struct {
+	VoteID          string           `protobuf:"bytes,1,opt,name=voteID,proto3" json:"voteID,omitempty"`
+	ChainID         string           `protobuf:"bytes,2,opt,name=chainID,proto3" json:"chainID,omitempty"`
+	Consumer        string           `protobuf:"bytes,3,opt,name=consumer,proto3" json:"consumer,omitempty"`
+	FirstProvider   Provider         `protobuf:"bytes,4,opt,name=first_provider,json=firstProvider,proto3" json:"first_provider"`
+	SecondProvider  Provider         `protobuf:"bytes,5,opt,name=second_provider,json=secondProvider,proto3" json:"second_provider"`
+	ApiUrl          string           `protobuf:"bytes,6,opt,name=api_url,json=apiUrl,proto3" json:"api_url,omitempty"`
+	RequestDataHash []byte           `protobuf:"bytes,7,opt,name=request_data_hash,json=requestDataHash,proto3" json:"request_data_hash,omitempty"`
+	RequestBlock    uint64           `protobuf:"varint,8,opt,name=request_block,json=requestBlock,proto3" json:"request_block,omitempty"`
+	VoteStartBlock  uint64           `protobuf:"varint,9,opt,name=vote_start_block,json=voteStartBlock,proto3" json:"vote_start_block,omitempty"`
+	CloseBlock      uint64           `protobuf:"varint,10,opt,name=close_block,json=closeBlock,proto3" json:"close_block,omitempty"`
+	Status          string           `protobuf:"bytes,11,opt,name=status,proto3" json:"status,omitempty"`
+	Result          int64            `protobuf:"varint,12,opt,name=result,proto3" json:"result,omitempty"`
+	Votes           []Vote           `protobuf:"bytes,13,rep,name=votes,proto3" json:"votes"`
+	Slashed         []ConflictAmount `protobuf:"bytes,14,rep,name=slashed,proto3" json:"slashed"`
+	Rewarded        []ConflictAmount `protobuf:"bytes,15,rep,name=rewarded,proto3" json:"rewarded"`
+}
+
+func (m *ConflictRecord) Reset()         { *m = ConflictRecord{} }
+func (m *ConflictRecord) String() string { return proto.CompactTextString(m) }
+func (*ConflictRecord) ProtoMessage()    {}
+func (*ConflictRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_37c88154c52c5cc2, []int{0}
+}
+func (m *ConflictRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConflictRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConflictRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConflictRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConflictRecord.Merge(m, src)
+}
+func (m *ConflictRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConflictRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConflictRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConflictRecord proto.InternalMessageInfo
+
+func (m *ConflictRecord) GetVoteID() string {
+	if m != nil {
+		return m.VoteID
+	}
+	return ""
+}
+
+func (m *ConflictRecord) GetChainID() string {
+	if m != nil {
+		return m.ChainID
+	}
+	return ""
+}
+
+func (m *ConflictRecord) GetConsumer() string {
+	if m != nil {
+		return m.Consumer
+	}
+	return ""
+}
+
+func (m *ConflictRecord) GetFirstProvider() Provider {
+	if m != nil {
+		return m.FirstProvider
+	}
+	return Provider{}
+}
+
+func (m *ConflictRecord) GetSecondProvider() Provider {
+	if m != nil {
+		return m.SecondProvider
+	}
+	return Provider{}
+}
+
+func (m *ConflictRecord) GetApiUrl() string {
+	if m != nil {
+		return m.ApiUrl
+	}
+	return ""
+}
+
+func (m *ConflictRecord) GetRequestDataHash() []byte {
+	if m != nil {
+		return m.RequestDataHash
+	}
+	return nil
+}
+
+func (m *ConflictRecord) GetRequestBlock() uint64 {
+	if m != nil {
+		return m.RequestBlock
+	}
+	return 0
+}
+
+func (m *ConflictRecord) GetVoteStartBlock() uint64 {
+	if m != nil {
+		return m.VoteStartBlock
+	}
+	return 0
+}
+
+func (m *ConflictRecord) GetCloseBlock() uint64 {
+	if m != nil {
+		return m.CloseBlock
+	}
+	return 0
+}
+
+func (m *ConflictRecord) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *ConflictRecord) GetResult() int64 {
+	if m != nil {
+		return m.Result
+	}
+	return 0
+}
+
+func (m *ConflictRecord) GetVotes() []Vote {
+	if m != nil {
+		return m.Votes
+	}
+	return nil
+}
+
+func (m *ConflictRecord) GetSlashed() []ConflictAmount {
+	if m != nil {
+		return m.Slashed
+	}
+	return nil
+}
+
+func (m *ConflictRecord) GetRewarded() []ConflictAmount {
+	if m != nil {
+		return m.Rewarded
+	}
+	return nil
+}
+
+// ConflictAmount is an amount slashed from (or rewarded to) an address in a conflict vote
+type ConflictAmount struct {
+	Address string     `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Amount  types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *ConflictAmount) Reset()         { *m = ConflictAmount{} }
+func (m *ConflictAmount) String() string { return proto.CompactTextString(m) }
+func (*ConflictAmount) ProtoMessage()    {}
+func (*ConflictAmount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_37c88154c52c5cc2, []int{1}
+}
+func (m *ConflictAmount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConflictAmount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConflictAmount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConflictAmount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConflictAmount.Merge(m, src)
+}
+func (m *ConflictAmount) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConflictAmount) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConflictAmount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConflictAmount proto.InternalMessageInfo
+
+func (m *ConflictAmount) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ConflictAmount) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func init() {
+	proto.RegisterType((*ConflictRecord)(nil), "lavanet.lava.conflict.ConflictRecord")
+	proto.RegisterType((*ConflictAmount)(nil), "lavanet.lava.conflict.ConflictAmount")
+}
+
+func init() {
+	proto.RegisterFile("lavanet/lava/conflict/conflict_record.proto", fileDescriptor_37c88154c52c5cc2)
+}
+
+var fileDescriptor_37c88154c52c5cc2 = []byte{
+	// 538 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0xdd, 0x6e, 0xd3, 0x30,
+	0x14, 0xc7, 0x1b, 0xd6, 0xaf, 0xb9, 0x6d, 0x0a, 0x16, 0x0c, 0x53, 0xa4, 0x34, 0x1a, 0x42, 0x0a,
+	0x20, 0x25, 0x5a, 0xb9, 0xd8, 0x35, 0x5d, 0x11, 0x4c, 0x42, 0x08, 0x05, 0xc1, 0x05, 0x37, 0x91,
+	0xeb, 0x78, 0x4d, 0x44, 0x1a, 0x17, 0xdb, 0x29, 0xf0, 0x16, 0x3c, 0x07, 0x4f, 0xb2, 0xcb, 0x5d,
+	0x72, 0x85, 0x50, 0xfb, 0x22, 0xc8, 0x1f, 0xe9, 0x34, 0x89, 0x09, 0xed, 0xca, 0x3e, 0xff, 0xf3,
+	0x3b, 0xc7, 0x1f, 0x3a, 0x7f, 0xf0, 0xac, 0xc0, 0x6b, 0x5c, 0x52, 0x19, 0xa9, 0x35, 0x22, 0xac,
+	0x3c, 0x2b, 0x72, 0x22, 0x77, 0x9b, 0x84, 0x53, 0xc2, 0x78, 0x1a, 0xae, 0x38, 0x93, 0x0c, 0xde,
+	0xb3, 0x70, 0xa8, 0xd6, 0xb0, 0x66, 0x46, 0x77, 0x17, 0x6c, 0xc1, 0x34, 0x11, 0xa9, 0x9d, 0x81,
+	0x47, 0x1e, 0x61, 0x62, 0xc9, 0x44, 0x34, 0xc7, 0x82, 0x46, 0xeb, 0xa3, 0x39, 0x95, 0xf8, 0x28,
+	0x22, 0x2c, 0x2f, 0x6d, 0xfe, 0xc9, 0x7f, 0x4e, 0x5e, 0x33, 0x49, 0x0d, 0x7a, 0xf8, 0xb3, 0x05,
+	0xdc, 0x13, 0xab, 0xc7, 0xfa, 0x42, 0xf0, 0x00, 0xb4, 0x15, 0x70, 0x3a, 0x43, 0x8e, 0xef, 0x04,
+	0xfb, 0xb1, 0x8d, 0x20, 0x02, 0x1d, 0x92, 0xe1, 0xbc, 0x3c, 0x9d, 0xa1, 0x5b, 0x3a, 0x51, 0x87,
+	0x70, 0x04, 0xba, 0x84, 0x95, 0xa2, 0x5a, 0x52, 0x8e, 0xf6, 0x74, 0x6a, 0x17, 0xc3, 0x37, 0xc0,
+	0x3d, 0xcb, 0xb9, 0x90, 0xc9, 0x8a, 0xb3, 0x75, 0x9e, 0x52, 0x8e, 0x9a, 0xbe, 0x13, 0xf4, 0x26,
+	0xe3, 0xf0, 0x9f, 0x2f, 0x0e, 0xdf, 0x59, 0x6c, 0xda, 0x3c, 0xff, 0x3d, 0x6e, 0xc4, 0x03, 0x5d,
+	0x5c, 0x8b, 0xf0, 0x2d, 0x18, 0x0a, 0x4a, 0x58, 0x99, 0x5e, 0xb6, 0x6b, 0xdd, 0xa4, 0x9d, 0x6b,
+	0xaa, 0x77, 0xfd, 0xee, 0x83, 0x0e, 0x5e, 0xe5, 0x49, 0xc5, 0x0b, 0xd4, 0x36, 0x8f, 0xc5, 0xab,
+	0xfc, 0x03, 0x2f, 0xe0, 0x53, 0x70, 0x87, 0xd3, 0x2f, 0x15, 0x15, 0x32, 0x49, 0xb1, 0xc4, 0x49,
+	0x86, 0x45, 0x86, 0x3a, 0xbe, 0x13, 0xf4, 0xe3, 0xa1, 0x4d, 0xcc, 0xb0, 0xc4, 0xaf, 0xb1, 0xc8,
+	0xe0, 0x23, 0x30, 0xa8, 0xd9, 0x79, 0xc1, 0xc8, 0x67, 0xd4, 0xf5, 0x9d, 0xa0, 0x19, 0xf7, 0xad,
+	0x38, 0x55, 0x1a, 0x0c, 0xc0, 0x6d, 0xf5, 0x8f, 0x89, 0x90, 0x98, 0xd7, 0xdc, 0xbe, 0xe6, 0x5c,
+	0xa5, 0xbf, 0x57, 0xb2, 0x21, 0xc7, 0xa0, 0x47, 0x0a, 0x26, 0xa8, 0x85, 0x80, 0x86, 0x80, 0x96,
+	0x0c, 0x70, 0x00, 0xda, 0x42, 0x62, 0x59, 0x09, 0xd4, 0x33, 0x77, 0x36, 0x91, 0xd2, 0x39, 0x15,
+	0x55, 0x21, 0x51, 0xdf, 0x77, 0x82, 0xbd, 0xd8, 0x46, 0xf0, 0x18, 0xb4, 0xd4, 0x11, 0x02, 0x0d,
+	0xfc, 0xbd, 0xa0, 0x37, 0x79, 0x78, 0xcd, 0x57, 0x7d, 0x64, 0x92, 0xda, 0x6f, 0x32, 0x3c, 0x7c,
+	0x09, 0x3a, 0xa2, 0xc0, 0x22, 0xa3, 0x29, 0x72, 0x75, 0xe9, 0xe3, 0x6b, 0x4a, 0xeb, 0x09, 0x7a,
+	0xb1, 0x64, 0x55, 0x29, 0x6d, 0x93, 0xba, 0x16, 0xbe, 0x02, 0x5d, 0x4e, 0xbf, 0x62, 0x9e, 0xd2,
+	0x14, 0x0d, 0x6f, 0xde, 0x67, 0x57, 0x7c, 0x48, 0x80, 0x7b, 0x95, 0x50, 0x33, 0x89, 0xd3, 0x94,
+	0x53, 0x21, 0xec, 0xb0, 0xd6, 0x21, 0x3c, 0x06, 0x6d, 0xac, 0x19, 0x3d, 0xac, 0xbd, 0xc9, 0x83,
+	0xd0, 0x98, 0x26, 0x54, 0xa6, 0x09, 0xad, 0x69, 0xc2, 0x13, 0x96, 0x97, 0xf6, 0x18, 0x8b, 0x4f,
+	0xa7, 0xe7, 0x1b, 0xcf, 0xb9, 0xd8, 0x78, 0xce, 0x9f, 0x8d, 0xe7, 0xfc, 0xd8, 0x7a, 0x8d, 0x8b,
+	0xad, 0xd7, 0xf8, 0xb5, 0xf5, 0x1a, 0x9f, 0x82, 0x45, 0x2e, 0xb3, 0x6a, 0x1e, 0x12, 0xb6, 0x8c,
+	0xae, 0x38, 0xec, 0xdb, 0xa5, 0xc7, 0xe4, 0xf7, 0x15, 0x15, 0xf3, 0xb6, 0x36, 0xd7, 0xf3, 0xbf,
+	0x03, 0x00, 0x2c, 0x55, 0x35, 0xab, 0x03, 0x04, 0x00, 0x00,
+}
+
+func (m *ConflictRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConflictRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConflictRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rewarded) > 0 {
+		for iNdEx := len(m.Rewarded) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewarded[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintConflictRecord(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.Slashed) > 0 {
+		for iNdEx := len(m.Slashed) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Slashed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintConflictRecord(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.Votes) > 0 {
+		for iNdEx := len(m.Votes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Votes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintConflictRecord(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if m.Result != 0 {
+		i = encodeVarintConflictRecord(dAtA, i, uint64(m.Result))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintConflictRecord(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.CloseBlock != 0 {
+		i = encodeVarintConflictRecord(dAtA, i, uint64(m.CloseBlock))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.VoteStartBlock != 0 {
+		i = encodeVarintConflictRecord(dAtA, i, uint64(m.VoteStartBlock))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.RequestBlock != 0 {
+		i = encodeVarintConflictRecord(dAtA, i, uint64(m.RequestBlock))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.RequestDataHash) > 0 {
+		i -= len(m.RequestDataHash)
+		copy(dAtA[i:], m.RequestDataHash)
+		i = encodeVarintConflictRecord(dAtA, i, uint64(len(m.RequestDataHash)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.ApiUrl) > 0 {
+		i -= len(m.ApiUrl)
+		copy(dAtA[i:], m.ApiUrl)
+		i = encodeVarintConflictRecord(dAtA, i, uint64(len(m.ApiUrl)))
+		i--
+		dAtA[i] = 0x32
+	}
+	{
+		size, err := m.SecondProvider.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintConflictRecord(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.FirstProvider.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintConflictRecord(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Consumer) > 0 {
+		i -= len(m.Consumer)
+		copy(dAtA[i:], m.Consumer)
+		i = encodeVarintConflictRecord(dAtA, i, uint64(len(m.Consumer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintConflictRecord(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.VoteID) > 0 {
+		i -= len(m.VoteID)
+		copy(dAtA[i:], m.VoteID)
+		i = encodeVarintConflictRecord(dAtA, i, uint64(len(m.VoteID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConflictAmount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConflictAmount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConflictAmount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintConflictRecord(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintConflictRecord(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintConflictRecord(dAtA []byte, offset int, v uint64) int {
+	offset -= sovConflictRecord(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ConflictRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.VoteID)
+	if l > 0 {
+		n += 1 + l + sovConflictRecord(uint64(l))
+	}
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovConflictRecord(uint64(l))
+	}
+	l = len(m.Consumer)
+	if l > 0 {
+		n += 1 + l + sovConflictRecord(uint64(l))
+	}
+	l = m.FirstProvider.Size()
+	n += 1 + l + sovConflictRecord(uint64(l))
+	l = m.SecondProvider.Size()
+	n += 1 + l + sovConflictRecord(uint64(l))
+	l = len(m.ApiUrl)
+	if l > 0 {
+		n += 1 + l + sovConflictRecord(uint64(l))
+	}
+	l = len(m.RequestDataHash)
+	if l > 0 {
+		n += 1 + l + sovConflictRecord(uint64(l))
+	}
+	if m.RequestBlock != 0 {
+		n += 1 + sovConflictRecord(uint64(m.RequestBlock))
+	}
+	if m.VoteStartBlock != 0 {
+		n += 1 + sovConflictRecord(uint64(m.VoteStartBlock))
+	}
+	if m.CloseBlock != 0 {
+		n += 1 + sovConflictRecord(uint64(m.CloseBlock))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovConflictRecord(uint64(l))
+	}
+	if m.Result != 0 {
+		n += 1 + sovConflictRecord(uint64(m.Result))
+	}
+	if len(m.Votes) > 0 {
+		for _, e := range m.Votes {
+			l = e.Size()
+			n += 1 + l + sovConflictRecord(uint64(l))
+		}
+	}
+	if len(m.Slashed) > 0 {
+		for _, e := range m.Slashed {
+			l = e.Size()
+			n += 1 + l + sovConflictRecord(uint64(l))
+		}
+	}
+	if len(m.Rewarded) > 0 {
+		for _, e := range m.Rewarded {
+			l = e.Size()
+			n += 1 + l + sovConflictRecord(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ConflictAmount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovConflictRecord(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovConflictRecord(uint64(l))
+	return n
+}
+
+func sovConflictRecord(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozConflictRecord(x uint64) (n int) {
+	return sovConflictRecord(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ConflictRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConflictRecord
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConflictRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConflictRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConflictRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConflictRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConflictRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VoteID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConflictRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConflictRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConflictRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Consumer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConflictRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConflictRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConflictRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Consumer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstProvider", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConflictRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConflictRecord
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConflictRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FirstProvider.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondProvider", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConflictRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConflictRecord
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConflictRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SecondProvider.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConflictRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConflictRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConflictRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestDataHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConflictRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthConflictRecord
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConflictRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestDataHash = append(m.RequestDataHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.RequestDataHash == nil {
+				m.RequestDataHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestBlock", wireType)
+			}
+			m.RequestBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConflictRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteStartBlock", wireType)
+			}
+			m.VoteStartBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConflictRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VoteStartBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CloseBlock", wireType)
+			}
+			m.CloseBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConflictRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CloseBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConflictRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConflictRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConflictRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			m.Result = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConflictRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Result |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConflictRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConflictRecord
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConflictRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Votes = append(m.Votes, Vote{})
+			if err := m.Votes[len(m.Votes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slashed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConflictRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConflictRecord
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConflictRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Slashed = append(m.Slashed, ConflictAmount{})
+			if err := m.Slashed[len(m.Slashed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewarded", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConflictRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConflictRecord
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConflictRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewarded = append(m.Rewarded, ConflictAmount{})
+			if err := m.Rewarded[len(m.Rewarded)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConflictRecord(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthConflictRecord
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConflictAmount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConflictRecord
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConflictAmount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConflictAmount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConflictRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConflictRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConflictRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConflictRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConflictRecord
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConflictRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConflictRecord(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthConflictRecord
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipConflictRecord(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowConflictRecord
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowConflictRecord
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowConflictRecord
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthConflictRecord
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupConflictRecord
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthConflictRecord
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthConflictRecord        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowConflictRecord          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupConflictRecord = fmt.Errorf("proto: unexpected end of group")
+)
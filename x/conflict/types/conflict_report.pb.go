@@ -0,0 +1,989 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: lavanet/lava/conflict/conflict_report.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ConflictReport keeps the details and outcome of a conflict reported by a consumer (that started a vote).
+type ConflictReport struct {
+	Consumer       string     `protobuf:"bytes,1,opt,name=consumer,proto3" json:"consumer,omitempty"`
+	VoteID         string     `protobuf:"bytes,2,opt,name=voteID,proto3" json:"voteID,omitempty"`
+	ChainID        string     `protobuf:"bytes,3,opt,name=chainID,proto3" json:"chainID,omitempty"`
+	FirstProvider  string     `protobuf:"bytes,4,opt,name=first_provider,json=firstProvider,proto3" json:"first_provider,omitempty"`
+	SecondProvider string     `protobuf:"bytes,5,opt,name=second_provider,json=secondProvider,proto3" json:"second_provider,omitempty"`
+	ReportBlock    uint64     `protobuf:"varint,6,opt,name=report_block,json=reportBlock,proto3" json:"report_block,omitempty"`
+	Status         string     `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	CloseBlock     uint64     `protobuf:"varint,8,opt,name=close_block,json=closeBlock,proto3" json:"close_block,omitempty"`
+	Result         int64      `protobuf:"varint,9,opt,name=result,proto3" json:"result,omitempty"`
+	Severity       uint64     `protobuf:"varint,10,opt,name=severity,proto3" json:"severity,omitempty"`
+	Reward         types.Coin `protobuf:"bytes,11,opt,name=reward,proto3" json:"reward"`
+}
+
+func (m *ConflictReport) Reset()         { *m = ConflictReport{} }
+func (m *ConflictReport) String() string { return proto.CompactTextString(m) }
+func (*ConflictReport) ProtoMessage()    {}
+func (*ConflictReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3fb39e3dc8639d3d, []int{0}
+}
+func (m *ConflictReport) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConflictReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConflictReport.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConflictReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConflictReport.Merge(m, src)
+}
+func (m *ConflictReport) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConflictReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConflictReport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConflictReport proto.InternalMessageInfo
+
+func (m *ConflictReport) GetConsumer() string {
+	if m != nil {
+		return m.Consumer
+	}
+	return ""
+}
+
+func (m *ConflictReport) GetVoteID() string {
+	if m != nil {
+		return m.VoteID
+	}
+	return ""
+}
+
+func (m *ConflictReport) GetChainID() string {
+	if m != nil {
+		return m.ChainID
+	}
+	return ""
+}
+
+func (m *ConflictReport) GetFirstProvider() string {
+	if m != nil {
+		return m.FirstProvider
+	}
+	return ""
+}
+
+func (m *ConflictReport) GetSecondProvider() string {
+	if m != nil {
+		return m.SecondProvider
+	}
+	return ""
+}
+
+func (m *ConflictReport) GetReportBlock() uint64 {
+	if m != nil {
+		return m.ReportBlock
+	}
+	return 0
+}
+
+func (m *ConflictReport) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *ConflictReport) GetCloseBlock() uint64 {
+	if m != nil {
+		return m.CloseBlock
+	}
+	return 0
+}
+
+func (m *ConflictReport) GetResult() int64 {
+	if m != nil {
+		return m.Result
+	}
+	return 0
+}
+
+func (m *ConflictReport) GetSeverity() uint64 {
+	if m != nil {
+		return m.Severity
+	}
+	return 0
+}
+
+func (m *ConflictReport) GetReward() types.Coin {
+	if m != nil {
+		return m.Reward
+	}
+	return types.Coin{}
+}
+
+// ConsumerCooldown keeps the block until which a consumer can't report conflicts (after a report that wasn't upheld).
+type ConsumerCooldown struct {
+	Consumer   string `protobuf:"bytes,1,opt,name=consumer,proto3" json:"consumer,omitempty"`
+	UntilBlock uint64 `protobuf:"varint,2,opt,name=until_block,json=untilBlock,proto3" json:"until_block,omitempty"`
+}
+
+func (m *ConsumerCooldown) Reset()         { *m = ConsumerCooldown{} }
+func (m *ConsumerCooldown) String() string { return proto.CompactTextString(m) }
+func (*ConsumerCooldown) ProtoMessage()    {}
+func (*ConsumerCooldown) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3fb39e3dc8639d3d, []int{1}
+}
+func (m *ConsumerCooldown) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsumerCooldown) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsumerCooldown.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConsumerCooldown) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsumerCooldown.Merge(m, src)
+}
+func (m *ConsumerCooldown) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsumerCooldown) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsumerCooldown.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConsumerCooldown proto.InternalMessageInfo
+
+func (m *ConsumerCooldown) GetConsumer() string {
+	if m != nil {
+		return m.Consumer
+	}
+	return ""
+}
+
+func (m *ConsumerCooldown) GetUntilBlock() uint64 {
+	if m != nil {
+		return m.UntilBlock
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*ConflictReport)(nil), "lavanet.lava.conflict.ConflictReport")
+	proto.RegisterType((*ConsumerCooldown)(nil), "lavanet.lava.conflict.ConsumerCooldown")
+}
+
+func init() {
+	proto.RegisterFile("lavanet/lava/conflict/conflict_report.proto", fileDescriptor_3fb39e3dc8639d3d)
+}
+
+var fileDescriptor_3fb39e3dc8639d3d = []byte{
+	// 412 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x52, 0xcf, 0x6f, 0xd3, 0x30,
+	0x18, 0xad, 0xbb, 0xd2, 0x6d, 0x0e, 0x14, 0x64, 0x01, 0x32, 0x3d, 0xa4, 0x65, 0x12, 0x22, 0x12,
+	0x92, 0xa3, 0xc1, 0x81, 0x7b, 0xbb, 0xcb, 0x4e, 0xa0, 0x1c, 0xb9, 0x54, 0x89, 0xeb, 0x75, 0x16,
+	0xa9, 0xbf, 0xc8, 0x76, 0x32, 0xf6, 0x5f, 0xf0, 0x67, 0xed, 0xb8, 0x23, 0x27, 0x84, 0xda, 0x3f,
+	0x82, 0x2b, 0xf2, 0x8f, 0xa5, 0xe2, 0xb2, 0x93, 0xbf, 0xf7, 0xbe, 0xf7, 0x92, 0xa7, 0xa7, 0x0f,
+	0x7f, 0xa8, 0xcb, 0xae, 0x54, 0xc2, 0xe6, 0xee, 0xcd, 0x39, 0xa8, 0xab, 0x5a, 0x72, 0xdb, 0x0f,
+	0x2b, 0x2d, 0x1a, 0xd0, 0x96, 0x35, 0x1a, 0x2c, 0x90, 0x57, 0x51, 0xcc, 0xdc, 0xcb, 0x1e, 0x34,
+	0xd3, 0x97, 0x1b, 0xd8, 0x80, 0x57, 0xe4, 0x6e, 0x0a, 0xe2, 0x69, 0xca, 0xc1, 0x6c, 0xc1, 0xe4,
+	0x55, 0x69, 0x44, 0xde, 0x9d, 0x57, 0xc2, 0x96, 0xe7, 0x39, 0x07, 0xa9, 0xc2, 0xfe, 0xec, 0xef,
+	0x10, 0x4f, 0x96, 0xf1, 0x13, 0x85, 0xff, 0x0b, 0x99, 0xe2, 0x13, 0x0e, 0xca, 0xb4, 0x5b, 0xa1,
+	0x29, 0x9a, 0xa3, 0xec, 0xb4, 0xe8, 0x31, 0x79, 0x8d, 0xc7, 0x1d, 0x58, 0x71, 0x79, 0x41, 0x87,
+	0x7e, 0x13, 0x11, 0xa1, 0xf8, 0x98, 0x5f, 0x97, 0x52, 0x5d, 0x5e, 0xd0, 0x23, 0xbf, 0x78, 0x80,
+	0xe4, 0x1d, 0x9e, 0x5c, 0x49, 0x6d, 0xec, 0xaa, 0xd1, 0xd0, 0xc9, 0xb5, 0xd0, 0x74, 0xe4, 0x05,
+	0xcf, 0x3c, 0xfb, 0x35, 0x92, 0xe4, 0x3d, 0x7e, 0x6e, 0x04, 0x07, 0xb5, 0x3e, 0xe8, 0x9e, 0x78,
+	0xdd, 0x24, 0xd0, 0xbd, 0xf0, 0x2d, 0x7e, 0x1a, 0xda, 0x58, 0x55, 0x35, 0xf0, 0xef, 0x74, 0x3c,
+	0x47, 0xd9, 0xa8, 0x48, 0x02, 0xb7, 0x70, 0x94, 0x0b, 0x69, 0x6c, 0x69, 0x5b, 0x43, 0x8f, 0x43,
+	0xc8, 0x80, 0xc8, 0x0c, 0x27, 0xbc, 0x06, 0x23, 0xa2, 0xf3, 0xc4, 0x3b, 0xb1, 0xa7, 0x7a, 0xa3,
+	0x16, 0xa6, 0xad, 0x2d, 0x3d, 0x9d, 0xa3, 0xec, 0xa8, 0x88, 0xc8, 0x35, 0x62, 0x44, 0x27, 0xb4,
+	0xb4, 0xb7, 0x14, 0x7b, 0x57, 0x8f, 0xc9, 0x67, 0xe7, 0xb9, 0x29, 0xf5, 0x9a, 0x26, 0x73, 0x94,
+	0x25, 0x1f, 0xdf, 0xb0, 0xd0, 0x38, 0x73, 0x8d, 0xb3, 0xd8, 0x38, 0x5b, 0x82, 0x54, 0x8b, 0xd1,
+	0xdd, 0xef, 0xd9, 0xa0, 0x88, 0xf2, 0xb3, 0x2f, 0xf8, 0xc5, 0x32, 0xd6, 0xba, 0x04, 0xa8, 0xd7,
+	0x70, 0xa3, 0x1e, 0xad, 0x7e, 0x86, 0x93, 0x56, 0x59, 0x59, 0xc7, 0xf4, 0xc3, 0x90, 0xde, 0x53,
+	0x3e, 0xfd, 0x62, 0x71, 0xb7, 0x4b, 0xd1, 0xfd, 0x2e, 0x45, 0x7f, 0x76, 0x29, 0xfa, 0xb9, 0x4f,
+	0x07, 0xf7, 0xfb, 0x74, 0xf0, 0x6b, 0x9f, 0x0e, 0xbe, 0x65, 0x1b, 0x69, 0xaf, 0xdb, 0x8a, 0x71,
+	0xd8, 0xe6, 0xff, 0x5d, 0xda, 0x8f, 0xc3, 0xad, 0xd9, 0xdb, 0x46, 0x98, 0x6a, 0xec, 0xaf, 0xe2,
+	0xd3, 0xbf, 0x01, 0x00, 0xaf, 0xaa, 0xe8, 0x59, 0x91, 0x02, 0x00, 0x00,
+}
+
+func (m *ConflictReport) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConflictReport) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConflictReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Reward.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintConflictReport(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	if m.Severity != 0 {
+		i = encodeVarintConflictReport(dAtA, i, uint64(m.Severity))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.Result != 0 {
+		i = encodeVarintConflictReport(dAtA, i, uint64(m.Result))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.CloseBlock != 0 {
+		i = encodeVarintConflictReport(dAtA, i, uint64(m.CloseBlock))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintConflictReport(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.ReportBlock != 0 {
+		i = encodeVarintConflictReport(dAtA, i, uint64(m.ReportBlock))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.SecondProvider) > 0 {
+		i -= len(m.SecondProvider)
+		copy(dAtA[i:], m.SecondProvider)
+		i = encodeVarintConflictReport(dAtA, i, uint64(len(m.SecondProvider)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.FirstProvider) > 0 {
+		i -= len(m.FirstProvider)
+		copy(dAtA[i:], m.FirstProvider)
+		i = encodeVarintConflictReport(dAtA, i, uint64(len(m.FirstProvider)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintConflictReport(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.VoteID) > 0 {
+		i -= len(m.VoteID)
+		copy(dAtA[i:], m.VoteID)
+		i = encodeVarintConflictReport(dAtA, i, uint64(len(m.VoteID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Consumer) > 0 {
+		i -= len(m.Consumer)
+		copy(dAtA[i:], m.Consumer)
+		i = encodeVarintConflictReport(dAtA, i, uint64(len(m.Consumer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConsumerCooldown) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerCooldown) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerCooldown) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UntilBlock != 0 {
+		i = encodeVarintConflictReport(dAtA, i, uint64(m.UntilBlock))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Consumer) > 0 {
+		i -= len(m.Consumer)
+		copy(dAtA[i:], m.Consumer)
+		i = encodeVarintConflictReport(dAtA, i, uint64(len(m.Consumer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintConflictReport(dAtA []byte, offset int, v uint64) int {
+	offset -= sovConflictReport(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ConflictReport) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Consumer)
+	if l > 0 {
+		n += 1 + l + sovConflictReport(uint64(l))
+	}
+	l = len(m.VoteID)
+	if l > 0 {
+		n += 1 + l + sovConflictReport(uint64(l))
+	}
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovConflictReport(uint64(l))
+	}
+	l = len(m.FirstProvider)
+	if l > 0 {
+		n += 1 + l + sovConflictReport(uint64(l))
+	}
+	l = len(m.SecondProvider)
+	if l > 0 {
+		n += 1 + l + sovConflictReport(uint64(l))
+	}
+	if m.ReportBlock != 0 {
+		n += 1 + sovConflictReport(uint64(m.ReportBlock))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovConflictReport(uint64(l))
+	}
+	if m.CloseBlock != 0 {
+		n += 1 + sovConflictReport(uint64(m.CloseBlock))
+	}
+	if m.Result != 0 {
+		n += 1 + sovConflictReport(uint64(m.Result))
+	}
+	if m.Severity != 0 {
+		n += 1 + sovConflictReport(uint64(m.Severity))
+	}
+	l = m.Reward.Size()
+	n += 1 + l + sovConflictReport(uint64(l))
+	return n
+}
+
+func (m *ConsumerCooldown) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Consumer)
+	if l > 0 {
+		n += 1 + l + sovConflictReport(uint64(l))
+	}
+	if m.UntilBlock != 0 {
+		n += 1 + sovConflictReport(uint64(m.UntilBlock))
+	}
+	return n
+}
+
+func sovConflictReport(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozConflictReport(x uint64) (n int) {
+	return sovConflictReport(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ConflictReport) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConflictReport
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConflictReport: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConflictReport: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Consumer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConflictReport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConflictReport
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConflictReport
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Consumer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConflictReport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConflictReport
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConflictReport
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VoteID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConflictReport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConflictReport
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConflictReport
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstProvider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConflictReport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConflictReport
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConflictReport
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FirstProvider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondProvider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConflictReport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConflictReport
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConflictReport
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SecondProvider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReportBlock", wireType)
+			}
+			m.ReportBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConflictReport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReportBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConflictReport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConflictReport
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConflictReport
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CloseBlock", wireType)
+			}
+			m.CloseBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConflictReport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CloseBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			m.Result = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConflictReport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Result |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Severity", wireType)
+			}
+			m.Severity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConflictReport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Severity |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reward", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConflictReport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConflictReport
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConflictReport
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Reward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConflictReport(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthConflictReport
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConsumerCooldown) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConflictReport
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsumerCooldown: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsumerCooldown: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Consumer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConflictReport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConflictReport
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConflictReport
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Consumer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UntilBlock", wireType)
+			}
+			m.UntilBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConflictReport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UntilBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConflictReport(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthConflictReport
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipConflictReport(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowConflictReport
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowConflictReport
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowConflictReport
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthConflictReport
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupConflictReport
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthConflictReport
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthConflictReport        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowConflictReport          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupConflictReport = fmt.Errorf("proto: unexpected end of group")
+)
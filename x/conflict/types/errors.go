@@ -8,5 +8,6 @@ import (
 
 // x/conflict module sentinel errors
 var (
-	ErrSample = sdkerrors.Register(ModuleName, 1100, "sample error")
+	ErrSample             = sdkerrors.Register(ModuleName, 1100, "sample error")
+	ErrConsumerInCooldown = sdkerrors.Register(ModuleName, 1101, "consumer can't report conflicts during its cooldown")
 )
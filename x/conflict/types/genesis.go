@@ -10,7 +10,10 @@ const DefaultIndex uint64 = 1
 // DefaultGenesis returns the default Capability genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
-		ConflictVoteList: []ConflictVote{},
+		ConflictVoteList:     []ConflictVote{},
+		ConflictReportList:   []ConflictReport{},
+		ConsumerCooldownList: []ConsumerCooldown{},
+		ConflictRecordList:   []ConflictRecord{},
 		// this line is used by starport scaffolding # genesis/types/default
 		Params: DefaultParams(),
 	}
@@ -29,6 +32,39 @@ func (gs GenesisState) Validate() error {
 		}
 		conflictVoteIndexMap[index] = struct{}{}
 	}
+
+	// Check for duplicated index in conflictReport
+	conflictReportIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.ConflictReportList {
+		index := string(ConflictReportKey(elem.Consumer, elem.VoteID))
+		if _, ok := conflictReportIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for conflictReport")
+		}
+		conflictReportIndexMap[index] = struct{}{}
+	}
+
+	// Check for duplicated index in consumerCooldown
+	consumerCooldownIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.ConsumerCooldownList {
+		index := string(ConsumerCooldownKey(elem.Consumer))
+		if _, ok := consumerCooldownIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for consumerCooldown")
+		}
+		consumerCooldownIndexMap[index] = struct{}{}
+	}
+
+	// Check for duplicated index in conflictRecord
+	conflictRecordIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.ConflictRecordList {
+		index := string(ConflictRecordKey(elem.ChainID, elem.CloseBlock, elem.VoteID))
+		if _, ok := conflictRecordIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for conflictRecord")
+		}
+		conflictRecordIndexMap[index] = struct{}{}
+	}
 	// this line is used by starport scaffolding # genesis/types/validate
 
 	return gs.Params.Validate()
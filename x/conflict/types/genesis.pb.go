@@ -25,8 +25,11 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the conflict module's genesis state.
 type GenesisState struct {
-	Params           Params         `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	ConflictVoteList []ConflictVote `protobuf:"bytes,2,rep,name=conflictVoteList,proto3" json:"conflictVoteList"`
+	Params               Params             `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	ConflictVoteList     []ConflictVote     `protobuf:"bytes,2,rep,name=conflictVoteList,proto3" json:"conflictVoteList"`
+	ConflictReportList   []ConflictReport   `protobuf:"bytes,3,rep,name=conflictReportList,proto3" json:"conflictReportList"`
+	ConsumerCooldownList []ConsumerCooldown `protobuf:"bytes,4,rep,name=consumerCooldownList,proto3" json:"consumerCooldownList"`
+	ConflictRecordList   []ConflictRecord   `protobuf:"bytes,5,rep,name=conflictRecordList,proto3" json:"conflictRecordList"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -76,6 +79,27 @@ func (m *GenesisState) GetConflictVoteList() []ConflictVote {
 	return nil
 }
 
+func (m *GenesisState) GetConflictReportList() []ConflictReport {
+	if m != nil {
+		return m.ConflictReportList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetConsumerCooldownList() []ConsumerCooldown {
+	if m != nil {
+		return m.ConsumerCooldownList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetConflictRecordList() []ConflictRecord {
+	if m != nil {
+		return m.ConflictRecordList
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "lavanet.lava.conflict.GenesisState")
 }
@@ -85,22 +109,28 @@ func init() {
 }
 
 var fileDescriptor_71a0ca73fa4559da = []byte{
-	// 238 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xce, 0x49, 0x2c, 0x4b,
-	0xcc, 0x4b, 0x2d, 0xd1, 0x07, 0xd1, 0xfa, 0xc9, 0xf9, 0x79, 0x69, 0x39, 0x99, 0xc9, 0x25, 0xfa,
-	0xe9, 0xa9, 0x79, 0xa9, 0xc5, 0x99, 0xc5, 0x7a, 0x05, 0x45, 0xf9, 0x25, 0xf9, 0x42, 0xa2, 0x50,
-	0x45, 0x7a, 0x20, 0x5a, 0x0f, 0xa6, 0x48, 0x4a, 0x24, 0x3d, 0x3f, 0x3d, 0x1f, 0xac, 0x42, 0x1f,
-	0xc4, 0x82, 0x28, 0x96, 0x52, 0xc2, 0x6e, 0x62, 0x41, 0x62, 0x51, 0x62, 0x2e, 0xd4, 0x40, 0x29,
-	0x4d, 0xec, 0x6a, 0x60, 0x8c, 0xf8, 0xb2, 0xfc, 0x92, 0x54, 0x88, 0x52, 0xa5, 0x45, 0x8c, 0x5c,
-	0x3c, 0xee, 0x10, 0xd7, 0x04, 0x97, 0x24, 0x96, 0xa4, 0x0a, 0x59, 0x73, 0xb1, 0x41, 0xcc, 0x92,
-	0x60, 0x54, 0x60, 0xd4, 0xe0, 0x36, 0x92, 0xd5, 0xc3, 0xea, 0x3a, 0xbd, 0x00, 0xb0, 0x22, 0x27,
-	0x96, 0x13, 0xf7, 0xe4, 0x19, 0x82, 0xa0, 0x5a, 0x84, 0x42, 0xb9, 0x04, 0x60, 0x0a, 0xc2, 0xf2,
-	0x4b, 0x52, 0x7d, 0x32, 0x8b, 0x4b, 0x24, 0x98, 0x14, 0x98, 0x35, 0xb8, 0x8d, 0x94, 0x71, 0x18,
-	0xe3, 0x8c, 0xa4, 0x1c, 0x6a, 0x18, 0x86, 0x11, 0x4e, 0x4e, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78,
-	0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc,
-	0x78, 0x2c, 0xc7, 0x10, 0xa5, 0x91, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0xab,
-	0x8f, 0xe2, 0xe9, 0x0a, 0x84, 0xb7, 0x4b, 0x2a, 0x0b, 0x52, 0x8b, 0x93, 0xd8, 0xc0, 0xfe, 0x35,
-	0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0xfe, 0x24, 0xd4, 0x8c, 0x92, 0x01, 0x00, 0x00,
+	// 331 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x31, 0x4b, 0xf3, 0x40,
+	0x18, 0xc7, 0x93, 0xb7, 0x7d, 0x3b, 0x5c, 0x1d, 0xe4, 0xa8, 0x50, 0x0a, 0x9e, 0xa5, 0x45, 0x8c,
+	0x08, 0x17, 0xa8, 0xa3, 0x5b, 0x3a, 0xb8, 0x38, 0x48, 0x45, 0x07, 0x1d, 0xe4, 0x9a, 0x9e, 0x31,
+	0x90, 0xe4, 0x09, 0x97, 0x6b, 0xd5, 0x6f, 0xe1, 0x37, 0x72, 0xed, 0xd8, 0xd1, 0x49, 0x24, 0xf9,
+	0x22, 0x92, 0xcb, 0x05, 0x0d, 0x4d, 0x69, 0xa7, 0x3b, 0xc2, 0xef, 0xff, 0xbb, 0xff, 0x13, 0x1e,
+	0x34, 0x0c, 0xd8, 0x82, 0x45, 0x5c, 0xda, 0xf9, 0x69, 0xbb, 0x10, 0x3d, 0x05, 0xbe, 0x2b, 0x6d,
+	0x8f, 0x47, 0x3c, 0xf1, 0x13, 0x1a, 0x0b, 0x90, 0x80, 0x0f, 0x34, 0x44, 0xf3, 0x93, 0x96, 0x50,
+	0xaf, 0xe3, 0x81, 0x07, 0x8a, 0xb0, 0xf3, 0x5b, 0x01, 0xf7, 0x06, 0xf5, 0xc6, 0x98, 0x09, 0x16,
+	0x6a, 0x61, 0xef, 0xb4, 0x9e, 0x29, 0x2f, 0x8f, 0x0b, 0x90, 0x5c, 0xa3, 0x67, 0x5b, 0x50, 0xc1,
+	0x63, 0x10, 0x72, 0x67, 0xd8, 0x05, 0x31, 0x2b, 0xe0, 0xc1, 0x47, 0x03, 0xed, 0x5d, 0x16, 0x73,
+	0xde, 0x48, 0x26, 0x39, 0xbe, 0x40, 0xad, 0xa2, 0x65, 0xd7, 0xec, 0x9b, 0x56, 0x7b, 0x74, 0x48,
+	0x6b, 0xe7, 0xa6, 0xd7, 0x0a, 0x72, 0x9a, 0xcb, 0xaf, 0x23, 0x63, 0xa2, 0x23, 0xf8, 0x16, 0xed,
+	0x97, 0xc0, 0x1d, 0x48, 0x7e, 0xe5, 0x27, 0xb2, 0xfb, 0xaf, 0xdf, 0xb0, 0xda, 0xa3, 0xe1, 0x06,
+	0xcd, 0xf8, 0x0f, 0xae, 0x65, 0x6b, 0x0a, 0xfc, 0x80, 0x70, 0xf9, 0x6d, 0xa2, 0x26, 0x55, 0xe2,
+	0x86, 0x12, 0x1f, 0x6f, 0x11, 0x17, 0x01, 0xad, 0xae, 0xd1, 0x60, 0x86, 0x3a, 0x2e, 0x44, 0xc9,
+	0x3c, 0xe4, 0x62, 0x0c, 0x10, 0xcc, 0xe0, 0x25, 0x52, 0xfa, 0xa6, 0xd2, 0x9f, 0x6c, 0xd6, 0x57,
+	0x22, 0xfa, 0x81, 0x5a, 0x55, 0xb5, 0x7f, 0xfe, 0xf3, 0xd5, 0x03, 0xff, 0x77, 0xec, 0x9f, 0x07,
+	0xd6, 0xfb, 0x97, 0x1a, 0xc7, 0x59, 0xa6, 0xc4, 0x5c, 0xa5, 0xc4, 0xfc, 0x4e, 0x89, 0xf9, 0x9e,
+	0x11, 0x63, 0x95, 0x11, 0xe3, 0x33, 0x23, 0xc6, 0xbd, 0xe5, 0xf9, 0xf2, 0x79, 0x3e, 0xa5, 0x2e,
+	0x84, 0x76, 0x65, 0x27, 0x5e, 0x7f, 0xb7, 0x42, 0xbe, 0xc5, 0x3c, 0x99, 0xb6, 0xd4, 0x32, 0x9c,
+	0xff, 0x0c, 0x00, 0x45, 0x9f, 0xf7, 0x26, 0x09, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -123,6 +153,48 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ConflictRecordList) > 0 {
+		for iNdEx := len(m.ConflictRecordList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConflictRecordList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.ConsumerCooldownList) > 0 {
+		for iNdEx := len(m.ConsumerCooldownList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConsumerCooldownList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ConflictReportList) > 0 {
+		for iNdEx := len(m.ConflictReportList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConflictReportList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.ConflictVoteList) > 0 {
 		for iNdEx := len(m.ConflictVoteList) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -175,6 +247,24 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.ConflictReportList) > 0 {
+		for _, e := range m.ConflictReportList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ConsumerCooldownList) > 0 {
+		for _, e := range m.ConsumerCooldownList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ConflictRecordList) > 0 {
+		for _, e := range m.ConflictRecordList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -280,6 +370,108 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConflictReportList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConflictReportList = append(m.ConflictReportList, ConflictReport{})
+			if err := m.ConflictReportList[len(m.ConflictReportList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerCooldownList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerCooldownList = append(m.ConsumerCooldownList, ConsumerCooldown{})
+			if err := m.ConsumerCooldownList[len(m.ConsumerCooldownList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConflictRecordList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConflictRecordList = append(m.ConflictRecordList, ConflictRecord{})
+			if err := m.ConflictRecordList[len(m.ConflictRecordList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -0,0 +1,43 @@
+package types
+
+import "encoding/binary"
+
+const (
+	// ConflictRecordKeyPrefix is the prefix to retrieve all ConflictRecord
+	ConflictRecordKeyPrefix = "ConflictRecord/value/"
+
+	// ConflictRecordProviderIndexPrefix is the prefix of the index of ConflictRecord by the reported providers
+	ConflictRecordProviderIndexPrefix = "ConflictRecord/provider/"
+)
+
+// ConflictRecordPrefix returns the store prefix of all the ConflictRecord of a chain
+func ConflictRecordPrefix(chainID string) []byte {
+	return []byte(chainID + "/")
+}
+
+// ConflictRecordKey returns the store key to retrieve a ConflictRecord from the index fields
+// (the close block is big-endian so records are iterated in order)
+func ConflictRecordKey(chainID string, closeBlock uint64, voteID string) []byte {
+	key := ConflictRecordPrefix(chainID)
+	key = binary.BigEndian.AppendUint64(key, closeBlock)
+	key = append(key, []byte(voteID)...)
+	return key
+}
+
+// ConflictRecordProviderPrefix returns the provider index prefix of all the ConflictRecord of a
+// provider, or of a provider on a specific chain (when chainID is not empty)
+func ConflictRecordProviderPrefix(provider, chainID string) []byte {
+	key := []byte(provider + "/")
+	if chainID != "" {
+		key = append(key, []byte(chainID+"/")...)
+	}
+	return key
+}
+
+// ConflictRecordProviderKey returns the provider index key of a ConflictRecord
+func ConflictRecordProviderKey(provider, chainID string, closeBlock uint64, voteID string) []byte {
+	key := ConflictRecordProviderPrefix(provider, chainID)
+	key = binary.BigEndian.AppendUint64(key, closeBlock)
+	key = append(key, []byte(voteID)...)
+	return key
+}
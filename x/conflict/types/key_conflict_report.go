@@ -0,0 +1,24 @@
+package types
+
+const (
+	// ConflictReportKeyPrefix is the prefix to retrieve all ConflictReport
+	ConflictReportKeyPrefix = "ConflictReport/value/"
+
+	// ConsumerCooldownKeyPrefix is the prefix to retrieve all ConsumerCooldown
+	ConsumerCooldownKeyPrefix = "ConsumerCooldown/value/"
+)
+
+// ConflictReportPrefix returns the store prefix of all the ConflictReport of a consumer
+func ConflictReportPrefix(consumer string) []byte {
+	return []byte(consumer + "/")
+}
+
+// ConflictReportKey returns the store key to retrieve a ConflictReport from the index fields
+func ConflictReportKey(consumer, voteID string) []byte {
+	return append(ConflictReportPrefix(consumer), []byte(voteID+"/")...)
+}
+
+// ConsumerCooldownKey returns the store key to retrieve a ConsumerCooldown from the index fields
+func ConsumerCooldownKey(consumer string) []byte {
+	return []byte(consumer + "/")
+}
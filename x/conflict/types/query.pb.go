@@ -297,6 +297,230 @@ func (m *QueryAllConflictVoteResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+type QueryConsumerReportsRequest struct {
+	Consumer   string             `protobuf:"bytes,1,opt,name=consumer,proto3" json:"consumer,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryConsumerReportsRequest) Reset()         { *m = QueryConsumerReportsRequest{} }
+func (m *QueryConsumerReportsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryConsumerReportsRequest) ProtoMessage()    {}
+func (*QueryConsumerReportsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1179eb365bacd460, []int{6}
+}
+func (m *QueryConsumerReportsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsumerReportsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsumerReportsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryConsumerReportsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsumerReportsRequest.Merge(m, src)
+}
+func (m *QueryConsumerReportsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsumerReportsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsumerReportsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConsumerReportsRequest proto.InternalMessageInfo
+
+func (m *QueryConsumerReportsRequest) GetConsumer() string {
+	if m != nil {
+		return m.Consumer
+	}
+	return ""
+}
+
+func (m *QueryConsumerReportsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryConsumerReportsResponse struct {
+	Reports            []ConflictReport    `protobuf:"bytes,1,rep,name=reports,proto3" json:"reports"`
+	CooldownUntilBlock uint64              `protobuf:"varint,2,opt,name=cooldown_until_block,json=cooldownUntilBlock,proto3" json:"cooldown_until_block,omitempty"`
+	Pagination         *query.PageResponse `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryConsumerReportsResponse) Reset()         { *m = QueryConsumerReportsResponse{} }
+func (m *QueryConsumerReportsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryConsumerReportsResponse) ProtoMessage()    {}
+func (*QueryConsumerReportsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1179eb365bacd460, []int{7}
+}
+func (m *QueryConsumerReportsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsumerReportsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsumerReportsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryConsumerReportsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsumerReportsResponse.Merge(m, src)
+}
+func (m *QueryConsumerReportsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsumerReportsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsumerReportsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConsumerReportsResponse proto.InternalMessageInfo
+
+func (m *QueryConsumerReportsResponse) GetReports() []ConflictReport {
+	if m != nil {
+		return m.Reports
+	}
+	return nil
+}
+
+func (m *QueryConsumerReportsResponse) GetCooldownUntilBlock() uint64 {
+	if m != nil {
+		return m.CooldownUntilBlock
+	}
+	return 0
+}
+
+func (m *QueryConsumerReportsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryConflictRecordsRequest struct {
+	Provider   string             `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	ChainID    string             `protobuf:"bytes,2,opt,name=chainID,proto3" json:"chainID,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryConflictRecordsRequest) Reset()         { *m = QueryConflictRecordsRequest{} }
+func (m *QueryConflictRecordsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryConflictRecordsRequest) ProtoMessage()    {}
+func (*QueryConflictRecordsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1179eb365bacd460, []int{8}
+}
+func (m *QueryConflictRecordsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConflictRecordsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConflictRecordsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryConflictRecordsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConflictRecordsRequest.Merge(m, src)
+}
+func (m *QueryConflictRecordsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConflictRecordsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConflictRecordsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConflictRecordsRequest proto.InternalMessageInfo
+
+func (m *QueryConflictRecordsRequest) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
+}
+
+func (m *QueryConflictRecordsRequest) GetChainID() string {
+	if m != nil {
+		return m.ChainID
+	}
+	return ""
+}
+
+func (m *QueryConflictRecordsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryConflictRecordsResponse struct {
+	Records    []ConflictRecord    `protobuf:"bytes,1,rep,name=records,proto3" json:"records"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryConflictRecordsResponse) Reset()         { *m = QueryConflictRecordsResponse{} }
+func (m *QueryConflictRecordsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryConflictRecordsResponse) ProtoMessage()    {}
+func (*QueryConflictRecordsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1179eb365bacd460, []int{9}
+}
+func (m *QueryConflictRecordsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConflictRecordsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConflictRecordsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryConflictRecordsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConflictRecordsResponse.Merge(m, src)
+}
+func (m *QueryConflictRecordsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConflictRecordsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConflictRecordsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConflictRecordsResponse proto.InternalMessageInfo
+
+func (m *QueryConflictRecordsResponse) GetRecords() []ConflictRecord {
+	if m != nil {
+		return m.Records
+	}
+	return nil
+}
+
+func (m *QueryConflictRecordsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "lavanet.lava.conflict.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "lavanet.lava.conflict.QueryParamsResponse")
@@ -304,44 +528,63 @@ func init() {
 	proto.RegisterType((*QueryGetConflictVoteResponse)(nil), "lavanet.lava.conflict.QueryGetConflictVoteResponse")
 	proto.RegisterType((*QueryAllConflictVoteRequest)(nil), "lavanet.lava.conflict.QueryAllConflictVoteRequest")
 	proto.RegisterType((*QueryAllConflictVoteResponse)(nil), "lavanet.lava.conflict.QueryAllConflictVoteResponse")
+	proto.RegisterType((*QueryConsumerReportsRequest)(nil), "lavanet.lava.conflict.QueryConsumerReportsRequest")
+	proto.RegisterType((*QueryConsumerReportsResponse)(nil), "lavanet.lava.conflict.QueryConsumerReportsResponse")
+	proto.RegisterType((*QueryConflictRecordsRequest)(nil), "lavanet.lava.conflict.QueryConflictRecordsRequest")
+	proto.RegisterType((*QueryConflictRecordsResponse)(nil), "lavanet.lava.conflict.QueryConflictRecordsResponse")
 }
 
 func init() { proto.RegisterFile("lavanet/lava/conflict/query.proto", fileDescriptor_1179eb365bacd460) }
 
 var fileDescriptor_1179eb365bacd460 = []byte{
-	// 505 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0x41, 0x6b, 0x14, 0x31,
-	0x18, 0x86, 0x37, 0xad, 0x5d, 0x30, 0x16, 0x84, 0xb8, 0x82, 0xac, 0xed, 0x54, 0xa3, 0xd6, 0xb6,
-	0x94, 0x84, 0xee, 0x7a, 0xf3, 0xd4, 0x15, 0xec, 0x49, 0xa8, 0x73, 0xf0, 0xe0, 0x45, 0x32, 0x63,
-	0x1c, 0x07, 0x66, 0x92, 0xe9, 0x26, 0xbb, 0xb4, 0x88, 0x17, 0x0f, 0x9e, 0x05, 0xff, 0x84, 0x17,
-	0xaf, 0xfe, 0x86, 0x1e, 0x0b, 0x5e, 0x3c, 0x89, 0xec, 0x7a, 0xf3, 0x4f, 0xc8, 0x24, 0x19, 0x3a,
-	0x43, 0x67, 0xc6, 0x95, 0x9e, 0x26, 0x1b, 0xde, 0xf7, 0xfd, 0x9e, 0xe4, 0xfb, 0xb2, 0xf0, 0x6e,
-	0xc2, 0xa6, 0x4c, 0x70, 0x4d, 0xf3, 0x2f, 0x0d, 0xa5, 0x78, 0x93, 0xc4, 0xa1, 0xa6, 0x47, 0x13,
-	0x3e, 0x3e, 0x21, 0xd9, 0x58, 0x6a, 0x89, 0x6e, 0x3a, 0x09, 0xc9, 0xbf, 0xa4, 0x90, 0xf4, 0xd7,
-	0x22, 0x29, 0xa3, 0x84, 0x53, 0x96, 0xc5, 0x94, 0x09, 0x21, 0x35, 0xd3, 0xb1, 0x14, 0xca, 0x9a,
-	0xfa, 0x3b, 0xa1, 0x54, 0xa9, 0x54, 0x34, 0x60, 0x8a, 0xdb, 0x34, 0x3a, 0xdd, 0x0b, 0xb8, 0x66,
-	0x7b, 0x34, 0x63, 0x51, 0x2c, 0x8c, 0xd8, 0x69, 0x71, 0x3d, 0x43, 0xc6, 0xc6, 0x2c, 0x2d, 0xf2,
-	0xb6, 0xeb, 0x35, 0xc5, 0xe2, 0xd5, 0x54, 0x6a, 0xee, 0xa4, 0xbd, 0x48, 0x46, 0xd2, 0x2c, 0x69,
-	0xbe, 0xb2, 0xbb, 0xb8, 0x07, 0xd1, 0xf3, 0x1c, 0xe3, 0xd0, 0xa4, 0xfa, 0xfc, 0x68, 0xc2, 0x95,
-	0xc6, 0x3e, 0xbc, 0x51, 0xd9, 0x55, 0x99, 0x14, 0x8a, 0xa3, 0xc7, 0xb0, 0x6b, 0xab, 0xdf, 0x02,
-	0x77, 0xc0, 0xd6, 0xb5, 0xc1, 0x3a, 0xa9, 0xbd, 0x03, 0x62, 0x6d, 0xa3, 0x2b, 0xa7, 0x3f, 0x37,
-	0x3a, 0xbe, 0xb3, 0xe0, 0x21, 0xbc, 0x6d, 0x32, 0x0f, 0xb8, 0x7e, 0xe2, 0x84, 0x2f, 0xa4, 0xe6,
-	0xae, 0x24, 0xea, 0xc1, 0x95, 0x58, 0xbc, 0xe6, 0xc7, 0x26, 0xfa, 0xaa, 0x6f, 0x7f, 0xe0, 0x14,
-	0xae, 0xd5, 0x9b, 0x1c, 0xd1, 0x33, 0xb8, 0x1a, 0x96, 0xf6, 0x1d, 0xd7, 0xbd, 0x06, 0xae, 0x72,
-	0x84, 0xa3, 0xab, 0xd8, 0x31, 0x77, 0x8c, 0xfb, 0x49, 0x52, 0xc7, 0xf8, 0x14, 0xc2, 0xf3, 0x2e,
-	0xb9, 0x5a, 0x9b, 0xc4, 0xb6, 0x94, 0xe4, 0x2d, 0x25, 0x76, 0x40, 0x5c, 0x4b, 0xc9, 0x21, 0x8b,
-	0x0a, 0xaf, 0x5f, 0x72, 0xe2, 0x6f, 0xc0, 0x1d, 0xeb, 0x42, 0x9d, 0xc6, 0x63, 0x2d, 0x5f, 0xe2,
-	0x58, 0xe8, 0xa0, 0xc2, 0xbd, 0x64, 0xb8, 0x1f, 0xfe, 0x93, 0xdb, 0xb2, 0x94, 0xc1, 0x07, 0x7f,
-	0x96, 0xe1, 0x8a, 0x01, 0x47, 0x1f, 0x01, 0xec, 0xda, 0x36, 0xa3, 0xed, 0x06, 0xac, 0x8b, 0x73,
-	0xd5, 0xdf, 0x59, 0x44, 0x6a, 0xeb, 0xe2, 0x07, 0x1f, 0xbe, 0xff, 0xfe, 0xbc, 0xb4, 0x81, 0xd6,
-	0x69, 0xdb, 0x3b, 0x40, 0x5f, 0x01, 0x5c, 0x2d, 0x5f, 0x00, 0x1a, 0xb4, 0xd5, 0xa8, 0x1f, 0xbe,
-	0xfe, 0xf0, 0xbf, 0x3c, 0x0e, 0xf0, 0x91, 0x01, 0x24, 0x68, 0x97, 0x2e, 0xf0, 0x08, 0xe9, 0x3b,
-	0x33, 0xd0, 0xef, 0xd1, 0x17, 0x00, 0xaf, 0x97, 0xe3, 0xf6, 0x93, 0xa4, 0x1d, 0xb9, 0x7e, 0x16,
-	0xdb, 0x91, 0x1b, 0xe6, 0x0a, 0xef, 0x1a, 0xe4, 0x4d, 0x74, 0x7f, 0x11, 0xe4, 0xd1, 0xe8, 0x74,
-	0xe6, 0x81, 0xb3, 0x99, 0x07, 0x7e, 0xcd, 0x3c, 0xf0, 0x69, 0xee, 0x75, 0xce, 0xe6, 0x5e, 0xe7,
-	0xc7, 0xdc, 0xeb, 0xbc, 0xdc, 0x8a, 0x62, 0xfd, 0x76, 0x12, 0x90, 0x50, 0xa6, 0xd5, 0xa4, 0xe3,
-	0xf3, 0x2c, 0x7d, 0x92, 0x71, 0x15, 0x74, 0xcd, 0xdf, 0xcc, 0xf0, 0x6f, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0x90, 0x50, 0xda, 0xeb, 0x51, 0x05, 0x00, 0x00,
+	// 737 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0x4f, 0x4f, 0xd4, 0x4e,
+	0x18, 0xc7, 0xb7, 0xfc, 0x59, 0x7e, 0xcc, 0x8f, 0xc4, 0x64, 0x5c, 0x13, 0x52, 0x61, 0xd1, 0x2a,
+	0x7f, 0x25, 0x1d, 0xd9, 0xe5, 0xa4, 0x27, 0x16, 0x85, 0x78, 0x30, 0xc1, 0x26, 0x7a, 0xf0, 0x42,
+	0xba, 0xdd, 0xb1, 0x34, 0x76, 0x3b, 0xa5, 0x9d, 0x5d, 0x21, 0x84, 0x83, 0x1e, 0x3c, 0x9b, 0x78,
+	0xf4, 0x0d, 0x98, 0x18, 0x3d, 0xf2, 0x1a, 0x38, 0x12, 0xbd, 0x78, 0x32, 0x04, 0x7c, 0x21, 0xa6,
+	0x33, 0x4f, 0xdd, 0xae, 0xcc, 0xfe, 0x43, 0x4f, 0xed, 0xcc, 0x3c, 0xdf, 0x67, 0x3e, 0xf3, 0x9d,
+	0x99, 0xa7, 0x45, 0x37, 0x7d, 0xbb, 0x69, 0x07, 0x94, 0x93, 0xe4, 0x49, 0x1c, 0x16, 0xbc, 0xf0,
+	0x3d, 0x87, 0x93, 0xdd, 0x06, 0x8d, 0xf6, 0xcd, 0x30, 0x62, 0x9c, 0xe1, 0x6b, 0x10, 0x62, 0x26,
+	0x4f, 0x33, 0x0d, 0xd1, 0xa7, 0x5c, 0xc6, 0x5c, 0x9f, 0x12, 0x3b, 0xf4, 0x88, 0x1d, 0x04, 0x8c,
+	0xdb, 0xdc, 0x63, 0x41, 0x2c, 0x45, 0xfa, 0x92, 0xc3, 0xe2, 0x3a, 0x8b, 0x49, 0xd5, 0x8e, 0xa9,
+	0xcc, 0x46, 0x9a, 0x2b, 0x55, 0xca, 0xed, 0x15, 0x12, 0xda, 0xae, 0x17, 0x88, 0x60, 0x88, 0x35,
+	0xd4, 0x0c, 0xa1, 0x1d, 0xd9, 0xf5, 0x34, 0xdf, 0xa2, 0x3a, 0x26, 0x7d, 0xd9, 0x6e, 0x32, 0x4e,
+	0x21, 0xf4, 0x4e, 0x8f, 0xd0, 0x88, 0x86, 0x2c, 0xe2, 0x7d, 0x07, 0x3b, 0x2c, 0xaa, 0x41, 0x70,
+	0xc1, 0x65, 0x2e, 0x13, 0xaf, 0x24, 0x79, 0x93, 0xbd, 0x46, 0x01, 0xe1, 0x27, 0xc9, 0x02, 0xb7,
+	0x04, 0xaf, 0x45, 0x77, 0x1b, 0x34, 0xe6, 0x86, 0x85, 0xae, 0xb6, 0xf5, 0xc6, 0x21, 0x0b, 0x62,
+	0x8a, 0xef, 0xa3, 0xbc, 0x5c, 0xd7, 0xa4, 0x76, 0x43, 0x5b, 0xf8, 0xbf, 0x34, 0x6d, 0x2a, 0xdd,
+	0x35, 0xa5, 0xac, 0x32, 0x72, 0xfc, 0x63, 0x26, 0x67, 0x81, 0xc4, 0x28, 0xa3, 0xeb, 0x22, 0xe7,
+	0x26, 0xe5, 0xeb, 0x10, 0xf8, 0x8c, 0x71, 0x0a, 0x53, 0xe2, 0x02, 0x1a, 0xf5, 0x82, 0x1a, 0xdd,
+	0x13, 0xa9, 0xc7, 0x2d, 0xd9, 0x30, 0xea, 0x68, 0x4a, 0x2d, 0x02, 0xa2, 0xc7, 0x68, 0xc2, 0xc9,
+	0xf4, 0x03, 0xd7, 0xad, 0x0e, 0x5c, 0xd9, 0x14, 0x40, 0xd7, 0x26, 0x37, 0x28, 0x30, 0xae, 0xf9,
+	0xbe, 0x8a, 0x71, 0x03, 0xa1, 0xd6, 0xfe, 0xc3, 0x5c, 0x73, 0xa6, 0x3c, 0x2c, 0x66, 0x72, 0x58,
+	0x4c, 0x79, 0xf4, 0xe0, 0xb0, 0x98, 0x5b, 0xb6, 0x9b, 0x6a, 0xad, 0x8c, 0xd2, 0x38, 0xd2, 0xd0,
+	0x94, 0x7a, 0x9e, 0x8e, 0xcb, 0x1a, 0xfe, 0x8b, 0x65, 0xe1, 0xcd, 0x36, 0xee, 0x21, 0xc1, 0x3d,
+	0xdf, 0x93, 0x5b, 0xb2, 0xb4, 0x81, 0xbf, 0xd6, 0xc0, 0xa0, 0x75, 0x16, 0xc4, 0x8d, 0x3a, 0x8d,
+	0x2c, 0x71, 0x1c, 0xd3, 0x73, 0x83, 0x75, 0xf4, 0x9f, 0x03, 0x23, 0xb0, 0x8f, 0xbf, 0xdb, 0x78,
+	0x43, 0x01, 0x71, 0x19, 0xf3, 0x4e, 0x53, 0xf3, 0x2e, 0x30, 0x80, 0x79, 0x0f, 0xd1, 0x98, 0xbc,
+	0x25, 0x31, 0xf8, 0x36, 0xdb, 0xc3, 0x37, 0x99, 0x00, 0x9c, 0x4b, 0xb5, 0xf8, 0x2e, 0x2a, 0x38,
+	0x8c, 0xf9, 0x35, 0xf6, 0x2a, 0xd8, 0x6e, 0x04, 0xdc, 0xf3, 0xb7, 0xab, 0x3e, 0x73, 0x5e, 0x0a,
+	0xf2, 0x11, 0x0b, 0xa7, 0x63, 0x4f, 0x93, 0xa1, 0x4a, 0x32, 0xf2, 0x87, 0xcd, 0xc3, 0x97, 0xb7,
+	0xf9, 0x43, 0xc6, 0x66, 0x20, 0x4c, 0x2e, 0x72, 0xd6, 0xe6, 0x30, 0x62, 0x4d, 0xaf, 0xd6, 0xb2,
+	0x39, 0x6d, 0xe3, 0x49, 0x34, 0xe6, 0xec, 0xd8, 0x5e, 0xf0, 0xe8, 0x81, 0x20, 0x1d, 0xb7, 0xd2,
+	0x26, 0xde, 0x50, 0xe0, 0x5d, 0x66, 0x03, 0xbe, 0x64, 0x36, 0xa0, 0x9d, 0x2e, 0xbb, 0x01, 0xa2,
+	0xab, 0xef, 0x0d, 0x48, 0xa2, 0x5b, 0x1b, 0x20, 0xb4, 0xff, 0xec, 0xd4, 0x96, 0xbe, 0xe6, 0xd1,
+	0xa8, 0x00, 0xc6, 0x6f, 0x35, 0x94, 0x97, 0xc5, 0x09, 0x2f, 0x76, 0x60, 0xba, 0x58, 0x0d, 0xf5,
+	0xa5, 0x7e, 0x42, 0xe5, 0xbc, 0xc6, 0xec, 0x9b, 0x6f, 0x3f, 0xdf, 0x0f, 0xcd, 0xe0, 0x69, 0xd2,
+	0xed, 0xbb, 0x80, 0x3f, 0x6b, 0x68, 0x22, 0x7b, 0x6d, 0x71, 0xa9, 0xdb, 0x1c, 0xea, 0x92, 0xa9,
+	0x97, 0x07, 0xd2, 0x00, 0xe0, 0xaa, 0x00, 0x34, 0xf1, 0x32, 0xe9, 0xe3, 0xa3, 0x44, 0x0e, 0x44,
+	0x19, 0x3e, 0xc4, 0x1f, 0x35, 0x74, 0x25, 0x9b, 0x6e, 0xcd, 0xf7, 0xbb, 0x23, 0xab, 0x2b, 0xa8,
+	0x5e, 0x1e, 0x48, 0x03, 0xc8, 0xcb, 0x02, 0x79, 0x0e, 0xdf, 0xee, 0x07, 0x19, 0x1f, 0x49, 0xd4,
+	0x6c, 0x69, 0xe8, 0x8e, 0xaa, 0xae, 0x65, 0x7a, 0x79, 0x20, 0x0d, 0xa0, 0xde, 0x13, 0xa8, 0xab,
+	0xb8, 0xd4, 0x19, 0x55, 0xe8, 0xe0, 0x3b, 0x1e, 0x93, 0x83, 0xb4, 0xe7, 0x10, 0x7f, 0xca, 0x78,
+	0x0c, 0x57, 0xaa, 0x27, 0xb8, 0xa2, 0x3a, 0xe8, 0xe5, 0x81, 0x34, 0x00, 0x4e, 0x04, 0xf8, 0x22,
+	0x9e, 0x27, 0x7d, 0xfd, 0x53, 0xc4, 0x95, 0xca, 0xf1, 0x59, 0x51, 0x3b, 0x39, 0x2b, 0x6a, 0xa7,
+	0x67, 0x45, 0xed, 0xdd, 0x79, 0x31, 0x77, 0x72, 0x5e, 0xcc, 0x7d, 0x3f, 0x2f, 0xe6, 0x9e, 0x2f,
+	0xb8, 0x1e, 0xdf, 0x69, 0x54, 0x4d, 0x87, 0xd5, 0xdb, 0x93, 0xed, 0xb5, 0xd2, 0xf1, 0xfd, 0x90,
+	0xc6, 0xd5, 0xbc, 0xf8, 0x07, 0x29, 0xff, 0x1a, 0x00, 0x44, 0x4b, 0x0b, 0x64, 0xc8, 0x09, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -362,6 +605,10 @@ type QueryClient interface {
 	ConflictVote(ctx context.Context, in *QueryGetConflictVoteRequest, opts ...grpc.CallOption) (*QueryGetConflictVoteResponse, error)
 	// Queries a list of ConflictVote items.
 	ConflictVoteAll(ctx context.Context, in *QueryAllConflictVoteRequest, opts ...grpc.CallOption) (*QueryAllConflictVoteResponse, error)
+	// Queries the conflict reports of a consumer and their outcomes.
+	ConsumerReports(ctx context.Context, in *QueryConsumerReportsRequest, opts ...grpc.CallOption) (*QueryConsumerReportsResponse, error)
+	// Queries the records of closed conflict votes of a provider (as a reported provider) and/or of a chain.
+	ConflictRecords(ctx context.Context, in *QueryConflictRecordsRequest, opts ...grpc.CallOption) (*QueryConflictRecordsResponse, error)
 }
 
 type queryClient struct {
@@ -399,6 +646,24 @@ func (c *queryClient) ConflictVoteAll(ctx context.Context, in *QueryAllConflictV
 	return out, nil
 }
 
+func (c *queryClient) ConsumerReports(ctx context.Context, in *QueryConsumerReportsRequest, opts ...grpc.CallOption) (*QueryConsumerReportsResponse, error) {
+	out := new(QueryConsumerReportsResponse)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.conflict.Query/ConsumerReports", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ConflictRecords(ctx context.Context, in *QueryConflictRecordsRequest, opts ...grpc.CallOption) (*QueryConflictRecordsResponse, error) {
+	out := new(QueryConflictRecordsResponse)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.conflict.Query/ConflictRecords", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Parameters queries the parameters of the module.
@@ -407,6 +672,10 @@ type QueryServer interface {
 	ConflictVote(context.Context, *QueryGetConflictVoteRequest) (*QueryGetConflictVoteResponse, error)
 	// Queries a list of ConflictVote items.
 	ConflictVoteAll(context.Context, *QueryAllConflictVoteRequest) (*QueryAllConflictVoteResponse, error)
+	// Queries the conflict reports of a consumer and their outcomes.
+	ConsumerReports(context.Context, *QueryConsumerReportsRequest) (*QueryConsumerReportsResponse, error)
+	// Queries the records of closed conflict votes of a provider (as a reported provider) and/or of a chain.
+	ConflictRecords(context.Context, *QueryConflictRecordsRequest) (*QueryConflictRecordsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -422,6 +691,12 @@ func (*UnimplementedQueryServer) ConflictVote(ctx context.Context, req *QueryGet
 func (*UnimplementedQueryServer) ConflictVoteAll(ctx context.Context, req *QueryAllConflictVoteRequest) (*QueryAllConflictVoteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ConflictVoteAll not implemented")
 }
+func (*UnimplementedQueryServer) ConsumerReports(ctx context.Context, req *QueryConsumerReportsRequest) (*QueryConsumerReportsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConsumerReports not implemented")
+}
+func (*UnimplementedQueryServer) ConflictRecords(ctx context.Context, req *QueryConflictRecordsRequest) (*QueryConflictRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConflictRecords not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -481,6 +756,42 @@ func _Query_ConflictVoteAll_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ConsumerReports_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConsumerReportsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ConsumerReports(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.conflict.Query/ConsumerReports",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ConsumerReports(ctx, req.(*QueryConsumerReportsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ConflictRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConflictRecordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ConflictRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.conflict.Query/ConflictRecords",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ConflictRecords(ctx, req.(*QueryConflictRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "lavanet.lava.conflict.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -497,6 +808,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ConflictVoteAll",
 			Handler:    _Query_ConflictVoteAll_Handler,
 		},
+		{
+			MethodName: "ConsumerReports",
+			Handler:    _Query_ConsumerReports_Handler,
+		},
+		{
+			MethodName: "ConflictRecords",
+			Handler:    _Query_ConflictRecords_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "lavanet/lava/conflict/query.proto",
@@ -705,63 +1024,257 @@ func (m *QueryAllConflictVoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryConsumerReportsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryConsumerReportsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetConflictVoteRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryConsumerReportsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Index)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Consumer) > 0 {
+		i -= len(m.Consumer)
+		copy(dAtA[i:], m.Consumer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Consumer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetConflictVoteResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryConsumerReportsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.ConflictVote.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryAllConflictVoteRequest) Size() (n int) {
-	if m == nil {
+func (m *QueryConsumerReportsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerReportsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.CooldownUntilBlock != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CooldownUntilBlock))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Reports) > 0 {
+		for iNdEx := len(m.Reports) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Reports[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConflictRecordsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConflictRecordsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConflictRecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConflictRecordsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConflictRecordsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConflictRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetConflictVoteRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetConflictVoteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ConflictVote.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllConflictVoteRequest) Size() (n int) {
+	if m == nil {
 		return 0
 	}
 	var l int
@@ -792,13 +1305,390 @@ func (m *QueryAllConflictVoteResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryConsumerReportsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Consumer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryConsumerReportsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Reports) > 0 {
+		for _, e := range m.Reports {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.CooldownUntilBlock != 0 {
+		n += 1 + sovQuery(uint64(m.CooldownUntilBlock))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryConflictRecordsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryConflictRecordsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
 func sozQuery(x uint64) (n int) {
 	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetConflictVoteRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetConflictVoteRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetConflictVoteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetConflictVoteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetConflictVoteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetConflictVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConflictVote", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ConflictVote.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllConflictVoteRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -821,12 +1711,48 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllConflictVoteRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllConflictVoteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -848,7 +1774,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllConflictVoteResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -871,15 +1797,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllConflictVoteResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllConflictVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConflictVote", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -906,7 +1832,44 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ConflictVote = append(m.ConflictVote, ConflictVote{})
+			if err := m.ConflictVote[len(m.ConflictVote)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -931,7 +1894,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetConflictVoteRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryConsumerReportsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -954,15 +1917,15 @@ func (m *QueryGetConflictVoteRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetConflictVoteRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryConsumerReportsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetConflictVoteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryConsumerReportsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Consumer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -990,7 +1953,43 @@ func (m *QueryGetConflictVoteRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Index = string(dAtA[iNdEx:postIndex])
+			m.Consumer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1013,7 +2012,7 @@ func (m *QueryGetConflictVoteRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetConflictVoteResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryConsumerReportsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1036,15 +2035,15 @@ func (m *QueryGetConflictVoteResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetConflictVoteResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryConsumerReportsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetConflictVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryConsumerReportsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConflictVote", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reports", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1071,7 +2070,63 @@ func (m *QueryGetConflictVoteResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ConflictVote.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Reports = append(m.Reports, ConflictReport{})
+			if err := m.Reports[len(m.Reports)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CooldownUntilBlock", wireType)
+			}
+			m.CooldownUntilBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CooldownUntilBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1096,7 +2151,7 @@ func (m *QueryGetConflictVoteResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllConflictVoteRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryConflictRecordsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1119,13 +2174,77 @@ func (m *QueryAllConflictVoteRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllConflictVoteRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryConflictRecordsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllConflictVoteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryConflictRecordsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -1182,7 +2301,7 @@ func (m *QueryAllConflictVoteRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllConflictVoteResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryConflictRecordsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1205,15 +2324,15 @@ func (m *QueryAllConflictVoteResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllConflictVoteResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryConflictRecordsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllConflictVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryConflictRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConflictVote", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1240,8 +2359,8 @@ func (m *QueryAllConflictVoteResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConflictVote = append(m.ConflictVote, ConflictVote{})
-			if err := m.ConflictVote[len(m.ConflictVote)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Records = append(m.Records, ConflictRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
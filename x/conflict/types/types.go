@@ -30,6 +30,17 @@ const (
 	ConflictUnstakeFraudVoterEventName = "conflict_unstake_fraud_voter"
 )
 
+// conflict report status
+const (
+	ReportStatusOpen       = "open"
+	ReportStatusResolved   = "resolved"
+	ReportStatusRejected   = "rejected"
+	ReportStatusUnresolved = "unresolved"
+)
+
+// number of epochs a consumer can't report conflicts after a report that its vote rejected
+const DETECTION_COOLDOWN_EPOCHS uint64 = 10
+
 // unstake description
 const (
 	UnstakeDescriptionFraudVote = "fraud provider found in conflict detection"
@@ -22,6 +22,8 @@ import (
 	"fmt"
 	"strings"
 
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/lavanet/lava/utils"
@@ -208,7 +210,7 @@ func (k Keeper) increaseStakeEntryDelegation(ctx sdk.Context, provider, chainID
 		)
 	}
 
-	stakeEntry, exists, index := k.epochstorageKeeper.GetStakeEntryByAddressCurrent(ctx, chainID, providerAddr)
+	stakeEntry, exists := k.epochstorageKeeper.GetStakeEntryByAddressCurrent(ctx, chainID, providerAddr)
 	if !exists {
 		return types.ErrProviderNotStaked
 	}
@@ -223,7 +225,7 @@ func (k Keeper) increaseStakeEntryDelegation(ctx sdk.Context, provider, chainID
 
 	stakeEntry.DelegateTotal = stakeEntry.DelegateTotal.Add(amount)
 
-	k.epochstorageKeeper.ModifyStakeEntryCurrent(ctx, chainID, stakeEntry, index)
+	k.epochstorageKeeper.ModifyStakeEntryCurrent(ctx, chainID, stakeEntry)
 
 	return nil
 }
@@ -238,7 +240,7 @@ func (k Keeper) decreaseStakeEntryDelegation(ctx sdk.Context, provider, chainID
 		)
 	}
 
-	stakeEntry, exists, index := k.epochstorageKeeper.GetStakeEntryByAddressCurrent(ctx, chainID, providerAddr)
+	stakeEntry, exists := k.epochstorageKeeper.GetStakeEntryByAddressCurrent(ctx, chainID, providerAddr)
 	if !exists {
 		return nil
 	}
@@ -256,7 +258,7 @@ func (k Keeper) decreaseStakeEntryDelegation(ctx sdk.Context, provider, chainID
 		return fmt.Errorf("invalid or insufficient funds: %w", err)
 	}
 
-	k.epochstorageKeeper.ModifyStakeEntryCurrent(ctx, chainID, stakeEntry, index)
+	k.epochstorageKeeper.ModifyStakeEntryCurrent(ctx, chainID, stakeEntry)
 
 	return nil
 }
@@ -429,6 +431,205 @@ func (k Keeper) Unbond(ctx sdk.Context, delegator, provider, chainID string, amo
 	return nil
 }
 
+// SetAutoCompound lets a delegator enable (or disable) the automatic re-delegation of
+// the rewards of its delegation to a provider (see AutoCompoundRewards).
+// (effective on next epoch)
+func (k Keeper) SetAutoCompound(ctx sdk.Context, delegator, provider, chainID string, enable bool) error {
+	nextEpoch, err := k.getNextEpoch(ctx)
+	if err != nil {
+		return err
+	}
+
+	var delegationEntry types.Delegation
+	index := types.DelegationKey(provider, delegator, chainID)
+	found := k.delegationFS.FindEntry(ctx, index, nextEpoch, &delegationEntry)
+	if !found {
+		return utils.LavaFormatWarning("cannot set auto compound of delegation", types.ErrDelegationNotFound,
+			utils.Attribute{Key: "delegator", Value: delegator},
+			utils.Attribute{Key: "provider", Value: provider},
+			utils.Attribute{Key: "chainID", Value: chainID},
+		)
+	}
+
+	if delegationEntry.AutoCompound == enable {
+		return nil
+	}
+
+	delegationEntry.AutoCompound = enable
+
+	err = k.delegationFS.AppendEntry(ctx, index, nextEpoch, &delegationEntry)
+	if err != nil {
+		// append should never fail here
+		return utils.LavaFormatError("critical: append delegation entry", err,
+			utils.Attribute{Key: "delegator", Value: delegator},
+			utils.Attribute{Key: "provider", Value: provider},
+			utils.Attribute{Key: "chainID", Value: chainID},
+		)
+	}
+
+	if enable {
+		k.setAutoCompoundIndex(ctx, index)
+	} else {
+		k.removeAutoCompoundIndex(ctx, index)
+	}
+
+	return nil
+}
+
+// The auto compound index keeps the keys of the delegations that enabled auto compounding, so
+// AutoCompoundRewards doesn't go over all the delegator rewards. It may keep keys of delegations
+// that were since removed (e.g. fully unbonded): those are dropped by AutoCompoundRewards.
+
+func (k Keeper) autoCompoundIndexStore(ctx sdk.Context) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AutoCompoundPrefix))
+}
+
+func (k Keeper) setAutoCompoundIndex(ctx sdk.Context, index string) {
+	store := k.autoCompoundIndexStore(ctx)
+	store.Set([]byte(index), []byte{})
+}
+
+func (k Keeper) removeAutoCompoundIndex(ctx sdk.Context, index string) {
+	store := k.autoCompoundIndexStore(ctx)
+	store.Delete([]byte(index))
+}
+
+// getAllAutoCompoundIndices returns the keys of the delegations in the auto compound index
+func (k Keeper) getAllAutoCompoundIndices(ctx sdk.Context) (indices []string) {
+	store := k.autoCompoundIndexStore(ctx)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		indices = append(indices, string(iterator.Key()))
+	}
+
+	return indices
+}
+
+// indexAutoCompoundDelegations builds the auto compound index from the latest version of
+// each delegation (used when importing delegations from genesis)
+func (k Keeper) indexAutoCompoundDelegations(ctx sdk.Context) {
+	for _, index := range k.delegationFS.GetAllEntryIndices(ctx) {
+		blocks := k.delegationFS.GetAllEntryVersions(ctx, index)
+		if len(blocks) == 0 {
+			continue
+		}
+		var delegation types.Delegation
+		found := k.delegationFS.FindEntry(ctx, index, blocks[len(blocks)-1], &delegation)
+		if found && delegation.AutoCompound {
+			k.setAutoCompoundIndex(ctx, index)
+		}
+	}
+}
+
+// SlashDelegations slashes the given percentage of all the delegations to a provider
+// on a given chain, and moves the slashed coins from the bonded pool to the recipient
+// module. It returns the total amount slashed, which is always the amount that was
+// actually deducted from the delegations and moved (also when an error is returned).
+// Delegations that are unbonding are not slashed: their coins already moved to the
+// not-bonded pool, and the unbonding timers are keyed by expiry block and can't be
+// looked up by provider.
+// (effective on next epoch)
+func (k Keeper) SlashDelegations(ctx sdk.Context, provider, chainID string, percentage sdk.Dec, recipientModule string) (math.Int, error) {
+	total := math.ZeroInt()
+
+	nextEpoch, err := k.getNextEpoch(ctx)
+	if err != nil {
+		return total, err
+	}
+
+	delegations, err := k.GetProviderDelegators(ctx, provider, nextEpoch)
+	if err != nil {
+		return total, err
+	}
+
+	// compute and validate all the slashes before changing any state
+	var slashes []types.Delegation
+	expected := math.ZeroInt()
+	for _, delegation := range delegations {
+		if delegation.ChainID != chainID {
+			continue
+		}
+
+		slashed := sdk.NewCoin(delegation.Amount.Denom, percentage.MulInt(delegation.Amount.Amount).TruncateInt())
+		if slashed.IsZero() {
+			continue
+		}
+
+		delegation.Amount = slashed
+		slashes = append(slashes, delegation)
+		expected = expected.Add(slashed.Amount)
+	}
+
+	if err := k.validateDelegationsSlash(ctx, provider, chainID, expected); err != nil {
+		return total, err
+	}
+
+	for _, slash := range slashes {
+		err = k.decreaseDelegation(ctx, slash.Delegator, provider, chainID, slash.Amount, nextEpoch)
+		if err != nil {
+			// should never happen after the validation above; move what was slashed so far
+			err = utils.LavaFormatError("critical: failed to slash delegation", err,
+				utils.Attribute{Key: "delegator", Value: slash.Delegator},
+				utils.Attribute{Key: "provider", Value: provider},
+				utils.Attribute{Key: "chainID", Value: chainID},
+				utils.Attribute{Key: "amount", Value: slash.Amount},
+			)
+			break
+		}
+
+		total = total.Add(slash.Amount.Amount)
+	}
+
+	if sendErr := k.bondedTokensToModule(ctx, total, recipientModule); sendErr != nil {
+		return math.ZeroInt(), utils.LavaFormatError("critical: failed to move slashed delegations", sendErr,
+			utils.Attribute{Key: "provider", Value: provider},
+			utils.Attribute{Key: "chainID", Value: chainID},
+			utils.Attribute{Key: "amount", Value: total},
+			utils.Attribute{Key: "recipient", Value: recipientModule},
+		)
+	}
+
+	return total, err
+}
+
+// validateDelegationsSlash verifies that the delegations can be slashed by the given
+// total: the provider's stake entry (if still staked) must account for the delegations,
+// and the bonded pool must hold the coins to burn.
+func (k Keeper) validateDelegationsSlash(ctx sdk.Context, provider, chainID string, total math.Int) error {
+	if total.IsZero() {
+		return nil
+	}
+
+	providerAddr, err := sdk.AccAddressFromBech32(provider)
+	if err != nil {
+		return utils.LavaFormatWarning("invalid provider address", err,
+			utils.Attribute{Key: "provider", Value: provider},
+		)
+	}
+
+	stakeEntry, exists := k.epochstorageKeeper.GetStakeEntryByAddressCurrent(ctx, chainID, providerAddr)
+	if exists && stakeEntry.DelegateTotal.Amount.LT(total) {
+		return utils.LavaFormatError("critical: provider delegate total is less than its delegations", types.ErrInsufficientDelegation,
+			utils.Attribute{Key: "provider", Value: provider},
+			utils.Attribute{Key: "chainID", Value: chainID},
+			utils.Attribute{Key: "delegateTotal", Value: stakeEntry.DelegateTotal},
+			utils.Attribute{Key: "slash", Value: total},
+		)
+	}
+
+	if k.TotalBondedTokens(ctx).LT(total) {
+		return utils.LavaFormatError("critical: bonded pool is less than the delegations", types.ErrInsufficientDelegation,
+			utils.Attribute{Key: "provider", Value: provider},
+			utils.Attribute{Key: "chainID", Value: chainID},
+			utils.Attribute{Key: "slash", Value: total},
+		)
+	}
+
+	return nil
+}
+
 var space = " "
 
 // encodeForTimer generates timer key unique to a specific delegation; thus it
@@ -203,3 +203,112 @@ func (k Keeper) updateDelegatorsReward(ctx sdk.Context, totalDelegations math.In
 
 	return delegatorsReward.Sub(usedDelegatorRewards)
 }
+
+// AutoCompoundRewards re-delegates the rewards of the delegations that enabled auto compounding
+// (from the auto compound index) to their providers. A provider's delegations are increased up
+// to its delegation limit, and the rest of the rewards are sent to the delegators' balance.
+// (effective on next epoch)
+func (k Keeper) AutoCompoundRewards(ctx sdk.Context) {
+	nextEpoch, err := k.getNextEpoch(ctx)
+	if err != nil {
+		return
+	}
+
+	for _, index := range k.getAllAutoCompoundIndices(ctx) {
+		var delegation types.Delegation
+		found := k.delegationFS.FindEntry(ctx, index, nextEpoch, &delegation)
+		if !found || !delegation.AutoCompound {
+			// the delegation was removed since auto compound was enabled
+			k.removeAutoCompoundIndex(ctx, index)
+			continue
+		}
+
+		reward, found := k.GetDelegatorReward(ctx, index)
+		if !found || reward.Amount.IsZero() {
+			continue
+		}
+
+		err = k.compoundReward(ctx, reward, nextEpoch)
+		if err != nil {
+			utils.LavaFormatError("failed to compound delegator reward", err,
+				utils.Attribute{Key: "delegator", Value: reward.Delegator},
+				utils.Attribute{Key: "provider", Value: reward.Provider},
+				utils.Attribute{Key: "chainID", Value: reward.ChainId},
+				utils.Attribute{Key: "reward", Value: reward.Amount},
+			)
+		}
+	}
+}
+
+// compoundReward delegates a delegator reward to its provider (up to the provider's delegation
+// limit) and sends the rest of it to the delegator
+func (k Keeper) compoundReward(ctx sdk.Context, reward types.DelegatorReward, nextEpoch uint64) error {
+	delegatorAcc, err := sdk.AccAddressFromBech32(reward.Delegator)
+	if err != nil {
+		return err
+	}
+	providerAcc, err := sdk.AccAddressFromBech32(reward.Provider)
+	if err != nil {
+		return err
+	}
+
+	stakeEntry, found := k.epochstorageKeeper.GetStakeEntryByAddressCurrent(ctx, reward.ChainId, providerAcc)
+	if !found {
+		// the provider is not staked (anymore): keep the reward for the delegator to claim
+		return nil
+	}
+
+	// compound up to the provider's delegation limit
+	compound := stakeEntry.DelegateLimit.Amount.Sub(stakeEntry.DelegateTotal.Amount)
+	if compound.IsNegative() {
+		compound = math.ZeroInt()
+	}
+	compound = math.MinInt(compound, reward.Amount.Amount)
+	overflow := reward.Amount.Amount.Sub(compound)
+
+	if compound.IsPositive() {
+		compoundCoin := sdk.NewCoin(epochstoragetypes.TokenDenom, compound)
+		err = k.increaseDelegation(ctx, reward.Delegator, reward.Provider, reward.ChainId, compoundCoin, nextEpoch)
+		if err != nil {
+			return err
+		}
+
+		// not minting new coins because they're minted when the provider
+		// asked for payment (and the delegator reward map was updated)
+		err = k.bankKeeper.SendCoinsFromModuleToModule(ctx, pairingtypes.ModuleName, types.BondedPoolName, sdk.NewCoins(compoundCoin))
+		if err != nil {
+			// panic:ok: reward transfer should never fail
+			utils.LavaFormatPanic("critical: failed to compound reward of delegator for provider", err,
+				utils.Attribute{Key: "provider", Value: reward.Provider},
+				utils.Attribute{Key: "delegator", Value: reward.Delegator},
+				utils.Attribute{Key: "reward", Value: compoundCoin},
+			)
+		}
+	}
+
+	if overflow.IsPositive() {
+		overflowCoins := sdk.NewCoins(sdk.NewCoin(epochstoragetypes.TokenDenom, overflow))
+		err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, pairingtypes.ModuleName, delegatorAcc, overflowCoins)
+		if err != nil {
+			// panic:ok: reward transfer should never fail
+			utils.LavaFormatPanic("critical: failed to send reward to delegator for provider", err,
+				utils.Attribute{Key: "provider", Value: reward.Provider},
+				utils.Attribute{Key: "delegator", Value: reward.Delegator},
+				utils.Attribute{Key: "reward", Value: overflowCoins},
+			)
+		}
+	}
+
+	k.RemoveDelegatorReward(ctx, types.DelegationKey(reward.Provider, reward.Delegator, reward.ChainId))
+
+	details := map[string]string{
+		"delegator":  reward.Delegator,
+		"provider":   reward.Provider,
+		"chainID":    reward.ChainId,
+		"compounded": compound.String(),
+		"overflow":   overflow.String(),
+	}
+	utils.LogLavaEvent(ctx, k.Logger(ctx), types.CompoundedEventName, details, "Delegator Rewards Compounded")
+
+	return nil
+}
@@ -88,6 +88,15 @@ func (k Keeper) BeginBlock(ctx sdk.Context) {
 	k.delegationFS.AdvanceBlock(ctx)
 	k.delegatorFS.AdvanceBlock(ctx)
 	k.unbondingTS.Tick(ctx)
+
+	k.AutoCompoundOnEpochStart(ctx)
+}
+
+// AutoCompoundOnEpochStart re-delegates the rewards of delegations with auto compound (on epoch start)
+func (k Keeper) AutoCompoundOnEpochStart(ctx sdk.Context) {
+	if k.epochstorageKeeper.IsEpochStart(ctx) {
+		k.AutoCompoundRewards(ctx)
+	}
 }
 
 // ExportDelegations exports dualstaking delegations data (for genesis)
@@ -108,6 +117,7 @@ func (k Keeper) ExportUnbondings(ctx sdk.Context) []commontypes.RawMessage {
 // InitDelegations imports dualstaking delegations data (from genesis)
 func (k Keeper) InitDelegations(ctx sdk.Context, data commontypes.GenesisState) {
 	k.delegationFS.Init(ctx, data)
+	k.indexAutoCompoundDelegations(ctx)
 }
 
 // InitDelegators imports dualstaking delegators data (from genesis)
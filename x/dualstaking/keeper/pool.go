@@ -34,6 +34,18 @@ func (k Keeper) notBondedTokensToBonded(ctx sdk.Context, tokens math.Int) {
 	}
 }
 
+// bondedTokensToModule transfers coins from the bonded pool to another module (slashed delegations)
+func (k Keeper) bondedTokensToModule(ctx sdk.Context, amt math.Int, recipientModule string) error {
+	if !amt.IsPositive() {
+		// skip as no coins need to be moved
+		return nil
+	}
+
+	coins := sdk.NewCoins(sdk.NewCoin(epochstoragetypes.TokenDenom, amt))
+
+	return k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.BondedPoolName, recipientModule, coins)
+}
+
 // burnBondedTokens removes coins from the bonded pool module account
 func (k Keeper) burnBondedTokens(ctx sdk.Context, amt math.Int) error {
 	if !amt.IsPositive() {
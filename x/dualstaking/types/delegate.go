@@ -31,7 +31,8 @@ func (delegation *Delegation) Equal(other *Delegation) bool {
 	if delegation.Delegator != other.Delegator ||
 		delegation.Provider != other.Provider ||
 		delegation.ChainID != other.ChainID ||
-		!delegation.Amount.IsEqual(other.Amount) {
+		!delegation.Amount.IsEqual(other.Amount) ||
+		delegation.AutoCompound != other.AutoCompound {
 		return false
 	}
 	return true
@@ -25,10 +25,11 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type Delegation struct {
-	Provider  string     `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
-	ChainID   string     `protobuf:"bytes,2,opt,name=chainID,proto3" json:"chainID,omitempty"`
-	Delegator string     `protobuf:"bytes,3,opt,name=delegator,proto3" json:"delegator,omitempty"`
-	Amount    types.Coin `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount"`
+	Provider     string     `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	ChainID      string     `protobuf:"bytes,2,opt,name=chainID,proto3" json:"chainID,omitempty"`
+	Delegator    string     `protobuf:"bytes,3,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	Amount       types.Coin `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount"`
+	AutoCompound bool       `protobuf:"varint,5,opt,name=auto_compound,json=autoCompound,proto3" json:"auto_compound,omitempty"`
 }
 
 func (m *Delegation) Reset()         { *m = Delegation{} }
@@ -92,6 +93,13 @@ func (m *Delegation) GetAmount() types.Coin {
 	return types.Coin{}
 }
 
+func (m *Delegation) GetAutoCompound() bool {
+	if m != nil {
+		return m.AutoCompound
+	}
+	return false
+}
+
 type Delegator struct {
 	Providers []string `protobuf:"bytes,1,rep,name=providers,proto3" json:"providers,omitempty"`
 }
@@ -146,25 +154,27 @@ func init() {
 }
 
 var fileDescriptor_547eac7f30bf94d4 = []byte{
-	// 288 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x90, 0xbf, 0x4e, 0xc3, 0x30,
-	0x10, 0x87, 0x63, 0x5a, 0x15, 0x62, 0xb6, 0x88, 0xc1, 0x54, 0x95, 0xa9, 0xba, 0x50, 0x84, 0x64,
-	0xab, 0x30, 0xb0, 0x97, 0x30, 0xb0, 0x76, 0x64, 0x73, 0x12, 0x2b, 0xb5, 0x48, 0x7c, 0x51, 0xec,
-	0x44, 0xf0, 0x16, 0xac, 0xbc, 0x51, 0xc7, 0x8e, 0x4c, 0x08, 0x25, 0x2f, 0x82, 0xf2, 0xa7, 0x81,
-	0x4e, 0xe7, 0xbb, 0xfb, 0xe4, 0xfb, 0xf4, 0xc3, 0xd7, 0x89, 0x28, 0x85, 0x96, 0x96, 0x37, 0x95,
-	0x47, 0x85, 0x48, 0x8c, 0x15, 0xaf, 0x4a, 0xc7, 0x3c, 0x92, 0x89, 0x8c, 0x85, 0x95, 0x2c, 0xcb,
-	0xc1, 0x82, 0x47, 0x7a, 0x90, 0x35, 0x95, 0xfd, 0x03, 0xa7, 0x17, 0x31, 0xc4, 0xd0, 0x42, 0xbc,
-	0x79, 0x75, 0xfc, 0x94, 0x86, 0x60, 0x52, 0x30, 0x3c, 0x10, 0x46, 0xf2, 0x72, 0x15, 0x48, 0x2b,
-	0x56, 0x3c, 0x04, 0xa5, 0xbb, 0xfd, 0xe2, 0x13, 0x61, 0xec, 0x77, 0x27, 0x14, 0x68, 0x6f, 0x8a,
-	0xcf, 0xb2, 0x1c, 0x4a, 0x15, 0xc9, 0x9c, 0xa0, 0x39, 0x5a, 0xba, 0x9b, 0xa1, 0xf7, 0x08, 0x3e,
-	0x0d, 0xb7, 0x42, 0xe9, 0x67, 0x9f, 0x9c, 0xb4, 0xab, 0x43, 0xeb, 0xcd, 0xb0, 0xdb, 0x6b, 0x42,
-	0x4e, 0x46, 0xed, 0xee, 0x6f, 0xe0, 0x3d, 0xe0, 0x89, 0x48, 0xa1, 0xd0, 0x96, 0x8c, 0xe7, 0x68,
-	0x79, 0x7e, 0x77, 0xc9, 0x3a, 0x27, 0xd6, 0x38, 0xb1, 0xde, 0x89, 0x3d, 0x82, 0xd2, 0xeb, 0xf1,
-	0xee, 0xfb, 0xca, 0xd9, 0xf4, 0xf8, 0xe2, 0x06, 0xbb, 0xfe, 0xf0, 0xcb, 0x0c, 0xbb, 0x07, 0x13,
-	0x43, 0xd0, 0x7c, 0xd4, 0xdc, 0x18, 0x06, 0xeb, 0xa7, 0x5d, 0x45, 0xd1, 0xbe, 0xa2, 0xe8, 0xa7,
-	0xa2, 0xe8, 0xa3, 0xa6, 0xce, 0xbe, 0xa6, 0xce, 0x57, 0x4d, 0x9d, 0x97, 0xdb, 0x58, 0xd9, 0x6d,
-	0x11, 0xb0, 0x10, 0x52, 0x7e, 0x14, 0xf2, 0xdb, 0x51, 0xcc, 0xf6, 0x3d, 0x93, 0x26, 0x98, 0xb4,
-	0xa1, 0xdc, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0xbf, 0x95, 0x40, 0x43, 0x8f, 0x01, 0x00, 0x00,
+	// 312 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x91, 0xb1, 0x4e, 0xf3, 0x30,
+	0x10, 0xc7, 0xe3, 0xaf, 0xfd, 0x4a, 0x63, 0x60, 0x89, 0x18, 0x4c, 0x55, 0x99, 0xa8, 0x0c, 0x14,
+	0x21, 0xd9, 0x2a, 0x0c, 0xec, 0x6d, 0x19, 0x58, 0x3b, 0xb2, 0x20, 0x27, 0xb1, 0x52, 0x8b, 0xc6,
+	0x17, 0xc5, 0x4e, 0x05, 0x6f, 0xc1, 0x13, 0x31, 0x77, 0xec, 0xc8, 0x84, 0x50, 0xfb, 0x22, 0xc8,
+	0x49, 0x5a, 0xe8, 0x74, 0xbe, 0xff, 0xfd, 0xa4, 0xfb, 0xc9, 0x87, 0xaf, 0x16, 0x62, 0x29, 0xb4,
+	0xb4, 0xdc, 0x55, 0x9e, 0x94, 0x62, 0x61, 0xac, 0x78, 0x51, 0x3a, 0xe5, 0x89, 0x5c, 0xc8, 0x54,
+	0x58, 0xc9, 0xf2, 0x02, 0x2c, 0x04, 0xa4, 0x01, 0x99, 0xab, 0xec, 0x0f, 0xd8, 0x3b, 0x4b, 0x21,
+	0x85, 0x0a, 0xe2, 0xee, 0x55, 0xf3, 0x3d, 0x1a, 0x83, 0xc9, 0xc0, 0xf0, 0x48, 0x18, 0xc9, 0x97,
+	0xa3, 0x48, 0x5a, 0x31, 0xe2, 0x31, 0x28, 0x5d, 0xcf, 0x07, 0x1f, 0x08, 0xe3, 0x69, 0xbd, 0x42,
+	0x81, 0x0e, 0x7a, 0xb8, 0x9b, 0x17, 0xb0, 0x54, 0x89, 0x2c, 0x08, 0x0a, 0xd1, 0xd0, 0x9f, 0xed,
+	0xfb, 0x80, 0xe0, 0xa3, 0x78, 0x2e, 0x94, 0x7e, 0x9c, 0x92, 0x7f, 0xd5, 0x68, 0xd7, 0x06, 0x7d,
+	0xec, 0x37, 0x9a, 0x50, 0x90, 0x56, 0x35, 0xfb, 0x0d, 0x82, 0x7b, 0xdc, 0x11, 0x19, 0x94, 0xda,
+	0x92, 0x76, 0x88, 0x86, 0xc7, 0xb7, 0xe7, 0xac, 0x76, 0x62, 0xce, 0x89, 0x35, 0x4e, 0x6c, 0x02,
+	0x4a, 0x8f, 0xdb, 0xab, 0xaf, 0x0b, 0x6f, 0xd6, 0xe0, 0xc1, 0x25, 0x3e, 0x15, 0xa5, 0x85, 0xe7,
+	0x18, 0xb2, 0x1c, 0x4a, 0x9d, 0x90, 0xff, 0x21, 0x1a, 0x76, 0x67, 0x27, 0x2e, 0x9c, 0x34, 0xd9,
+	0xe0, 0x1a, 0xfb, 0xd3, 0xfd, 0xaa, 0x3e, 0xf6, 0x77, 0xba, 0x86, 0xa0, 0xb0, 0xe5, 0x44, 0xf6,
+	0xc1, 0xf8, 0x61, 0xb5, 0xa1, 0x68, 0xbd, 0xa1, 0xe8, 0x7b, 0x43, 0xd1, 0xfb, 0x96, 0x7a, 0xeb,
+	0x2d, 0xf5, 0x3e, 0xb7, 0xd4, 0x7b, 0xba, 0x49, 0x95, 0x9d, 0x97, 0x11, 0x8b, 0x21, 0xe3, 0x07,
+	0x97, 0x78, 0x3d, 0xb8, 0x85, 0x7d, 0xcb, 0xa5, 0x89, 0x3a, 0xd5, 0xcf, 0xdd, 0xfd, 0x0c, 0x00,
+	0x3a, 0xd5, 0xcf, 0x22, 0xb4, 0x01, 0x00, 0x00,
 }
 
 func (m *Delegation) Marshal() (dAtA []byte, err error) {
@@ -187,6 +197,16 @@ func (m *Delegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.AutoCompound {
+		i--
+		if m.AutoCompound {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
 	{
 		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -284,6 +304,9 @@ func (m *Delegation) Size() (n int) {
 	}
 	l = m.Amount.Size()
 	n += 1 + l + sovDelegate(uint64(l))
+	if m.AutoCompound {
+		n += 2
+	}
 	return n
 }
 
@@ -466,6 +489,26 @@ func (m *Delegation) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompound", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoCompound = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDelegate(dAtA[iNdEx:])
@@ -25,6 +25,9 @@ const (
 
 	// prefix for the unbonding timer store
 	UnbondingPrefix = "unbonding-ts"
+
+	// prefix for the index of delegations with auto compound
+	AutoCompoundPrefix = "auto-compound"
 )
 
 func KeyPrefix(p string) []byte {
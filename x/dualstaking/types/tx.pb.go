@@ -445,6 +445,110 @@ func (m *MsgClaimRewardsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgClaimRewardsResponse proto.InternalMessageInfo
 
+type MsgSetAutoCompound struct {
+	Creator  string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Provider string `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
+	ChainID  string `protobuf:"bytes,3,opt,name=chainID,proto3" json:"chainID,omitempty"`
+	Enable   bool   `protobuf:"varint,4,opt,name=enable,proto3" json:"enable,omitempty"`
+}
+
+func (m *MsgSetAutoCompound) Reset()         { *m = MsgSetAutoCompound{} }
+func (m *MsgSetAutoCompound) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAutoCompound) ProtoMessage()    {}
+func (*MsgSetAutoCompound) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29c4c178d368211c, []int{8}
+}
+func (m *MsgSetAutoCompound) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAutoCompound) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAutoCompound.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetAutoCompound) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAutoCompound.Merge(m, src)
+}
+func (m *MsgSetAutoCompound) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAutoCompound) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAutoCompound.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAutoCompound proto.InternalMessageInfo
+
+func (m *MsgSetAutoCompound) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgSetAutoCompound) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
+}
+
+func (m *MsgSetAutoCompound) GetChainID() string {
+	if m != nil {
+		return m.ChainID
+	}
+	return ""
+}
+
+func (m *MsgSetAutoCompound) GetEnable() bool {
+	if m != nil {
+		return m.Enable
+	}
+	return false
+}
+
+type MsgSetAutoCompoundResponse struct {
+}
+
+func (m *MsgSetAutoCompoundResponse) Reset()         { *m = MsgSetAutoCompoundResponse{} }
+func (m *MsgSetAutoCompoundResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAutoCompoundResponse) ProtoMessage()    {}
+func (*MsgSetAutoCompoundResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29c4c178d368211c, []int{9}
+}
+func (m *MsgSetAutoCompoundResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAutoCompoundResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAutoCompoundResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetAutoCompoundResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAutoCompoundResponse.Merge(m, src)
+}
+func (m *MsgSetAutoCompoundResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAutoCompoundResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAutoCompoundResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAutoCompoundResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgDelegate)(nil), "lavanet.lava.dualstaking.MsgDelegate")
 	proto.RegisterType((*MsgDelegateResponse)(nil), "lavanet.lava.dualstaking.MsgDelegateResponse")
@@ -454,43 +558,49 @@ func init() {
 	proto.RegisterType((*MsgUnbondResponse)(nil), "lavanet.lava.dualstaking.MsgUnbondResponse")
 	proto.RegisterType((*MsgClaimRewards)(nil), "lavanet.lava.dualstaking.MsgClaimRewards")
 	proto.RegisterType((*MsgClaimRewardsResponse)(nil), "lavanet.lava.dualstaking.MsgClaimRewardsResponse")
+	proto.RegisterType((*MsgSetAutoCompound)(nil), "lavanet.lava.dualstaking.MsgSetAutoCompound")
+	proto.RegisterType((*MsgSetAutoCompoundResponse)(nil), "lavanet.lava.dualstaking.MsgSetAutoCompoundResponse")
 }
 
 func init() { proto.RegisterFile("lavanet/lava/dualstaking/tx.proto", fileDescriptor_29c4c178d368211c) }
 
 var fileDescriptor_29c4c178d368211c = []byte{
-	// 495 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x94, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0xc7, 0x63, 0x12, 0x42, 0x33, 0x69, 0x85, 0x70, 0xa9, 0xea, 0x5a, 0xc2, 0x6d, 0x53, 0x21,
-	0x8a, 0x2a, 0xd6, 0x4a, 0x39, 0x70, 0x6f, 0x8a, 0x10, 0x07, 0x4b, 0xc8, 0x12, 0x97, 0x5e, 0xca,
-	0x3a, 0xde, 0x6e, 0x2d, 0xec, 0x9d, 0xc8, 0xbb, 0x09, 0xe5, 0x2d, 0x38, 0x20, 0x24, 0xde, 0xa8,
-	0xc7, 0x1e, 0x39, 0x21, 0x94, 0xdc, 0x78, 0x0a, 0xe4, 0xaf, 0xad, 0x53, 0x44, 0x08, 0x9c, 0x7a,
-	0xda, 0x0f, 0xff, 0xfe, 0x3b, 0xff, 0xdd, 0x19, 0x0f, 0xec, 0xc6, 0x74, 0x42, 0x05, 0x53, 0x6e,
-	0x36, 0xba, 0xe1, 0x98, 0xc6, 0x52, 0xd1, 0xf7, 0x91, 0xe0, 0xae, 0xba, 0x20, 0xa3, 0x14, 0x15,
-	0x9a, 0x56, 0x89, 0x90, 0x6c, 0x24, 0x35, 0xc4, 0x76, 0x86, 0x28, 0x13, 0x94, 0x6e, 0x40, 0x25,
-	0x73, 0x27, 0xfd, 0x80, 0x29, 0xda, 0x77, 0x87, 0x18, 0x89, 0x42, 0x69, 0x3f, 0xe4, 0xc8, 0x31,
-	0x9f, 0xba, 0xd9, 0xac, 0xd8, 0xed, 0x7d, 0x31, 0xa0, 0xeb, 0x49, 0x7e, 0xcc, 0x62, 0xc6, 0xa9,
-	0x62, 0xa6, 0x05, 0xf7, 0x86, 0x29, 0xa3, 0x0a, 0x53, 0xcb, 0xd8, 0x31, 0xf6, 0x3b, 0x7e, 0xb5,
-	0x34, 0x6d, 0x58, 0x19, 0xa5, 0x38, 0x89, 0x42, 0x96, 0x5a, 0x77, 0xf2, 0x4f, 0x7a, 0x9d, 0xab,
-	0xce, 0x69, 0x24, 0x5e, 0x1f, 0x5b, 0xcd, 0x52, 0x55, 0x2c, 0xcd, 0x17, 0xd0, 0xa6, 0x09, 0x8e,
-	0x85, 0xb2, 0x5a, 0x3b, 0xc6, 0x7e, 0xf7, 0x70, 0x8b, 0x14, 0x36, 0x49, 0x66, 0x93, 0x94, 0x36,
-	0xc9, 0x00, 0x23, 0x71, 0xd4, 0xba, 0xfc, 0xbe, 0xdd, 0xf0, 0x4b, 0xbc, 0xb7, 0x01, 0xeb, 0x35,
-	0x5f, 0x3e, 0x93, 0x23, 0x14, 0x92, 0xf5, 0x7e, 0x1a, 0xb0, 0xe6, 0x49, 0xee, 0xb3, 0xf0, 0xef,
-	0x8e, 0xf7, 0x60, 0xed, 0x2c, 0xc5, 0xe4, 0xf4, 0x86, 0xed, 0xd5, 0x6c, 0xf3, 0x4d, 0x65, 0x7d,
-	0x1b, 0xba, 0x0a, 0xaf, 0x91, 0xc2, 0x3e, 0x28, 0xd4, 0xc0, 0x2e, 0xe4, 0x82, 0xd3, 0xea, 0x82,
-	0xad, 0x9c, 0xe8, 0x66, 0x7b, 0x83, 0xf2, 0x92, 0x8f, 0x00, 0x14, 0x6a, 0xe0, 0x6e, 0x0e, 0x74,
-	0x14, 0x0e, 0x7e, 0x7b, 0x83, 0xf6, 0xbf, 0xbd, 0xc1, 0x26, 0x6c, 0xcc, 0xdd, 0x55, 0xbf, 0xc2,
-	0x67, 0x03, 0x3a, 0x9e, 0xe4, 0x6f, 0x45, 0x80, 0x22, 0xbc, 0x3d, 0x39, 0x5b, 0x87, 0x07, 0xda,
-	0x95, 0xf6, 0xfa, 0x0a, 0xee, 0x7b, 0x92, 0x0f, 0x62, 0x1a, 0x25, 0x3e, 0xfb, 0x40, 0xd3, 0x50,
-	0xfe, 0x9f, 0xe1, 0xde, 0x16, 0x6c, 0xde, 0x38, 0xa8, 0x8a, 0x71, 0xf8, 0xb5, 0x09, 0x4d, 0x4f,
-	0x72, 0xf3, 0x1d, 0xac, 0xe8, 0x4a, 0x7e, 0x4c, 0xfe, 0xf4, 0xab, 0x90, 0x5a, 0x61, 0xd9, 0xcf,
-	0x96, 0xc2, 0xaa, 0x48, 0xe6, 0x19, 0x40, 0xad, 0xf6, 0x9e, 0x2c, 0x14, 0x5f, 0x83, 0xb6, 0xbb,
-	0x24, 0xa8, 0xe3, 0x9c, 0x40, 0xbb, 0xcc, 0xee, 0xde, 0x42, 0x69, 0x01, 0xd9, 0x07, 0x4b, 0x40,
-	0xfa, 0xec, 0x18, 0x56, 0xe7, 0xd2, 0xf1, 0x74, 0xa1, 0xb8, 0x8e, 0xda, 0xfd, 0xa5, 0xd1, 0x2a,
-	0xda, 0xd1, 0xcb, 0xcb, 0xa9, 0x63, 0x5c, 0x4d, 0x1d, 0xe3, 0xc7, 0xd4, 0x31, 0x3e, 0xcd, 0x9c,
-	0xc6, 0xd5, 0xcc, 0x69, 0x7c, 0x9b, 0x39, 0x8d, 0x93, 0x03, 0x1e, 0xa9, 0xf3, 0x71, 0x40, 0x86,
-	0x98, 0xb8, 0x73, 0x9d, 0xef, 0x62, 0xbe, 0xf7, 0x7d, 0x1c, 0x31, 0x19, 0xb4, 0xf3, 0x7e, 0xf5,
-	0xfc, 0x57, 0x00, 0x00, 0x00, 0xff, 0xff, 0xe6, 0x62, 0x44, 0x17, 0x24, 0x05, 0x00, 0x00,
+	// 547 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x95, 0xcf, 0x6f, 0xd3, 0x30,
+	0x14, 0xc7, 0x1b, 0x56, 0x4a, 0xfb, 0xba, 0x69, 0x22, 0x63, 0x2c, 0x8b, 0x20, 0xdb, 0x3a, 0x21,
+	0x86, 0x06, 0x89, 0x3a, 0x90, 0x38, 0xb3, 0x0e, 0x21, 0x0e, 0x91, 0x50, 0x10, 0x97, 0x5d, 0x86,
+	0xd3, 0x7a, 0x5e, 0x44, 0xe2, 0x57, 0xc5, 0x4e, 0x19, 0x12, 0x7f, 0xc4, 0x0e, 0x88, 0xbf, 0x69,
+	0xc7, 0x1d, 0x39, 0x21, 0xd4, 0xde, 0xf8, 0x2b, 0x50, 0x7e, 0x79, 0x6d, 0x27, 0x4a, 0x87, 0x84,
+	0xc4, 0xc9, 0x7e, 0xee, 0xe7, 0xeb, 0xf7, 0xf5, 0xeb, 0x73, 0x0c, 0x5b, 0x21, 0x19, 0x10, 0x4e,
+	0xa5, 0x93, 0x8e, 0x4e, 0x2f, 0x21, 0xa1, 0x90, 0xe4, 0x43, 0xc0, 0x99, 0x23, 0x4f, 0xed, 0x7e,
+	0x8c, 0x12, 0x75, 0xa3, 0x40, 0xec, 0x74, 0xb4, 0xc7, 0x10, 0xd3, 0xea, 0xa2, 0x88, 0x50, 0x38,
+	0x3e, 0x11, 0xd4, 0x19, 0xb4, 0x7d, 0x2a, 0x49, 0xdb, 0xe9, 0x62, 0xc0, 0x73, 0xa5, 0x79, 0x87,
+	0x21, 0xc3, 0x6c, 0xea, 0xa4, 0xb3, 0x7c, 0xb5, 0xf5, 0x55, 0x83, 0xa6, 0x2b, 0xd8, 0x01, 0x0d,
+	0x29, 0x23, 0x92, 0xea, 0x06, 0xdc, 0xea, 0xc6, 0x94, 0x48, 0x8c, 0x0d, 0x6d, 0x53, 0xdb, 0x69,
+	0x78, 0x65, 0xa8, 0x9b, 0x50, 0xef, 0xc7, 0x38, 0x08, 0x7a, 0x34, 0x36, 0x6e, 0x64, 0x3f, 0xa9,
+	0x38, 0x53, 0x9d, 0x90, 0x80, 0xbf, 0x3e, 0x30, 0x16, 0x0a, 0x55, 0x1e, 0xea, 0xcf, 0xa1, 0x46,
+	0x22, 0x4c, 0xb8, 0x34, 0xaa, 0x9b, 0xda, 0x4e, 0x73, 0x6f, 0xdd, 0xce, 0x6d, 0xda, 0xa9, 0x4d,
+	0xbb, 0xb0, 0x69, 0x77, 0x30, 0xe0, 0xfb, 0xd5, 0xf3, 0xef, 0x1b, 0x15, 0xaf, 0xc0, 0x5b, 0xab,
+	0xb0, 0x32, 0xe6, 0xcb, 0xa3, 0xa2, 0x8f, 0x5c, 0xd0, 0xd6, 0x4f, 0x0d, 0x96, 0x5c, 0xc1, 0x3c,
+	0xda, 0xfb, 0xb3, 0xe3, 0x6d, 0x58, 0x3a, 0x8e, 0x31, 0x3a, 0x9a, 0xb2, 0xbd, 0x98, 0x2e, 0xbe,
+	0x29, 0xad, 0x6f, 0x40, 0x53, 0xe2, 0x25, 0x92, 0xdb, 0x07, 0x89, 0x0a, 0xd8, 0x82, 0x4c, 0x70,
+	0x54, 0x1e, 0xb0, 0x9a, 0x11, 0xcd, 0x74, 0xad, 0x53, 0x1c, 0xf2, 0x3e, 0x80, 0x44, 0x05, 0xdc,
+	0xcc, 0x80, 0x86, 0xc4, 0xce, 0x95, 0x1a, 0xd4, 0xae, 0x57, 0x83, 0x35, 0x58, 0x9d, 0x38, 0xab,
+	0xaa, 0xc2, 0x17, 0x0d, 0x1a, 0xae, 0x60, 0xef, 0xb8, 0x8f, 0xbc, 0xf7, 0xff, 0xfc, 0x67, 0x2b,
+	0x70, 0x5b, 0xb9, 0x52, 0x5e, 0x5f, 0xc1, 0xb2, 0x2b, 0x58, 0x27, 0x24, 0x41, 0xe4, 0xd1, 0x8f,
+	0x24, 0xee, 0x89, 0xbf, 0x33, 0xdc, 0x5a, 0x87, 0xb5, 0xa9, 0x8d, 0x54, 0x8e, 0xcf, 0xa0, 0xbb,
+	0x82, 0xbd, 0xa5, 0xf2, 0x45, 0x22, 0xb1, 0x83, 0x51, 0x1f, 0x93, 0x7f, 0x50, 0x97, 0xbb, 0x50,
+	0xa3, 0x9c, 0xf8, 0x21, 0xcd, 0xea, 0x52, 0xf7, 0x8a, 0xa8, 0x75, 0x0f, 0xcc, 0xab, 0xd9, 0x4b,
+	0x6f, 0x7b, 0x67, 0x55, 0x58, 0x70, 0x05, 0xd3, 0xdf, 0x43, 0x5d, 0xdd, 0xb2, 0x07, 0xf6, 0xef,
+	0xae, 0xb1, 0x3d, 0xd6, 0xf4, 0xe6, 0x93, 0xb9, 0xb0, 0x32, 0x93, 0x7e, 0x0c, 0x30, 0x76, 0x2f,
+	0x1e, 0xce, 0x14, 0x5f, 0x82, 0xa6, 0x33, 0x27, 0xa8, 0xf2, 0x1c, 0x42, 0xad, 0xe8, 0xbc, 0xed,
+	0x99, 0xd2, 0x1c, 0x32, 0x77, 0xe7, 0x80, 0xd4, 0xde, 0x21, 0x2c, 0x4e, 0xb4, 0xca, 0xa3, 0x99,
+	0xe2, 0x71, 0xd4, 0x6c, 0xcf, 0x8d, 0xaa, 0x6c, 0x09, 0x2c, 0x4f, 0x37, 0xcd, 0xe3, 0x99, 0xbb,
+	0x4c, 0xd1, 0xe6, 0xb3, 0xeb, 0xd0, 0x65, 0xda, 0xfd, 0x97, 0xe7, 0x43, 0x4b, 0xbb, 0x18, 0x5a,
+	0xda, 0x8f, 0xa1, 0xa5, 0x9d, 0x8d, 0xac, 0xca, 0xc5, 0xc8, 0xaa, 0x7c, 0x1b, 0x59, 0x95, 0xc3,
+	0x5d, 0x16, 0xc8, 0x93, 0xc4, 0xb7, 0xbb, 0x18, 0x39, 0x13, 0x8f, 0xc1, 0xe9, 0xe4, 0x73, 0xf0,
+	0xa9, 0x4f, 0x85, 0x5f, 0xcb, 0x3e, 0xe1, 0x4f, 0x7f, 0x0d, 0x00, 0x3f, 0x37, 0x5b, 0xda, 0x37,
+	0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -509,6 +619,7 @@ type MsgClient interface {
 	Redelegate(ctx context.Context, in *MsgRedelegate, opts ...grpc.CallOption) (*MsgRedelegateResponse, error)
 	Unbond(ctx context.Context, in *MsgUnbond, opts ...grpc.CallOption) (*MsgUnbondResponse, error)
 	ClaimRewards(ctx context.Context, in *MsgClaimRewards, opts ...grpc.CallOption) (*MsgClaimRewardsResponse, error)
+	SetAutoCompound(ctx context.Context, in *MsgSetAutoCompound, opts ...grpc.CallOption) (*MsgSetAutoCompoundResponse, error)
 }
 
 type msgClient struct {
@@ -555,12 +666,22 @@ func (c *msgClient) ClaimRewards(ctx context.Context, in *MsgClaimRewards, opts
 	return out, nil
 }
 
+func (c *msgClient) SetAutoCompound(ctx context.Context, in *MsgSetAutoCompound, opts ...grpc.CallOption) (*MsgSetAutoCompoundResponse, error) {
+	out := new(MsgSetAutoCompoundResponse)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.dualstaking.Msg/SetAutoCompound", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	Delegate(context.Context, *MsgDelegate) (*MsgDelegateResponse, error)
 	Redelegate(context.Context, *MsgRedelegate) (*MsgRedelegateResponse, error)
 	Unbond(context.Context, *MsgUnbond) (*MsgUnbondResponse, error)
 	ClaimRewards(context.Context, *MsgClaimRewards) (*MsgClaimRewardsResponse, error)
+	SetAutoCompound(context.Context, *MsgSetAutoCompound) (*MsgSetAutoCompoundResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -579,6 +700,9 @@ func (*UnimplementedMsgServer) Unbond(ctx context.Context, req *MsgUnbond) (*Msg
 func (*UnimplementedMsgServer) ClaimRewards(ctx context.Context, req *MsgClaimRewards) (*MsgClaimRewardsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ClaimRewards not implemented")
 }
+func (*UnimplementedMsgServer) SetAutoCompound(ctx context.Context, req *MsgSetAutoCompound) (*MsgSetAutoCompoundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAutoCompound not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -656,6 +780,24 @@ func _Msg_ClaimRewards_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetAutoCompound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAutoCompound)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetAutoCompound(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.dualstaking.Msg/SetAutoCompound",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAutoCompound(ctx, req.(*MsgSetAutoCompound))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "lavanet.lava.dualstaking.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -676,6 +818,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ClaimRewards",
 			Handler:    _Msg_ClaimRewards_Handler,
 		},
+		{
+			MethodName: "SetAutoCompound",
+			Handler:    _Msg_SetAutoCompound_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "lavanet/lava/dualstaking/tx.proto",
@@ -986,6 +1132,83 @@ func (m *MsgClaimRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgSetAutoCompound) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAutoCompound) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAutoCompound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Enable {
+		i--
+		if m.Enable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAutoCompoundResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAutoCompoundResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAutoCompoundResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1127,6 +1350,39 @@ func (m *MsgClaimRewardsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgSetAutoCompound) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Enable {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetAutoCompoundResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2048,6 +2304,222 @@ func (m *MsgClaimRewardsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgSetAutoCompound) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetAutoCompound: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetAutoCompound: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enable = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetAutoCompoundResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetAutoCompoundResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetAutoCompoundResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
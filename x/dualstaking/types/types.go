@@ -6,6 +6,8 @@ const (
 	RedelegateEventName   = "redelegate_between_providers"
 	RefundedEventName     = "refund_to_delegator"
 	ClaimRewardsEventName = "delegator_claim_rewards"
+	AutoCompoundEventName = "delegator_set_auto_compound"
+	CompoundedEventName   = "delegator_rewards_compounded"
 )
 
 const (
@@ -12,6 +12,7 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	// Set all the stakeStorage
 	for _, elem := range genState.StakeStorageList {
 		k.SetStakeStorage(ctx, elem)
+		k.IndexStakeStorage(ctx, elem)
 	}
 	// Set if defined
 	if genState.EpochDetails != nil {
@@ -129,3 +129,19 @@ func (m Migrator) Migrate4to5(ctx sdk.Context) error {
 
 	return nil
 }
+
+// Migrate5to6 implements store migration from v5 to v6:
+// - index the stake entries of the current stake storages by address
+func (m Migrator) Migrate5to6(ctx sdk.Context) error {
+	utils.LavaFormatDebug("migrate: epochstorage index stake entries by address")
+
+	for _, chainID := range m.keeper.specKeeper.GetAllChainIDs(ctx) {
+		stakeStorage, found := m.keeper.GetStakeStorageCurrent(ctx, chainID)
+		if !found {
+			continue
+		}
+		m.keeper.IndexStakeStorage(ctx, stakeStorage)
+	}
+
+	return nil
+}
@@ -0,0 +1,98 @@
+package keeper
+
+import (
+	"bytes"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/x/epochstorage/types"
+)
+
+// The stake entry index is a secondary store that maps (chainID, address) to the provider's
+// StakeEntry in the current stake storage of the chain. It allows getting a current stake
+// entry with a single read, without decoding the entire stake storage. The index doesn't
+// keep the entries' positions in the (stake-sorted) entries list, so a stake change writes
+// only the entry that changed; the position of an entry is found by its stake (see
+// stakeEntryPositionCurrent). Only the current stake storages are indexed: the epoch stake
+// storages and the unstake storage are not.
+
+func (k Keeper) stakeEntryIndexStore(ctx sdk.Context) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.StakeEntryIndexKeyPrefix))
+}
+
+// isStakeStorageCurrent checks whether a stake storage is the current stake storage of
+// a chain (that is, keyed by the chainID of its entries)
+func (k Keeper) isStakeStorageCurrent(stakeStorage types.StakeStorage) bool {
+	return len(stakeStorage.StakeEntries) > 0 &&
+		stakeStorage.Index == k.stakeStorageKeyCurrent(stakeStorage.StakeEntries[0].Chain)
+}
+
+// IndexStakeStorage indexes the entries of a stake storage if it is the current stake
+// storage of a chain (used for stake storages that are set in bulk, e.g. from genesis)
+func (k Keeper) IndexStakeStorage(ctx sdk.Context, stakeStorage types.StakeStorage) {
+	if !k.isStakeStorageCurrent(stakeStorage) {
+		return
+	}
+	k.updateStakeEntryIndices(ctx, stakeStorage.Index, nil, stakeStorage.StakeEntries)
+}
+
+// updateStakeEntryIndices updates the stake entry index of the current stake storage of a
+// chain whose entries were replaced from oldEntries to newEntries. Only the entries that
+// were added or modified are written; the entries that were removed are deleted.
+func (k Keeper) updateStakeEntryIndices(ctx sdk.Context, chainID string, oldEntries, newEntries []types.StakeEntry) {
+	old := make(map[string]types.StakeEntry, len(oldEntries))
+	for _, entry := range oldEntries {
+		old[entry.Address] = entry
+	}
+
+	for _, entry := range newEntries {
+		if oldEntry, ok := old[entry.Address]; !ok || !bytes.Equal(k.cdc.MustMarshal(&oldEntry), k.cdc.MustMarshal(&entry)) {
+			k.setStakeEntryIndex(ctx, chainID, entry)
+		}
+		delete(old, entry.Address)
+	}
+
+	for address := range old {
+		k.removeStakeEntryIndex(ctx, chainID, address)
+	}
+}
+
+// setStakeEntryIndex sets an address' StakeEntry in the index of the current stake storage of a chain
+func (k Keeper) setStakeEntryIndex(ctx sdk.Context, chainID string, entry types.StakeEntry) {
+	store := k.stakeEntryIndexStore(ctx)
+	b := k.cdc.MustMarshal(&entry)
+	store.Set(types.StakeEntryIndexKey(chainID, entry.Address), b)
+}
+
+// removeStakeEntryIndex removes an address from the index of the current stake storage of a chain
+func (k Keeper) removeStakeEntryIndex(ctx sdk.Context, chainID string, address string) {
+	store := k.stakeEntryIndexStore(ctx)
+	store.Delete(types.StakeEntryIndexKey(chainID, address))
+}
+
+// removeStakeEntryIndices removes the stake entry index of a stake storage (if any)
+func (k Keeper) removeStakeEntryIndices(ctx sdk.Context, stakeStorageIndex string) {
+	store := k.stakeEntryIndexStore(ctx)
+	iterator := sdk.KVStorePrefixIterator(store, types.StakeEntryIndexPrefix(stakeStorageIndex))
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// getStakeEntryIndex returns an address' StakeEntry in the current stake storage of a chain
+func (k Keeper) getStakeEntryIndex(ctx sdk.Context, chainID string, address string) (entry types.StakeEntry, found bool) {
+	store := k.stakeEntryIndexStore(ctx)
+	b := store.Get(types.StakeEntryIndexKey(chainID, address))
+	if b == nil {
+		return entry, false
+	}
+	k.cdc.MustUnmarshal(b, &entry)
+	return entry, true
+}
@@ -48,6 +48,7 @@ func (k Keeper) RemoveStakeStorage(
 	store.Delete(types.StakeStorageKey(
 		index,
 	))
+	k.removeStakeEntryIndices(ctx, index)
 }
 
 // GetAllStakeStorage returns all stakeStorage
@@ -140,8 +141,11 @@ func (k Keeper) GetStakeStorageCurrent(ctx sdk.Context, chainID string) (types.S
 	return k.GetStakeStorage(ctx, k.stakeStorageKeyCurrent(chainID))
 }
 
+// SetStakeStorageCurrent sets the current stake storage of a chain (and its stake entry index)
 func (k Keeper) SetStakeStorageCurrent(ctx sdk.Context, chainID string, stakeStorage types.StakeStorage) {
+	oldStakeStorage, _ := k.GetStakeStorageCurrent(ctx, chainID)
 	stakeStorage.Index = k.stakeStorageKeyCurrent(chainID)
+	k.updateStakeEntryIndices(ctx, stakeStorage.Index, oldStakeStorage.StakeEntries, stakeStorage.StakeEntries)
 	k.SetStakeStorage(ctx, stakeStorage)
 }
 
@@ -179,66 +183,93 @@ func (k Keeper) GetStakeEntryByAddressFromStorage(ctx sdk.Context, stakeStorage
 	return
 }
 
-func (k Keeper) GetStakeEntryByAddressCurrent(ctx sdk.Context, chainID string, address sdk.AccAddress) (value types.StakeEntry, found bool, index uint64) {
-	stakeStorage, found := k.GetStakeStorageCurrent(ctx, chainID)
-	if !found {
-		return types.StakeEntry{}, false, 0
+// GetStakeEntryByAddressCurrent returns the stake entry of an address in the current stake
+// storage of a chain (using the stake entry index)
+func (k Keeper) GetStakeEntryByAddressCurrent(ctx sdk.Context, chainID string, address sdk.AccAddress) (value types.StakeEntry, found bool) {
+	return k.getStakeEntryIndex(ctx, k.stakeStorageKeyCurrent(chainID), address.String())
+}
+
+// stakeEntryPositionCurrent returns the position of an entry in the entries of a current stake
+// storage: the entries are sorted by stake, so the entry is looked up by its (indexed) stake
+// among the entries with the same stake
+func (k Keeper) stakeEntryPositionCurrent(ctx sdk.Context, stakeStorage types.StakeStorage, entry types.StakeEntry) (index uint64, found bool) {
+	entries := stakeStorage.StakeEntries
+	first := sort.Search(len(entries), func(i int) bool {
+		return entries[i].Stake.Amount.GTE(entry.Stake.Amount)
+	})
+	for idx := first; idx < len(entries) && entries[idx].Stake.Amount.Equal(entry.Stake.Amount); idx++ {
+		if entries[idx].Address == entry.Address {
+			return uint64(idx), true
+		}
 	}
-	// the following finds the address of stakeEntry and returns it
-	idx, found := k.stakeEntryIndexByAddress(ctx, stakeStorage, address)
-	if !found {
-		return types.StakeEntry{}, false, 0
+
+	// should not happen, the index and the stake storage are updated together
+	utils.LavaFormatError("critical: stake entry not found in the stake storage by its indexed stake", legacyerrors.ErrNotFound,
+		utils.LogAttr("chainID", entry.Chain),
+		utils.LogAttr("stakeAddr", entry.Address),
+	)
+	address, err := sdk.AccAddressFromBech32(entry.Address)
+	if err != nil {
+		return 0, false
 	}
-	// found the right thing
-	value = stakeStorage.StakeEntries[idx]
-	found = true
-	index = idx
-	return
+	return k.stakeEntryIndexByAddress(ctx, stakeStorage, address)
 }
 
-func (k Keeper) RemoveStakeEntryCurrent(ctx sdk.Context, chainID string, idx uint64) error {
+// RemoveStakeEntryCurrent removes the stake entry of an address from the current stake storage of a chain
+func (k Keeper) RemoveStakeEntryCurrent(ctx sdk.Context, chainID string, address sdk.AccAddress) error {
 	stakeStorage, found := k.GetStakeStorageCurrent(ctx, chainID)
 	if !found {
 		return legacyerrors.ErrNotFound
 	}
-	if idx >= uint64(len(stakeStorage.StakeEntries)) {
+	entry, found := k.GetStakeEntryByAddressCurrent(ctx, chainID, address)
+	if !found {
+		return legacyerrors.ErrNotFound
+	}
+	idx, found := k.stakeEntryPositionCurrent(ctx, stakeStorage, entry)
+	if !found {
 		return legacyerrors.ErrNotFound
 	}
 	stakeStorage.StakeEntries = append(stakeStorage.StakeEntries[:idx], stakeStorage.StakeEntries[idx+1:]...)
-	k.SetStakeStorageCurrent(ctx, chainID, stakeStorage)
+	k.removeStakeEntryIndex(ctx, stakeStorage.Index, entry.Address)
+	k.SetStakeStorage(ctx, stakeStorage)
 	return nil
 }
 
+// insertStakeEntry inserts a stake entry into entries that are sorted by stake
+func insertStakeEntry(entries []types.StakeEntry, stakeEntry types.StakeEntry) []types.StakeEntry {
+	// sort func needs to return true if the inserted entry is less than the existing entry
+	sortFunc := func(i int) bool {
+		return stakeEntry.Stake.Amount.LT(entries[i].Stake.Amount)
+	}
+	// returns the smallest index in which the sort func is true
+	index := sort.Search(len(entries), sortFunc)
+	if index < len(entries) {
+		entries = append(entries[:index+1], entries[index:]...)
+		entries[index] = stakeEntry
+	} else {
+		// put in the end
+		entries = append(entries, stakeEntry)
+	}
+	return entries
+}
+
 func (k Keeper) AppendStakeEntryCurrent(ctx sdk.Context, chainID string, stakeEntry types.StakeEntry) {
 	// this stake storage entries are sorted by stake amount
 	stakeStorage, found := k.GetStakeStorageCurrent(ctx, chainID)
-	var entries []types.StakeEntry
 	if !found {
-		entries = []types.StakeEntry{stakeEntry}
 		// create a new one
-		stakeStorage = types.StakeStorage{Index: k.stakeStorageKeyCurrent(chainID), StakeEntries: entries, EpochBlockHash: nil}
+		stakeStorage = types.StakeStorage{Index: k.stakeStorageKeyCurrent(chainID), StakeEntries: []types.StakeEntry{stakeEntry}, EpochBlockHash: nil}
 	} else {
 		// the following code inserts stakeEntry into the existing entries by stake
-		entries = stakeStorage.StakeEntries
-		// sort func needs to return true if the inserted entry is less than the existing entry
-		sortFunc := func(i int) bool {
-			return stakeEntry.Stake.Amount.LT(entries[i].Stake.Amount)
-		}
-		// returns the smallest index in which the sort func is true
-		index := sort.Search(len(entries), sortFunc)
-		if index < len(entries) {
-			entries = append(entries[:index+1], entries[index:]...)
-			entries[index] = stakeEntry
-		} else {
-			// put in the end
-			entries = append(entries, stakeEntry)
-		}
+		stakeStorage.StakeEntries = insertStakeEntry(stakeStorage.StakeEntries, stakeEntry)
 	}
-	stakeStorage.StakeEntries = entries
-	k.SetStakeStorageCurrent(ctx, chainID, stakeStorage)
+	k.setStakeEntryIndex(ctx, stakeStorage.Index, stakeEntry)
+	k.SetStakeStorage(ctx, stakeStorage)
 }
 
-func (k Keeper) ModifyStakeEntryCurrent(ctx sdk.Context, chainID string, stakeEntry types.StakeEntry, removeIndex uint64) {
+// ModifyStakeEntryCurrent replaces the stake entry of the address of stakeEntry in the current
+// stake storage of a chain, keeping the entries sorted by stake
+func (k Keeper) ModifyStakeEntryCurrent(ctx sdk.Context, chainID string, stakeEntry types.StakeEntry) {
 	// this stake storage entries are sorted by stake amount
 	stakeStorage, found := k.GetStakeStorageCurrent(ctx, chainID)
 	if !found {
@@ -250,26 +281,25 @@ func (k Keeper) ModifyStakeEntryCurrent(ctx sdk.Context, chainID string, stakeEn
 		)
 		return
 	}
-	// TODO: more efficient: only create a new list once, after the second index is identified
-	// remove the given index, then store the new entry in the sorted list at the right place
-	entries := []types.StakeEntry{}
-	entries = append(entries, stakeStorage.StakeEntries[:removeIndex]...)
-	entries = append(entries, stakeStorage.StakeEntries[removeIndex+1:]...)
-	// the following code inserts stakeEntry into the existing entries by stake
-	// sort func needs to return true if the inserted entry is less than the existing entry
-	sortFunc := func(i int) bool {
-		return stakeEntry.Stake.Amount.LT(entries[i].Stake.Amount)
+	oldEntry, found := k.getStakeEntryIndex(ctx, stakeStorage.Index, stakeEntry.Address)
+	var removeIndex uint64
+	if found {
+		removeIndex, found = k.stakeEntryPositionCurrent(ctx, stakeStorage, oldEntry)
 	}
-	// returns the smallest index in which the sort func is true
-	index := sort.Search(len(entries), sortFunc)
-	if index < len(entries) {
-		entries = append(entries[:index+1], entries[index:]...)
-		entries[index] = stakeEntry
-	} else {
-		entries = append(entries, stakeEntry)
+	if !found {
+		// should not happen since caller is expected to get the entry first;
+		// do nothing and return to avoid panic.
+		utils.LavaFormatError("critical: ModifyStakeEntryCurrent with unknown stake entry", legacyerrors.ErrNotFound,
+			utils.LogAttr("chainID", chainID),
+			utils.LogAttr("stakeAddr", stakeEntry.Address),
+		)
+		return
 	}
-	stakeStorage.StakeEntries = entries
-	k.SetStakeStorageCurrent(ctx, chainID, stakeStorage)
+	// remove the old entry, then store the new entry in the sorted list at the right place
+	stakeStorage.StakeEntries = append(stakeStorage.StakeEntries[:removeIndex], stakeStorage.StakeEntries[removeIndex+1:]...)
+	stakeStorage.StakeEntries = insertStakeEntry(stakeStorage.StakeEntries, stakeEntry)
+	k.setStakeEntryIndex(ctx, stakeStorage.Index, stakeEntry)
+	k.SetStakeStorage(ctx, stakeStorage)
 }
 
 // -------------------------------------------------- unstaking list --------------------------------------------
@@ -8,6 +8,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	testkeeper "github.com/lavanet/lava/testutil/keeper"
 	"github.com/lavanet/lava/testutil/nullify"
+	"github.com/lavanet/lava/testutil/sample"
 	"github.com/lavanet/lava/x/epochstorage/keeper"
 	epochstoragetypes "github.com/lavanet/lava/x/epochstorage/types"
 	"github.com/stretchr/testify/require"
@@ -136,3 +137,137 @@ func TestStakeStorageGetAll(t *testing.T) {
 		nullify.Fill(keeper.GetAllStakeStorage(ctx)),
 	)
 }
+
+// verifyStakeEntryLookup checks that every entry of the current stake storage is found by
+// its address, and that the entries are still sorted by stake
+func verifyStakeEntryLookup(t *testing.T, keeper *keeper.Keeper, ctx sdk.Context, chainID string) {
+	stakeStorage, found := keeper.GetStakeStorageCurrent(ctx, chainID)
+	require.True(t, found)
+	for idx, entry := range stakeStorage.StakeEntries {
+		if idx > 0 {
+			require.True(t, stakeStorage.StakeEntries[idx-1].Stake.Amount.LTE(entry.Stake.Amount))
+		}
+		stakeEntry, found := keeper.GetStakeEntryByAddressCurrent(ctx, chainID, sdk.MustAccAddressFromBech32(entry.Address))
+		require.True(t, found)
+		require.Equal(t, entry, stakeEntry)
+	}
+}
+
+func TestStakeEntryLookupByAddress(t *testing.T) {
+	keeper, ctx := testkeeper.EpochstorageKeeper(t)
+	chainID := "ETH1"
+
+	var addresses []sdk.AccAddress
+	for i := 0; i < 20; i++ {
+		addr := sdk.MustAccAddressFromBech32(sample.AccAddress())
+		addresses = append(addresses, addr)
+		entry := epochstoragetypes.StakeEntry{
+			Address: addr.String(),
+			Chain:   chainID,
+			Stake:   sdk.NewCoin(epochstoragetypes.TokenDenom, sdk.NewInt(int64((i*7)%20+1))),
+		}
+		keeper.AppendStakeEntryCurrent(ctx, chainID, entry)
+		verifyStakeEntryLookup(t, keeper, ctx, chainID)
+	}
+
+	// modify the stake of entries (moving them in the sorted list)
+	for i, addr := range addresses {
+		entry, found := keeper.GetStakeEntryByAddressCurrent(ctx, chainID, addr)
+		require.True(t, found)
+		entry.Stake.Amount = sdk.NewInt(int64((i*13)%20 + 1))
+		keeper.ModifyStakeEntryCurrent(ctx, chainID, entry)
+		verifyStakeEntryLookup(t, keeper, ctx, chainID)
+	}
+
+	// remove entries
+	for _, addr := range addresses[:10] {
+		_, found := keeper.GetStakeEntryByAddressCurrent(ctx, chainID, addr)
+		require.True(t, found)
+		require.NoError(t, keeper.RemoveStakeEntryCurrent(ctx, chainID, addr))
+		_, found = keeper.GetStakeEntryByAddressCurrent(ctx, chainID, addr)
+		require.False(t, found)
+		verifyStakeEntryLookup(t, keeper, ctx, chainID)
+	}
+
+	// unknown address
+	_, found := keeper.GetStakeEntryByAddressCurrent(ctx, chainID, sdk.MustAccAddressFromBech32(sample.AccAddress()))
+	require.False(t, found)
+
+	// removing the stake storage removes its index too
+	keeper.RemoveStakeStorage(ctx, chainID)
+	keeper.SetStakeStorageCurrent(ctx, chainID, epochstoragetypes.StakeStorage{})
+	for _, addr := range addresses {
+		_, found := keeper.GetStakeEntryByAddressCurrent(ctx, chainID, addr)
+		require.False(t, found)
+	}
+}
+
+func TestUnstakeEntryLookupByAddress(t *testing.T) {
+	keeper, ctx := testkeeper.EpochstorageKeeper(t)
+
+	addr := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	other := sdk.MustAccAddressFromBech32(sample.AccAddress())
+
+	// the same provider unstakes from two chains: the lookup finds the first to be released
+	ctx = ctx.WithBlockHeight(10)
+	require.NoError(t, keeper.AppendUnstakeEntry(ctx, epochstoragetypes.StakeEntry{Address: addr.String(), Chain: "ETH1"}, 10))
+	require.NoError(t, keeper.AppendUnstakeEntry(ctx, epochstoragetypes.StakeEntry{Address: other.String(), Chain: "ETH1"}, 5))
+	require.NoError(t, keeper.AppendUnstakeEntry(ctx, epochstoragetypes.StakeEntry{Address: addr.String(), Chain: "COS3"}, 1))
+
+	entry, found, index := keeper.UnstakeEntryByAddress(ctx, addr)
+	require.True(t, found)
+	require.Equal(t, uint64(0), index)
+	require.Equal(t, "COS3", entry.Chain)
+
+	entry, found, index = keeper.UnstakeEntryByAddress(ctx, other)
+	require.True(t, found)
+	require.Equal(t, uint64(1), index)
+	require.Equal(t, other.String(), entry.Address)
+
+	// pop the first entry: the provider's other unstake entry is found
+	popped := keeper.PopUnstakeEntries(ctx, 11)
+	require.Len(t, popped, 1)
+	entry, found, index = keeper.UnstakeEntryByAddress(ctx, addr)
+	require.True(t, found)
+	require.Equal(t, uint64(1), index)
+	require.Equal(t, "ETH1", entry.Chain)
+}
+
+func TestStakeEntryIndexCurrentOnly(t *testing.T) {
+	keeper, ctx := testkeeper.EpochstorageKeeper(t)
+	chainID := "ETH1"
+
+	var addresses []sdk.AccAddress
+	for i := 0; i < 5; i++ {
+		addr := sdk.MustAccAddressFromBech32(sample.AccAddress())
+		addresses = append(addresses, addr)
+		entry := epochstoragetypes.StakeEntry{
+			Address: addr.String(),
+			Chain:   chainID,
+			Stake:   sdk.NewCoin(epochstoragetypes.TokenDenom, sdk.NewInt(int64(i+1))),
+		}
+		keeper.AppendStakeEntryCurrent(ctx, chainID, entry)
+	}
+	current, found := keeper.GetStakeStorageCurrent(ctx, chainID)
+	require.True(t, found)
+
+	// an epoch stake storage is not indexed
+	epochStorage := current.Copy()
+	epochStorage.Index = keeper.StakeStorageKey(10, chainID)
+	keeper.SetStakeStorage(ctx, epochStorage)
+	keeper.IndexStakeStorage(ctx, epochStorage)
+
+	keeper.RemoveStakeStorage(ctx, chainID)
+	for _, addr := range addresses {
+		_, found := keeper.GetStakeEntryByAddressCurrent(ctx, chainID, addr)
+		require.False(t, found)
+		entry, err := keeper.GetStakeEntryForProviderEpoch(ctx, chainID, addr, 10)
+		require.NoError(t, err)
+		require.Equal(t, addr.String(), entry.Address)
+	}
+
+	// a current stake storage set in bulk (as in genesis) is indexed
+	keeper.SetStakeStorage(ctx, current)
+	keeper.IndexStakeStorage(ctx, current)
+	verifyStakeEntryLookup(t, keeper, ctx, chainID)
+}
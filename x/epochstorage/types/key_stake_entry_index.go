@@ -0,0 +1,38 @@
+package types
+
+import "encoding/binary"
+
+var _ binary.ByteOrder
+
+const (
+	// StakeEntryIndexKeyPrefix is the prefix to retrieve a StakeEntry (by its address)
+	// of the current StakeStorage of a chain
+	StakeEntryIndexKeyPrefix = "StakeEntryIndex/value/"
+)
+
+// StakeEntryIndexPrefix returns the store key prefix of all the StakeEntry indices of a (current) StakeStorage
+func StakeEntryIndexPrefix(
+	stakeStorageIndex string,
+) []byte {
+	var key []byte
+
+	stakeStorageIndexBytes := []byte(stakeStorageIndex)
+	key = append(key, stakeStorageIndexBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// StakeEntryIndexKey returns the store key to retrieve the StakeEntry of an address from the index fields
+func StakeEntryIndexKey(
+	stakeStorageIndex string,
+	address string,
+) []byte {
+	key := StakeEntryIndexPrefix(stakeStorageIndex)
+
+	addressBytes := []byte(address)
+	key = append(key, addressBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
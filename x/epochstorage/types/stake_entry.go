@@ -15,4 +15,10 @@ func (se StakeEntry) EffectiveStake() math.Int {
 		effective.Add(se.DelegateTotal.Amount)
 	}
 	return effective
-}
\ No newline at end of file
+}
+
+// IsJailed returns whether the provider is jailed: a jailed provider stays out of the
+// pairing until it is unjailed (which is allowed only after JailEndBlock).
+func (se StakeEntry) IsJailed() bool {
+	return se.JailEndBlock != 0
+}
@@ -25,16 +25,21 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type StakeEntry struct {
-	Stake              types.Coin `protobuf:"bytes,1,opt,name=stake,proto3" json:"stake"`
-	Address            string     `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
-	StakeAppliedBlock  uint64     `protobuf:"varint,3,opt,name=stake_applied_block,json=stakeAppliedBlock,proto3" json:"stake_applied_block,omitempty"`
-	Endpoints          []Endpoint `protobuf:"bytes,4,rep,name=endpoints,proto3" json:"endpoints"`
-	Geolocation        int32      `protobuf:"varint,5,opt,name=geolocation,proto3" json:"geolocation,omitempty"`
-	Chain              string     `protobuf:"bytes,6,opt,name=chain,proto3" json:"chain,omitempty"`
-	Moniker            string     `protobuf:"bytes,8,opt,name=moniker,proto3" json:"moniker,omitempty"`
-	DelegateTotal      types.Coin `protobuf:"bytes,9,opt,name=delegate_total,json=delegateTotal,proto3" json:"delegate_total"`
-	DelegateLimit      types.Coin `protobuf:"bytes,10,opt,name=delegate_limit,json=delegateLimit,proto3" json:"delegate_limit"`
-	DelegateCommission uint64     `protobuf:"varint,11,opt,name=delegate_commission,json=delegateCommission,proto3" json:"delegate_commission,omitempty"`
+	Stake                   types.Coin        `protobuf:"bytes,1,opt,name=stake,proto3" json:"stake"`
+	Address                 string            `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	StakeAppliedBlock       uint64            `protobuf:"varint,3,opt,name=stake_applied_block,json=stakeAppliedBlock,proto3" json:"stake_applied_block,omitempty"`
+	Endpoints               []Endpoint        `protobuf:"bytes,4,rep,name=endpoints,proto3" json:"endpoints"`
+	Geolocation             int32             `protobuf:"varint,5,opt,name=geolocation,proto3" json:"geolocation,omitempty"`
+	Chain                   string            `protobuf:"bytes,6,opt,name=chain,proto3" json:"chain,omitempty"`
+	Moniker                 string            `protobuf:"bytes,8,opt,name=moniker,proto3" json:"moniker,omitempty"`
+	DelegateTotal           types.Coin        `protobuf:"bytes,9,opt,name=delegate_total,json=delegateTotal,proto3" json:"delegate_total"`
+	DelegateLimit           types.Coin        `protobuf:"bytes,10,opt,name=delegate_limit,json=delegateLimit,proto3" json:"delegate_limit"`
+	DelegateCommission      uint64            `protobuf:"varint,11,opt,name=delegate_commission,json=delegateCommission,proto3" json:"delegate_commission,omitempty"`
+	JailEndBlock            uint64            `protobuf:"varint,12,opt,name=jail_end_block,json=jailEndBlock,proto3" json:"jail_end_block,omitempty"`
+	Jails                   uint64            `protobuf:"varint,13,opt,name=jails,proto3" json:"jails,omitempty"`
+	UnjailBlock             uint64            `protobuf:"varint,14,opt,name=unjail_block,json=unjailBlock,proto3" json:"unjail_block,omitempty"`
+	LastReportedEpoch       uint64            `protobuf:"varint,15,opt,name=last_reported_epoch,json=lastReportedEpoch,proto3" json:"last_reported_epoch,omitempty"`
+	PendingDelegationChange *DelegationChange `protobuf:"bytes,16,opt,name=pending_delegation_change,json=pendingDelegationChange,proto3" json:"pending_delegation_change,omitempty"`
 }
 
 func (m *StakeEntry) Reset()         { *m = StakeEntry{} }
@@ -140,8 +145,105 @@ func (m *StakeEntry) GetDelegateCommission() uint64 {
 	return 0
 }
 
+func (m *StakeEntry) GetJailEndBlock() uint64 {
+	if m != nil {
+		return m.JailEndBlock
+	}
+	return 0
+}
+
+func (m *StakeEntry) GetJails() uint64 {
+	if m != nil {
+		return m.Jails
+	}
+	return 0
+}
+
+func (m *StakeEntry) GetUnjailBlock() uint64 {
+	if m != nil {
+		return m.UnjailBlock
+	}
+	return 0
+}
+
+func (m *StakeEntry) GetLastReportedEpoch() uint64 {
+	if m != nil {
+		return m.LastReportedEpoch
+	}
+	return 0
+}
+
+func (m *StakeEntry) GetPendingDelegationChange() *DelegationChange {
+	if m != nil {
+		return m.PendingDelegationChange
+	}
+	return nil
+}
+
+// DelegationChange is a change of a provider's delegation terms that takes effect after a notice period
+type DelegationChange struct {
+	DelegateCommission uint64     `protobuf:"varint,1,opt,name=delegate_commission,json=delegateCommission,proto3" json:"delegate_commission,omitempty"`
+	DelegateLimit      types.Coin `protobuf:"bytes,2,opt,name=delegate_limit,json=delegateLimit,proto3" json:"delegate_limit"`
+	ApplyBlock         uint64     `protobuf:"varint,3,opt,name=apply_block,json=applyBlock,proto3" json:"apply_block,omitempty"`
+}
+
+func (m *DelegationChange) Reset()         { *m = DelegationChange{} }
+func (m *DelegationChange) String() string { return proto.CompactTextString(m) }
+func (*DelegationChange) ProtoMessage()    {}
+func (*DelegationChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df6302d6b53c056e, []int{1}
+}
+func (m *DelegationChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelegationChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelegationChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DelegationChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegationChange.Merge(m, src)
+}
+func (m *DelegationChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelegationChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegationChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelegationChange proto.InternalMessageInfo
+
+func (m *DelegationChange) GetDelegateCommission() uint64 {
+	if m != nil {
+		return m.DelegateCommission
+	}
+	return 0
+}
+
+func (m *DelegationChange) GetDelegateLimit() types.Coin {
+	if m != nil {
+		return m.DelegateLimit
+	}
+	return types.Coin{}
+}
+
+func (m *DelegationChange) GetApplyBlock() uint64 {
+	if m != nil {
+		return m.ApplyBlock
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*StakeEntry)(nil), "lavanet.lava.epochstorage.StakeEntry")
+	proto.RegisterType((*DelegationChange)(nil), "lavanet.lava.epochstorage.DelegationChange")
 }
 
 func init() {
@@ -149,34 +251,42 @@ func init() {
 }
 
 var fileDescriptor_df6302d6b53c056e = []byte{
-	// 422 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0xc1, 0x6e, 0x13, 0x31,
-	0x10, 0xcd, 0x92, 0xa4, 0x4d, 0x1c, 0x81, 0xc0, 0xed, 0xc1, 0xed, 0x61, 0x59, 0xc1, 0x65, 0x25,
-	0x90, 0xad, 0x16, 0xf1, 0x01, 0xa4, 0x6a, 0x91, 0x10, 0xa7, 0xc0, 0x89, 0x4b, 0xe4, 0xf5, 0x8e,
-	0x36, 0x56, 0x76, 0x3d, 0xab, 0xb5, 0xa9, 0xe8, 0x5f, 0xf0, 0x59, 0x3d, 0xf6, 0xc8, 0x09, 0xa1,
-	0xe4, 0x03, 0xf8, 0x05, 0xe4, 0xf5, 0x6e, 0x69, 0x0e, 0x91, 0xe0, 0x64, 0xcf, 0xbc, 0x79, 0x4f,
-	0xef, 0x8d, 0x86, 0xbc, 0x2a, 0xe5, 0xb5, 0x34, 0xe0, 0x84, 0x7f, 0x05, 0xd4, 0xa8, 0x56, 0xd6,
-	0x61, 0x23, 0x0b, 0x10, 0xd6, 0xc9, 0x35, 0x2c, 0xc1, 0xb8, 0xe6, 0x86, 0xd7, 0x0d, 0x3a, 0xa4,
-	0x27, 0xdd, 0x30, 0xf7, 0x2f, 0x7f, 0x38, 0x7c, 0x9a, 0xee, 0xd7, 0x01, 0x93, 0xd7, 0xa8, 0x8d,
-	0x0b, 0x22, 0xa7, 0xc7, 0x05, 0x16, 0xd8, 0x7e, 0x85, 0xff, 0x75, 0xdd, 0x58, 0xa1, 0xad, 0xd0,
-	0x8a, 0x4c, 0x5a, 0x10, 0xd7, 0x67, 0x19, 0x38, 0x79, 0x26, 0x14, 0x6a, 0x13, 0xf0, 0x17, 0xbf,
-	0x87, 0x84, 0x7c, 0xf2, 0x86, 0x2e, 0xbd, 0x1f, 0xfa, 0x96, 0x8c, 0x5b, 0x7b, 0x2c, 0x4a, 0xa2,
-	0x74, 0x76, 0x7e, 0xc2, 0x03, 0x9d, 0x7b, 0x3a, 0xef, 0xe8, 0xfc, 0x02, 0xb5, 0x99, 0x8f, 0x6e,
-	0x7f, 0x3e, 0x1f, 0x2c, 0xc2, 0x34, 0x65, 0xe4, 0x50, 0xe6, 0x79, 0x03, 0xd6, 0xb2, 0x47, 0x49,
-	0x94, 0x4e, 0x17, 0x7d, 0x49, 0x39, 0x39, 0x0a, 0x79, 0x65, 0x5d, 0x97, 0x1a, 0xf2, 0x65, 0x56,
-	0xa2, 0x5a, 0xb3, 0x61, 0x12, 0xa5, 0xa3, 0xc5, 0xb3, 0x16, 0x7a, 0x17, 0x90, 0xb9, 0x07, 0xe8,
-	0x7b, 0x32, 0xed, 0x73, 0x59, 0x36, 0x4a, 0x86, 0xe9, 0xec, 0xfc, 0x25, 0xdf, 0xbb, 0x1e, 0x7e,
-	0xd9, 0xcd, 0x76, 0x76, 0xfe, 0x72, 0x69, 0x42, 0x66, 0x05, 0x60, 0x89, 0x4a, 0x3a, 0x8d, 0x86,
-	0x8d, 0x93, 0x28, 0x1d, 0x2f, 0x1e, 0xb6, 0xe8, 0x31, 0x19, 0xab, 0x95, 0xd4, 0x86, 0x1d, 0xb4,
-	0x96, 0x43, 0xe1, 0xa3, 0x54, 0x68, 0xf4, 0x1a, 0x1a, 0x36, 0x09, 0x51, 0xba, 0x92, 0x5e, 0x91,
-	0x27, 0x39, 0x94, 0x50, 0x48, 0x07, 0x4b, 0x87, 0x4e, 0x96, 0x6c, 0xfa, 0x6f, 0x4b, 0x7a, 0xdc,
-	0xd3, 0x3e, 0x7b, 0xd6, 0x8e, 0x4e, 0xa9, 0x2b, 0xed, 0x18, 0xf9, 0x4f, 0x9d, 0x8f, 0x9e, 0x45,
-	0x05, 0x39, 0xba, 0xd7, 0x51, 0x58, 0x55, 0xda, 0x5a, 0x9f, 0x74, 0xd6, 0xae, 0x96, 0xf6, 0xd0,
-	0xc5, 0x3d, 0xf2, 0x61, 0x34, 0x39, 0x7c, 0x3a, 0x99, 0x5f, 0xdd, 0x6e, 0xe2, 0xe8, 0x6e, 0x13,
-	0x47, 0xbf, 0x36, 0x71, 0xf4, 0x7d, 0x1b, 0x0f, 0xee, 0xb6, 0xf1, 0xe0, 0xc7, 0x36, 0x1e, 0x7c,
-	0x79, 0x5d, 0x68, 0xb7, 0xfa, 0x9a, 0x71, 0x85, 0x95, 0xd8, 0x39, 0xbb, 0x6f, 0xbb, 0x87, 0xe7,
-	0x6e, 0x6a, 0xb0, 0xd9, 0x41, 0x7b, 0x40, 0x6f, 0xfe, 0x04, 0x00, 0x00, 0xff, 0xff, 0x3d, 0x45,
-	0x2d, 0xb5, 0xea, 0x02, 0x00, 0x00,
+	// 558 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x4f, 0x6f, 0xd3, 0x30,
+	0x14, 0x6f, 0xb6, 0x66, 0x7f, 0x9c, 0x6d, 0x14, 0xb7, 0x12, 0xee, 0x0e, 0x59, 0x18, 0x1c, 0x22,
+	0x0d, 0x39, 0xda, 0x10, 0x1f, 0x80, 0x96, 0x0e, 0x09, 0x71, 0x0a, 0x9c, 0xb8, 0x44, 0x6e, 0x62,
+	0xa5, 0xa6, 0x89, 0x1d, 0xc5, 0xde, 0x44, 0xbf, 0x05, 0x1f, 0x84, 0x0f, 0xb2, 0xe3, 0x8e, 0x9c,
+	0x10, 0x6a, 0x0f, 0x7c, 0x0d, 0x64, 0x3b, 0x2d, 0xeb, 0x50, 0x11, 0x70, 0xb2, 0xdf, 0xfb, 0xbd,
+	0xdf, 0xd3, 0x7b, 0xbf, 0x9f, 0x6c, 0x70, 0x56, 0x90, 0x6b, 0xc2, 0xa9, 0x8a, 0xf4, 0x19, 0xd1,
+	0x4a, 0xa4, 0x13, 0xa9, 0x44, 0x4d, 0x72, 0x1a, 0x49, 0x45, 0xa6, 0x34, 0xa1, 0x5c, 0xd5, 0x33,
+	0x5c, 0xd5, 0x42, 0x09, 0xd8, 0x6f, 0x8a, 0xb1, 0x3e, 0xf1, 0xdd, 0xe2, 0xe3, 0x70, 0x73, 0x1f,
+	0xca, 0xb3, 0x4a, 0x30, 0xae, 0x6c, 0x93, 0xe3, 0x5e, 0x2e, 0x72, 0x61, 0xae, 0x91, 0xbe, 0x35,
+	0x59, 0x3f, 0x15, 0xb2, 0x14, 0x32, 0x1a, 0x13, 0x49, 0xa3, 0xeb, 0xf3, 0x31, 0x55, 0xe4, 0x3c,
+	0x4a, 0x05, 0xe3, 0x16, 0x3f, 0xfd, 0xe1, 0x02, 0xf0, 0x4e, 0x0f, 0x34, 0xd2, 0xf3, 0xc0, 0x17,
+	0xc0, 0x35, 0xe3, 0x21, 0x27, 0x70, 0x42, 0xef, 0xa2, 0x8f, 0x2d, 0x1d, 0x6b, 0x3a, 0x6e, 0xe8,
+	0x78, 0x28, 0x18, 0x1f, 0xb4, 0x6f, 0xbe, 0x9d, 0xb4, 0x62, 0x5b, 0x0d, 0x11, 0xd8, 0x25, 0x59,
+	0x56, 0x53, 0x29, 0xd1, 0x56, 0xe0, 0x84, 0xfb, 0xf1, 0x32, 0x84, 0x18, 0x74, 0xed, 0xbe, 0xa4,
+	0xaa, 0x0a, 0x46, 0xb3, 0x64, 0x5c, 0x88, 0x74, 0x8a, 0xb6, 0x03, 0x27, 0x6c, 0xc7, 0x0f, 0x0d,
+	0xf4, 0xd2, 0x22, 0x03, 0x0d, 0xc0, 0xd7, 0x60, 0x7f, 0xb9, 0x97, 0x44, 0xed, 0x60, 0x3b, 0xf4,
+	0x2e, 0x9e, 0xe0, 0x8d, 0xf2, 0xe0, 0x51, 0x53, 0xdb, 0x8c, 0xf3, 0x8b, 0x0b, 0x03, 0xe0, 0xe5,
+	0x54, 0x14, 0x22, 0x25, 0x8a, 0x09, 0x8e, 0xdc, 0xc0, 0x09, 0xdd, 0xf8, 0x6e, 0x0a, 0xf6, 0x80,
+	0x9b, 0x4e, 0x08, 0xe3, 0x68, 0xc7, 0x8c, 0x6c, 0x03, 0xbd, 0x4a, 0x29, 0x38, 0x9b, 0xd2, 0x1a,
+	0xed, 0xd9, 0x55, 0x9a, 0x10, 0x5e, 0x82, 0xa3, 0x8c, 0x16, 0x34, 0x27, 0x8a, 0x26, 0x4a, 0x28,
+	0x52, 0xa0, 0xfd, 0xbf, 0x13, 0xe9, 0x70, 0x49, 0x7b, 0xaf, 0x59, 0x6b, 0x7d, 0x0a, 0x56, 0x32,
+	0x85, 0xc0, 0x3f, 0xf6, 0x79, 0xab, 0x59, 0x30, 0x02, 0xdd, 0x55, 0x9f, 0x54, 0x94, 0x25, 0x93,
+	0x52, 0x6f, 0xea, 0x19, 0x69, 0xe1, 0x12, 0x1a, 0xae, 0x10, 0xf8, 0x14, 0x1c, 0x7d, 0x24, 0xac,
+	0x48, 0x28, 0x5f, 0xda, 0x70, 0x60, 0x6a, 0x0f, 0x74, 0x76, 0xc4, 0x1b, 0x07, 0x7a, 0xc0, 0xd5,
+	0xb1, 0x44, 0x87, 0x06, 0xb4, 0x01, 0x7c, 0x0c, 0x0e, 0xae, 0xb8, 0x61, 0x5b, 0xe6, 0x91, 0x01,
+	0x3d, 0x9b, 0xb3, 0x44, 0x0c, 0xba, 0x05, 0x91, 0x2a, 0xa9, 0x69, 0x25, 0x6a, 0x45, 0xb3, 0xc4,
+	0x38, 0x85, 0x1e, 0x58, 0xab, 0x35, 0x14, 0x37, 0xc8, 0x48, 0x03, 0x30, 0x07, 0xfd, 0x8a, 0xf2,
+	0x8c, 0xf1, 0x3c, 0x69, 0x86, 0x65, 0x82, 0x27, 0xe9, 0x84, 0xf0, 0x9c, 0xa2, 0x8e, 0x91, 0xe4,
+	0xec, 0x0f, 0xd6, 0xbf, 0x5a, 0x71, 0x86, 0x86, 0x12, 0x3f, 0x6a, 0xba, 0xdd, 0x07, 0xde, 0xb4,
+	0xf7, 0x76, 0x3b, 0x7b, 0xa7, 0x5f, 0x1c, 0xd0, 0xb9, 0x0f, 0x6d, 0xd2, 0xd0, 0xd9, 0xa8, 0xe1,
+	0xef, 0xe6, 0x6d, 0xfd, 0x97, 0x79, 0x27, 0xc0, 0xd3, 0x2f, 0x62, 0xb6, 0xf6, 0x1e, 0x80, 0x49,
+	0x19, 0x35, 0x07, 0x97, 0x37, 0x73, 0xdf, 0xb9, 0x9d, 0xfb, 0xce, 0xf7, 0xb9, 0xef, 0x7c, 0x5e,
+	0xf8, 0xad, 0xdb, 0x85, 0xdf, 0xfa, 0xba, 0xf0, 0x5b, 0x1f, 0x9e, 0xe5, 0x4c, 0x4d, 0xae, 0xc6,
+	0x38, 0x15, 0x65, 0xb4, 0xf6, 0x3b, 0x7c, 0x5a, 0xff, 0x1f, 0xd4, 0xac, 0xa2, 0x72, 0xbc, 0x63,
+	0xde, 0xf9, 0xf3, 0x9f, 0x03, 0x00, 0x06, 0xd1, 0x3a, 0x33, 0x91, 0x04, 0x00, 0x00,
 }
 
 func (m *StakeEntry) Marshal() (dAtA []byte, err error) {
@@ -199,6 +309,40 @@ func (m *StakeEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.PendingDelegationChange != nil {
+		{
+			size, err := m.PendingDelegationChange.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStakeEntry(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.LastReportedEpoch != 0 {
+		i = encodeVarintStakeEntry(dAtA, i, uint64(m.LastReportedEpoch))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.UnjailBlock != 0 {
+		i = encodeVarintStakeEntry(dAtA, i, uint64(m.UnjailBlock))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.Jails != 0 {
+		i = encodeVarintStakeEntry(dAtA, i, uint64(m.Jails))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.JailEndBlock != 0 {
+		i = encodeVarintStakeEntry(dAtA, i, uint64(m.JailEndBlock))
+		i--
+		dAtA[i] = 0x60
+	}
 	if m.DelegateCommission != 0 {
 		i = encodeVarintStakeEntry(dAtA, i, uint64(m.DelegateCommission))
 		i--
@@ -282,6 +426,49 @@ func (m *StakeEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DelegationChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegationChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegationChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ApplyBlock != 0 {
+		i = encodeVarintStakeEntry(dAtA, i, uint64(m.ApplyBlock))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.DelegateLimit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintStakeEntry(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.DelegateCommission != 0 {
+		i = encodeVarintStakeEntry(dAtA, i, uint64(m.DelegateCommission))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintStakeEntry(dAtA []byte, offset int, v uint64) int {
 	offset -= sovStakeEntry(v)
 	base := offset
@@ -332,6 +519,39 @@ func (m *StakeEntry) Size() (n int) {
 	if m.DelegateCommission != 0 {
 		n += 1 + sovStakeEntry(uint64(m.DelegateCommission))
 	}
+	if m.JailEndBlock != 0 {
+		n += 1 + sovStakeEntry(uint64(m.JailEndBlock))
+	}
+	if m.Jails != 0 {
+		n += 1 + sovStakeEntry(uint64(m.Jails))
+	}
+	if m.UnjailBlock != 0 {
+		n += 1 + sovStakeEntry(uint64(m.UnjailBlock))
+	}
+	if m.LastReportedEpoch != 0 {
+		n += 1 + sovStakeEntry(uint64(m.LastReportedEpoch))
+	}
+	if m.PendingDelegationChange != nil {
+		l = m.PendingDelegationChange.Size()
+		n += 2 + l + sovStakeEntry(uint64(l))
+	}
+	return n
+}
+
+func (m *DelegationChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DelegateCommission != 0 {
+		n += 1 + sovStakeEntry(uint64(m.DelegateCommission))
+	}
+	l = m.DelegateLimit.Size()
+	n += 1 + l + sovStakeEntry(uint64(l))
+	if m.ApplyBlock != 0 {
+		n += 1 + sovStakeEntry(uint64(m.ApplyBlock))
+	}
 	return n
 }
 
@@ -656,6 +876,239 @@ func (m *StakeEntry) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailEndBlock", wireType)
+			}
+			m.JailEndBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStakeEntry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JailEndBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jails", wireType)
+			}
+			m.Jails = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStakeEntry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Jails |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnjailBlock", wireType)
+			}
+			m.UnjailBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStakeEntry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnjailBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastReportedEpoch", wireType)
+			}
+			m.LastReportedEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStakeEntry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastReportedEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingDelegationChange", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStakeEntry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStakeEntry
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStakeEntry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PendingDelegationChange == nil {
+				m.PendingDelegationChange = &DelegationChange{}
+			}
+			if err := m.PendingDelegationChange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStakeEntry(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStakeEntry
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelegationChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStakeEntry
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegationChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegationChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegateCommission", wireType)
+			}
+			m.DelegateCommission = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStakeEntry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DelegateCommission |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegateLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStakeEntry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStakeEntry
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStakeEntry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DelegateLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplyBlock", wireType)
+			}
+			m.ApplyBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStakeEntry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ApplyBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStakeEntry(dAtA[iNdEx:])
@@ -39,6 +39,10 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	cmd.AddCommand(CmdStaticProvidersList())
 	cmd.AddCommand(CmdAccountInfo())
 	cmd.AddCommand(CmdEffectivePolicy())
+	cmd.AddCommand(CmdSlashRecords())
+	cmd.AddCommand(CmdProviderEarnings())
+	cmd.AddCommand(CmdSimulatePairing())
+	cmd.AddCommand(CmdUpcomingDelegationChanges())
 
 	cmd.AddCommand(CmdSdkPairing())
 
@@ -0,0 +1,185 @@
+package cli
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	commontypes "github.com/lavanet/lava/common/types"
+	epochstoragetypes "github.com/lavanet/lava/x/epochstorage/types"
+	"github.com/lavanet/lava/x/pairing/keeper"
+	"github.com/lavanet/lava/x/pairing/types"
+	planstypes "github.com/lavanet/lava/x/plans/types"
+	projectstypes "github.com/lavanet/lava/x/projects/types"
+	spectypes "github.com/lavanet/lava/x/spec/types"
+	subscriptiontypes "github.com/lavanet/lava/x/subscription/types"
+	"github.com/spf13/cobra"
+)
+
+const (
+	EpochHashFlag = "epoch-hash"
+	GenesisFlag   = "genesis"
+)
+
+func CmdSimulatePairing() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "simulate-pairing [chain-id] [consumer]",
+		Short: "Simulate the pairing of a consumer on a chain",
+		Long: `Simulate the pairing of a consumer (or a project's developer key) on a chain, showing how the
+pairing was calculated: the filter results of every provider, the per-requirement scores (stake, geo,
+QoS) of the providers in each group of pairing slots, and the providers that were picked.
+By default the simulation uses the chain's state (at the current epoch, or at an epoch given by --epoch).
+With --genesis it runs offline on an exported genesis file instead. The epoch hash used to pick providers
+can be overridden with --epoch-hash, to see how the pairing would be with a different hash.`,
+		Example: `lavad q pairing simulate-pairing ETH1 lava@consumer
+lavad q pairing simulate-pairing ETH1 lava@consumer --epoch 1200 --epoch-hash 6e1f...
+lavad q pairing simulate-pairing ETH1 lava@consumer --genesis exported_genesis.json`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			chainID := args[0]
+			consumer := args[1]
+
+			epoch, err := cmd.Flags().GetUint64(EpochFlag)
+			if err != nil {
+				return err
+			}
+			epochHashStr, err := cmd.Flags().GetString(EpochHashFlag)
+			if err != nil {
+				return err
+			}
+			epochHash, err := hex.DecodeString(epochHashStr)
+			if err != nil {
+				return fmt.Errorf("invalid epoch hash %s: %w", epochHashStr, err)
+			}
+			genesisFile, err := cmd.Flags().GetString(GenesisFlag)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var res *types.QuerySimulatePairingResponse
+			if genesisFile != "" {
+				res, err = simulatePairingFromGenesis(clientCtx.Codec, genesisFile, chainID, consumer, epoch, epochHash)
+			} else {
+				queryClient := types.NewQueryClient(clientCtx)
+				res, err = queryClient.SimulatePairing(cmd.Context(), &types.QuerySimulatePairingRequest{
+					ChainID:   chainID,
+					Client:    consumer,
+					Epoch:     epoch,
+					EpochHash: epochHash,
+				})
+			}
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Uint64(EpochFlag, 0, "epoch to simulate (default: the current epoch)")
+	cmd.Flags().String(EpochHashFlag, "", "epoch hash (hex) to pick the providers with (default: the epoch's hash)")
+	cmd.Flags().String(GenesisFlag, "", "exported genesis file to simulate with (instead of the chain's state)")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// simulatePairingFromGenesis simulates a pairing using the state in an exported genesis file
+func simulatePairingFromGenesis(cdc codec.Codec, genesisFile, chainID, consumer string, epoch uint64, epochHash []byte) (*types.QuerySimulatePairingResponse, error) {
+	appState, _, err := genutiltypes.GenesisStateFromGenFile(genesisFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read genesis file %s: %w", genesisFile, err)
+	}
+
+	var epochstorageGenesis epochstoragetypes.GenesisState
+	var specGenesis spectypes.GenesisState
+	var projectsGenesis projectstypes.GenesisState
+	var subscriptionGenesis subscriptiontypes.GenesisState
+	var plansGenesis planstypes.GenesisState
+	cdc.MustUnmarshalJSON(appState[epochstoragetypes.ModuleName], &epochstorageGenesis)
+	cdc.MustUnmarshalJSON(appState[spectypes.ModuleName], &specGenesis)
+	cdc.MustUnmarshalJSON(appState[projectstypes.ModuleName], &projectsGenesis)
+	cdc.MustUnmarshalJSON(appState[subscriptiontypes.ModuleName], &subscriptionGenesis)
+	cdc.MustUnmarshalJSON(appState[planstypes.ModuleName], &plansGenesis)
+
+	// use the stake storage of the given epoch, or the current stake storage (at the current epoch)
+	stakeStorageIndex := chainID
+	if epoch != 0 {
+		stakeStorageIndex = strconv.FormatUint(epoch, 10) + chainID
+	} else if epochstorageGenesis.EpochDetails != nil {
+		epoch = epochstorageGenesis.EpochDetails.StartBlock
+	}
+	var stakeStorage *epochstoragetypes.StakeStorage
+	for i := range epochstorageGenesis.StakeStorageList {
+		if epochstorageGenesis.StakeStorageList[i].Index == stakeStorageIndex {
+			stakeStorage = &epochstorageGenesis.StakeStorageList[i]
+			break
+		}
+	}
+	if stakeStorage == nil {
+		return nil, fmt.Errorf("did not find providers for pairing: epoch:%d, chainID: %s", epoch, chainID)
+	}
+	if len(epochHash) == 0 {
+		epochHash = stakeStorage.EpochBlockHash
+	}
+
+	var spec *spectypes.Spec
+	for i := range specGenesis.SpecList {
+		if specGenesis.SpecList[i].Index == chainID {
+			spec = &specGenesis.SpecList[i]
+			break
+		}
+	}
+	if spec == nil {
+		return nil, fmt.Errorf("spec not found for chainID given: %s", chainID)
+	}
+
+	var developerData projectstypes.ProtoDeveloperData
+	if err := findGenesisFixationEntry(cdc, projectsGenesis.DeveloperFS, consumer, epoch, &developerData); err != nil {
+		return nil, fmt.Errorf("developer key %s: %w", consumer, err)
+	}
+	var project projectstypes.Project
+	if err := findGenesisFixationEntry(cdc, projectsGenesis.ProjectsFS, developerData.ProjectID, epoch, &project); err != nil {
+		return nil, fmt.Errorf("project %s: %w", developerData.ProjectID, err)
+	}
+	var sub subscriptiontypes.Subscription
+	if err := findGenesisFixationEntry(cdc, subscriptionGenesis.SubsFS, project.Subscription, epoch, &sub); err != nil {
+		return nil, fmt.Errorf("subscription %s: %w", project.Subscription, err)
+	}
+	var plan planstypes.Plan
+	if err := findGenesisFixationEntry(cdc, plansGenesis.PlansFS, sub.PlanIndex, sub.PlanBlock, &plan); err != nil {
+		return nil, fmt.Errorf("plan %s: %w", sub.PlanIndex, err)
+	}
+
+	return keeper.SimulatePairingOffline(stakeStorage.StakeEntries, spec.ProvidersTypes, plan, project, sub, chainID, epoch, epochHash, epochstorageGenesis.Params.EpochBlocks)
+}
+
+// findGenesisFixationEntry finds the version of an entry (by index) in force at a block, in a
+// fixation store's genesis, and unmarshals its data
+func findGenesisFixationEntry(cdc codec.Codec, gs commontypes.GenesisState, index string, block uint64, entryData codec.ProtoMarshaler) error {
+	var found *commontypes.Entry
+	for i := range gs.Entries {
+		for j := range gs.Entries[i].Entries {
+			entry := &gs.Entries[i].Entries[j]
+			if commontypes.DesanitizeIndex(entry.SafeIndex()) != index || entry.Block > block || entry.IsDeletedBy(block) {
+				continue
+			}
+			if found == nil || entry.Block > found.Block {
+				found = entry
+			}
+		}
+	}
+	if found == nil {
+		return fmt.Errorf("entry not found in genesis")
+	}
+	return cdc.Unmarshal(found.Data, entryData)
+}
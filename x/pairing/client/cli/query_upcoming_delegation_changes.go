@@ -0,0 +1,40 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/lavanet/lava/x/pairing/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdUpcomingDelegationChanges() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "upcoming-delegation-changes [chain-id] [provider]",
+		Short: "Query the scheduled delegation terms changes of providers, optionally only on a specific chain (and of a specific provider)",
+		Args:  cobra.RangeArgs(0, 2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			params := &types.QueryUpcomingDelegationChangesRequest{}
+			if len(args) > 0 {
+				params.ChainID = args[0]
+			}
+			if len(args) > 1 {
+				params.Provider = args[1]
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.UpcomingDelegationChanges(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,48 @@
+package cli
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/lavanet/lava/x/pairing/types"
+	"github.com/spf13/cobra"
+)
+
+var _ = strconv.Itoa(0)
+
+func CmdUnjail() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unjail [chain-ids]",
+		Short: "Unjails a provider",
+		Long:  `The unjail command allows a jailed provider to resume its service, effective next epoch. It can only be executed once the jail period has ended. Once executed, the provider will be again paired with consumers and expected to render its services.`,
+		Example: `required flags: --from alice
+		lavad tx pairing unjail [chain-ids] --from <provider_address>
+		lavad tx pairing unjail ETH1,COS3 --from alice`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argChainIds := strings.Split(args[0], listSeparator)
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUnjail(
+				clientCtx.GetFromAddress().String(),
+				argChainIds,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.MarkFlagRequired(flags.FlagFrom)
+
+	return cmd
+}
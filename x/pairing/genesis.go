@@ -28,6 +28,14 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 
 	k.InitBadgeTimers(ctx, genState.BadgesTS)
 	k.InitProviderQoS(ctx, genState.ProviderQosFS)
+	// Set all the slashRecord
+	for _, elem := range genState.SlashRecordList {
+		k.SetSlashRecord(ctx, elem)
+	}
+	// Set all the providerEarnings
+	for _, elem := range genState.ProviderEarningsList {
+		k.SetProviderEarnings(ctx, elem)
+	}
 	// this line is used by starport scaffolding # genesis/module/init
 	k.SetParams(ctx, genState.Params)
 }
@@ -43,6 +51,8 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis.BadgeUsedCuList = k.GetAllBadgeUsedCu(ctx)
 	genesis.BadgesTS = k.ExportBadgesTimers(ctx)
 	genesis.ProviderQosFS = k.ExportProviderQoS(ctx)
+	genesis.SlashRecordList = k.GetAllSlashRecord(ctx)
+	genesis.ProviderEarningsList = k.GetAllProviderEarnings(ctx)
 	// this line is used by starport scaffolding # genesis/module/export
 
 	return genesis
@@ -0,0 +1,124 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/utils"
+	epochstoragetypes "github.com/lavanet/lava/x/epochstorage/types"
+	"github.com/lavanet/lava/x/pairing/types"
+)
+
+// setDelegationTerms sets the delegation terms (commission and limit) of a staked provider. Changes in
+// favor of the delegators (lower commission, higher limit) take effect immediately. Other changes are
+// scheduled to be applied after a notice period of DELEGATION_CHANGE_NOTICE_EPOCHS epochs, and can increase
+// the commission by at most MAX_COMMISSION_INCREASE. Only one change can be scheduled at a time (a new
+// change replaces the scheduled one).
+func (k Keeper) setDelegationTerms(ctx sdk.Context, stakeEntry *epochstoragetypes.StakeEntry, commission uint64, limit sdk.Coin) error {
+	if commission == stakeEntry.DelegateCommission && limit.Amount.Equal(stakeEntry.DelegateLimit.Amount) {
+		// the current terms: cancel the scheduled change (if any)
+		stakeEntry.PendingDelegationChange = nil
+		return nil
+	}
+
+	if commission <= stakeEntry.DelegateCommission && limit.Amount.GTE(stakeEntry.DelegateLimit.Amount) {
+		stakeEntry.DelegateCommission = commission
+		stakeEntry.DelegateLimit = limit
+		stakeEntry.PendingDelegationChange = nil
+		return nil
+	}
+
+	if commission > stakeEntry.DelegateCommission+types.MAX_COMMISSION_INCREASE {
+		return utils.LavaFormatWarning("delegation commission increase is too high", types.DelegateCommissionIncreaseError,
+			utils.Attribute{Key: "provider", Value: stakeEntry.Address},
+			utils.Attribute{Key: "chainID", Value: stakeEntry.Chain},
+			utils.Attribute{Key: "commission", Value: stakeEntry.DelegateCommission},
+			utils.Attribute{Key: "newCommission", Value: commission},
+			utils.Attribute{Key: "maxIncrease", Value: types.MAX_COMMISSION_INCREASE},
+		)
+	}
+
+	pending := stakeEntry.PendingDelegationChange
+	if pending != nil && pending.DelegateCommission == commission && pending.DelegateLimit.Amount.Equal(limit.Amount) {
+		// already scheduled
+		return nil
+	}
+
+	block := uint64(ctx.BlockHeight())
+	nextEpoch, err := k.epochStorageKeeper.GetNextEpoch(ctx, block)
+	if err != nil {
+		return utils.LavaFormatError("failed to get next epoch", err,
+			utils.Attribute{Key: "block", Value: block},
+		)
+	}
+	epochBlocks, err := k.epochStorageKeeper.EpochBlocks(ctx, block)
+	if err != nil {
+		return utils.LavaFormatError("failed to get epoch blocks", err,
+			utils.Attribute{Key: "block", Value: block},
+		)
+	}
+
+	stakeEntry.PendingDelegationChange = &epochstoragetypes.DelegationChange{
+		DelegateCommission: commission,
+		DelegateLimit:      limit,
+		ApplyBlock:         nextEpoch + (types.DELEGATION_CHANGE_NOTICE_EPOCHS-1)*epochBlocks,
+	}
+
+	details := map[string]string{
+		"provider":      stakeEntry.Address,
+		"chainID":       stakeEntry.Chain,
+		"commission":    strconv.FormatUint(stakeEntry.DelegateCommission, 10),
+		"limit":         stakeEntry.DelegateLimit.String(),
+		"newCommission": strconv.FormatUint(commission, 10),
+		"newLimit":      limit.String(),
+		"applyBlock":    strconv.FormatUint(stakeEntry.PendingDelegationChange.ApplyBlock, 10),
+	}
+	utils.LogLavaEvent(ctx, k.Logger(ctx), types.ProviderDelegationChangeScheduledEventName, details, "Provider delegation change scheduled")
+
+	return nil
+}
+
+// applyDelegationChanges applies the scheduled delegation terms changes that their notice period is over
+// (called on epoch start, so the changes are effective from the next epoch)
+func (k Keeper) applyDelegationChanges(ctx sdk.Context) {
+	block := uint64(ctx.BlockHeight())
+	for _, stakeStorage := range k.getCurrentProviderStakeStorageList(ctx) {
+		for _, stakeEntry := range stakeStorage.GetStakeEntries() {
+			change := stakeEntry.PendingDelegationChange
+			if change == nil || change.ApplyBlock > block {
+				continue
+			}
+
+			providerAddr, err := sdk.AccAddressFromBech32(stakeEntry.Address)
+			if err != nil {
+				utils.LavaFormatError("critical: invalid provider address in stake entry", err,
+					utils.Attribute{Key: "provider", Value: stakeEntry.Address},
+				)
+				continue
+			}
+			// get the index of the entry (in the current stake storage) for the modification
+			_, found := k.epochStorageKeeper.GetStakeEntryByAddressCurrent(ctx, stakeEntry.Chain, providerAddr)
+			if !found {
+				utils.LavaFormatError("critical: stake entry of current stake storage not found", fmt.Errorf("stake entry not found"),
+					utils.Attribute{Key: "provider", Value: stakeEntry.Address},
+					utils.Attribute{Key: "chainID", Value: stakeEntry.Chain},
+				)
+				continue
+			}
+
+			stakeEntry.DelegateCommission = change.DelegateCommission
+			stakeEntry.DelegateLimit = change.DelegateLimit
+			stakeEntry.PendingDelegationChange = nil
+			k.epochStorageKeeper.ModifyStakeEntryCurrent(ctx, stakeEntry.Chain, stakeEntry)
+
+			details := map[string]string{
+				"provider":   stakeEntry.Address,
+				"chainID":    stakeEntry.Chain,
+				"commission": strconv.FormatUint(stakeEntry.DelegateCommission, 10),
+				"limit":      stakeEntry.DelegateLimit.String(),
+			}
+			utils.LogLavaEvent(ctx, k.Logger(ctx), types.ProviderDelegationChangeAppliedEventName, details, "Provider delegation change applied")
+		}
+	}
+}
@@ -97,13 +97,13 @@ func TestProviderDelegatorsRewards(t *testing.T) {
 			ts.AdvanceEpoch() // apply delegations
 
 			// change delegation traits of stake entry and get the modified one
-			stakeEntry, found, stakeEntryIndex := ts.Keepers.Epochstorage.GetStakeEntryByAddressCurrent(ts.Ctx, ts.spec.Index, providerAcc.Addr)
+			stakeEntry, found := ts.Keepers.Epochstorage.GetStakeEntryByAddressCurrent(ts.Ctx, ts.spec.Index, providerAcc.Addr)
 			require.True(t, found)
 			stakeEntry.DelegateLimit = sdk.NewCoin(epochstoragetypes.TokenDenom, sdk.NewInt(tt.limit*testStake/100))
 			stakeEntry.DelegateCommission = tt.commission
-			ts.Keepers.Epochstorage.ModifyStakeEntryCurrent(ts.Ctx, ts.spec.Index, stakeEntry, stakeEntryIndex)
+			ts.Keepers.Epochstorage.ModifyStakeEntryCurrent(ts.Ctx, ts.spec.Index, stakeEntry)
 			ts.AdvanceEpoch()
-			stakeEntry, found, _ = ts.Keepers.Epochstorage.GetStakeEntryByAddressCurrent(ts.Ctx, ts.spec.Index, providerAcc.Addr)
+			stakeEntry, found = ts.Keepers.Epochstorage.GetStakeEntryByAddressCurrent(ts.Ctx, ts.spec.Index, providerAcc.Addr)
 			require.True(t, found)
 
 			// check that there are two delegators
@@ -199,15 +199,15 @@ func TestDelegationLimitAffectingProviderReward(t *testing.T) {
 	require.Nil(t, err)
 	ts.AdvanceEpoch() // apply delegations
 
-	stakeEntry, found, stakeEntryIndex := ts.Keepers.Epochstorage.GetStakeEntryByAddressCurrent(ts.Ctx, ts.spec.Index, providerAcc.Addr)
+	stakeEntry, found := ts.Keepers.Epochstorage.GetStakeEntryByAddressCurrent(ts.Ctx, ts.spec.Index, providerAcc.Addr)
 	require.True(t, found)
 
 	// modify the stake entry to have a delegation limit higher than the total delegations
 	stakeEntry.DelegateLimit = sdk.NewCoin(epochstoragetypes.TokenDenom, sdk.NewInt(2*testStake))
 	stakeEntry.DelegateCommission = 50
-	ts.Keepers.Epochstorage.ModifyStakeEntryCurrent(ts.Ctx, ts.spec.Index, stakeEntry, stakeEntryIndex)
+	ts.Keepers.Epochstorage.ModifyStakeEntryCurrent(ts.Ctx, ts.spec.Index, stakeEntry)
 	ts.AdvanceEpoch()
-	stakeEntry, found, stakeEntryIndex = ts.Keepers.Epochstorage.GetStakeEntryByAddressCurrent(ts.Ctx, ts.spec.Index, providerAcc.Addr)
+	stakeEntry, found = ts.Keepers.Epochstorage.GetStakeEntryByAddressCurrent(ts.Ctx, ts.spec.Index, providerAcc.Addr)
 	require.True(t, found)
 
 	res, err := ts.QueryDualstakingProviderDelegators(provider, false)
@@ -228,7 +228,7 @@ func TestDelegationLimitAffectingProviderReward(t *testing.T) {
 
 	// modify the stake entry to have a delegation limit lower than the total delegations
 	stakeEntry.DelegateLimit = sdk.NewCoin(epochstoragetypes.TokenDenom, sdk.NewInt(testStake))
-	ts.Keepers.Epochstorage.ModifyStakeEntryCurrent(ts.Ctx, ts.spec.Index, stakeEntry, stakeEntryIndex)
+	ts.Keepers.Epochstorage.ModifyStakeEntryCurrent(ts.Ctx, ts.spec.Index, stakeEntry)
 	ts.AdvanceEpoch()
 
 	providerReward, _ = ts.Keepers.Dualstaking.CalcRewards(stakeEntry, math.NewInt(int64(relayCuSum)))
@@ -258,16 +258,16 @@ func TestProviderRewardWithCommission(t *testing.T) {
 	require.Nil(t, err)
 	ts.AdvanceEpoch() // apply delegations
 
-	stakeEntry, found, stakeEntryIndex := ts.Keepers.Epochstorage.GetStakeEntryByAddressCurrent(ts.Ctx, ts.spec.Index, providerAcc.Addr)
+	stakeEntry, found := ts.Keepers.Epochstorage.GetStakeEntryByAddressCurrent(ts.Ctx, ts.spec.Index, providerAcc.Addr)
 	require.True(t, found)
 
 	// ** provider's commission is 100% ** //
 
 	stakeEntry.DelegateCommission = 100
 	stakeEntry.DelegateLimit = delegationAmount1
-	ts.Keepers.Epochstorage.ModifyStakeEntryCurrent(ts.Ctx, ts.spec.Index, stakeEntry, stakeEntryIndex)
+	ts.Keepers.Epochstorage.ModifyStakeEntryCurrent(ts.Ctx, ts.spec.Index, stakeEntry)
 	ts.AdvanceEpoch()
-	stakeEntry, found, stakeEntryIndex = ts.Keepers.Epochstorage.GetStakeEntryByAddressCurrent(ts.Ctx, ts.spec.Index, providerAcc.Addr)
+	stakeEntry, found = ts.Keepers.Epochstorage.GetStakeEntryByAddressCurrent(ts.Ctx, ts.spec.Index, providerAcc.Addr)
 	require.True(t, found)
 
 	res, err := ts.QueryDualstakingProviderDelegators(provider, false)
@@ -305,7 +305,7 @@ func TestProviderRewardWithCommission(t *testing.T) {
 	// ** provider's commission is 0% ** //
 
 	stakeEntry.DelegateCommission = 0
-	ts.Keepers.Epochstorage.ModifyStakeEntryCurrent(ts.Ctx, ts.spec.Index, stakeEntry, stakeEntryIndex)
+	ts.Keepers.Epochstorage.ModifyStakeEntryCurrent(ts.Ctx, ts.spec.Index, stakeEntry)
 	ts.AdvanceEpoch()
 
 	// the expected reward for the provider with 0% commission is half of the total rewards
@@ -421,10 +421,140 @@ func TestQueryDelegatorRewards(t *testing.T) {
 }
 
 func makeProviderCommissionZero(ts *tester, chainID string, provider sdk.AccAddress) {
-	stakeEntry, found, stakeEntryIndex := ts.Keepers.Epochstorage.GetStakeEntryByAddressCurrent(ts.Ctx, chainID, provider)
+	stakeEntry, found := ts.Keepers.Epochstorage.GetStakeEntryByAddressCurrent(ts.Ctx, chainID, provider)
 	require.True(ts.T, found)
 	stakeEntry.DelegateCommission = 0
 	stakeEntry.DelegateLimit = sdk.NewCoin(epochstoragetypes.TokenDenom, sdk.NewInt(testStake))
-	ts.Keepers.Epochstorage.ModifyStakeEntryCurrent(ts.Ctx, stakeEntry.Chain, stakeEntry, stakeEntryIndex)
+	ts.Keepers.Epochstorage.ModifyStakeEntryCurrent(ts.Ctx, stakeEntry.Chain, stakeEntry)
 	ts.AdvanceEpoch()
 }
+
+// TestDelegatorRewardsAutoCompound checks that the rewards of a delegation with auto compounding are
+// re-delegated on the next epoch start, up to the provider's delegation limit (the rest is sent to the delegator)
+func TestDelegatorRewardsAutoCompound(t *testing.T) {
+	ts := newTester(t)
+	ts.setupForPayments(1, 1, 1)                   // 1 provider, 1 client, 1 providersToPair
+	ts.AddAccount(common.CONSUMER, 1, testBalance) // add delegator
+
+	providerAcc, provider := ts.GetAccount(common.PROVIDER, 0)
+	clientAcc, _ := ts.GetAccount(common.CONSUMER, 0)
+	delegatorAcc, delegator := ts.GetAccount(common.CONSUMER, 1)
+
+	ts.AdvanceEpoch()
+	makeProviderCommissionZero(ts, ts.spec.Index, providerAcc.Addr)
+
+	// auto compound can only be set for an existing delegation
+	_, err := ts.TxDualstakingSetAutoCompound(delegator, provider, ts.spec.Index, true)
+	require.Error(t, err)
+
+	delegationAmount := sdk.NewCoin(epochstoragetypes.TokenDenom, sdk.NewInt(testStake))
+	_, err = ts.TxDualstakingDelegate(delegator, provider, ts.spec.Index, delegationAmount)
+	require.Nil(t, err)
+	_, err = ts.TxDualstakingSetAutoCompound(delegator, provider, ts.spec.Index, true)
+	require.Nil(t, err)
+
+	// leave room for the rewards below the delegation limit
+	stakeEntry, found := ts.Keepers.Epochstorage.GetStakeEntryByAddressCurrent(ts.Ctx, ts.spec.Index, providerAcc.Addr)
+	require.True(t, found)
+	stakeEntry.DelegateLimit = sdk.NewCoin(epochstoragetypes.TokenDenom, sdk.NewInt(2*testStake))
+	ts.Keepers.Epochstorage.ModifyStakeEntryCurrent(ts.Ctx, ts.spec.Index, stakeEntry)
+	ts.AdvanceEpoch() // apply delegation
+
+	getDelegation := func() sdk.Coin {
+		res, err := ts.QueryDualstakingProviderDelegators(provider, true)
+		require.Nil(t, err)
+		require.Len(t, res.Delegations, 1)
+		require.True(t, res.Delegations[0].AutoCompound)
+		return res.Delegations[0].Amount
+	}
+
+	getReward := func() math.Int {
+		res, err := ts.QueryDualstakingDelegatorRewards(delegator, provider, ts.spec.Index)
+		require.Nil(t, err)
+		require.Len(t, res.Rewards, 1)
+		return res.Rewards[0].Amount.Amount
+	}
+
+	// the reward is fully re-delegated on the next epoch start
+	_, err = ts.TxPairingRelayPayment(provider, sendRelay(ts, provider, clientAcc, []string{ts.spec.Index}).Relays...)
+	require.Nil(t, err)
+	reward := getReward()
+	require.True(t, reward.IsPositive())
+	balance := ts.GetBalance(delegatorAcc.Addr)
+
+	ts.AdvanceEpoch()
+	require.Equal(t, delegationAmount.AddAmount(reward), getDelegation())
+	require.Equal(t, balance, ts.GetBalance(delegatorAcc.Addr))
+	res, err := ts.QueryDualstakingDelegatorRewards(delegator, provider, ts.spec.Index)
+	require.Nil(t, err)
+	require.Empty(t, res.Rewards)
+
+	// with the delegation limit reached, the reward is sent to the delegator instead
+	stakeEntry, found = ts.Keepers.Epochstorage.GetStakeEntryByAddressCurrent(ts.Ctx, ts.spec.Index, providerAcc.Addr)
+	require.True(t, found)
+	stakeEntry.DelegateLimit = stakeEntry.DelegateTotal
+	ts.Keepers.Epochstorage.ModifyStakeEntryCurrent(ts.Ctx, ts.spec.Index, stakeEntry)
+	ts.AdvanceEpoch()
+	delegation := getDelegation()
+
+	_, err = ts.TxPairingRelayPayment(provider, sendRelay(ts, provider, clientAcc, []string{ts.spec.Index}).Relays...)
+	require.Nil(t, err)
+	reward = getReward()
+
+	ts.AdvanceEpoch()
+	require.Equal(t, delegation, getDelegation())
+	require.Equal(t, balance+reward.Int64(), ts.GetBalance(delegatorAcc.Addr))
+
+	// after disabling auto compound the rewards remain to be claimed
+	_, err = ts.TxDualstakingSetAutoCompound(delegator, provider, ts.spec.Index, false)
+	require.Nil(t, err)
+	ts.AdvanceEpoch()
+
+	_, err = ts.TxPairingRelayPayment(provider, sendRelay(ts, provider, clientAcc, []string{ts.spec.Index}).Relays...)
+	require.Nil(t, err)
+	reward = getReward()
+	ts.AdvanceEpoch()
+	require.Equal(t, reward, getReward())
+}
+
+// TestDelegatorRewardsAutoCompoundUnbonded checks that auto compounding ends with the delegation:
+// the rewards of a delegation that was fully unbonded are not compounded
+func TestDelegatorRewardsAutoCompoundUnbonded(t *testing.T) {
+	ts := newTester(t)
+	ts.setupForPayments(1, 1, 1)                   // 1 provider, 1 client, 1 providersToPair
+	ts.AddAccount(common.CONSUMER, 1, testBalance) // add delegator
+
+	providerAcc, provider := ts.GetAccount(common.PROVIDER, 0)
+	clientAcc, _ := ts.GetAccount(common.CONSUMER, 0)
+	_, delegator := ts.GetAccount(common.CONSUMER, 1)
+
+	ts.AdvanceEpoch()
+	makeProviderCommissionZero(ts, ts.spec.Index, providerAcc.Addr)
+
+	delegationAmount := sdk.NewCoin(epochstoragetypes.TokenDenom, sdk.NewInt(testStake))
+	_, err := ts.TxDualstakingDelegate(delegator, provider, ts.spec.Index, delegationAmount)
+	require.Nil(t, err)
+	_, err = ts.TxDualstakingSetAutoCompound(delegator, provider, ts.spec.Index, true)
+	require.Nil(t, err)
+	ts.AdvanceEpoch()
+
+	_, err = ts.TxPairingRelayPayment(provider, sendRelay(ts, provider, clientAcc, []string{ts.spec.Index}).Relays...)
+	require.Nil(t, err)
+	rewards, err := ts.QueryDualstakingDelegatorRewards(delegator, provider, ts.spec.Index)
+	require.Nil(t, err)
+	require.Len(t, rewards.Rewards, 1)
+	reward := rewards.Rewards[0].Amount
+	require.True(t, reward.IsPositive())
+
+	// unbond the entire delegation before the rewards are compounded
+	_, err = ts.TxDualstakingUnbond(delegator, provider, ts.spec.Index, delegationAmount)
+	require.Nil(t, err)
+	ts.AdvanceEpochs(2)
+
+	res, err := ts.QueryDualstakingProviderDelegators(provider, true)
+	require.Nil(t, err)
+	require.Empty(t, res.Delegations)
+	delegatorReward, found := ts.Keepers.Dualstaking.GetDelegatorReward(ts.Ctx, dualstakingtypes.DelegationKey(provider, delegator, ts.spec.Index))
+	require.True(t, found)
+	require.Equal(t, reward, delegatorReward.Amount)
+}
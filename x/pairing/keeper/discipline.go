@@ -1,21 +1,211 @@
 package keeper
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/utils"
 	epochstoragetypes "github.com/lavanet/lava/x/epochstorage/types"
+	"github.com/lavanet/lava/x/pairing/types"
 )
 
+// JailEntry jails a provider on a chain from jailStartBlock for jailBlocks blocks. A
+// jailed provider is excluded from the pairing (from the next epoch), and remains so
+// until it sends an unjail transaction after the jail ends. If the provider is already
+// jailed, the jail is extended if needed. The jail doesn't count as an offense of the
+// provider (see jailEntryForOffense), it is used by the conflict module for jurors that
+// didn't vote.
 func (k Keeper) JailEntry(ctx sdk.Context, account sdk.AccAddress, chainID string, jailStartBlock, jailBlocks uint64, bail sdk.Coin) error {
-	// todo - provider will not get pairing and payment for this period
+	// todo - bail amount
+	entry, found := k.epochStorageKeeper.GetStakeEntryByAddressCurrent(ctx, chainID, account)
+	if !found {
+		return utils.LavaFormatWarning("can't jail entry, stake entry not found for address", fmt.Errorf("stake entry not found"),
+			utils.Attribute{Key: "provider", Value: account.String()},
+			utils.Attribute{Key: "chainID", Value: chainID},
+		)
+	}
+
+	jailEndBlock := jailStartBlock + jailBlocks
+	if jailEndBlock > entry.JailEndBlock {
+		entry.JailEndBlock = jailEndBlock
+	}
+	k.epochStorageKeeper.ModifyStakeEntryCurrent(ctx, chainID, entry)
+
 	return nil
 }
 
+// jailEntryForOffense jails a provider on a chain from the current block for an offense
+// (unresponsiveness). The jail duration escalates with the provider's offenses (see
+// jailBlocksForOffense), after the offenses that were forgiven are deducted (see
+// decayedJails). It returns the jail's end block and the provider's offenses count.
+func (k Keeper) jailEntryForOffense(ctx sdk.Context, account sdk.AccAddress, chainID string) (uint64, uint64, error) {
+	entry, found := k.epochStorageKeeper.GetStakeEntryByAddressCurrent(ctx, chainID, account)
+	if !found {
+		return 0, 0, utils.LavaFormatWarning("can't jail entry, stake entry not found for address", fmt.Errorf("stake entry not found"),
+			utils.Attribute{Key: "provider", Value: account.String()},
+			utils.Attribute{Key: "chainID", Value: chainID},
+		)
+	}
+
+	jails, err := k.decayedJails(ctx, entry)
+	if err != nil {
+		return 0, 0, err
+	}
+	jailBlocks, err := k.jailBlocksForOffense(ctx, jails)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	jailEndBlock := uint64(ctx.BlockHeight()) + jailBlocks
+	if jailEndBlock > entry.JailEndBlock {
+		entry.JailEndBlock = jailEndBlock
+	}
+	entry.Jails = jails + 1
+	k.epochStorageKeeper.ModifyStakeEntryCurrent(ctx, chainID, entry)
+
+	return entry.JailEndBlock, entry.Jails, nil
+}
+
+// decayedJails returns the offenses count of a provider after one offense is forgiven
+// for every JAIL_DECAY_EPOCHS epochs the provider wasn't jailed (since its last jail
+// ended or since it was unjailed).
+func (k Keeper) decayedJails(ctx sdk.Context, entry epochstoragetypes.StakeEntry) (uint64, error) {
+	currentBlock := uint64(ctx.BlockHeight())
+	freeSince := entry.UnjailBlock
+	if entry.IsJailed() {
+		freeSince = entry.JailEndBlock
+	}
+	if entry.Jails == 0 || freeSince >= currentBlock {
+		return entry.Jails, nil
+	}
+
+	epochBlocks, err := k.epochStorageKeeper.EpochBlocks(ctx, currentBlock)
+	if err != nil {
+		return 0, err
+	}
+
+	forgiven := (currentBlock - freeSince) / (epochBlocks * types.JAIL_DECAY_EPOCHS)
+	if forgiven >= entry.Jails {
+		return 0, nil
+	}
+	return entry.Jails - forgiven, nil
+}
+
+// jailBlocksForOffense returns the jail duration (in blocks) for a provider that was
+// already jailed the given number of times: it starts at JAIL_EPOCHS_BASE epochs and
+// doubles on every offense, up to JAIL_ESCALATION_MAX times.
+func (k Keeper) jailBlocksForOffense(ctx sdk.Context, jails uint64) (uint64, error) {
+	epochBlocks, err := k.epochStorageKeeper.EpochBlocks(ctx, uint64(ctx.BlockHeight()))
+	if err != nil {
+		return 0, err
+	}
+
+	escalation := jails
+	if escalation > types.JAIL_ESCALATION_MAX {
+		escalation = types.JAIL_ESCALATION_MAX
+	}
+
+	return epochBlocks * types.JAIL_EPOCHS_BASE << escalation, nil
+}
+
 func (k Keeper) BailEntry(ctx sdk.Context, account sdk.AccAddress, chainID string, bail sdk.Coin) error {
 	// todo - remove provider from jail and remove bail amount from account and add to stake
 	return nil
 }
 
-func (k Keeper) SlashEntry(ctx sdk.Context, account sdk.AccAddress, chainID string, percentage sdk.Dec) (sdk.Coin, error) {
-	// TODO: jail user, and count problems
-	return sdk.NewCoin(epochstoragetypes.TokenDenom, sdk.ZeroInt()), nil
+// SlashEntry slashes the given percentage of a provider's self-stake on a chain, and
+// the same percentage (capped by DelegationsSlashCap) of each of the provider's
+// delegations on that chain. The slashed coins are moved to the recipient module (the
+// conflict module, which pays the conflict rewards from them), and the total slashed
+// amount is returned to the caller. A provider whose remaining self-stake falls below
+// the spec's min stake is frozen. A SlashRecord is kept for every slash. A provider
+// that is already unstaking is slashed from its unstake entry. Delegations that are
+// unbonding are not slashed (see SlashDelegations).
+func (k Keeper) SlashEntry(ctx sdk.Context, account sdk.AccAddress, chainID string, percentage sdk.Dec, recipientModule string) (sdk.Coin, error) {
+	slashed := sdk.NewCoin(epochstoragetypes.TokenDenom, sdk.ZeroInt())
+
+	if percentage.IsNegative() || percentage.GT(sdk.OneDec()) {
+		return slashed, utils.LavaFormatWarning("invalid slash percentage", fmt.Errorf("slash percentage must be between 0 and 1"),
+			utils.Attribute{Key: "percentage", Value: percentage},
+		)
+	}
+
+	provider := account.String()
+	stakeSlashed := sdk.NewCoin(epochstoragetypes.TokenDenom, sdk.ZeroInt())
+	frozen := false
+
+	entry, found := k.epochStorageKeeper.GetStakeEntryByAddressCurrent(ctx, chainID, account)
+	if found {
+		stakeSlashed.Amount = percentage.MulInt(entry.Stake.Amount).TruncateInt()
+		entry.Stake = entry.Stake.Sub(stakeSlashed)
+		// a provider left with less than the min stake is frozen (see FreezeProvider), it
+		// has to stake more before it can unfreeze
+		spec, err := k.specKeeper.GetExpandedSpec(ctx, chainID)
+		if err == nil && entry.Stake.IsLT(spec.MinStakeProvider) && entry.StakeAppliedBlock != types.FROZEN_BLOCK {
+			entry.StakeAppliedBlock = types.FROZEN_BLOCK
+			frozen = true
+		}
+		k.epochStorageKeeper.ModifyStakeEntryCurrent(ctx, chainID, entry)
+	} else {
+		var index uint64
+		entry, found, index = k.epochStorageKeeper.UnstakeEntryByAddress(ctx, account)
+		if !found || entry.Chain != chainID {
+			return slashed, utils.LavaFormatWarning("can't slash entry, stake entry not found for address", fmt.Errorf("stake entry not found"),
+				utils.Attribute{Key: "provider", Value: provider},
+				utils.Attribute{Key: "chainID", Value: chainID},
+			)
+		}
+		stakeSlashed.Amount = percentage.MulInt(entry.Stake.Amount).TruncateInt()
+		entry.Stake = entry.Stake.Sub(stakeSlashed)
+		k.epochStorageKeeper.ModifyUnstakeEntry(ctx, entry, index)
+	}
+
+	if stakeSlashed.IsPositive() {
+		err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, recipientModule, sdk.NewCoins(stakeSlashed))
+		if err != nil {
+			return slashed, utils.LavaFormatError("critical: failed to move slashed stake", err,
+				utils.Attribute{Key: "provider", Value: provider},
+				utils.Attribute{Key: "chainID", Value: chainID},
+				utils.Attribute{Key: "amount", Value: stakeSlashed},
+				utils.Attribute{Key: "recipient", Value: recipientModule},
+			)
+		}
+	}
+	slashed = slashed.Add(stakeSlashed)
+
+	delegationsPercentage := sdk.MinDec(percentage, types.DelegationsSlashCap)
+	delegationsSlashed := sdk.NewCoin(epochstoragetypes.TokenDenom, sdk.ZeroInt())
+	amount, err := k.dualStakingKeeper.SlashDelegations(ctx, provider, chainID, delegationsPercentage, recipientModule)
+	delegationsSlashed.Amount = amount
+	slashed = slashed.Add(delegationsSlashed)
+
+	record := k.AppendSlashRecord(ctx, types.SlashRecord{
+		Provider:           provider,
+		ChainID:            chainID,
+		Percentage:         percentage,
+		StakeSlashed:       stakeSlashed,
+		DelegationsSlashed: delegationsSlashed,
+	})
+
+	details := map[string]string{
+		"provider":              provider,
+		"chainID":               chainID,
+		"percentage":            percentage.String(),
+		"delegationsPercentage": delegationsPercentage.String(),
+		"stakeSlashed":          stakeSlashed.String(),
+		"delegationsSlashed":    delegationsSlashed.String(),
+		"recipient":             recipientModule,
+		"frozen":                fmt.Sprint(frozen),
+		"sequence":              fmt.Sprint(record.Sequence),
+	}
+	utils.LogLavaEvent(ctx, k.Logger(ctx), types.ProviderSlashedEventName, details, "Provider slashed")
+
+	if err != nil {
+		return slashed, utils.LavaFormatWarning("failed to slash delegations", err,
+			utils.Attribute{Key: "provider", Value: provider},
+			utils.Attribute{Key: "chainID", Value: chainID},
+		)
+	}
+
+	return slashed, nil
 }
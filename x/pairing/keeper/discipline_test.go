@@ -0,0 +1,127 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/testutil/common"
+	conflicttypes "github.com/lavanet/lava/x/conflict/types"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSlashEntry(t *testing.T) {
+	ts := newTester(t)
+	ts.setupForPayments(1, 0, 0) // 1 provider, 0 client, default providers-to-pair
+
+	_, provider := ts.GetAccount(common.PROVIDER, 0)
+	providerAcc, _ := ts.GetAccount(common.PROVIDER, 0)
+	_, delegator := ts.AddAccount(common.CONSUMER, 1, testBalance)
+
+	delegated := int64(20000)
+	_, err := ts.TxDualstakingDelegate(delegator, provider, ts.spec.Index, common.NewCoin(delegated))
+	require.Nil(t, err)
+	ts.AdvanceEpoch()
+
+	pairingModuleBalance := ts.GetBalance(ts.Keepers.AccountKeeper.GetModuleAddress(pairingtypes.ModuleName))
+
+	// slash 10%
+	percentage := sdk.NewDecWithPrec(1, 1)
+	slashed, err := ts.Keepers.Pairing.SlashEntry(ts.Ctx, providerAcc.Addr, ts.spec.Index, percentage, conflicttypes.ModuleName)
+	require.Nil(t, err)
+	require.Equal(t, (testStake+delegated)/10, slashed.Amount.Int64())
+
+	entry, found := ts.Keepers.Epochstorage.GetStakeEntryByAddressCurrent(ts.Ctx, ts.spec.Index, providerAcc.Addr)
+	require.True(t, found)
+	require.Equal(t, testStake*9/10, entry.Stake.Amount.Int64())
+	require.Equal(t, delegated*9/10, entry.DelegateTotal.Amount.Int64())
+
+	// the slashed coins are moved to the recipient module
+	require.Equal(t, pairingModuleBalance-testStake/10,
+		ts.GetBalance(ts.Keepers.AccountKeeper.GetModuleAddress(pairingtypes.ModuleName)))
+	require.Equal(t, slashed.Amount.Int64(),
+		ts.GetBalance(ts.Keepers.AccountKeeper.GetModuleAddress(conflicttypes.ModuleName)))
+
+	res, err := ts.QueryDualstakingProviderDelegators(provider, true)
+	require.Nil(t, err)
+	for _, d := range res.Delegations {
+		if d.Delegator == delegator {
+			require.Equal(t, delegated*9/10, d.Amount.Amount.Int64())
+		}
+	}
+
+	// slash again in the same block: a second record is kept
+	_, err = ts.Keepers.Pairing.SlashEntry(ts.Ctx, providerAcc.Addr, ts.spec.Index, percentage, conflicttypes.ModuleName)
+	require.Nil(t, err)
+
+	records, err := ts.Keepers.Pairing.SlashRecords(ts.GoCtx, &pairingtypes.QuerySlashRecordsRequest{Provider: provider})
+	require.Nil(t, err)
+	require.Len(t, records.SlashRecords, 2)
+	require.Equal(t, uint64(0), records.SlashRecords[0].Sequence)
+	require.Equal(t, uint64(1), records.SlashRecords[1].Sequence)
+	require.Equal(t, testStake/10, records.SlashRecords[0].StakeSlashed.Amount.Int64())
+	require.Equal(t, delegated/10, records.SlashRecords[0].DelegationsSlashed.Amount.Int64())
+	require.True(t, percentage.Equal(records.SlashRecords[0].Percentage))
+
+	records, err = ts.Keepers.Pairing.SlashRecords(ts.GoCtx, &pairingtypes.QuerySlashRecordsRequest{Provider: provider, ChainID: "other"})
+	require.Nil(t, err)
+	require.Len(t, records.SlashRecords, 0)
+
+	// delegations that can't be slashed (inconsistent delegate total) are left untouched,
+	// and the record only holds what was actually slashed
+	entry, found = ts.Keepers.Epochstorage.GetStakeEntryByAddressCurrent(ts.Ctx, ts.spec.Index, providerAcc.Addr)
+	require.True(t, found)
+	entry.DelegateTotal = common.NewCoin(0)
+	ts.Keepers.Epochstorage.ModifyStakeEntryCurrent(ts.Ctx, ts.spec.Index, entry)
+	bonded := ts.Keepers.Dualstaking.TotalBondedTokens(ts.Ctx)
+
+	_, err = ts.Keepers.Pairing.SlashEntry(ts.Ctx, providerAcc.Addr, ts.spec.Index, percentage, conflicttypes.ModuleName)
+	require.NotNil(t, err)
+	require.True(t, bonded.Equal(ts.Keepers.Dualstaking.TotalBondedTokens(ts.Ctx)))
+	res, err = ts.QueryDualstakingProviderDelegators(provider, true)
+	require.Nil(t, err)
+	for _, d := range res.Delegations {
+		if d.Delegator == delegator {
+			require.Equal(t, delegated*81/100, d.Amount.Amount.Int64())
+		}
+	}
+
+	records, err = ts.Keepers.Pairing.SlashRecords(ts.GoCtx, &pairingtypes.QuerySlashRecordsRequest{Provider: provider})
+	require.Nil(t, err)
+	require.Len(t, records.SlashRecords, 3)
+	require.True(t, records.SlashRecords[2].DelegationsSlashed.IsZero())
+
+	// invalid percentage
+	_, err = ts.Keepers.Pairing.SlashEntry(ts.Ctx, providerAcc.Addr, ts.spec.Index, sdk.NewDec(2), conflicttypes.ModuleName)
+	require.NotNil(t, err)
+
+	// not staked
+	_, err = ts.Keepers.Pairing.SlashEntry(ts.Ctx, providerAcc.Addr, "other", percentage, conflicttypes.ModuleName)
+	require.NotNil(t, err)
+}
+
+func TestSlashEntryDelegationsCap(t *testing.T) {
+	ts := newTester(t)
+	ts.setupForPayments(1, 0, 0) // 1 provider, 0 client, default providers-to-pair
+
+	providerAcc, provider := ts.GetAccount(common.PROVIDER, 0)
+	_, delegator := ts.AddAccount(common.CONSUMER, 1, testBalance)
+
+	delegated := int64(20000)
+	_, err := ts.TxDualstakingDelegate(delegator, provider, ts.spec.Index, common.NewCoin(delegated))
+	require.Nil(t, err)
+	ts.AdvanceEpoch()
+
+	// the whole self-stake is slashed, the delegations only up to the cap
+	slashed, err := ts.Keepers.Pairing.SlashEntry(ts.Ctx, providerAcc.Addr, ts.spec.Index, sdk.OneDec(), conflicttypes.ModuleName)
+	require.Nil(t, err)
+	delegationsSlashed := pairingtypes.DelegationsSlashCap.MulInt64(delegated).TruncateInt64()
+	require.Equal(t, testStake+delegationsSlashed, slashed.Amount.Int64())
+
+	// the provider is left without stake, so it is frozen
+	entry, found := ts.Keepers.Epochstorage.GetStakeEntryByAddressCurrent(ts.Ctx, ts.spec.Index, providerAcc.Addr)
+	require.True(t, found)
+	require.True(t, entry.Stake.IsZero())
+	require.Equal(t, delegated-delegationsSlashed, entry.DelegateTotal.Amount.Int64())
+	require.Equal(t, uint64(pairingtypes.FROZEN_BLOCK), entry.StakeAppliedBlock)
+}
@@ -2,6 +2,7 @@ package filters
 
 import (
 	"fmt"
+	"reflect"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/lavanet/lava/utils"
@@ -17,13 +18,26 @@ type Filter interface {
 	IsMix() bool
 }
 
-func GetAllFilters() []Filter {
+// FiltersKeeper gives the filters access to the chain's state (beyond the providers' stake entries)
+type FiltersKeeper interface {
+	GetQosAtBlock(ctx sdk.Context, chainID string, cluster string, provider string, block uint64) (types.QualityOfServiceReport, bool)
+	GetBlockEpochsAgo(ctx sdk.Context, blockHeight, numEpochs uint64) (uint64, error)
+}
+
+func GetAllFilters(fk FiltersKeeper, cluster string) []Filter {
 	var selectedProvidersFilter SelectedProvidersFilter
 	var frozenProvidersFilter FrozenProvidersFilter
+	var jailedProvidersFilter JailedProvidersFilter
 	var geolocationFilter GeolocationFilter
 	var addonFilter AddonFilter
+	qosExcellenceFilter := QosExcellenceFilter{keeper: fk, cluster: cluster}
+	punishedProvidersFilter := PunishedProvidersFilter{keeper: fk}
+	var selfStakeRatioFilter SelfStakeRatioFilter
 
-	filters := []Filter{&selectedProvidersFilter, &frozenProvidersFilter, &geolocationFilter, &addonFilter}
+	filters := []Filter{
+		&selectedProvidersFilter, &frozenProvidersFilter, &jailedProvidersFilter, &geolocationFilter, &addonFilter,
+		&qosExcellenceFilter, &punishedProvidersFilter, &selfStakeRatioFilter,
+	}
 	return filters
 }
 
@@ -40,21 +54,43 @@ func initFilters(filters []Filter, strictestPolicy planstypes.Policy) []Filter {
 	return activeFilters
 }
 
-func SetupScores(ctx sdk.Context, filters []Filter, providers []epochstoragetypes.StakeEntry, strictestPolicy *planstypes.Policy, currentEpoch uint64, slotCount int, cluster string, qg pairingscores.QosGetter) ([]*pairingscores.PairingScore, error) {
+// FilterName returns the name of a filter (its type name)
+func FilterName(filter Filter) string {
+	t := reflect.TypeOf(filter)
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	return t.Name()
+}
+
+// RunFilters runs the filters that are active under the policy on the providers. It returns
+// the active filters, and for each of them the filter result of each provider.
+func RunFilters(ctx sdk.Context, filters []Filter, providers []epochstoragetypes.StakeEntry, strictestPolicy *planstypes.Policy, currentEpoch uint64) ([]Filter, [][]bool, error) {
 	filters = initFilters(filters, *strictestPolicy)
 
 	var filtersResult [][]bool
-	mixFilters := []Filter{} // mix filters
-
 	for _, filter := range filters {
 		res := filter.Filter(ctx, providers, currentEpoch)
 		if len(res) != len(providers) {
-			return nil, utils.LavaFormatError("filter result length is not equal to providers list length", fmt.Errorf("filter failed"),
+			return nil, nil, utils.LavaFormatError("filter result length is not equal to providers list length", fmt.Errorf("filter failed"),
 				utils.Attribute{Key: "filter result length", Value: len(res)},
 				utils.Attribute{Key: "providers length", Value: len(providers)},
 			)
 		}
 		filtersResult = append(filtersResult, res)
+	}
+
+	return filters, filtersResult, nil
+}
+
+func SetupScores(ctx sdk.Context, filters []Filter, providers []epochstoragetypes.StakeEntry, strictestPolicy *planstypes.Policy, currentEpoch uint64, slotCount int, cluster string, qg pairingscores.QosGetter) ([]*pairingscores.PairingScore, error) {
+	filters, filtersResult, err := RunFilters(ctx, filters, providers, strictestPolicy, currentEpoch)
+	if err != nil {
+		return nil, err
+	}
+
+	mixFilters := []Filter{} // mix filters
+	for _, filter := range filters {
 		if filter.IsMix() {
 			mixFilters = append(mixFilters, filter)
 		}
@@ -85,7 +121,7 @@ func SetupScores(ctx sdk.Context, filters []Filter, providers []epochstoragetype
 		}
 
 		if result {
-			// TODO: uncomment this code once the providerQosFS's update is implemented (it's currently always empty)
+			// TODO: uncomment this code once the QoS excellence is used for the pairing score (see QosReq)
 			// qos, err := qg.GetQos(ctx, providers[j].Chain, cluster, providers[j].Address)
 			// if err != nil {
 			// 	// only printing error and skipping provider so pairing won't fail
@@ -1,11 +1,13 @@
 package filters
 
 import (
+	"fmt"
 	"strconv"
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	epochstoragetypes "github.com/lavanet/lava/x/epochstorage/types"
+	"github.com/lavanet/lava/x/pairing/types"
 	planstypes "github.com/lavanet/lava/x/plans/types"
 	"github.com/stretchr/testify/require"
 )
@@ -184,3 +186,102 @@ func TestMixFilterBatch(t *testing.T) {
 		})
 	}
 }
+
+type mockFiltersKeeper struct {
+	qos         map[string]types.QualityOfServiceReport // key is the provider address
+	epochBlocks uint64
+}
+
+func (fk mockFiltersKeeper) GetQosAtBlock(ctx sdk.Context, chainID string, cluster string, provider string, block uint64) (types.QualityOfServiceReport, bool) {
+	qos, found := fk.qos[provider]
+	return qos, found
+}
+
+func (fk mockFiltersKeeper) GetBlockEpochsAgo(ctx sdk.Context, blockHeight, numEpochs uint64) (uint64, error) {
+	if fk.epochBlocks*numEpochs > blockHeight {
+		return 0, fmt.Errorf("too early")
+	}
+	return blockHeight - fk.epochBlocks*numEpochs, nil
+}
+
+func TestQualityFilters(t *testing.T) {
+	const epoch = uint64(1000)
+	fk := mockFiltersKeeper{
+		qos: map[string]types.QualityOfServiceReport{
+			"goodQos": {Latency: sdk.NewDecWithPrec(5, 1), Availability: sdk.OneDec(), Sync: sdk.ZeroDec()},
+			"badQos":  {Latency: sdk.NewDec(2), Availability: sdk.NewDecWithPrec(9, 1), Sync: sdk.NewDec(2)},
+		},
+		epochBlocks: 20,
+	}
+
+	newStakeEntry := func(address string, stake, delegations int64) epochstoragetypes.StakeEntry {
+		return epochstoragetypes.StakeEntry{
+			Address:       address,
+			Stake:         sdk.NewInt64Coin("ulava", stake),
+			DelegateTotal: sdk.NewInt64Coin("ulava", delegations),
+		}
+	}
+
+	unjailedRecently := newStakeEntry("unjailedRecently", 100, 0)
+	unjailedRecently.UnjailBlock = epoch - 20
+	unjailedLongAgo := newStakeEntry("unjailedLongAgo", 100, 0)
+	unjailedLongAgo.UnjailBlock = epoch - 200
+	reportedRecently := newStakeEntry("reportedRecently", 100, 0)
+	reportedRecently.LastReportedEpoch = epoch - 40
+	jailed := newStakeEntry("jailed", 100, 0)
+	jailed.JailEndBlock = epoch + 100
+
+	templates := []struct {
+		name     string
+		filter   Filter
+		policy   planstypes.Policy
+		provider epochstoragetypes.StakeEntry
+		active   bool
+		pass     bool
+	}{
+		{"qos inactive", &QosExcellenceFilter{keeper: fk}, planstypes.Policy{}, newStakeEntry("badQos", 100, 0), false, true},
+		{"qos good", &QosExcellenceFilter{keeper: fk}, planstypes.Policy{MinQosExcellence: 90}, newStakeEntry("goodQos", 100, 0), true, true},
+		{"qos bad", &QosExcellenceFilter{keeper: fk}, planstypes.Policy{MinQosExcellence: 90}, newStakeEntry("badQos", 100, 0), true, false},
+		{"qos not reported", &QosExcellenceFilter{keeper: fk}, planstypes.Policy{MinQosExcellence: 90}, newStakeEntry("noQos", 100, 0), true, true},
+		{"punished inactive", &PunishedProvidersFilter{keeper: fk}, planstypes.Policy{}, jailed, false, true},
+		{"never punished", &PunishedProvidersFilter{keeper: fk}, planstypes.Policy{ExcludePunishedEpochs: 5}, newStakeEntry("clean", 100, 0), true, true},
+		{"jailed", &PunishedProvidersFilter{keeper: fk}, planstypes.Policy{ExcludePunishedEpochs: 5}, jailed, true, false},
+		{"unjailed recently", &PunishedProvidersFilter{keeper: fk}, planstypes.Policy{ExcludePunishedEpochs: 5}, unjailedRecently, true, false},
+		{"unjailed long ago", &PunishedProvidersFilter{keeper: fk}, planstypes.Policy{ExcludePunishedEpochs: 5}, unjailedLongAgo, true, true},
+		{"reported recently", &PunishedProvidersFilter{keeper: fk}, planstypes.Policy{ExcludePunishedEpochs: 5}, reportedRecently, true, false},
+		{"reported before window", &PunishedProvidersFilter{keeper: fk}, planstypes.Policy{ExcludePunishedEpochs: 1}, reportedRecently, true, true},
+		{"self stake inactive", &SelfStakeRatioFilter{}, planstypes.Policy{}, newStakeEntry("lowSelfStake", 10, 1000), false, true},
+		{"self stake high", &SelfStakeRatioFilter{}, planstypes.Policy{MinSelfStakeRatio: 10}, newStakeEntry("highSelfStake", 100, 1000), true, true},
+		{"self stake low", &SelfStakeRatioFilter{}, planstypes.Policy{MinSelfStakeRatio: 10}, newStakeEntry("lowSelfStake", 99, 1000), true, false},
+		{"self stake no delegations", &SelfStakeRatioFilter{}, planstypes.Policy{MinSelfStakeRatio: 10}, newStakeEntry("noDelegations", 1, 0), true, true},
+	}
+
+	for _, tt := range templates {
+		t.Run(tt.name, func(t *testing.T) {
+			active := tt.filter.InitFilter(tt.policy)
+			require.Equal(t, tt.active, active)
+			if !active {
+				return
+			}
+			require.False(t, tt.filter.IsMix())
+			res := tt.filter.Filter(sdk.Context{}, []epochstoragetypes.StakeEntry{tt.provider}, epoch)
+			require.Equal(t, []bool{tt.pass}, res)
+		})
+	}
+}
+
+func TestQualityFiltersMix(t *testing.T) {
+	policy := planstypes.Policy{MinQosExcellence: 90, ExcludePunishedEpochs: 5, MinSelfStakeRatio: 10, QualityMixed: true}
+	filters := []Filter{&QosExcellenceFilter{}, &PunishedProvidersFilter{}, &SelfStakeRatioFilter{}}
+	for _, filter := range filters {
+		require.True(t, filter.InitFilter(policy))
+		require.True(t, filter.IsMix())
+	}
+
+	// the quality filters share the mix slots like any other mix filters
+	mixFilterIndexes := CalculateMixFilterSlots(filters, 8)
+	require.Len(t, mixFilterIndexes, len(filters))
+	for _, filter := range filters {
+		require.NotEmpty(t, mixFilterIndexes[filter])
+	}
+}
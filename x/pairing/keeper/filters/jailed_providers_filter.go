@@ -0,0 +1,29 @@
+package filters
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	epochstoragetypes "github.com/lavanet/lava/x/epochstorage/types"
+	planstypes "github.com/lavanet/lava/x/plans/types"
+)
+
+type JailedProvidersFilter struct{}
+
+func (f *JailedProvidersFilter) IsMix() bool {
+	return false
+}
+
+func (f *JailedProvidersFilter) InitFilter(strictestPolicy planstypes.Policy) bool {
+	// jailed providers can't be part of the pairing - this filter is always active
+	return true
+}
+
+func (f *JailedProvidersFilter) Filter(ctx sdk.Context, providers []epochstoragetypes.StakeEntry, currentEpoch uint64) []bool {
+	filterResult := make([]bool, len(providers))
+	for i := range providers {
+		if !providers[i].IsJailed() {
+			filterResult[i] = true
+		}
+	}
+
+	return filterResult
+}
@@ -0,0 +1,50 @@
+package filters
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	epochstoragetypes "github.com/lavanet/lava/x/epochstorage/types"
+	planstypes "github.com/lavanet/lava/x/plans/types"
+)
+
+type PunishedProvidersFilter struct {
+	keeper FiltersKeeper
+	epochs uint64
+	mix    bool
+}
+
+func (f *PunishedProvidersFilter) IsMix() bool {
+	return f.mix
+}
+
+func (f *PunishedProvidersFilter) InitFilter(strictestPolicy planstypes.Policy) bool {
+	if strictestPolicy.ExcludePunishedEpochs == 0 {
+		return false
+	}
+	f.epochs = strictestPolicy.ExcludePunishedEpochs
+	f.mix = strictestPolicy.QualityMixed
+	return true
+}
+
+func (f *PunishedProvidersFilter) Filter(ctx sdk.Context, providers []epochstoragetypes.StakeEntry, currentEpoch uint64) []bool {
+	fromBlock, err := f.keeper.GetBlockEpochsAgo(ctx, currentEpoch, f.epochs)
+	if err != nil {
+		// too early in the chain life: the entire chain history is considered
+		fromBlock = 0
+	}
+
+	filterResult := make([]bool, len(providers))
+	for i := range providers {
+		if !isProviderPunishedSince(providers[i], fromBlock) {
+			filterResult[i] = true
+		}
+	}
+
+	return filterResult
+}
+
+// isProviderPunishedSince checks if a provider is jailed, or was jailed or reported since the given block
+func isProviderPunishedSince(stakeEntry epochstoragetypes.StakeEntry, fromBlock uint64) bool {
+	wasJailed := stakeEntry.UnjailBlock != 0 && stakeEntry.UnjailBlock >= fromBlock
+	wasReported := stakeEntry.LastReportedEpoch != 0 && stakeEntry.LastReportedEpoch >= fromBlock
+	return stakeEntry.IsJailed() || wasJailed || wasReported
+}
@@ -0,0 +1,46 @@
+package filters
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	epochstoragetypes "github.com/lavanet/lava/x/epochstorage/types"
+	planstypes "github.com/lavanet/lava/x/plans/types"
+)
+
+type QosExcellenceFilter struct {
+	keeper           FiltersKeeper
+	cluster          string
+	minQosExcellence uint64
+	mix              bool
+}
+
+func (f *QosExcellenceFilter) IsMix() bool {
+	return f.mix
+}
+
+func (f *QosExcellenceFilter) InitFilter(strictestPolicy planstypes.Policy) bool {
+	if strictestPolicy.MinQosExcellence == 0 {
+		return false
+	}
+	f.minQosExcellence = strictestPolicy.MinQosExcellence
+	f.mix = strictestPolicy.QualityMixed
+	return true
+}
+
+func (f *QosExcellenceFilter) Filter(ctx sdk.Context, providers []epochstoragetypes.StakeEntry, currentEpoch uint64) []bool {
+	minScore := sdk.NewDecWithPrec(int64(f.minQosExcellence), 2)
+	filterResult := make([]bool, len(providers))
+	for i := range providers {
+		qos, found := f.keeper.GetQosAtBlock(ctx, providers[i].Chain, f.cluster, providers[i].Address, currentEpoch)
+		if !found {
+			// no QoS excellence reports on the provider yet (e.g. a new provider) - it's not filtered
+			filterResult[i] = true
+			continue
+		}
+		score, err := qos.ComputeQoSExcellence()
+		if err == nil && score.GTE(minScore) {
+			filterResult[i] = true
+		}
+	}
+
+	return filterResult
+}
@@ -0,0 +1,39 @@
+package filters
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	epochstoragetypes "github.com/lavanet/lava/x/epochstorage/types"
+	planstypes "github.com/lavanet/lava/x/plans/types"
+)
+
+type SelfStakeRatioFilter struct {
+	minSelfStakeRatio uint64
+	mix               bool
+}
+
+func (f *SelfStakeRatioFilter) IsMix() bool {
+	return f.mix
+}
+
+func (f *SelfStakeRatioFilter) InitFilter(strictestPolicy planstypes.Policy) bool {
+	if strictestPolicy.MinSelfStakeRatio == 0 {
+		return false
+	}
+	f.minSelfStakeRatio = strictestPolicy.MinSelfStakeRatio
+	f.mix = strictestPolicy.QualityMixed
+	return true
+}
+
+func (f *SelfStakeRatioFilter) Filter(ctx sdk.Context, providers []epochstoragetypes.StakeEntry, currentEpoch uint64) []bool {
+	filterResult := make([]bool, len(providers))
+	for i := range providers {
+		// the ratio is a percentage: selfStake/delegations >= minSelfStakeRatio/100
+		selfStake := providers[i].Stake.Amount.MulRaw(100)
+		minSelfStake := providers[i].DelegateTotal.Amount.MulRaw(int64(f.minSelfStakeRatio))
+		if selfStake.GTE(minSelfStake) {
+			filterResult[i] = true
+		}
+	}
+
+	return filterResult
+}
@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/lavanet/lava/x/pairing/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) ProviderEarnings(c context.Context, req *types.QueryProviderEarningsRequest) (*types.QueryProviderEarningsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(req.Provider); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid provider address")
+	}
+
+	var earnings []types.ProviderEarnings
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	providerEarningsStore := prefix.NewStore(store, types.KeyPrefix(types.ProviderEarningsKeyPrefix))
+	providerEarningsStore = prefix.NewStore(providerEarningsStore, types.ProviderEarningsPrefix(req.Provider, req.ChainID))
+
+	pageRes, err := query.Paginate(providerEarningsStore, req.Pagination, func(key, value []byte) error {
+		var providerEarnings types.ProviderEarnings
+		if err := k.cdc.Unmarshal(value, &providerEarnings); err != nil {
+			return err
+		}
+
+		earnings = append(earnings, providerEarnings)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryProviderEarningsResponse{Earnings: earnings, Pagination: pageRes}, nil
+}
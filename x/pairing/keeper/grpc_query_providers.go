@@ -29,7 +29,8 @@ func (k Keeper) Providers(goCtx context.Context, req *types.QueryProvidersReques
 		stakeEntriesNoFrozen := []epochstoragetypes.StakeEntry{}
 		for _, stakeEntry := range stakeEntries {
 			// show providers with valid stakeAppliedBlock (frozen providers have stakeAppliedBlock = MaxUint64)
-			if stakeEntry.GetStakeAppliedBlock() <= uint64(ctx.BlockHeight()) {
+			// that are not jailed
+			if stakeEntry.GetStakeAppliedBlock() <= uint64(ctx.BlockHeight()) && !stakeEntry.IsJailed() {
 				stakeEntriesNoFrozen = append(stakeEntriesNoFrozen, stakeEntry)
 			}
 		}
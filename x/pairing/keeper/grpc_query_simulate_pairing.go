@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/x/pairing/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) SimulatePairing(goCtx context.Context, req *types.QuerySimulatePairingRequest) (*types.QuerySimulatePairingResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	clientAddr, err := sdk.AccAddressFromBech32(req.Client)
+	if err != nil {
+		return nil, fmt.Errorf("invalid client address %s error: %s", req.Client, err)
+	}
+
+	block := req.Epoch
+	if block == 0 {
+		block = uint64(ctx.BlockHeight())
+	}
+
+	epoch, providersType, err := k.VerifyPairingData(ctx, req.ChainID, clientAddr, block)
+	if err != nil {
+		return nil, fmt.Errorf("invalid pairing data: %s", err)
+	}
+
+	stakeEntries, found, epochHash := k.epochStorageKeeper.GetEpochStakeEntries(ctx, epoch, req.ChainID)
+	if !found {
+		return nil, fmt.Errorf("did not find providers for pairing: epoch:%d, chainID: %s", epoch, req.ChainID)
+	}
+	if len(req.EpochHash) != 0 {
+		epochHash = req.EpochHash
+	}
+
+	project, err := k.GetProjectData(ctx, clientAddr, req.ChainID, block)
+	if err != nil {
+		return nil, err
+	}
+
+	strictestPolicy, cluster, err := k.GetProjectStrictestPolicy(ctx, project, req.ChainID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user for pairing: %s", err.Error())
+	}
+
+	return k.simulatePairing(ctx, k, stakeEntries, providersType, strictestPolicy, cluster, project.Index, req.ChainID, epoch, epochHash)
+}
@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/lavanet/lava/x/pairing/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) SlashRecords(c context.Context, req *types.QuerySlashRecordsRequest) (*types.QuerySlashRecordsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(req.Provider); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid provider address")
+	}
+
+	var slashRecords []types.SlashRecord
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	slashRecordStore := prefix.NewStore(store, types.KeyPrefix(types.SlashRecordKeyPrefix))
+	slashRecordStore = prefix.NewStore(slashRecordStore, types.SlashRecordPrefix(req.Provider, req.ChainID))
+
+	pageRes, err := query.Paginate(slashRecordStore, req.Pagination, func(key, value []byte) error {
+		var slashRecord types.SlashRecord
+		if err := k.cdc.Unmarshal(value, &slashRecord); err != nil {
+			return err
+		}
+
+		slashRecords = append(slashRecords, slashRecord)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QuerySlashRecordsResponse{SlashRecords: slashRecords, Pagination: pageRes}, nil
+}
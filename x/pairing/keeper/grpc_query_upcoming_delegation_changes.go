@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/x/pairing/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) UpcomingDelegationChanges(goCtx context.Context, req *types.QueryUpcomingDelegationChangesRequest) (*types.QueryUpcomingDelegationChangesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	changes := []types.UpcomingDelegationChange{}
+	for _, stakeStorage := range k.getCurrentProviderStakeStorageList(ctx) {
+		for _, stakeEntry := range stakeStorage.GetStakeEntries() {
+			if stakeEntry.PendingDelegationChange == nil {
+				continue
+			}
+			if req.ChainID != "" && req.ChainID != stakeEntry.Chain {
+				continue
+			}
+			if req.Provider != "" && req.Provider != stakeEntry.Address {
+				continue
+			}
+			changes = append(changes, types.UpcomingDelegationChange{
+				Provider:           stakeEntry.Address,
+				ChainID:            stakeEntry.Chain,
+				DelegateCommission: stakeEntry.DelegateCommission,
+				DelegateLimit:      stakeEntry.DelegateLimit,
+				Change:             *stakeEntry.PendingDelegationChange,
+			})
+		}
+	}
+
+	return &types.QueryUpcomingDelegationChangesResponse{Changes: changes}, nil
+}
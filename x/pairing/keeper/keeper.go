@@ -102,16 +102,19 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 
 func (k Keeper) BeginBlock(ctx sdk.Context) {
 	k.badgeTimerStore.Tick(ctx)
+	k.providerQosFS.AdvanceBlock(ctx)
 
 	if k.epochStorageKeeper.IsEpochStart(ctx) {
 		// remove old session payments
 		k.RemoveOldEpochPayment(ctx)
 		// unstake any unstaking providers
 		k.CheckUnstakingForCommit(ctx)
-		// unstake/jail unresponsive providers
+		// jail unresponsive providers
 		k.UnstakeUnresponsiveProviders(ctx,
 			types.EPOCHS_NUM_TO_CHECK_CU_FOR_UNRESPONSIVE_PROVIDER,
 			types.EPOCHS_NUM_TO_CHECK_FOR_COMPLAINERS)
+		// apply delegation terms changes that their notice period is over
+		k.applyDelegationChanges(ctx)
 	}
 }
 
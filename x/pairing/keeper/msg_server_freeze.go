@@ -25,14 +25,14 @@ func (k Keeper) FreezeProvider(ctx sdk.Context, provider string, chainIDs []stri
 	}
 
 	for _, chainId := range chainIDs {
-		stakeEntry, found, index := k.epochStorageKeeper.GetStakeEntryByAddressCurrent(ctx, chainId, providerAddr)
+		stakeEntry, found := k.epochStorageKeeper.GetStakeEntryByAddressCurrent(ctx, chainId, providerAddr)
 		if !found {
 			return utils.LavaFormatError("Freeze_cant_get_stake_entry", types.FreezeStakeEntryNotFoundError, []utils.Attribute{{Key: "chainID", Value: chainId}, {Key: "providerAddress", Value: provider}}...)
 		}
 
 		// freeze the provider by making the StakeAppliedBlock be max. This will remove the provider from the pairing list in the next epoch
 		stakeEntry.StakeAppliedBlock = types.FROZEN_BLOCK
-		k.epochStorageKeeper.ModifyStakeEntryCurrent(ctx, chainId, stakeEntry, index)
+		k.epochStorageKeeper.ModifyStakeEntryCurrent(ctx, chainId, stakeEntry)
 	}
 
 	utils.LogLavaEvent(ctx, ctx.Logger(), "freeze_provider", map[string]string{"providerAddress": providerAddr.String(), "chainIDs": strings.Join(chainIDs, ","), "freezeRequestBlock": strconv.FormatInt(ctx.BlockHeight(), 10), "freezeReason": reason}, "Provider Freeze")
@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strconv"
 	"time"
 
@@ -20,6 +21,13 @@ type BadgeData struct {
 	BadgeSigner sdk.AccAddress
 }
 
+// reportedProvider is a provider that was reported as unresponsive in a relay payment message
+type reportedProvider struct {
+	chainID string
+	address sdk.AccAddress
+	epoch   uint64 // the latest epoch it was reported in
+}
+
 func (k msgServer) RelayPayment(goCtx context.Context, msg *types.MsgRelayPayment) (*types.MsgRelayPaymentResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	logger := k.Logger(ctx)
@@ -51,6 +59,7 @@ func (k msgServer) RelayPayment(goCtx context.Context, msg *types.MsgRelayPaymen
 		}
 	}
 
+	reportedProviders := map[string]reportedProvider{}
 	for relayIdx, relay := range msg.Relays {
 		if relay.LavaChainId != lavaChainID {
 			return nil, utils.LavaFormatWarning("relay request for the wrong lava chain", fmt.Errorf("relay_payment_wrong_lava_chain_id"),
@@ -234,6 +243,14 @@ func (k msgServer) RelayPayment(goCtx context.Context, msg *types.MsgRelayPaymen
 			details["ExcellenceQoSLatency"] = relay.QosExcellenceReport.Latency.String()
 			details["ExcellenceQoSAvailability"] = relay.QosExcellenceReport.Availability.String()
 			details["ExcellenceQoSSync"] = relay.QosExcellenceReport.Sync.String()
+
+			err = k.updateProviderQosFromRelay(ctx, projectID, relay)
+			if err != nil {
+				utils.LavaFormatWarning("could not update provider QoS excellence", err,
+					utils.Attribute{Key: "provider", Value: providerAddr.String()},
+					utils.Attribute{Key: "chainID", Value: relay.SpecId},
+				)
+			}
 		}
 
 		details["projectID"] = projectID
@@ -243,6 +260,7 @@ func (k msgServer) RelayPayment(goCtx context.Context, msg *types.MsgRelayPaymen
 		details["Mint"] = details["BasePay"]
 
 		// Mint to module
+		providerReward := math.ZeroInt()
 		if !rewardCoins.AmountOf(epochstoragetypes.TokenDenom).IsZero() {
 			err = k.Keeper.bankKeeper.MintCoins(ctx, types.ModuleName, rewardCoins)
 			if err != nil {
@@ -253,12 +271,15 @@ func (k msgServer) RelayPayment(goCtx context.Context, msg *types.MsgRelayPaymen
 				)
 			}
 
-			err = k.distributeRewards(ctx, providerAddr, relay.SpecId, uint64(relay.Epoch), reward.TruncateInt())
+			providerReward, err = k.distributeRewards(ctx, providerAddr, relay.SpecId, uint64(relay.Epoch), reward.TruncateInt())
 			if err != nil {
 				return nil, utils.LavaFormatError("could not distribute rewards for provider and delegators", err)
 			}
 		}
 
+		// accumulate the provider's monthly earnings
+		k.AddProviderEarnings(ctx, providerAddr.String(), relay.SpecId, relay.CuSum, rewardCoins.AmountOf(epochstoragetypes.TokenDenom), providerReward)
+
 		details["relayNumber"] = strconv.FormatUint(relay.RelayNum, 10)
 		// differentiate between different relays by providing the index in the keys
 		successDetails := appendRelayPaymentDetailsToEvent(details, uint64(relayIdx))
@@ -271,16 +292,18 @@ func (k msgServer) RelayPayment(goCtx context.Context, msg *types.MsgRelayPaymen
 		}
 
 		// update provider payment storage with complainer's CU
-		err = k.updateProviderPaymentStorageWithComplainerCU(ctx, relay.UnresponsiveProviders, logger, epochStart, relay.SpecId, relay.CuSum, servicersToPair, projectID)
+		err = k.updateProviderPaymentStorageWithComplainerCU(ctx, relay.UnresponsiveProviders, logger, epochStart, relay.SpecId, relay.CuSum, servicersToPair, projectID, reportedProviders)
 		if err != nil {
 			utils.LogLavaEvent(ctx, logger, types.UnresponsiveProviderUnstakeFailedEventName, map[string]string{"err:": err.Error()}, "Error Unresponsive Providers could not unstake")
 		}
 	}
 
+	k.updateReportedProvidersStakeEntries(ctx, reportedProviders)
+
 	return &types.MsgRelayPaymentResponse{}, nil
 }
 
-func (k msgServer) updateProviderPaymentStorageWithComplainerCU(ctx sdk.Context, unresponsiveProviders []*types.ReportedProvider, logger log.Logger, epoch uint64, chainID string, cuSum, servicersToPair uint64, projectID string) error {
+func (k msgServer) updateProviderPaymentStorageWithComplainerCU(ctx sdk.Context, unresponsiveProviders []*types.ReportedProvider, logger log.Logger, epoch uint64, chainID string, cuSum, servicersToPair uint64, projectID string, reportedProviders map[string]reportedProvider) error {
 	// check that unresponsiveData exists
 	if len(unresponsiveProviders) == 0 {
 		return nil
@@ -338,11 +361,43 @@ func (k msgServer) updateProviderPaymentStorageWithComplainerCU(ctx sdk.Context,
 		utils.LogLavaEvent(ctx, logger, types.ProviderReportedEventName, map[string]string{"provider": unresponsiveProvider.GetAddress(), "timestamp": timestamp.Format(time.DateTime), "disconnections": strconv.FormatUint(unresponsiveProvider.GetDisconnections(), 10), "errors": strconv.FormatUint(unresponsiveProvider.GetErrors(), 10), "project": projectID, "cu": strconv.FormatUint(complainerCuToAdd, 10), "epoch": strconv.FormatUint(epoch, 10), "total_complaint_this_epoch": strconv.FormatUint(providerPaymentStorage.ComplainersTotalCu, 10)}, "provider got reported by consumer")
 		// set the final provider payment storage state including the complaints
 		k.SetProviderPaymentStorage(ctx, providerPaymentStorage)
+
+		// remember the report's epoch (see updateReportedProvidersStakeEntries)
+		reportedKey := chainID + " " + sdkUnresponsiveProviderAddress.String()
+		if reported, ok := reportedProviders[reportedKey]; !ok || reported.epoch < epoch {
+			reportedProviders[reportedKey] = reportedProvider{chainID: chainID, address: sdkUnresponsiveProviderAddress, epoch: epoch}
+		}
 	}
 
 	return nil
 }
 
+// updateReportedProvidersStakeEntries keeps the latest epoch in which each provider was reported in its
+// stake entry (for policies that exclude recently reported providers). A provider is considered reported
+// only once the complaints against it pass the unresponsiveness threshold (see countCuForUnresponsiveness),
+// so a single consumer can't mark a serving provider. Each stake entry is modified once per relay payment
+// message, however many relays reported the provider.
+func (k msgServer) updateReportedProvidersStakeEntries(ctx sdk.Context, reportedProviders map[string]reportedProvider) {
+	keys := make([]string, 0, len(reportedProviders))
+	for key := range reportedProviders {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		reported := reportedProviders[key]
+		stakeEntry, found := k.epochStorageKeeper.GetStakeEntryByAddressCurrent(ctx, reported.chainID, reported.address)
+		if !found || stakeEntry.LastReportedEpoch >= reported.epoch {
+			continue
+		}
+		keysToResetIfJail, _, _, err := k.countCuForUnresponsiveness(ctx, reported.epoch, types.EPOCHS_NUM_TO_CHECK_CU_FOR_UNRESPONSIVE_PROVIDER, types.EPOCHS_NUM_TO_CHECK_FOR_COMPLAINERS, stakeEntry)
+		if err == nil && len(keysToResetIfJail) != 0 {
+			stakeEntry.LastReportedEpoch = reported.epoch
+			k.epochStorageKeeper.ModifyStakeEntryCurrent(ctx, reported.chainID, stakeEntry)
+		}
+	}
+}
+
 func (k Keeper) chargeComputeUnitsToProjectAndSubscription(ctx sdk.Context, clientAddr sdk.AccAddress, relay *types.RelaySession) error {
 	epoch := uint64(relay.Epoch)
 
@@ -374,10 +429,11 @@ func appendRelayPaymentDetailsToEvent(from map[string]string, uniqueIdentifier u
 }
 
 // distributeRewards is the main function for reward distribution for providers and delegators
-func (k Keeper) distributeRewards(ctx sdk.Context, providerAddr sdk.AccAddress, chainID string, block uint64, totalReward math.Int) error {
+// (returns the provider's part of the reward)
+func (k Keeper) distributeRewards(ctx sdk.Context, providerAddr sdk.AccAddress, chainID string, block uint64, totalReward math.Int) (math.Int, error) {
 	providerReward, err := k.dualStakingKeeper.CalcProviderRewardWithDelegations(ctx, providerAddr, chainID, block, totalReward)
 	if err != nil {
-		return utils.LavaFormatError(types.ProviderRewardError.Error(), err,
+		return math.ZeroInt(), utils.LavaFormatError(types.ProviderRewardError.Error(), err,
 			utils.Attribute{Key: "provider", Value: providerAddr.String()},
 			utils.Attribute{Key: "chainID", Value: chainID},
 			utils.Attribute{Key: "block", Value: block},
@@ -397,5 +453,5 @@ func (k Keeper) distributeRewards(ctx sdk.Context, providerAddr sdk.AccAddress,
 		}
 	}
 
-	return nil
+	return providerReward, nil
 }
@@ -3,9 +3,11 @@ package keeper_test
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/lavanet/lava/testutil/common"
 	epochstoragetypes "github.com/lavanet/lava/x/epochstorage/types"
 	"github.com/lavanet/lava/x/pairing/client/cli"
+	"github.com/lavanet/lava/x/pairing/types"
 	spectypes "github.com/lavanet/lava/x/spec/types"
 	"github.com/stretchr/testify/require"
 )
@@ -40,7 +42,7 @@ func TestStakeProviderWithMoniker(t *testing.T) {
 			ts.AdvanceEpoch()
 
 			// Get the stake entry and check the provider is staked
-			stakeEntry, foundProvider, _ := ts.Keepers.Epochstorage.GetStakeEntryByAddressCurrent(ts.Ctx, ts.spec.Index, providerAcct.Addr)
+			stakeEntry, foundProvider := ts.Keepers.Epochstorage.GetStakeEntryByAddressCurrent(ts.Ctx, ts.spec.Index, providerAcct.Addr)
 			require.Equal(t, tt.validStake, foundProvider)
 
 			// Check the assigned moniker
@@ -68,7 +70,7 @@ func TestModifyStakeProviderWithMoniker(t *testing.T) {
 	providerAcct, providerAddr := ts.GetAccount(common.PROVIDER, 0)
 
 	// Get the stake entry and check the provider is staked
-	stakeEntry, foundProvider, _ := ts.Keepers.Epochstorage.GetStakeEntryByAddressCurrent(ts.Ctx, ts.spec.Index, providerAcct.Addr)
+	stakeEntry, foundProvider := ts.Keepers.Epochstorage.GetStakeEntryByAddressCurrent(ts.Ctx, ts.spec.Index, providerAcct.Addr)
 	require.True(t, foundProvider)
 	require.Equal(t, moniker, stakeEntry.Moniker)
 
@@ -78,7 +80,7 @@ func TestModifyStakeProviderWithMoniker(t *testing.T) {
 	ts.AdvanceEpoch()
 
 	// Get the stake entry and check the provider is staked
-	stakeEntry, foundProvider, _ = ts.Keepers.Epochstorage.GetStakeEntryByAddressCurrent(ts.Ctx, ts.spec.Index, providerAcct.Addr)
+	stakeEntry, foundProvider = ts.Keepers.Epochstorage.GetStakeEntryByAddressCurrent(ts.Ctx, ts.spec.Index, providerAcct.Addr)
 	require.True(t, foundProvider)
 
 	require.Equal(t, moniker, stakeEntry.Moniker)
@@ -705,7 +707,7 @@ func TestStakeEndpoints(t *testing.T) {
 			if play.success {
 				require.NoError(t, err)
 
-				providerEntry, found, _ := ts.Keepers.Epochstorage.GetStakeEntryByAddressCurrent(ts.Ctx, ts.spec.Index, providerAcc.Addr)
+				providerEntry, found := ts.Keepers.Epochstorage.GetStakeEntryByAddressCurrent(ts.Ctx, ts.spec.Index, providerAcc.Addr)
 				require.True(t, found)
 				addons := 0
 				extensions := 0
@@ -729,3 +731,80 @@ func TestStakeEndpoints(t *testing.T) {
 		})
 	}
 }
+
+// Test that delegation terms changes that aren't in favor of the delegators are delayed by
+// a notice period (and limited), while changes in their favor are applied immediately
+func TestStakeProviderDelegationTermsChange(t *testing.T) {
+	ts := newTester(t)
+	ts.setupForPayments(1, 1, 0) // 1 provider, 1 client, default providers-to-pair
+
+	providerAcc, provider := ts.GetAccount(common.PROVIDER, 0)
+
+	// start from commission=10, limit=testStake
+	stakeEntry, found := ts.Keepers.Epochstorage.GetStakeEntryByAddressCurrent(ts.Ctx, ts.spec.Index, providerAcc.Addr)
+	require.True(t, found)
+	stakeEntry.DelegateCommission = 10
+	stakeEntry.DelegateLimit = sdk.NewCoin(epochstoragetypes.TokenDenom, sdk.NewInt(testStake))
+	ts.Keepers.Epochstorage.ModifyStakeEntryCurrent(ts.Ctx, ts.spec.Index, stakeEntry)
+	ts.AdvanceEpoch()
+	epochBefore := ts.EpochStart()
+
+	restake := func(commission uint64, limit int64) error {
+		_, err := ts.TxPairingStakeProviderFull(provider, ts.spec.Index, stakeEntry.Stake, stakeEntry.Endpoints,
+			stakeEntry.Geolocation, stakeEntry.Moniker, commission, sdk.NewCoin(epochstoragetypes.TokenDenom, sdk.NewInt(limit)))
+		return err
+	}
+	currentTerms := func() (uint64, int64) {
+		entry, found := ts.Keepers.Epochstorage.GetStakeEntryByAddressCurrent(ts.Ctx, ts.spec.Index, providerAcc.Addr)
+		require.True(t, found)
+		return entry.DelegateCommission, entry.DelegateLimit.Amount.Int64()
+	}
+	upcoming := func() []types.UpcomingDelegationChange {
+		res, err := ts.QueryPairingUpcomingDelegationChanges(ts.spec.Index, provider)
+		require.Nil(t, err)
+		return res.Changes
+	}
+
+	// commission increase above the max is rejected
+	require.NotNil(t, restake(10+types.MAX_COMMISSION_INCREASE+1, testStake))
+	require.Len(t, upcoming(), 0)
+
+	// commission increase (and limit decrease) within the max is scheduled
+	require.Nil(t, restake(10+types.MAX_COMMISSION_INCREASE, testStake/2))
+	commission, limit := currentTerms()
+	require.Equal(t, uint64(10), commission)
+	require.Equal(t, testStake, limit)
+	changes := upcoming()
+	require.Len(t, changes, 1)
+	require.Equal(t, 10+types.MAX_COMMISSION_INCREASE, changes[0].Change.DelegateCommission)
+	require.Equal(t, testStake/2, changes[0].Change.DelegateLimit.Amount.Int64())
+
+	// not applied before the notice period is over
+	for i := uint64(0); i < types.DELEGATION_CHANGE_NOTICE_EPOCHS-1; i++ {
+		ts.AdvanceEpoch()
+	}
+	commission, _ = currentTerms()
+	require.Equal(t, uint64(10), commission)
+	require.Len(t, upcoming(), 1)
+
+	// applied once the notice period is over
+	ts.AdvanceEpoch()
+	commission, limit = currentTerms()
+	require.Equal(t, 10+types.MAX_COMMISSION_INCREASE, commission)
+	require.Equal(t, testStake/2, limit)
+	require.Len(t, upcoming(), 0)
+
+	// the epoch stake entries keep the terms that were in effect at the time
+	entry, err := ts.Keepers.Epochstorage.GetStakeEntryForProviderEpoch(ts.Ctx, ts.spec.Index, providerAcc.Addr, epochBefore)
+	require.Nil(t, err)
+	require.Equal(t, uint64(10), entry.DelegateCommission)
+
+	// change in favor of the delegators is applied immediately (and cancels a scheduled change)
+	require.Nil(t, restake(10+types.MAX_COMMISSION_INCREASE+1, testStake/2))
+	require.Len(t, upcoming(), 1)
+	require.Nil(t, restake(5, testStake))
+	commission, limit = currentTerms()
+	require.Equal(t, uint64(5), commission)
+	require.Equal(t, testStake, limit)
+	require.Len(t, upcoming(), 0)
+}
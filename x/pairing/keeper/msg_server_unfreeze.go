@@ -19,7 +19,7 @@ func (k msgServer) UnfreezeProvider(goCtx context.Context, msg *types.MsgUnfreez
 	current_block := uint64(ctx.BlockHeight())
 	unfrozen_chains := []string{}
 	for _, chainId := range msg.GetChainIds() {
-		stakeEntry, found, index := k.epochStorageKeeper.GetStakeEntryByAddressCurrent(ctx, chainId, providerAddr)
+		stakeEntry, found := k.epochStorageKeeper.GetStakeEntryByAddressCurrent(ctx, chainId, providerAddr)
 		if !found {
 			return nil, utils.LavaFormatError("Unfreeze_cant_get_stake_entry", types.FreezeStakeEntryNotFoundError, []utils.Attribute{{Key: "chainID", Value: chainId}, {Key: "providerAddress", Value: msg.GetCreator()}}...)
 		}
@@ -27,7 +27,7 @@ func (k msgServer) UnfreezeProvider(goCtx context.Context, msg *types.MsgUnfreez
 		if stakeEntry.StakeAppliedBlock > current_block {
 			// unfreeze the provider by making the StakeAppliedBlock the current block. This will let the provider be added to the pairing list in the next epoch, when current entries becomes the front of epochStorage
 			stakeEntry.StakeAppliedBlock = current_block
-			k.epochStorageKeeper.ModifyStakeEntryCurrent(ctx, chainId, stakeEntry, index)
+			k.epochStorageKeeper.ModifyStakeEntryCurrent(ctx, chainId, stakeEntry)
 			unfrozen_chains = append(unfrozen_chains, chainId)
 		}
 		// else case does not throw an error because we don't want to fail unfreezing other chains
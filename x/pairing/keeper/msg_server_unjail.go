@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/utils"
+	"github.com/lavanet/lava/x/pairing/types"
+)
+
+func (k msgServer) Unjail(goCtx context.Context, msg *types.MsgUnjail) (*types.MsgUnjailResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	providerAddr, err := sdk.AccAddressFromBech32(msg.GetCreator())
+	if err != nil {
+		return nil, utils.LavaFormatWarning("Unjail_get_provider_address", err, utils.Attribute{Key: "providerAddress", Value: msg.GetCreator()})
+	}
+	currentBlock := uint64(ctx.BlockHeight())
+	for _, chainId := range msg.GetChainIds() {
+		stakeEntry, found := k.epochStorageKeeper.GetStakeEntryByAddressCurrent(ctx, chainId, providerAddr)
+		if !found {
+			return nil, utils.LavaFormatWarning("Unjail_cant_get_stake_entry", types.UnjailStakeEntryNotFoundError, []utils.Attribute{{Key: "chainID", Value: chainId}, {Key: "providerAddress", Value: msg.GetCreator()}}...)
+		}
+
+		if !stakeEntry.IsJailed() {
+			return nil, utils.LavaFormatWarning("Unjail_provider_not_jailed", types.UnjailProviderNotJailedError, []utils.Attribute{{Key: "chainID", Value: chainId}, {Key: "providerAddress", Value: msg.GetCreator()}}...)
+		}
+
+		if stakeEntry.JailEndBlock > currentBlock {
+			return nil, utils.LavaFormatWarning("Unjail_jail_not_expired", types.UnjailJailNotExpiredError, []utils.Attribute{{Key: "chainID", Value: chainId}, {Key: "providerAddress", Value: msg.GetCreator()}, {Key: "jailEndBlock", Value: stakeEntry.JailEndBlock}}...)
+		}
+
+		// unjail the provider, and (like in unfreeze) make StakeAppliedBlock the current block. This will let the provider
+		// be added to the pairing list in the next epoch, and gives it a fresh history for the unresponsiveness check
+		stakeEntry.JailEndBlock = 0
+		stakeEntry.UnjailBlock = currentBlock
+		if stakeEntry.StakeAppliedBlock < currentBlock {
+			stakeEntry.StakeAppliedBlock = currentBlock
+		}
+		k.epochStorageKeeper.ModifyStakeEntryCurrent(ctx, chainId, stakeEntry)
+	}
+	utils.LogLavaEvent(ctx, ctx.Logger(), types.ProviderUnjailedEventName, map[string]string{"providerAddress": msg.GetCreator(), "chainIDs": strings.Join(msg.GetChainIds(), ","), "unjailBlock": strconv.FormatUint(currentBlock, 10)}, "Provider Unjail")
+	return &types.MsgUnjailResponse{}, nil
+}
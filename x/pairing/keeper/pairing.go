@@ -10,9 +10,11 @@ import (
 	epochstoragetypes "github.com/lavanet/lava/x/epochstorage/types"
 	pairingfilters "github.com/lavanet/lava/x/pairing/keeper/filters"
 	pairingscores "github.com/lavanet/lava/x/pairing/keeper/scores"
+	"github.com/lavanet/lava/x/pairing/types"
 	planstypes "github.com/lavanet/lava/x/plans/types"
 	projectstypes "github.com/lavanet/lava/x/projects/types"
 	spectypes "github.com/lavanet/lava/x/spec/types"
+	subscriptiontypes "github.com/lavanet/lava/x/subscription/types"
 )
 
 func (k Keeper) VerifyPairingData(ctx sdk.Context, chainID string, clientAddress sdk.AccAddress, block uint64) (epoch uint64, providersType spectypes.Spec_ProvidersTypes, errorRet error) {
@@ -138,7 +140,18 @@ func (k Keeper) getPairingForClient(ctx sdk.Context, chainID string, clientAddre
 		return stakeEntries, strictestPolicy.EpochCuLimit, project.Index, nil
 	}
 
-	filters := pairingfilters.GetAllFilters()
+	providers, err = k.calculatePairing(ctx, k, stakeEntries, strictestPolicy, cluster, project.Index, chainID, epoch, epochHash, nil)
+	if err != nil {
+		return nil, 0, "", err
+	}
+
+	return providers, strictestPolicy.EpochCuLimit, project.Index, nil
+}
+
+// calculatePairing picks the pairing providers out of the given stake entries (the filters read the chain's
+// state using fk). If sim is not nil, the filter results, scores and picks of the calculation are recorded in it.
+func (k Keeper) calculatePairing(ctx sdk.Context, fk pairingfilters.FiltersKeeper, stakeEntries []epochstoragetypes.StakeEntry, strictestPolicy *planstypes.Policy, cluster, projectIndex, chainID string, epoch uint64, epochHash []byte, sim *types.QuerySimulatePairingResponse) (providers []epochstoragetypes.StakeEntry, err error) {
+	filters := pairingfilters.GetAllFilters(fk, cluster)
 	// create the pairing slots with assigned reqs
 	slots := pairingscores.CalcSlots(strictestPolicy)
 	// group identical slots (in terms of reqs types)
@@ -146,7 +159,14 @@ func (k Keeper) getPairingForClient(ctx sdk.Context, chainID string, clientAddre
 	// filter relevant providers and add slotFiltering for mix filters
 	providerScores, err := pairingfilters.SetupScores(ctx, filters, stakeEntries, strictestPolicy, epoch, len(slots), cluster, k)
 	if err != nil {
-		return nil, 0, "", err
+		return nil, err
+	}
+
+	if sim != nil {
+		err = simulateFilters(ctx, sim, pairingfilters.GetAllFilters(fk, cluster), stakeEntries, strictestPolicy, epoch, providerScores)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	if len(slots) >= len(providerScores) {
@@ -154,24 +174,35 @@ func (k Keeper) getPairingForClient(ctx sdk.Context, chainID string, clientAddre
 		for _, score := range providerScores {
 			filteredEntries = append(filteredEntries, *score.Provider)
 		}
-		return filteredEntries, strictestPolicy.EpochCuLimit, project.Index, nil
+		return filteredEntries, nil
 	}
 
 	// calculate score (always on the diff in score components of consecutive groups) and pick providers
 	prevGroupSlot := pairingscores.NewPairingSlotGroup(pairingscores.NewPairingSlot(-1)) // init dummy slot to compare to
 	for idx, group := range slotGroups {
-		hashData := pairingscores.PrepareHashData(project.Index, chainID, epochHash, idx)
+		hashData := pairingscores.PrepareHashData(projectIndex, chainID, epochHash, idx)
 		diffSlot := group.Subtract(prevGroupSlot)
 		err := pairingscores.CalcPairingScore(providerScores, pairingscores.GetStrategy(), diffSlot)
 		if err != nil {
-			return nil, 0, "", err
+			return nil, err
+		}
+		var simGroup *types.SimulatedSlotGroup
+		if sim != nil {
+			// record the scores before picking (picking may modify them)
+			simGroup = simulateSlotGroup(group, providerScores)
 		}
 		pickedProviders := pairingscores.PickProviders(ctx, providerScores, group.Indexes(), hashData)
 		providers = append(providers, pickedProviders...)
 		prevGroupSlot = group
+		if simGroup != nil {
+			for _, picked := range pickedProviders {
+				simGroup.Picked = append(simGroup.Picked, picked.Address)
+			}
+			sim.SlotGroups = append(sim.SlotGroups, *simGroup)
+		}
 	}
 
-	return providers, strictestPolicy.EpochCuLimit, project.Index, err
+	return providers, nil
 }
 
 func (k Keeper) GetProjectStrictestPolicy(ctx sdk.Context, project projectstypes.Project, chainID string) (*planstypes.Policy, string, error) {
@@ -180,6 +211,22 @@ func (k Keeper) GetProjectStrictestPolicy(ctx sdk.Context, project projectstypes
 		return nil, "", err
 	}
 
+	sub, found := k.subscriptionKeeper.GetSubscription(ctx, project.GetSubscription())
+	if !found {
+		return nil, "", fmt.Errorf("could not find subscription with address %s", project.GetSubscription())
+	}
+
+	strictestPolicy, err := k.CalculateStrictestPolicy(plan, project, sub, chainID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return strictestPolicy, sub.Cluster, nil
+}
+
+// CalculateStrictestPolicy calculates the strictest policy of a project (on a chain) from the policies of
+// its subscription's plan and the project's own policies
+func (k Keeper) CalculateStrictestPolicy(plan planstypes.Plan, project projectstypes.Project, sub subscriptiontypes.Subscription, chainID string) (*planstypes.Policy, error) {
 	planPolicy := plan.GetPlanPolicy()
 	policies := []*planstypes.Policy{&planPolicy}
 	if project.SubscriptionPolicy != nil {
@@ -190,26 +237,24 @@ func (k Keeper) GetProjectStrictestPolicy(ctx sdk.Context, project projectstypes
 	}
 	chainPolicy, allowed := planstypes.GetStrictestChainPolicyForSpec(chainID, policies)
 	if !allowed {
-		return nil, "", fmt.Errorf("chain ID not allowed in all policies, or collections specified and have no intersection %#v", policies)
+		return nil, fmt.Errorf("chain ID not allowed in all policies, or collections specified and have no intersection %#v", policies)
 	}
 	geolocation, err := k.CalculateEffectiveGeolocationFromPolicies(policies)
 	if err != nil {
-		return nil, "", err
+		return nil, err
 	}
 
 	providersToPair, err := k.CalculateEffectiveProvidersToPairFromPolicies(policies)
 	if err != nil {
-		return nil, "", err
+		return nil, err
 	}
 
-	sub, found := k.subscriptionKeeper.GetSubscription(ctx, project.GetSubscription())
-	if !found {
-		return nil, "", fmt.Errorf("could not find subscription with address %s", project.GetSubscription())
-	}
 	allowedCUEpoch, allowedCUTotal := k.CalculateEffectiveAllowedCuPerEpochFromPolicies(policies, project.GetUsedCu(), sub.GetMonthCuLeft())
 
 	selectedProvidersMode, selectedProvidersList := k.CalculateEffectiveSelectedProviders(policies)
 
+	minQosExcellence, excludePunishedEpochs, minSelfStakeRatio, qualityMixed := k.CalculateEffectiveQualityRequirements(policies)
+
 	strictestPolicy := &planstypes.Policy{
 		GeolocationProfile:    geolocation,
 		MaxProvidersToPair:    providersToPair,
@@ -218,9 +263,13 @@ func (k Keeper) GetProjectStrictestPolicy(ctx sdk.Context, project projectstypes
 		ChainPolicies:         []planstypes.ChainPolicy{chainPolicy},
 		EpochCuLimit:          allowedCUEpoch,
 		TotalCuLimit:          allowedCUTotal,
+		MinQosExcellence:      minQosExcellence,
+		ExcludePunishedEpochs: excludePunishedEpochs,
+		MinSelfStakeRatio:     minSelfStakeRatio,
+		QualityMixed:          qualityMixed,
 	}
 
-	return strictestPolicy, sub.Cluster, nil
+	return strictestPolicy, nil
 }
 
 func (k Keeper) CalculateEffectiveSelectedProviders(policies []*planstypes.Policy) (planstypes.SELECTED_PROVIDERS_MODE, []string) {
@@ -241,6 +290,29 @@ func (k Keeper) CalculateEffectiveSelectedProviders(policies []*planstypes.Polic
 	return effectiveMode, effectiveSelectedProviders
 }
 
+// CalculateEffectiveQualityRequirements calculates the strictest providers quality requirements: the
+// highest of each requirement. The requirements are mixed only if all the policies that set any of them
+// allow mixing
+func (k Keeper) CalculateEffectiveQualityRequirements(policies []*planstypes.Policy) (minQosExcellence, excludePunishedEpochs, minSelfStakeRatio uint64, mixed bool) {
+	mixed = true
+	requirementsSet := false
+	for _, policy := range policies {
+		if policy == nil {
+			continue
+		}
+		if policy.MinQosExcellence == 0 && policy.ExcludePunishedEpochs == 0 && policy.MinSelfStakeRatio == 0 {
+			continue
+		}
+		requirementsSet = true
+		minQosExcellence = slices.Max([]uint64{minQosExcellence, policy.MinQosExcellence})
+		excludePunishedEpochs = slices.Max([]uint64{excludePunishedEpochs, policy.ExcludePunishedEpochs})
+		minSelfStakeRatio = slices.Max([]uint64{minSelfStakeRatio, policy.MinSelfStakeRatio})
+		mixed = mixed && policy.QualityMixed
+	}
+
+	return minQosExcellence, excludePunishedEpochs, minSelfStakeRatio, requirementsSet && mixed
+}
+
 func (k Keeper) CalculateEffectiveGeolocationFromPolicies(policies []*planstypes.Policy) (int32, error) {
 	geolocation := int32(math.MaxInt32)
 
@@ -9,9 +9,11 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/lavanet/lava/testutil/common"
 	testkeeper "github.com/lavanet/lava/testutil/keeper"
+	"github.com/lavanet/lava/utils/sigs"
 	"github.com/lavanet/lava/utils/slices"
 	epochstoragetypes "github.com/lavanet/lava/x/epochstorage/types"
 	pairingscores "github.com/lavanet/lava/x/pairing/keeper/scores"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
 	planstypes "github.com/lavanet/lava/x/plans/types"
 	spectypes "github.com/lavanet/lava/x/spec/types"
 	"github.com/stretchr/testify/require"
@@ -2211,3 +2213,125 @@ func TestNoZeroLatency(t *testing.T) {
 		}
 	}
 }
+
+// TestPairingPunishedProvidersPolicy checks that a policy that excludes punished providers keeps
+// recently reported providers out of the pairing, and that they return once the reports are old enough
+func TestPairingPunishedProvidersPolicy(t *testing.T) {
+	ts := newTester(t)
+
+	excludeEpochs := uint64(3)
+	ts.plan.PlanPolicy.ExcludePunishedEpochs = excludeEpochs
+	ts.setupForPayments(5, 1, 5)
+
+	consumer, _ := ts.GetAccount(common.CONSUMER, 0)
+	reported, _ := ts.GetAccount(common.PROVIDER, 0)
+	ts.checkProviderInPairing(consumer.Addr, reported.Addr, true)
+
+	stakeEntry, found := ts.Keepers.Epochstorage.GetStakeEntryByAddressCurrent(ts.Ctx, ts.spec.Index, reported.Addr)
+	require.True(t, found)
+	stakeEntry.LastReportedEpoch = ts.EpochStart()
+	ts.Keepers.Epochstorage.ModifyStakeEntryCurrent(ts.Ctx, ts.spec.Index, stakeEntry)
+
+	// the reported provider is excluded from the next epoch, for excludeEpochs epochs
+	for i := uint64(0); i < excludeEpochs; i++ {
+		ts.AdvanceEpoch()
+		ts.checkProviderInPairing(consumer.Addr, reported.Addr, false)
+	}
+
+	ts.AdvanceEpoch()
+	ts.checkProviderInPairing(consumer.Addr, reported.Addr, true)
+}
+
+// TestPairingQosExcellencePolicy checks that the QoS excellence reports of paid relays update the
+// providers' QoS excellence from the next epoch, and that a policy with a minimal QoS excellence keeps
+// the providers with bad QoS out of the pairing
+func TestPairingQosExcellencePolicy(t *testing.T) {
+	ts := newTester(t)
+
+	ts.plan.PlanPolicy.MinQosExcellence = 90
+	ts.setupForPayments(5, 1, 5)
+
+	consumer, _ := ts.GetAccount(common.CONSUMER, 0)
+	good, _ := ts.GetAccount(common.PROVIDER, 0)
+	bad, _ := ts.GetAccount(common.PROVIDER, 1)
+	ts.checkProviderInPairing(consumer.Addr, good.Addr, true)
+	ts.checkProviderInPairing(consumer.Addr, bad.Addr, true)
+
+	reports := map[string]pairingtypes.QualityOfServiceReport{
+		good.Addr.String(): {Latency: sdk.NewDecWithPrec(5, 1), Availability: sdk.OneDec(), Sync: sdk.ZeroDec()},
+		bad.Addr.String():  {Latency: sdk.NewDec(3), Availability: sdk.NewDecWithPrec(8, 1), Sync: sdk.NewDec(2)},
+	}
+	paidEpoch := ts.EpochStart()
+	for provider, report := range reports {
+		report := report
+		relaySession := ts.newRelaySession(provider, 0, 10, paidEpoch, 0)
+		relaySession.QosExcellenceReport = &report
+		sig, err := sigs.Sign(consumer.SK, *relaySession)
+		require.Nil(t, err)
+		relaySession.Sig = sig
+		_, err = ts.TxPairingRelayPayment(provider, relaySession)
+		require.Nil(t, err)
+	}
+
+	// the reports don't affect the current epoch
+	ts.checkProviderInPairing(consumer.Addr, bad.Addr, true)
+	sub, found := ts.Keepers.Subscription.GetSubscription(ts.Ctx, consumer.Addr.String())
+	require.True(t, found)
+	_, err := ts.Keepers.Pairing.GetQos(ts.Ctx, ts.spec.Index, sub.Cluster, bad.Addr.String())
+	require.NotNil(t, err)
+
+	ts.AdvanceEpoch()
+
+	for provider, report := range reports {
+		qos, err := ts.Keepers.Pairing.GetQos(ts.Ctx, ts.spec.Index, sub.Cluster, provider)
+		require.Nil(t, err)
+		require.Equal(t, report, qos)
+	}
+	ts.checkProviderInPairing(consumer.Addr, good.Addr, true)
+	ts.checkProviderInPairing(consumer.Addr, bad.Addr, false)
+
+	// good reports (of relays from the epoch in which it was still paired) gradually improve
+	// the bad provider's QoS excellence
+	goodReport := reports[good.Addr.String()]
+	for i := uint64(1); i <= 20; i++ {
+		relaySession := ts.newRelaySession(bad.Addr.String(), i, 10, paidEpoch, 0)
+		relaySession.QosExcellenceReport = &goodReport
+		sig, err := sigs.Sign(consumer.SK, *relaySession)
+		require.Nil(t, err)
+		relaySession.Sig = sig
+		_, err = ts.TxPairingRelayPayment(bad.Addr.String(), relaySession)
+		require.Nil(t, err)
+	}
+
+	ts.AdvanceEpoch()
+
+	qos, err := ts.Keepers.Pairing.GetQos(ts.Ctx, ts.spec.Index, sub.Cluster, bad.Addr.String())
+	require.Nil(t, err)
+	require.True(t, qos.Latency.LT(reports[bad.Addr.String()].Latency))
+	require.True(t, qos.Latency.GT(goodReport.Latency))
+	ts.checkProviderInPairing(consumer.Addr, bad.Addr, true)
+}
+
+func TestCalculateEffectiveQualityRequirements(t *testing.T) {
+	ts := newTester(t)
+
+	policies := []*planstypes.Policy{
+		{MinQosExcellence: 90, ExcludePunishedEpochs: 2, QualityMixed: true},
+		{ExcludePunishedEpochs: 5, MinSelfStakeRatio: 10, QualityMixed: true},
+		{}, // a policy without quality requirements doesn't affect the requirements (or mixing)
+	}
+	minQos, excludeEpochs, minSelfStakeRatio, mixed := ts.Keepers.Pairing.CalculateEffectiveQualityRequirements(policies)
+	require.Equal(t, uint64(90), minQos)
+	require.Equal(t, uint64(5), excludeEpochs)
+	require.Equal(t, uint64(10), minSelfStakeRatio)
+	require.True(t, mixed)
+
+	// a single policy that doesn't allow mixing makes the requirements mandatory
+	policies = append(policies, &planstypes.Policy{MinSelfStakeRatio: 5})
+	_, _, minSelfStakeRatio, mixed = ts.Keepers.Pairing.CalculateEffectiveQualityRequirements(policies)
+	require.Equal(t, uint64(10), minSelfStakeRatio)
+	require.False(t, mixed)
+
+	_, _, _, mixed = ts.Keepers.Pairing.CalculateEffectiveQualityRequirements([]*planstypes.Policy{{}})
+	require.False(t, mixed)
+}
@@ -0,0 +1,76 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	epochstoragetypes "github.com/lavanet/lava/x/epochstorage/types"
+	"github.com/lavanet/lava/x/pairing/types"
+)
+
+// SetProviderEarnings set a specific ProviderEarnings in the store from its index
+func (k Keeper) SetProviderEarnings(ctx sdk.Context, providerEarnings types.ProviderEarnings) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ProviderEarningsKeyPrefix))
+	b := k.cdc.MustMarshal(&providerEarnings)
+	store.Set(types.ProviderEarningsKey(
+		providerEarnings.Provider,
+		providerEarnings.ChainID,
+		providerEarnings.Month,
+	), b)
+}
+
+// GetProviderEarnings returns a ProviderEarnings from its index
+func (k Keeper) GetProviderEarnings(ctx sdk.Context, provider, chainID, month string) (val types.ProviderEarnings, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ProviderEarningsKeyPrefix))
+
+	b := store.Get(types.ProviderEarningsKey(provider, chainID, month))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// AddProviderEarnings accumulates a relay payment (CU and rewards) to the earnings of the
+// provider on the chain in the current month
+func (k Keeper) AddProviderEarnings(ctx sdk.Context, provider, chainID string, cu uint64, totalReward, providerReward math.Int) {
+	month := types.ProviderEarningsMonth(ctx.BlockTime())
+
+	earnings, found := k.GetProviderEarnings(ctx, provider, chainID, month)
+	if !found {
+		zeroCoin := sdk.NewCoin(epochstoragetypes.TokenDenom, math.ZeroInt())
+		earnings = types.ProviderEarnings{
+			Provider:         provider,
+			ChainID:          chainID,
+			Month:            month,
+			TotalReward:      zeroCoin,
+			ProviderReward:   zeroCoin,
+			DelegatorsReward: zeroCoin,
+		}
+	}
+
+	earnings.CuServed += cu
+	earnings.RelayPayments++
+	earnings.TotalReward.Amount = earnings.TotalReward.Amount.Add(totalReward)
+	earnings.ProviderReward.Amount = earnings.ProviderReward.Amount.Add(providerReward)
+	earnings.DelegatorsReward.Amount = earnings.DelegatorsReward.Amount.Add(totalReward.Sub(providerReward))
+
+	k.SetProviderEarnings(ctx, earnings)
+}
+
+// GetAllProviderEarnings returns all ProviderEarnings
+func (k Keeper) GetAllProviderEarnings(ctx sdk.Context) (list []types.ProviderEarnings) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ProviderEarningsKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.ProviderEarnings
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
@@ -0,0 +1,68 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/lavanet/lava/testutil/common"
+	epochstoragetypes "github.com/lavanet/lava/x/epochstorage/types"
+	"github.com/lavanet/lava/x/pairing/types"
+	"github.com/stretchr/testify/require"
+)
+
+// Test that relay payments are accumulated in the provider's monthly earnings
+func TestProviderEarnings(t *testing.T) {
+	ts := newTester(t)
+	ts.setupForPayments(1, 1, 1)                   // 1 provider, 1 client, 1 providersToPair
+	ts.AddAccount(common.CONSUMER, 1, testBalance) // add delegator
+
+	providerAcc, provider := ts.GetAccount(common.PROVIDER, 0)
+	clientAcc, _ := ts.GetAccount(common.CONSUMER, 0)
+	_, delegator := ts.GetAccount(common.CONSUMER, 1)
+
+	// delegator's part is half of the reward
+	makeProviderCommissionZero(ts, ts.spec.Index, providerAcc.Addr)
+	_, err := ts.TxDualstakingDelegate(delegator, provider, ts.spec.Index, sdk.NewCoin(epochstoragetypes.TokenDenom, sdk.NewInt(testStake)))
+	require.Nil(t, err)
+	ts.AdvanceEpoch()
+
+	res, err := ts.QueryPairingProviderEarnings(provider, "", nil)
+	require.Nil(t, err)
+	require.Len(t, res.Earnings, 0)
+
+	relayPaymentMessage := sendRelay(ts, provider, clientAcc, []string{ts.spec.Index})
+	ts.payAndVerifyBalance(relayPaymentMessage, clientAcc.Addr, providerAcc.Addr, true, true, 50)
+	relayPaymentMessage = sendRelay(ts, provider, clientAcc, []string{ts.spec.Index})
+	ts.payAndVerifyBalance(relayPaymentMessage, clientAcc.Addr, providerAcc.Addr, true, true, 50)
+
+	reward := ts.Keepers.Pairing.MintCoinsPerCU(ts.Ctx).MulInt64(int64(relayCuSum)).TruncateInt64()
+
+	res, err = ts.QueryPairingProviderEarnings(provider, ts.spec.Index, nil)
+	require.Nil(t, err)
+	require.Len(t, res.Earnings, 1)
+	earnings := res.Earnings[0]
+	require.Equal(t, types.ProviderEarningsMonth(ts.BlockTime()), earnings.Month)
+	require.Equal(t, 2*relayCuSum, earnings.CuServed)
+	require.Equal(t, uint64(2), earnings.RelayPayments)
+	require.Equal(t, 2*reward, earnings.TotalReward.Amount.Int64())
+	require.Equal(t, reward, earnings.ProviderReward.Amount.Int64())
+	require.Equal(t, reward, earnings.DelegatorsReward.Amount.Int64())
+
+	// payments in the next month are accumulated separately
+	nextMonthCtx := ts.Ctx.WithBlockTime(ts.BlockTime().AddDate(0, 1, 0))
+	ts.Keepers.Pairing.AddProviderEarnings(nextMonthCtx, provider, ts.spec.Index, relayCuSum, sdk.NewInt(reward), sdk.NewInt(reward))
+
+	res, err = ts.QueryPairingProviderEarnings(provider, "", nil)
+	require.Nil(t, err)
+	require.Len(t, res.Earnings, 2)
+	require.Equal(t, earnings, res.Earnings[0])
+	require.Equal(t, relayCuSum, res.Earnings[1].CuServed)
+	require.True(t, res.Earnings[1].DelegatorsReward.IsZero())
+
+	// paginated
+	res, err = ts.QueryPairingProviderEarnings(provider, ts.spec.Index, &query.PageRequest{Limit: 1})
+	require.Nil(t, err)
+	require.Len(t, res.Earnings, 1)
+	require.NotNil(t, res.Pagination.NextKey)
+}
@@ -8,14 +8,54 @@ import (
 	pairingtypes "github.com/lavanet/lava/x/pairing/types"
 )
 
-func (k Keeper) UpdateProviderQos(epochPayments pairingtypes.EpochPayments) {
+// weight of a new QoS excellence report in the provider's QoS excellence (the weight of the
+// previous reports decays accordingly)
+var QosExcellenceReportWeight = sdk.NewDecWithPrec(1, 1) // 0.1
+
+// UpdateProviderQos adds the QoS excellence report of a paid relay to the provider's QoS excellence (in the
+// consumer's cluster). The update is stored for the next epoch start, so the current epoch's pairing is not affected.
+func (k Keeper) UpdateProviderQos(ctx sdk.Context, chainID string, cluster string, provider string, report pairingtypes.QualityOfServiceReport) error {
+	if _, err := report.ComputeQoSExcellence(); err != nil {
+		return err
+	}
+
+	nextEpoch, err := k.epochStorageKeeper.GetNextEpoch(ctx, uint64(ctx.BlockHeight()))
+	if err != nil {
+		return err
+	}
+
+	var qos pairingtypes.QualityOfServiceReport
+	key := pairingtypes.ProviderQosKey(provider, chainID, cluster)
+	if k.providerQosFS.FindEntry(ctx, key, nextEpoch, &qos) {
+		prevWeight := sdk.OneDec().Sub(QosExcellenceReportWeight)
+		qos.Latency = qos.Latency.Mul(prevWeight).Add(report.Latency.Mul(QosExcellenceReportWeight))
+		qos.Availability = qos.Availability.Mul(prevWeight).Add(report.Availability.Mul(QosExcellenceReportWeight))
+		qos.Sync = qos.Sync.Mul(prevWeight).Add(report.Sync.Mul(QosExcellenceReportWeight))
+	} else {
+		qos = report
+	}
+
+	return k.providerQosFS.AppendEntry(ctx, key, nextEpoch, &qos)
+}
+
+// updateProviderQosFromRelay updates the provider's QoS excellence with the QoS excellence report of a paid relay
+func (k Keeper) updateProviderQosFromRelay(ctx sdk.Context, projectID string, relay *pairingtypes.RelaySession) error {
+	project, err := k.projectsKeeper.GetProjectForBlock(ctx, projectID, uint64(relay.Epoch))
+	if err != nil {
+		return err
+	}
+
+	sub, found := k.subscriptionKeeper.GetSubscription(ctx, project.GetSubscription())
+	if !found {
+		return fmt.Errorf("could not find subscription with address %s", project.GetSubscription())
+	}
+
+	return k.UpdateProviderQos(ctx, relay.SpecId, sub.Cluster, relay.Provider, *relay.QosExcellenceReport)
 }
 
 // GetQos gets a provider's QoS excellence report from the providerQosFS
 func (k Keeper) GetQos(ctx sdk.Context, chainID string, cluster string, provider string) (pairingtypes.QualityOfServiceReport, error) {
-	var qos pairingtypes.QualityOfServiceReport
-	key := pairingtypes.ProviderQosKey(provider, chainID, cluster)
-	found := k.providerQosFS.FindEntry(ctx, key, uint64(ctx.BlockHeight()), &qos)
+	qos, found := k.GetQosAtBlock(ctx, chainID, cluster, provider, uint64(ctx.BlockHeight()))
 	if !found {
 		return qos, utils.LavaFormatWarning("provider of chain and cluster was not found in the store", fmt.Errorf("qos not found"),
 			utils.Attribute{Key: "provider", Value: provider},
@@ -25,3 +65,11 @@ func (k Keeper) GetQos(ctx sdk.Context, chainID string, cluster string, provider
 	}
 	return qos, nil
 }
+
+// GetQosAtBlock gets a provider's QoS excellence report (as it was in a specific block) from the providerQosFS
+func (k Keeper) GetQosAtBlock(ctx sdk.Context, chainID string, cluster string, provider string, block uint64) (pairingtypes.QualityOfServiceReport, bool) {
+	var qos pairingtypes.QualityOfServiceReport
+	key := pairingtypes.ProviderQosKey(provider, chainID, cluster)
+	found := k.providerQosFS.FindEntry(ctx, key, block, &qos)
+	return qos, found
+}
@@ -22,7 +22,7 @@ func (qr *QosReq) Init(policy planstypes.Policy) bool {
 
 // Score calculates the the provider's qos score
 func (qr *QosReq) Score(score PairingScore) math.Uint {
-	// TODO: update Qos in providerQosFS properly and uncomment this code below
+	// TODO: score by the QoS excellence (ComputeQoSExcellence) and uncomment this code below
 	// Also, the qos score should range between 0.5-2
 
 	// qosScore, err := score.QosExcellenceReport.ComputeQoS()
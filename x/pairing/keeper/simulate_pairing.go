@@ -0,0 +1,150 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/cometbft/cometbft/libs/log"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	epochstoragetypes "github.com/lavanet/lava/x/epochstorage/types"
+	pairingfilters "github.com/lavanet/lava/x/pairing/keeper/filters"
+	pairingscores "github.com/lavanet/lava/x/pairing/keeper/scores"
+	"github.com/lavanet/lava/x/pairing/types"
+	planstypes "github.com/lavanet/lava/x/plans/types"
+	projectstypes "github.com/lavanet/lava/x/projects/types"
+	spectypes "github.com/lavanet/lava/x/spec/types"
+	subscriptiontypes "github.com/lavanet/lava/x/subscription/types"
+)
+
+// offlineFiltersKeeper provides the pairing filters with the chain's state in an offline pairing
+// simulation. It has no QoS excellence reports, and assumes fixed length epochs.
+type offlineFiltersKeeper struct {
+	epochBlocks uint64
+}
+
+func (fk offlineFiltersKeeper) GetQosAtBlock(ctx sdk.Context, chainID string, cluster string, provider string, block uint64) (types.QualityOfServiceReport, bool) {
+	return types.QualityOfServiceReport{}, false
+}
+
+func (fk offlineFiltersKeeper) GetBlockEpochsAgo(ctx sdk.Context, blockHeight, numEpochs uint64) (uint64, error) {
+	if fk.epochBlocks*numEpochs > blockHeight {
+		return 0, fmt.Errorf("block %d is less than %d epochs from genesis", blockHeight, numEpochs)
+	}
+	return blockHeight - fk.epochBlocks*numEpochs, nil
+}
+
+// SimulatePairingOffline simulates the pairing of a project on a chain with data exported from
+// the chain (e.g. an exported genesis file) instead of the chain's state: the given stake entries
+// (of the epoch), the project with its subscription and plan, the epoch hash and the epoch length.
+func SimulatePairingOffline(stakeEntries []epochstoragetypes.StakeEntry, providersType spectypes.Spec_ProvidersTypes, plan planstypes.Plan, project projectstypes.Project, sub subscriptiontypes.Subscription, chainID string, epoch uint64, epochHash []byte, epochBlocks uint64) (*types.QuerySimulatePairingResponse, error) {
+	// the strictest policy and pairing calculations don't access the keeper's stores
+	var k Keeper
+	ctx := sdk.NewContext(nil, tmproto.Header{Height: int64(epoch)}, false, log.NewNopLogger())
+
+	strictestPolicy, err := k.CalculateStrictestPolicy(plan, project, sub, chainID)
+	if err != nil {
+		return nil, err
+	}
+
+	fk := offlineFiltersKeeper{epochBlocks: epochBlocks}
+	return k.simulatePairing(ctx, fk, stakeEntries, providersType, strictestPolicy, sub.Cluster, project.Index, chainID, epoch, epochHash)
+}
+
+func (k Keeper) simulatePairing(ctx sdk.Context, fk pairingfilters.FiltersKeeper, stakeEntries []epochstoragetypes.StakeEntry, providersType spectypes.Spec_ProvidersTypes, strictestPolicy *planstypes.Policy, cluster, projectIndex, chainID string, epoch uint64, epochHash []byte) (*types.QuerySimulatePairingResponse, error) {
+	sim := &types.QuerySimulatePairingResponse{
+		Epoch:     epoch,
+		EpochHash: epochHash,
+		Project:   projectIndex,
+		Policy:    strictestPolicy,
+	}
+
+	providers := stakeEntries
+	if providersType != spectypes.Spec_static {
+		var err error
+		providers, err = k.calculatePairing(ctx, fk, stakeEntries, strictestPolicy, cluster, projectIndex, chainID, epoch, epochHash, sim)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, provider := range providers {
+		sim.Pairing = append(sim.Pairing, provider.Address)
+	}
+
+	return sim, nil
+}
+
+// simulateFilters records the filter results of each provider in a pairing simulation
+func simulateFilters(ctx sdk.Context, sim *types.QuerySimulatePairingResponse, filters []pairingfilters.Filter, stakeEntries []epochstoragetypes.StakeEntry, strictestPolicy *planstypes.Policy, epoch uint64, providerScores []*pairingscores.PairingScore) error {
+	filters, filtersResult, err := pairingfilters.RunFilters(ctx, filters, stakeEntries, strictestPolicy, epoch)
+	if err != nil {
+		return err
+	}
+
+	slotFiltering := map[string]map[int]struct{}{}
+	for _, score := range providerScores {
+		slotFiltering[score.Provider.Address] = score.SlotFiltering
+	}
+
+	for j, stakeEntry := range stakeEntries {
+		provider := types.SimulatedProvider{
+			Address:  stakeEntry.Address,
+			Stake:    sdk.NewCoin(stakeEntry.Stake.Denom, stakeEntry.EffectiveStake()),
+			Eligible: true,
+		}
+		for i, filter := range filters {
+			provider.Filters = append(provider.Filters, types.SimulatedFilterResult{
+				Filter: pairingfilters.FilterName(filter),
+				Passed: filtersResult[i][j],
+				Mix:    filter.IsMix(),
+			})
+			if !filtersResult[i][j] && !filter.IsMix() {
+				provider.Eligible = false
+			}
+		}
+		if provider.Eligible {
+			for slot := range slotFiltering[stakeEntry.Address] {
+				provider.FilteredSlots = append(provider.FilteredSlots, int32(slot))
+			}
+			sort.Slice(provider.FilteredSlots, func(a, b int) bool { return provider.FilteredSlots[a] < provider.FilteredSlots[b] })
+		}
+		sim.Providers = append(sim.Providers, provider)
+	}
+
+	return nil
+}
+
+// simulateSlotGroup records the scores of the providers (that can still be picked) for a slot group in a
+// pairing simulation
+func simulateSlotGroup(group *pairingscores.PairingSlotGroup, providerScores []*pairingscores.PairingScore) *types.SimulatedSlotGroup {
+	simGroup := &types.SimulatedSlotGroup{}
+	for _, slot := range group.Indexes() {
+		simGroup.Slots = append(simGroup.Slots, int32(slot))
+	}
+
+	for _, score := range providerScores {
+		if score.SkipForSelection {
+			// already picked for a previous slot group
+			continue
+		}
+		simScore := types.SimulatedScore{
+			Provider: score.Provider.Address,
+			Score:    score.Score.String(),
+		}
+		reqs := []string{}
+		for req := range score.ScoreComponents {
+			reqs = append(reqs, req)
+		}
+		sort.Strings(reqs)
+		for _, req := range reqs {
+			simScore.Components = append(simScore.Components, types.SimulatedScoreComponent{
+				Req:   req,
+				Score: score.ScoreComponents[req].String(),
+			})
+		}
+		simGroup.Scores = append(simGroup.Scores, simScore)
+	}
+
+	return simGroup
+}
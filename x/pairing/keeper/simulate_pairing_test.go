@@ -0,0 +1,83 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/lavanet/lava/testutil/common"
+	"github.com/lavanet/lava/x/pairing/keeper"
+	"github.com/lavanet/lava/x/pairing/keeper/filters"
+	spectypes "github.com/lavanet/lava/x/spec/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSimulatePairing(t *testing.T) {
+	providersCount := 10
+	providersToPair := 3
+
+	ts := newTester(t)
+	ts.setupForPayments(providersCount, 1, providersToPair)
+	_, clientAddr := ts.GetAccount(common.CONSUMER, 0)
+	_, frozenAddr := ts.GetAccount(common.PROVIDER, 0)
+
+	_, err := ts.TxPairingFreezeProvider(frozenAddr, ts.spec.Index)
+	require.NoError(t, err)
+	ts.AdvanceEpoch()
+
+	pairing, err := ts.QueryPairingGetPairing(ts.spec.Index, clientAddr)
+	require.NoError(t, err)
+	pairingAddrs := []string{}
+	for _, provider := range pairing.Providers {
+		pairingAddrs = append(pairingAddrs, provider.Address)
+	}
+
+	// the simulation picks the same providers as the actual pairing
+	res, err := ts.QueryPairingSimulatePairing(ts.spec.Index, clientAddr, 0, nil)
+	require.NoError(t, err)
+	require.Equal(t, pairingAddrs, res.Pairing)
+	require.Equal(t, ts.EpochStart(), res.Epoch)
+
+	// every provider is shown with its filter results
+	require.Len(t, res.Providers, providersCount)
+	frozenFilter := filters.FilterName(&filters.FrozenProvidersFilter{})
+	for _, provider := range res.Providers {
+		frozen := provider.Address == frozenAddr
+		require.Equal(t, !frozen, provider.Eligible)
+		for _, filter := range provider.Filters {
+			if filter.Filter == frozenFilter {
+				require.Equal(t, !frozen, filter.Passed)
+			}
+		}
+	}
+
+	// every picked provider was scored in its slot group
+	picked := []string{}
+	for _, group := range res.SlotGroups {
+		require.NotEmpty(t, group.Slots)
+		for _, score := range group.Scores {
+			require.NotEqual(t, frozenAddr, score.Provider)
+			require.NotEmpty(t, score.Components)
+		}
+		picked = append(picked, group.Picked...)
+	}
+	require.Equal(t, res.Pairing, picked)
+
+	// a different epoch hash picks a valid pairing as well
+	res, err = ts.QueryPairingSimulatePairing(ts.spec.Index, clientAddr, res.Epoch, []byte("different hash"))
+	require.NoError(t, err)
+	require.Len(t, res.Pairing, providersToPair)
+	require.NotContains(t, res.Pairing, frozenAddr)
+
+	// the offline simulation (with the same data) picks the same providers as well
+	stakeEntries, found, epochHash := ts.Keepers.Epochstorage.GetEpochStakeEntries(ts.Ctx, ts.EpochStart(), ts.spec.Index)
+	require.True(t, found)
+	project, err := ts.GetProjectForDeveloper(clientAddr, ts.BlockHeight())
+	require.NoError(t, err)
+	sub, found := ts.Keepers.Subscription.GetSubscription(ts.Ctx, project.Subscription)
+	require.True(t, found)
+	plan, found := ts.FindPlan(sub.PlanIndex, sub.PlanBlock)
+	require.True(t, found)
+
+	res, err = keeper.SimulatePairingOffline(stakeEntries, spectypes.Spec_dynamic, plan, project, sub, ts.spec.Index, ts.EpochStart(), epochHash, ts.EpochBlocks())
+	require.NoError(t, err)
+	require.Equal(t, pairingAddrs, res.Pairing)
+}
@@ -0,0 +1,66 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/x/pairing/types"
+)
+
+// SetSlashRecord set a specific SlashRecord in the store from its index
+func (k Keeper) SetSlashRecord(ctx sdk.Context, slashRecord types.SlashRecord) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SlashRecordKeyPrefix))
+	b := k.cdc.MustMarshal(&slashRecord)
+	store.Set(types.SlashRecordKey(
+		slashRecord.Provider,
+		slashRecord.ChainID,
+		slashRecord.Block,
+		slashRecord.Sequence,
+	), b)
+}
+
+// AppendSlashRecord stores a new SlashRecord for the current block, assigning it the
+// next free sequence (a provider may be slashed more than once in the same block)
+func (k Keeper) AppendSlashRecord(ctx sdk.Context, slashRecord types.SlashRecord) types.SlashRecord {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SlashRecordKeyPrefix))
+
+	slashRecord.Block = uint64(ctx.BlockHeight())
+	slashRecord.Sequence = 0
+	for store.Has(types.SlashRecordKey(slashRecord.Provider, slashRecord.ChainID, slashRecord.Block, slashRecord.Sequence)) {
+		slashRecord.Sequence++
+	}
+
+	k.SetSlashRecord(ctx, slashRecord)
+	return slashRecord
+}
+
+// GetSlashRecords returns all the SlashRecord of a provider, optionally filtered by chain
+func (k Keeper) GetSlashRecords(ctx sdk.Context, provider, chainID string) (list []types.SlashRecord) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SlashRecordKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, types.SlashRecordPrefix(provider, chainID))
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.SlashRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetAllSlashRecord returns all SlashRecord
+func (k Keeper) GetAllSlashRecord(ctx sdk.Context) (list []types.SlashRecord) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SlashRecordKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.SlashRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
@@ -8,19 +8,25 @@ import (
 	"github.com/lavanet/lava/x/pairing/types"
 )
 
-func (k Keeper) CreditStakeEntry(ctx sdk.Context, chainID string, lookUpAddress sdk.AccAddress, creditAmount sdk.Coin) (bool, error) {
+func (k Keeper) CreditStakeEntry(ctx sdk.Context, chainID string, lookUpAddress sdk.AccAddress, creditAmount sdk.Coin, senderModule string) (bool, error) {
 	if creditAmount.Denom != epochstoragetypes.TokenDenom {
 		return false, fmt.Errorf("burn coin isn't right denom: %s", creditAmount.Denom)
 	}
 	// find the user in the stake list
 
-	entry, found, indexFound := k.epochStorageKeeper.GetStakeEntryByAddressCurrent(ctx, chainID, lookUpAddress)
+	entry, found := k.epochStorageKeeper.GetStakeEntryByAddressCurrent(ctx, chainID, lookUpAddress)
 	if found {
+		// move the credit from the sender module to the stake
+		if creditAmount.IsPositive() {
+			err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, senderModule, types.ModuleName, sdk.NewCoins(creditAmount))
+			if err != nil {
+				return false, err
+			}
+		}
 		// add the requested credit to the entry
 		entry.Stake = entry.Stake.Add(creditAmount)
 		// now we need to save the entry
-		k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(creditAmount))
-		k.epochStorageKeeper.ModifyStakeEntryCurrent(ctx, chainID, entry, indexFound)
+		k.epochStorageKeeper.ModifyStakeEntryCurrent(ctx, chainID, entry)
 		return true, nil
 	}
 
@@ -31,8 +37,13 @@ func (k Keeper) CreditStakeEntry(ctx sdk.Context, chainID string, lookUpAddress
 		// appending new unstake entry in order to delay liquidity of the reward
 		entry.Stake = creditAmount
 
-		// now we need to save the entry
-		k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(creditAmount))
+		// move the credit from the sender module to the stake
+		if creditAmount.IsPositive() {
+			err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, senderModule, types.ModuleName, sdk.NewCoins(creditAmount))
+			if err != nil {
+				return false, err
+			}
+		}
 
 		unstakeHoldBlocks := k.getUnstakeHoldBlocks(ctx, entry.Chain)
 		return true, k.epochStorageKeeper.AppendUnstakeEntry(ctx, entry, unstakeHoldBlocks)
@@ -82,7 +82,7 @@ func (k Keeper) StakeNewEntry(ctx sdk.Context, creator, chainID string, amount s
 		moniker = moniker[:50]
 	}
 
-	existingEntry, entryExists, indexInStakeStorage := k.epochStorageKeeper.GetStakeEntryByAddressCurrent(ctx, chainID, senderAddr)
+	existingEntry, entryExists := k.epochStorageKeeper.GetStakeEntryByAddressCurrent(ctx, chainID, senderAddr)
 	if entryExists {
 		// modify the entry
 		if existingEntry.Address != creator {
@@ -100,6 +100,11 @@ func (k Keeper) StakeNewEntry(ctx sdk.Context, creator, chainID string, amount s
 		details = append(details, utils.Attribute{Key: "moniker", Value: moniker})
 		if amount.IsGTE(existingEntry.Stake) {
 			// support modifying with the same stake or greater only
+			// delegation terms changes that aren't in favor of the delegators are delayed (see setDelegationTerms)
+			err := k.setDelegationTerms(ctx, &existingEntry, delegationCommission, delegationLimit)
+			if err != nil {
+				return err
+			}
 			if !amount.Equal(existingEntry.Stake) {
 				// needs to charge additional tokens
 				err := verifySufficientAmountAndSendToModule(ctx, k, senderAddr, amount.Sub(existingEntry.Stake))
@@ -120,10 +125,8 @@ func (k Keeper) StakeNewEntry(ctx sdk.Context, creator, chainID string, amount s
 			existingEntry.Geolocation = geolocation
 			existingEntry.Endpoints = endpointsVerified
 			existingEntry.Moniker = moniker
-			existingEntry.DelegateCommission = delegationCommission
-			existingEntry.DelegateLimit = delegationLimit
 
-			k.epochStorageKeeper.ModifyStakeEntryCurrent(ctx, chainID, existingEntry, indexInStakeStorage)
+			k.epochStorageKeeper.ModifyStakeEntryCurrent(ctx, chainID, existingEntry)
 			detailsMap := map[string]string{}
 			for _, val := range details {
 				detailsMap[val.Key] = fmt.Sprint(val.Value)
@@ -250,7 +253,7 @@ func (k Keeper) GetStakeEntry(ctx sdk.Context, chainID string, provider string)
 		)
 	}
 
-	stakeEntry, found, _ := k.epochStorageKeeper.GetStakeEntryByAddressCurrent(ctx, chainID, providerAcc)
+	stakeEntry, found := k.epochStorageKeeper.GetStakeEntryByAddressCurrent(ctx, chainID, providerAcc)
 	if !found {
 		return epochstoragetypes.StakeEntry{}, utils.LavaFormatWarning("provider not staked on chain", fmt.Errorf("cannot get stake entry"),
 			utils.Attribute{Key: "chainID", Value: chainID},
@@ -38,7 +38,7 @@ func (k Keeper) UnstakeUnresponsiveProviders(ctx sdk.Context, epochsNumToCheckCU
 	//   recommendedEpochNumToCollectPayment+
 	//   max(epochsNumToCheckCUForComplainers,epochsNumToCheckCUForUnresponsiveProvider)
 	// epochs from the current epoch.
-	minHistoryBlock, err := k.getBlockEpochsAgo(ctx, currentEpoch, largerEpochsNumConst+recommendedEpochNumToCollectPayment)
+	minHistoryBlock, err := k.GetBlockEpochsAgo(ctx, currentEpoch, largerEpochsNumConst+recommendedEpochNumToCollectPayment)
 	if err != nil {
 		// not enough history, do nothing
 		return
@@ -53,7 +53,7 @@ func (k Keeper) UnstakeUnresponsiveProviders(ctx sdk.Context, epochsNumToCheckCU
 	}
 
 	// Go back recommendedEpochNumToCollectPayment
-	minPaymentBlock, err := k.getBlockEpochsAgo(ctx, currentEpoch, recommendedEpochNumToCollectPayment)
+	minPaymentBlock, err := k.GetBlockEpochsAgo(ctx, currentEpoch, recommendedEpochNumToCollectPayment)
 	if err != nil {
 		// not enough history, do nothiing
 		return
@@ -75,6 +75,10 @@ func (k Keeper) UnstakeUnresponsiveProviders(ctx sdk.Context, epochsNumToCheckCU
 		providerStakeEntriesForChain := providerStakeStorage.GetStakeEntries()
 		// count providers per geolocation
 		for _, providerStakeEntry := range providerStakeEntriesForChain {
+			if providerStakeEntry.IsJailed() {
+				// jailed providers don't serve consumers
+				continue
+			}
 			for _, endpoint := range providerStakeEntry.Endpoints {
 				_, ok := existingProviders[endpoint.Geolocation]
 				if !ok {
@@ -90,7 +94,11 @@ func (k Keeper) UnstakeUnresponsiveProviders(ctx sdk.Context, epochsNumToCheckCU
 		for _, providerStakeEntry := range providerStakeEntriesForChain {
 			if minHistoryBlock < providerStakeEntry.StakeAppliedBlock {
 				// this staked provider has too short history (either since staking
-				// or since it was last unfrozen) - do not consider for jailing
+				// or since it was last unfrozen or unjailed) - do not consider for jailing
+				continue
+			}
+			if providerStakeEntry.IsJailed() {
+				// already jailed - nothing more to do until it is unjailed
 				continue
 			}
 			// update the CU count for this provider in providerCuCounterForUnreponsivenessMap
@@ -104,7 +112,7 @@ func (k Keeper) UnstakeUnresponsiveProviders(ctx sdk.Context, epochsNumToCheckCU
 
 			// providerPaymentStorageKeyList is not empty -> provider should be punished
 			if len(providerPaymentStorageKeyList) != 0 && uint64(len(existingProviders[providerStakeEntry.Geolocation])) > minProviders {
-				err = k.punishUnresponsiveProvider(ctx, minPaymentBlock, providerPaymentStorageKeyList, providerStakeEntry.GetAddress(), providerStakeEntry.GetChain(), complaintCU, servicedCU)
+				err = k.punishUnresponsiveProvider(ctx, providerPaymentStorageKeyList, providerStakeEntry.GetAddress(), providerStakeEntry.GetChain(), complaintCU, servicedCU)
 				delete(existingProviders[providerStakeEntry.Geolocation], providerStakeEntry.Address)
 				if err != nil {
 					utils.LavaFormatError("unstake unresponsive providers failed to punish provider", err,
@@ -116,8 +124,8 @@ func (k Keeper) UnstakeUnresponsiveProviders(ctx sdk.Context, epochsNumToCheckCU
 	}
 }
 
-// getBlockEpochsAgo returns the block numEpochs back from the given blockHeight
-func (k Keeper) getBlockEpochsAgo(ctx sdk.Context, blockHeight, numEpochs uint64) (uint64, error) {
+// GetBlockEpochsAgo returns the block numEpochs back from the given blockHeight
+func (k Keeper) GetBlockEpochsAgo(ctx sdk.Context, blockHeight, numEpochs uint64) (uint64, error) {
 	for counter := 0; counter < int(numEpochs); counter++ {
 		var err error
 		blockHeight, err = k.epochStorageKeeper.GetPreviousEpochStartForBlock(ctx, blockHeight)
@@ -213,8 +221,8 @@ func (k Keeper) getCurrentProviderStakeStorageList(ctx sdk.Context) []epochstora
 	return stakeStorageList
 }
 
-// Function that punishes providers. Current punishment is unstake
-func (k Keeper) punishUnresponsiveProvider(ctx sdk.Context, epoch uint64, providerPaymentStorageKeyList []string, providerAddress, chainID string, complaintCU uint64, servicedCU uint64) error {
+// Function that punishes providers. Current punishment is jail (with escalating duration)
+func (k Keeper) punishUnresponsiveProvider(ctx sdk.Context, providerPaymentStorageKeyList []string, providerAddress, chainID string, complaintCU uint64, servicedCU uint64) error {
 	// Get provider's sdk.Account address
 	sdkUnresponsiveProviderAddress, err := sdk.AccAddressFromBech32(providerAddress)
 	if err != nil {
@@ -223,18 +231,18 @@ func (k Keeper) punishUnresponsiveProvider(ctx sdk.Context, epoch uint64, provid
 	}
 
 	// Get provider's stake entry
-	existingEntry, entryExists, indexInStakeStorage := k.epochStorageKeeper.GetStakeEntryByAddressCurrent(ctx, chainID, sdkUnresponsiveProviderAddress)
+	_, entryExists := k.epochStorageKeeper.GetStakeEntryByAddressCurrent(ctx, chainID, sdkUnresponsiveProviderAddress)
 	if !entryExists {
 		// if provider is not staked, nothing to do.
 		return nil
 	}
 
-	// unstake the unresponsive provider
-	utils.LogLavaEvent(ctx, k.Logger(ctx), types.ProviderJailedEventName, map[string]string{"provider_address": providerAddress, "chain_id": chainID, "complaint_cu": strconv.FormatUint(complaintCU, 10), "serviced_cu": strconv.FormatUint(servicedCU, 10)}, "Unresponsive provider was unstaked from the chain due to unresponsiveness")
-	err = k.unsafeUnstakeProviderEntry(ctx, epoch, chainID, indexInStakeStorage, existingEntry)
+	// jail the unresponsive provider
+	jailEndBlock, jails, err := k.jailEntryForOffense(ctx, sdkUnresponsiveProviderAddress, chainID)
 	if err != nil {
-		utils.LavaFormatError("unable to unstake provider entry (unsafe method)", err, []utils.Attribute{{Key: "chainID", Value: chainID}, {Key: "indexInStakeStorage", Value: indexInStakeStorage}, {Key: "existingEntry", Value: existingEntry.GetStake()}}...)
+		return utils.LavaFormatError("unable to jail provider entry", err, []utils.Attribute{{Key: "chainID", Value: chainID}, {Key: "provider", Value: providerAddress}}...)
 	}
+	utils.LogLavaEvent(ctx, k.Logger(ctx), types.ProviderJailedEventName, map[string]string{"provider_address": providerAddress, "chain_id": chainID, "complaint_cu": strconv.FormatUint(complaintCU, 10), "serviced_cu": strconv.FormatUint(servicedCU, 10), "jail_end_block": strconv.FormatUint(jailEndBlock, 10), "jails": strconv.FormatUint(jails, 10)}, "Unresponsive provider was jailed on the chain due to unresponsiveness")
 
 	// reset the provider's complainer CU (so he won't get punished for the same complaints twice)
 	k.resetComplainersCU(ctx, providerPaymentStorageKeyList)
@@ -257,22 +265,3 @@ func (k Keeper) resetComplainersCU(ctx sdk.Context, providerPaymentStorageIndexL
 		k.SetProviderPaymentStorage(ctx, providerPaymentStorage)
 	}
 }
-
-// Function that unstakes a provider (considered unsafe because it doesn't check that the provider is staked. It's ok since we check the provider is staked before we call it)
-func (k Keeper) unsafeUnstakeProviderEntry(ctx sdk.Context, epoch uint64, chainID string, indexInStakeStorage uint64, existingEntry epochstoragetypes.StakeEntry) error {
-	// Remove the provider's stake entry
-	err := k.epochStorageKeeper.RemoveStakeEntryCurrent(ctx, chainID, indexInStakeStorage)
-	if err != nil {
-		return utils.LavaFormatWarning("tried to unstake unsafe but didnt find entry", err,
-			utils.Attribute{Key: "existingEntry", Value: fmt.Sprintf("%+v", existingEntry)},
-		)
-	}
-
-	// get unstakeHoldBlocks
-	unstakeHoldBlocks := k.epochStorageKeeper.UnstakeHoldBlocks(ctx, epoch)
-
-	// Appened the provider's stake entry to the unstake entry list
-	k.epochStorageKeeper.AppendUnstakeEntry(ctx, existingEntry, unstakeHoldBlocks)
-
-	return nil
-}
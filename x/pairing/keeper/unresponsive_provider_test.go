@@ -8,15 +8,44 @@ import (
 	"github.com/lavanet/lava/testutil/common"
 	"github.com/lavanet/lava/utils/sigs"
 	"github.com/lavanet/lava/utils/slices"
+	epochstoragetypes "github.com/lavanet/lava/x/epochstorage/types"
 	"github.com/lavanet/lava/x/pairing/types"
 	"github.com/stretchr/testify/require"
 )
 
-func (ts *tester) checkProviderUnstaked(provider sdk.AccAddress) {
+func (ts *tester) checkProviderJailed(provider sdk.AccAddress) epochstoragetypes.StakeEntry {
 	_, unstakeStoragefound, _ := ts.Keepers.Epochstorage.UnstakeEntryByAddress(ts.Ctx, provider)
-	require.True(ts.T, unstakeStoragefound)
-	_, stakeStorageFound, _ := ts.Keepers.Epochstorage.GetStakeEntryByAddressCurrent(ts.Ctx, ts.spec.Name, provider)
-	require.False(ts.T, stakeStorageFound)
+	require.False(ts.T, unstakeStoragefound)
+	stakeEntry, stakeStorageFound := ts.Keepers.Epochstorage.GetStakeEntryByAddressCurrent(ts.Ctx, ts.spec.Name, provider)
+	require.True(ts.T, stakeStorageFound)
+	require.True(ts.T, stakeEntry.IsJailed())
+	return stakeEntry
+}
+
+// advanceUntilJailed advances epochs (up to maxEpochs) until the provider gets jailed
+func (ts *tester) advanceUntilJailed(provider sdk.AccAddress, maxEpochs uint64) epochstoragetypes.StakeEntry {
+	for i := uint64(0); i < maxEpochs; i++ {
+		ts.AdvanceEpoch()
+		stakeEntry, found := ts.Keepers.Epochstorage.GetStakeEntryByAddressCurrent(ts.Ctx, ts.spec.Name, provider)
+		require.True(ts.T, found)
+		if stakeEntry.IsJailed() {
+			return stakeEntry
+		}
+	}
+	require.FailNow(ts.T, "provider was not jailed")
+	return epochstoragetypes.StakeEntry{}
+}
+
+func (ts *tester) checkProviderInPairing(consumer, provider sdk.AccAddress, expected bool) {
+	pairing, err := ts.QueryPairingGetPairing(ts.spec.Name, consumer.String())
+	require.NoError(ts.T, err)
+	found := false
+	for _, p := range pairing.Providers {
+		if p.Address == provider.String() {
+			found = true
+		}
+	}
+	require.Equal(ts.T, expected, found)
 }
 
 func (ts *tester) checkComplainerReset(provider sdk.AccAddress, epoch uint64) {
@@ -31,7 +60,7 @@ func (ts *tester) checkComplainerReset(provider sdk.AccAddress, epoch uint64) {
 func (ts *tester) checkProviderStaked(provider sdk.AccAddress) {
 	_, unstakeStoragefound, _ := ts.Keepers.Epochstorage.UnstakeEntryByAddress(ts.Ctx, provider)
 	require.False(ts.T, unstakeStoragefound)
-	_, stakeStorageFound, _ := ts.Keepers.Epochstorage.GetStakeEntryByAddressCurrent(ts.Ctx, ts.spec.Name, provider)
+	_, stakeStorageFound := ts.Keepers.Epochstorage.GetStakeEntryByAddressCurrent(ts.Ctx, ts.spec.Name, provider)
 	require.True(ts.T, stakeStorageFound)
 }
 
@@ -99,7 +128,8 @@ func TestUnresponsivenessStressTest(t *testing.T) {
 	ts.AdvanceEpochs(largerConst)
 
 	for i := 0; i < unresponsiveCount; i++ {
-		ts.checkProviderUnstaked(providers[i].Addr)
+		stakeEntry := ts.checkProviderJailed(providers[i].Addr)
+		require.Equal(t, relayEpoch, stakeEntry.LastReportedEpoch)
 		ts.checkComplainerReset(providers[i].Addr, relayEpoch)
 	}
 
@@ -108,8 +138,9 @@ func TestUnresponsivenessStressTest(t *testing.T) {
 	}
 }
 
-// Test to measure the time the check for unresponsiveness every epoch start takes
-func TestUnstakingProviderForUnresponsiveness(t *testing.T) {
+// Test that an unresponsive provider is jailed (and keeps its stake), and that it can
+// unjail only after the jail ends
+func TestJailProviderForUnresponsiveness(t *testing.T) {
 	// setup test for unresponsiveness
 	clientsCount := 1
 	providersCount := 10
@@ -138,7 +169,7 @@ func TestUnstakingProviderForUnresponsiveness(t *testing.T) {
 
 	// get provider1's balance before the stake
 	provider1_balance := ts.GetBalance(provider1_addr)
-	staked_amount, _, _ := ts.Keepers.Epochstorage.GetStakeEntryByAddressCurrent(ts.Ctx, ts.spec.Name, provider1_addr)
+	staked_amount, _ := ts.Keepers.Epochstorage.GetStakeEntryByAddressCurrent(ts.Ctx, ts.spec.Name, provider1_addr)
 	balanceProvideratBeforeStake := staked_amount.Stake.Amount.Int64() + provider1_balance
 	unresponsiveProvidersData := []*types.ReportedProvider{{Address: provider1_addr.String()}}
 
@@ -165,31 +196,178 @@ func TestUnstakingProviderForUnresponsiveness(t *testing.T) {
 		largerConst = recommendedEpochNumToCollectPayment
 	}
 
-	ts.AdvanceEpochs(largerConst)
-
-	ts.checkProviderUnstaked(provider1_addr)
+	stakeEntry := ts.advanceUntilJailed(provider1_addr, largerConst)
+	require.Equal(t, uint64(1), stakeEntry.Jails)
 	ts.checkComplainerReset(provider1_addr, relayEpoch)
 	ts.checkProviderStaked(provider0_addr)
 
-	// advance enough epochs so the current block will be deleted
-	epochBlocks := ts.EpochBlocks()
-	epochsToSkip := (ts.BlocksToSave() + epochBlocks - 1) / epochBlocks
-	ts.AdvanceEpochs(epochsToSkip)
+	// the jailed provider keeps its stake
+	require.Equal(t, balanceProvideratBeforeStake, stakeEntry.Stake.Amount.Int64()+ts.GetBalance(provider1_addr))
 
-	// validate that the provider is no longer unstaked
-	// but also validate that the provider hasn't returned to the stake pool
-	_, unstakeStoragefound, _ := ts.Keepers.Epochstorage.UnstakeEntryByAddress(ts.Ctx, provider1_addr)
-	require.False(t, unstakeStoragefound)
+	// unjail before the jail ends should fail
+	_, err = ts.TxPairingUnjail(provider1_addr.String(), ts.spec.Name)
+	require.Error(t, err)
+
+	// the jailed provider is not paired from the next epoch
+	ts.AdvanceEpoch()
+	ts.checkProviderInPairing(clients[0].Addr, provider1_addr, false)
+
+	// unjail of a provider that is not jailed should fail
+	_, err = ts.TxPairingUnjail(provider0_addr.String(), ts.spec.Name)
+	require.Error(t, err)
+
+	// advance until the jail ends: the provider is still jailed until it unjails
+	ts.AdvanceBlocks(stakeEntry.JailEndBlock - ts.BlockHeight())
+	ts.checkProviderJailed(provider1_addr)
+
+	_, err = ts.TxPairingUnjail(provider1_addr.String(), ts.spec.Name)
+	require.NoError(t, err)
+	ts.checkProviderStaked(provider1_addr)
+	stakeEntry, _ = ts.Keepers.Epochstorage.GetStakeEntryByAddressCurrent(ts.Ctx, ts.spec.Name, provider1_addr)
+	require.False(t, stakeEntry.IsJailed())
+	require.Equal(t, ts.BlockHeight(), stakeEntry.UnjailBlock)
+
+	// the provider is a pairing candidate again from the next epoch (not checking the
+	// pairing itself, since only some of the providers are randomly paired)
+	ts.AdvanceEpoch()
+	epochEntry, err := ts.Keepers.Epochstorage.GetStakeEntryForProviderEpoch(ts.Ctx, ts.spec.Name, provider1_addr, ts.EpochStart())
+	require.NoError(t, err)
+	require.False(t, epochEntry.IsJailed())
+}
+
+// Test that a provider is marked as reported only once the complaints against it pass the
+// unresponsiveness threshold (the CU it serviced)
+func TestReportedProviderUnresponsivenessThreshold(t *testing.T) {
+	clientsCount := 1
+	providersCount := 10
+
+	ts := newTester(t)
+	ts.setupForPayments(providersCount, clientsCount, providersCount-1) // set providers-to-pair
 
-	_, stakeStorageFound, _ := ts.Keepers.Epochstorage.GetStakeEntryByAddressCurrent(ts.Ctx, ts.spec.Name, provider1_addr)
-	require.False(t, stakeStorageFound)
+	clients := ts.Accounts(common.CONSUMER)
 
-	// validate that the provider's balance after the unstake is the same as before he staked
-	balanceProviderAfterUnstakeMoneyReturned := ts.GetBalance(provider1_addr)
-	require.Equal(t, balanceProvideratBeforeStake, balanceProviderAfterUnstakeMoneyReturned)
+	largerConst := types.EPOCHS_NUM_TO_CHECK_CU_FOR_UNRESPONSIVE_PROVIDER
+	if largerConst < types.EPOCHS_NUM_TO_CHECK_FOR_COMPLAINERS {
+		largerConst = types.EPOCHS_NUM_TO_CHECK_FOR_COMPLAINERS
+	}
+	ts.AdvanceEpochs(largerConst + ts.Keepers.Pairing.RecommendedEpochNumToCollectPayment(ts.Ctx))
+
+	pairing, err := ts.QueryPairingGetPairing(ts.spec.Name, clients[0].Addr.String())
+	require.NoError(t, err)
+	provider0_addr := sdk.MustAccAddressFromBech32(pairing.Providers[0].Address)
+	provider1_addr := sdk.MustAccAddressFromBech32(pairing.Providers[1].Address)
+	unresponsiveProvidersData := []*types.ReportedProvider{{Address: provider1_addr.String()}}
+
+	relayEpoch := ts.BlockHeight()
+	cuSum := ts.spec.ApiCollections[0].Apis[0].ComputeUnits * 10
+	pay := func(provider sdk.AccAddress, session uint64, cuSum uint64, unresponsiveProviders []*types.ReportedProvider) {
+		relaySession := ts.newRelaySession(provider.String(), session, cuSum, relayEpoch, 0)
+		relaySession.UnresponsiveProviders = unresponsiveProviders
+		sig, err := sigs.Sign(clients[0].SK, *relaySession)
+		require.NoError(t, err)
+		relaySession.Sig = sig
+		relayPaymentMessage := types.MsgRelayPayment{
+			Creator: provider.String(),
+			Relays:  slices.Slice(relaySession),
+		}
+		ts.payAndVerifyBalance(relayPaymentMessage, clients[0].Addr, provider, true, true, 100)
+	}
+
+	// provider1 serviced more CU than the complaints against it: not reported
+	pay(provider1_addr, 0, cuSum, nil)
+	pay(provider0_addr, 1, cuSum, unresponsiveProvidersData)
+	stakeEntry, found := ts.Keepers.Epochstorage.GetStakeEntryByAddressCurrent(ts.Ctx, ts.spec.Name, provider1_addr)
+	require.True(t, found)
+	require.Zero(t, stakeEntry.LastReportedEpoch)
+
+	// the complaints pass the CU it serviced: reported (each complaint is divided between the other
+	// paired providers, so it takes a relay of 8 times the serviced CU)
+	pay(provider0_addr, 2, cuSum*uint64(providersCount-2), unresponsiveProvidersData)
+	stakeEntry, found = ts.Keepers.Epochstorage.GetStakeEntryByAddressCurrent(ts.Ctx, ts.spec.Name, provider1_addr)
+	require.True(t, found)
+	require.Equal(t, relayEpoch, stakeEntry.LastReportedEpoch)
 }
 
-func TestUnstakingProviderForUnresponsivenessContinueComplainingAfterUnstake(t *testing.T) {
+// Test that the jail duration escalates for providers that are repeatedly jailed
+func TestJailProviderForUnresponsivenessEscalation(t *testing.T) {
+	clientsCount := 1
+	providersCount := 5
+
+	ts := newTester(t)
+	ts.setupForPayments(providersCount, clientsCount, providersCount-1) // set providers-to-pair
+
+	clients := ts.Accounts(common.CONSUMER)
+
+	recommendedEpochNumToCollectPayment := ts.Keepers.Pairing.RecommendedEpochNumToCollectPayment(ts.Ctx)
+
+	largerConst := types.EPOCHS_NUM_TO_CHECK_CU_FOR_UNRESPONSIVE_PROVIDER
+	if largerConst < types.EPOCHS_NUM_TO_CHECK_FOR_COMPLAINERS {
+		largerConst = types.EPOCHS_NUM_TO_CHECK_FOR_COMPLAINERS
+	}
+
+	// advance enough epochs so we can check punishment due to unresponsiveness
+	// (if the epoch is too early, there's no punishment)
+	ts.AdvanceEpochs(largerConst + recommendedEpochNumToCollectPayment)
+
+	// find the provider to complain about in the pairing
+	pairing, err := ts.QueryPairingGetPairing(ts.spec.Name, clients[0].Addr.String())
+	require.NoError(t, err)
+	provider1_addr := sdk.MustAccAddressFromBech32(pairing.Providers[1].Address)
+
+	unresponsiveProvidersData := []*types.ReportedProvider{{Address: provider1_addr.String()}}
+	cuSum := ts.spec.ApiCollections[0].Apis[0].ComputeUnits * 10
+
+	if largerConst < recommendedEpochNumToCollectPayment {
+		largerConst = recommendedEpochNumToCollectPayment
+	}
+
+	// the third jail is after two offenses were forgiven
+	expectedJails := []uint64{1, 2, 1}
+	var jailDurations []uint64
+	for i := 0; i < len(expectedJails); i++ {
+		if i == 2 {
+			ts.AdvanceEpochs(2 * types.JAIL_DECAY_EPOCHS)
+		}
+
+		// find another provider in the (current) pairing to send the complaints
+		pairing, err := ts.QueryPairingGetPairing(ts.spec.Name, clients[0].Addr.String())
+		require.NoError(t, err)
+		provider0_addr := sdk.MustAccAddressFromBech32(pairing.Providers[0].Address)
+		if provider0_addr.Equals(provider1_addr) {
+			provider0_addr = sdk.MustAccAddressFromBech32(pairing.Providers[1].Address)
+		}
+
+		// complain about provider1 and wait for it to be jailed
+		relaySession := ts.newRelaySession(provider0_addr.String(), uint64(i), cuSum, ts.BlockHeight(), 0)
+		relaySession.UnresponsiveProviders = unresponsiveProvidersData
+		sig, err := sigs.Sign(clients[0].SK, *relaySession)
+		relaySession.Sig = sig
+		require.Nil(t, err)
+		relayPaymentMessage := types.MsgRelayPayment{
+			Creator: provider0_addr.String(),
+			Relays:  slices.Slice(relaySession),
+		}
+		ts.payAndVerifyBalance(relayPaymentMessage, clients[0].Addr, provider0_addr, true, true, 100)
+
+		stakeEntry := ts.advanceUntilJailed(provider1_addr, largerConst)
+		require.Equal(t, expectedJails[i], stakeEntry.Jails)
+		jailDurations = append(jailDurations, stakeEntry.JailEndBlock-ts.BlockHeight())
+
+		// wait for the jail to end and unjail
+		ts.AdvanceBlocks(stakeEntry.JailEndBlock - ts.BlockHeight())
+		_, err = ts.TxPairingUnjail(provider1_addr.String(), ts.spec.Name)
+		require.NoError(t, err)
+
+		// advance enough epochs so the unjailed provider has enough history to be checked again
+		ts.AdvanceEpochs(largerConst + recommendedEpochNumToCollectPayment)
+	}
+
+	require.Equal(t, ts.EpochBlocks()*types.JAIL_EPOCHS_BASE, jailDurations[0])
+	require.Equal(t, 2*jailDurations[0], jailDurations[1])
+	require.Equal(t, jailDurations[0], jailDurations[2])
+}
+
+func TestJailProviderForUnresponsivenessContinueComplainingAfterJail(t *testing.T) {
 	clientsCount := 1
 	providersCount := 5
 
@@ -241,7 +419,7 @@ func TestUnstakingProviderForUnresponsivenessContinueComplainingAfterUnstake(t *
 
 	ts.AdvanceEpochs(largerConst)
 
-	ts.checkProviderUnstaked(provider1_addr)
+	jailedEntry := ts.checkProviderJailed(provider1_addr)
 	ts.checkComplainerReset(provider1_addr, relayEpoch)
 
 	ts.AdvanceEpochs(2)
@@ -262,27 +440,15 @@ func TestUnstakingProviderForUnresponsivenessContinueComplainingAfterUnstake(t *
 		ts.payAndVerifyBalance(relayPaymentMessage, clients[clientIndex].Addr, provider0_addr, true, true, 100)
 	}
 
-	// test the provider is still unstaked
-	_, stakeStorageFound, _ := ts.Keepers.Epochstorage.GetStakeEntryByAddressCurrent(ts.Ctx, ts.spec.Name, provider1_addr)
-	require.False(t, stakeStorageFound)
-	_, unStakeStoragefound, _ := ts.Keepers.Epochstorage.UnstakeEntryByAddress(ts.Ctx, provider1_addr)
-	require.True(t, unStakeStoragefound)
-
-	// get the current unstake storage
-	storage, foundStorage := ts.Keepers.Epochstorage.GetStakeStorageUnstake(ts.Ctx)
-	require.True(t, foundStorage)
-
-	// validate the punished provider is not shown twice (or more) in the unstake storage
-	var numberOfAppearances int
-	for _, stored := range storage.StakeEntries {
-		if stored.Address == provider1_addr.String() {
-			numberOfAppearances += 1
-		}
-	}
-	require.Equal(t, numberOfAppearances, 1)
+	ts.AdvanceEpochs(largerConst)
+
+	// test the provider is still jailed, and was not jailed again for the same offense
+	stakeEntry := ts.checkProviderJailed(provider1_addr)
+	require.Equal(t, jailedEntry.Jails, stakeEntry.Jails)
+	require.Equal(t, jailedEntry.JailEndBlock, stakeEntry.JailEndBlock)
 }
 
-func TestNotUnstakingProviderForUnresponsivenessWithMinProviders(t *testing.T) {
+func TestNotJailingProviderForUnresponsivenessWithMinProviders(t *testing.T) {
 	clientsCount := 1
 	providersCount := 2
 
@@ -339,9 +505,10 @@ func TestNotUnstakingProviderForUnresponsivenessWithMinProviders(t *testing.T) {
 
 	ts.AdvanceEpochs(largerConst)
 
-	// test the unresponsive provider1 has been unstaked
+	// test the unresponsive provider1 has not been jailed
 	_, unstakeStoragefound, _ := ts.Keepers.Epochstorage.UnstakeEntryByAddress(ts.Ctx, provider1_addr)
 	require.False(t, unstakeStoragefound)
-	_, stakeStorageFound, _ := ts.Keepers.Epochstorage.GetStakeEntryByAddressCurrent(ts.Ctx, ts.spec.Name, provider1_addr)
+	stakeEntry, stakeStorageFound := ts.Keepers.Epochstorage.GetStakeEntryByAddressCurrent(ts.Ctx, ts.spec.Name, provider1_addr)
 	require.True(t, stakeStorageFound)
+	require.False(t, stakeEntry.IsJailed())
 }
@@ -30,17 +30,17 @@ func (k Keeper) UnstakeEntry(ctx sdk.Context, chainID, creator, unstakeDescripti
 		)
 	}
 
-	existingEntry, entryExists, indexInStakeStorage := k.epochStorageKeeper.GetStakeEntryByAddressCurrent(ctx, chainID, senderAddr)
+	existingEntry, entryExists := k.epochStorageKeeper.GetStakeEntryByAddressCurrent(ctx, chainID, senderAddr)
 	if !entryExists {
 		return utils.LavaFormatWarning("can't unstake Entry, stake entry not found for address", fmt.Errorf("stake entry not found"),
 			utils.Attribute{Key: "provider", Value: creator},
 			utils.Attribute{Key: "spec", Value: chainID},
 		)
 	}
-	err = k.epochStorageKeeper.RemoveStakeEntryCurrent(ctx, chainID, indexInStakeStorage)
+	err = k.epochStorageKeeper.RemoveStakeEntryCurrent(ctx, chainID, senderAddr)
 	if err != nil {
 		return utils.LavaFormatWarning("can't remove stake Entry, stake entry not found in index", err,
-			utils.Attribute{Key: "index", Value: indexInStakeStorage},
+			utils.Attribute{Key: "provider", Value: creator},
 			utils.Attribute{Key: "spec", Value: chainID},
 		)
 	}
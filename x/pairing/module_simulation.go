@@ -53,6 +53,10 @@ const (
 	// TODO: Determine the simulation weight value
 	defaultWeightMsgUnfreeze int = 100
 
+	opWeightMsgUnjail = "op_weight_msg_unjail"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgUnjail int = 100
+
 	// this line is used by starport scaffolding # simapp/module/const
 )
 
@@ -181,6 +185,17 @@ func (am AppModule) WeightedOperations(simState module.SimulationState) []simtyp
 		pairingsimulation.SimulateMsgUnfreeze(am.accountKeeper, am.bankKeeper, am.keeper),
 	))
 
+	var weightMsgUnjail int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgUnjail, &weightMsgUnjail, nil,
+		func(_ *rand.Rand) {
+			weightMsgUnjail = defaultWeightMsgUnjail
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgUnjail,
+		pairingsimulation.SimulateMsgUnjail(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
 	// this line is used by starport scaffolding # simapp/module/operation
 
 	return operations
@@ -15,3 +15,27 @@ func (qos *QualityOfServiceReport) ComputeQoS() (sdk.Dec, error) {
 
 	return qos.Availability.Mul(qos.Sync).Mul(qos.Latency).ApproxRoot(3)
 }
+
+// ComputeQoSExcellence computes the score of a QoS excellence report. Unlike a QoS report, the latency and
+// sync of an excellence report are relative to the ones expected by the spec (1 is as expected, lower is
+// better), so they lower the score only when they are worse than expected.
+func (qos *QualityOfServiceReport) ComputeQoSExcellence() (sdk.Dec, error) {
+	if qos.Availability.IsNil() || qos.Latency.IsNil() || qos.Sync.IsNil() {
+		return sdk.ZeroDec(), fmt.Errorf("QoS excellence report is incomplete")
+	}
+	if qos.Availability.GT(sdk.OneDec()) || qos.Availability.IsNegative() ||
+		qos.Latency.IsNegative() || qos.Sync.IsNegative() {
+		return sdk.ZeroDec(), fmt.Errorf("QoS excellence availability is not between 0-1, or latency/sync is negative")
+	}
+
+	latency := sdk.OneDec()
+	if qos.Latency.GT(sdk.OneDec()) {
+		latency = sdk.OneDec().Quo(qos.Latency)
+	}
+	sync := sdk.OneDec()
+	if qos.Sync.GT(sdk.OneDec()) {
+		sync = sdk.OneDec().Quo(qos.Sync)
+	}
+
+	return qos.Availability.Mul(sync).Mul(latency).ApproxRoot(3)
+}
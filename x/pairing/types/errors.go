@@ -21,4 +21,8 @@ var (
 	DelegateCommissionOOBError                         = sdkerrors.New("DelegateCommissionOOBError Error", 694, "Delegation commission out of bound [0,100]")
 	DelegateLimitError                                 = sdkerrors.New("DelegateLimitError Error", 695, "Delegation limit coin is invalid")
 	ProviderRewardError                                = sdkerrors.New("ProviderRewardError Error", 696, "could not calculate provider reward with delegations")
+	UnjailStakeEntryNotFoundError                      = sdkerrors.New("UnjailStakeEntryNotFoundError Error", 697, "can't get stake entry to unjail")
+	UnjailProviderNotJailedError                       = sdkerrors.New("UnjailProviderNotJailedError Error", 698, "the provider is not jailed")
+	UnjailJailNotExpiredError                          = sdkerrors.New("UnjailJailNotExpiredError Error", 699, "the provider's jail has not expired yet")
+	DelegateCommissionIncreaseError                    = sdkerrors.New("DelegateCommissionIncreaseError Error", 700, "Delegation commission increase is above the maximum allowed")
 )
@@ -14,6 +14,8 @@ func DefaultGenesis() *GenesisState {
 		ProviderPaymentStorageList:             []ProviderPaymentStorage{},
 		EpochPaymentsList:                      []EpochPayments{},
 		BadgeUsedCuList:                        []BadgeUsedCu{},
+		SlashRecordList:                        []SlashRecord{},
+		ProviderEarningsList:                   []ProviderEarnings{},
 		// this line is used by starport scaffolding # genesis/types/default
 		Params: DefaultParams(),
 	}
@@ -58,6 +60,28 @@ func (gs GenesisState) Validate() error {
 		return fmt.Errorf("badgeUsedCuList is not empty")
 	}
 
+	// Check for duplicated index in slashRecord
+	slashRecordIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.SlashRecordList {
+		index := string(SlashRecordKey(elem.Provider, elem.ChainID, elem.Block, elem.Sequence))
+		if _, ok := slashRecordIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for slashRecord")
+		}
+		slashRecordIndexMap[index] = struct{}{}
+	}
+
+	// Check for duplicated index in providerEarnings
+	providerEarningsIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.ProviderEarningsList {
+		index := string(ProviderEarningsKey(elem.Provider, elem.ChainID, elem.Month))
+		if _, ok := providerEarningsIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for providerEarnings")
+		}
+		providerEarningsIndexMap[index] = struct{}{}
+	}
+
 	// this line is used by starport scaffolding # genesis/types/validate
 
 	return gs.Params.Validate()
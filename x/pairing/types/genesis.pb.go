@@ -85,6 +85,8 @@ type GenesisState struct {
 	BadgeUsedCuList                        []BadgeUsedCu                        `protobuf:"bytes,5,rep,name=badgeUsedCuList,proto3" json:"badgeUsedCuList"`
 	BadgesTS                               []types.RawMessage                   `protobuf:"bytes,6,rep,name=badgesTS,proto3" json:"badgesTS"`
 	ProviderQosFS                          types.GenesisState                   `protobuf:"bytes,7,opt,name=providerQosFS,proto3" json:"providerQosFS"`
+	SlashRecordList                        []SlashRecord                        `protobuf:"bytes,8,rep,name=slashRecordList,proto3" json:"slashRecordList"`
+	ProviderEarningsList                   []ProviderEarnings                   `protobuf:"bytes,9,rep,name=providerEarningsList,proto3" json:"providerEarningsList"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -169,6 +171,20 @@ func (m *GenesisState) GetProviderQosFS() types.GenesisState {
 	return types.GenesisState{}
 }
 
+func (m *GenesisState) GetSlashRecordList() []SlashRecord {
+	if m != nil {
+		return m.SlashRecordList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetProviderEarningsList() []ProviderEarnings {
+	if m != nil {
+		return m.ProviderEarningsList
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*BadgeUsedCu)(nil), "lavanet.lava.pairing.BadgeUsedCu")
 	proto.RegisterType((*GenesisState)(nil), "lavanet.lava.pairing.GenesisState")
@@ -179,39 +195,42 @@ func init() {
 }
 
 var fileDescriptor_dbd1e49b8b57595b = []byte{
-	// 498 bytes of a gzipped FileDescriptorProto
+	// 559 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0x4f, 0x6f, 0xd3, 0x30,
-	0x18, 0xc6, 0x9b, 0xad, 0xeb, 0x90, 0x3b, 0x40, 0xb3, 0x26, 0x11, 0x55, 0x28, 0x6b, 0x8b, 0xc4,
-	0x3a, 0x09, 0x25, 0xd2, 0x76, 0x41, 0xdc, 0xda, 0x69, 0x70, 0x80, 0x49, 0xfd, 0xc3, 0x84, 0xc4,
-	0x25, 0x72, 0xdb, 0x97, 0xcc, 0x62, 0x8d, 0x43, 0xec, 0x8c, 0xe5, 0x5b, 0x70, 0x81, 0xcf, 0xb4,
-	0xe3, 0x8e, 0x9c, 0x10, 0x6a, 0xbf, 0x08, 0xca, 0x6b, 0x77, 0x6b, 0x86, 0xf9, 0x73, 0x4a, 0xd3,
-	0x3c, 0xcf, 0xef, 0xb1, 0x1f, 0xbf, 0x26, 0xed, 0x73, 0x76, 0xc1, 0x62, 0x50, 0x41, 0xf1, 0x0c,
-	0x12, 0xc6, 0x53, 0x1e, 0x47, 0x41, 0x04, 0x31, 0x48, 0x2e, 0xfd, 0x24, 0x15, 0x4a, 0xd0, 0x1d,
-	0xa3, 0xf1, 0x8b, 0xa7, 0x6f, 0x34, 0x8d, 0x9d, 0x48, 0x44, 0x02, 0x05, 0x41, 0xf1, 0x4b, 0x6b,
-	0x1b, 0x2d, 0x2b, 0x2f, 0x61, 0x29, 0x9b, 0x19, 0x5c, 0xa3, 0x6b, 0x95, 0x64, 0x31, 0xff, 0x94,
-	0x41, 0x98, 0xb0, 0x7c, 0x06, 0xb1, 0x0a, 0xa5, 0x12, 0x29, 0x8b, 0x20, 0x9c, 0x9c, 0xf3, 0xe2,
-	0x35, 0x49, 0xc5, 0x05, 0x9f, 0x42, 0x6a, 0x10, 0x87, 0xf6, 0x14, 0x23, 0xba, 0x0b, 0x31, 0xa6,
-	0x7d, 0xab, 0x09, 0x12, 0x31, 0x39, 0x5b, 0x3a, 0x96, 0x4b, 0xdc, 0x2b, 0x49, 0x27, 0x62, 0x36,
-	0x13, 0x71, 0xf0, 0x81, 0x5f, 0x32, 0xc5, 0x45, 0x7c, 0x1c, 0xab, 0x34, 0xd7, 0xc2, 0xf6, 0x80,
-	0xd4, 0x7b, 0x6c, 0x1a, 0xc1, 0xa9, 0x84, 0xe9, 0x51, 0x46, 0xf7, 0xc9, 0xf6, 0xb8, 0x78, 0x0d,
-	0x33, 0x09, 0xd3, 0x70, 0x92, 0x85, 0x1f, 0x21, 0x77, 0x9d, 0xa6, 0xd3, 0xd9, 0x1a, 0x3e, 0x18,
-	0xdf, 0xea, 0x5e, 0x43, 0x4e, 0x1f, 0x91, 0x4d, 0x23, 0x72, 0xd7, 0x9a, 0x4e, 0xa7, 0x3a, 0xac,
-	0x65, 0xf8, 0xad, 0xfd, 0x75, 0x83, 0x6c, 0xbd, 0xd2, 0xfd, 0x8f, 0x14, 0x53, 0x40, 0x5f, 0x90,
-	0x9a, 0xee, 0x0f, 0x49, 0xf5, 0x83, 0xc7, 0xbe, 0xed, 0x3c, 0xfc, 0x3e, 0x6a, 0x7a, 0xd5, 0xab,
-	0x1f, 0xbb, 0x95, 0xa1, 0x71, 0xd0, 0x6f, 0x0e, 0x79, 0xaa, 0x9b, 0xed, 0xeb, 0x1d, 0x8e, 0x74,
-	0x25, 0x47, 0x58, 0x6b, 0xdf, 0x14, 0xf6, 0x86, 0x4b, 0xe5, 0xae, 0x35, 0xd7, 0x3b, 0xf5, 0x83,
-	0xe7, 0x76, 0xf8, 0xe9, 0x3f, 0x19, 0x26, 0xf8, 0x3f, 0xd3, 0x68, 0x4a, 0x1a, 0xcb, 0xe3, 0x2a,
-	0x6b, 0x71, 0x2d, 0xeb, 0xb8, 0x96, 0x67, 0x7f, 0xd8, 0xa8, 0xd5, 0x67, 0xf2, 0xff, 0x42, 0xa5,
-	0xef, 0xc8, 0x36, 0x9e, 0xb6, 0xf9, 0x24, 0x31, 0xaa, 0x8a, 0x51, 0x4f, 0xec, 0x51, 0xc7, 0xab,
-	0x72, 0x93, 0xf0, 0x3b, 0x83, 0x0e, 0xc8, 0xc3, 0x95, 0xd3, 0x45, 0xec, 0x06, 0x62, 0x5b, 0x76,
-	0xec, 0xca, 0xc8, 0x18, 0xe8, 0x5d, 0x3f, 0xed, 0x92, 0x7b, 0xf8, 0x97, 0x7c, 0x3b, 0x72, 0x6b,
-	0xc8, 0xda, 0x2d, 0xb3, 0xf4, 0x50, 0xfa, 0x43, 0xf6, 0xf9, 0x04, 0xa4, 0xbc, 0x2d, 0xe0, 0xc6,
-	0x46, 0x4f, 0xc8, 0xfd, 0x65, 0x19, 0x03, 0x21, 0x5f, 0x8e, 0xdc, 0x4d, 0x1c, 0x9f, 0x96, 0x95,
-	0xb3, 0x3a, 0x71, 0x86, 0x54, 0x76, 0xf7, 0xba, 0x57, 0x73, 0xcf, 0xb9, 0x9e, 0x7b, 0xce, 0xcf,
-	0xb9, 0xe7, 0x7c, 0x59, 0x78, 0x95, 0xeb, 0x85, 0x57, 0xf9, 0xbe, 0xf0, 0x2a, 0xef, 0xf7, 0x22,
-	0xae, 0xce, 0xb2, 0x71, 0x81, 0x0a, 0x4a, 0x17, 0xe7, 0xf2, 0xe6, 0x96, 0xa9, 0x3c, 0x01, 0x39,
-	0xae, 0xe1, 0xa5, 0x39, 0xfc, 0x15, 0x00, 0x00, 0xff, 0xff, 0x58, 0xde, 0xff, 0x8a, 0x75, 0x04,
-	0x00, 0x00,
+	0x18, 0xc6, 0x9b, 0x6d, 0x74, 0xc3, 0x1d, 0xa0, 0x59, 0x95, 0xa8, 0x2a, 0x94, 0x75, 0x45, 0xda,
+	0x3a, 0x69, 0x4a, 0xa4, 0xed, 0x82, 0xb8, 0xb5, 0x53, 0xe1, 0x00, 0x93, 0xfa, 0x87, 0x09, 0x89,
+	0x4b, 0x70, 0x5b, 0x93, 0x5a, 0xb4, 0x76, 0xb0, 0x9d, 0xb1, 0x7e, 0x0b, 0x4e, 0x7c, 0xa6, 0x89,
+	0xd3, 0x8e, 0x9c, 0x10, 0x6a, 0xbf, 0x08, 0x8a, 0xf3, 0x66, 0xfd, 0x33, 0x53, 0x38, 0xa5, 0x69,
+	0x9e, 0xe7, 0xf7, 0xf8, 0x7d, 0x5f, 0xbf, 0xa8, 0x3a, 0x22, 0x57, 0x84, 0x53, 0xed, 0x27, 0x4f,
+	0x3f, 0x22, 0x4c, 0x32, 0x1e, 0xfa, 0x21, 0xe5, 0x54, 0x31, 0xe5, 0x45, 0x52, 0x68, 0x81, 0x8b,
+	0xa0, 0xf1, 0x92, 0xa7, 0x07, 0x9a, 0x72, 0x31, 0x14, 0xa1, 0x30, 0x02, 0x3f, 0xf9, 0x95, 0x6a,
+	0xcb, 0x07, 0x56, 0x5e, 0x44, 0x24, 0x19, 0x03, 0xae, 0x5c, 0xb7, 0x4a, 0x62, 0xce, 0xbe, 0xc4,
+	0x34, 0x88, 0xc8, 0x64, 0x4c, 0xb9, 0x0e, 0x94, 0x16, 0x92, 0x84, 0x34, 0xe8, 0x8f, 0x58, 0xf2,
+	0x1a, 0x49, 0x71, 0xc5, 0x06, 0x54, 0x02, 0xe2, 0xcc, 0x9e, 0x02, 0xa2, 0x55, 0x08, 0x98, 0x8e,
+	0xad, 0x26, 0x1a, 0x89, 0xfe, 0x30, 0x73, 0x64, 0x47, 0x3c, 0x5a, 0x92, 0xf6, 0xc5, 0x78, 0x2c,
+	0xb8, 0xff, 0x89, 0x5d, 0x13, 0xcd, 0x04, 0x6f, 0x72, 0x2d, 0x27, 0x56, 0x61, 0xc6, 0x54, 0x23,
+	0xa2, 0x86, 0x81, 0xa4, 0x7d, 0x21, 0x07, 0x20, 0x3c, 0x59, 0x7f, 0x62, 0x4a, 0x24, 0x67, 0x3c,
+	0x84, 0xfc, 0x6a, 0x1b, 0x15, 0x1a, 0x64, 0x10, 0xd2, 0x4b, 0x45, 0x07, 0xe7, 0x31, 0x3e, 0x46,
+	0x7b, 0xbd, 0xe4, 0x35, 0x88, 0x15, 0x1d, 0x04, 0xfd, 0x38, 0xf8, 0x4c, 0x27, 0x25, 0xa7, 0xe2,
+	0xd4, 0x76, 0x3b, 0x8f, 0x7b, 0x73, 0xdd, 0x1b, 0x3a, 0xc1, 0x4f, 0xd1, 0x36, 0x88, 0x4a, 0x1b,
+	0x15, 0xa7, 0xb6, 0xd5, 0xc9, 0xc7, 0xe6, 0x5b, 0xf5, 0x47, 0x1e, 0xed, 0xbe, 0x4e, 0xc7, 0xda,
+	0xd5, 0x44, 0x53, 0xfc, 0x12, 0xe5, 0xd3, 0xb1, 0x18, 0x52, 0xe1, 0xf4, 0x99, 0x67, 0x1b, 0xb3,
+	0xd7, 0x32, 0x9a, 0xc6, 0xd6, 0xcd, 0xaf, 0xfd, 0x5c, 0x07, 0x1c, 0xf8, 0xbb, 0x83, 0x0e, 0xd3,
+	0x81, 0xb5, 0xd2, 0xc6, 0x75, 0xd3, 0x4e, 0x9f, 0x9b, 0x69, 0xb5, 0xa0, 0xaa, 0xb7, 0x4c, 0xe9,
+	0xd2, 0x46, 0x65, 0xb3, 0x56, 0x38, 0x7d, 0x61, 0x87, 0x5f, 0xfe, 0x93, 0x01, 0xc1, 0xff, 0x99,
+	0x86, 0x25, 0x2a, 0x67, 0x3d, 0x5d, 0xd6, 0x9a, 0xb3, 0x6c, 0x9a, 0xb3, 0x9c, 0xfc, 0xa5, 0x50,
+	0xab, 0x0f, 0xf2, 0xd7, 0x50, 0xf1, 0x7b, 0xb4, 0x67, 0x2e, 0x11, 0x7c, 0x52, 0x26, 0x6a, 0xcb,
+	0x44, 0x3d, 0xb7, 0x47, 0x35, 0x17, 0xe5, 0x90, 0x70, 0x9f, 0x81, 0xdb, 0xe8, 0xc9, 0xc2, 0x74,
+	0x0d, 0xf6, 0x81, 0xc1, 0x1e, 0xd8, 0xb1, 0x0b, 0x57, 0x06, 0xa0, 0xab, 0x7e, 0x5c, 0x47, 0x3b,
+	0xe6, 0x2f, 0xf5, 0xae, 0x5b, 0xca, 0x1b, 0xd6, 0xfe, 0x32, 0x2b, 0xbd, 0xeb, 0x5e, 0x87, 0x7c,
+	0xbd, 0xa0, 0x4a, 0xcd, 0x1b, 0x70, 0x67, 0xc3, 0x17, 0xe8, 0x51, 0xd6, 0x8c, 0xb6, 0x50, 0xaf,
+	0xba, 0xa5, 0xed, 0x8a, 0x73, 0xff, 0x4c, 0xc0, 0x59, 0xbc, 0x71, 0x40, 0x5a, 0x76, 0x27, 0x45,
+	0x9a, 0x75, 0xe9, 0x98, 0x6d, 0x31, 0x45, 0xee, 0xac, 0x2b, 0xb2, 0x3b, 0x17, 0x67, 0x45, 0xae,
+	0xf8, 0xf1, 0x47, 0x54, 0xcc, 0x32, 0x9a, 0xb0, 0x57, 0x86, 0xfb, 0xd0, 0x70, 0x0f, 0xd7, 0x8f,
+	0x3f, 0x73, 0x00, 0xdc, 0x4a, 0x6a, 0xd4, 0x6f, 0xa6, 0xae, 0x73, 0x3b, 0x75, 0x9d, 0xdf, 0x53,
+	0xd7, 0xf9, 0x36, 0x73, 0x73, 0xb7, 0x33, 0x37, 0xf7, 0x73, 0xe6, 0xe6, 0x3e, 0x1c, 0x85, 0x4c,
+	0x0f, 0xe3, 0x5e, 0x52, 0xbf, 0xbf, 0xb4, 0xf2, 0xd7, 0x77, 0x4b, 0xaf, 0x27, 0x11, 0x55, 0xbd,
+	0xbc, 0xd9, 0xf4, 0xb3, 0x3f, 0x03, 0x00, 0xea, 0xef, 0x41, 0x15, 0x81, 0x05, 0x00, 0x00,
 }
 
 func (m *BadgeUsedCu) Marshal() (dAtA []byte, err error) {
@@ -269,6 +288,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ProviderEarningsList) > 0 {
+		for iNdEx := len(m.ProviderEarningsList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProviderEarningsList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.SlashRecordList) > 0 {
+		for iNdEx := len(m.SlashRecordList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SlashRecordList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	{
 		size, err := m.ProviderQosFS.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -429,6 +476,18 @@ func (m *GenesisState) Size() (n int) {
 	}
 	l = m.ProviderQosFS.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.SlashRecordList) > 0 {
+		for _, e := range m.SlashRecordList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ProviderEarningsList) > 0 {
+		for _, e := range m.ProviderEarningsList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -806,6 +865,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashRecordList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashRecordList = append(m.SlashRecordList, SlashRecord{})
+			if err := m.SlashRecordList[len(m.SlashRecordList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderEarningsList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProviderEarningsList = append(m.ProviderEarningsList, ProviderEarnings{})
+			if err := m.ProviderEarningsList[len(m.ProviderEarningsList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -0,0 +1,32 @@
+package types
+
+import "time"
+
+const (
+	// ProviderEarningsKeyPrefix is the prefix to retrieve all ProviderEarnings
+	ProviderEarningsKeyPrefix = "ProviderEarnings/value/"
+
+	// ProviderEarningsMonthFormat is the (time) format of the month of ProviderEarnings
+	ProviderEarningsMonthFormat = "2006-01"
+)
+
+// ProviderEarningsMonth returns the month (of ProviderEarnings) of a block time
+func ProviderEarningsMonth(blockTime time.Time) string {
+	return blockTime.UTC().Format(ProviderEarningsMonthFormat)
+}
+
+// ProviderEarningsPrefix returns the store prefix of all the ProviderEarnings of a provider,
+// or of a provider on a specific chain (when chainID is not empty)
+func ProviderEarningsPrefix(provider, chainID string) []byte {
+	key := []byte(provider + "/")
+	if chainID != "" {
+		key = append(key, []byte(chainID+"/")...)
+	}
+	return key
+}
+
+// ProviderEarningsKey returns the store key to retrieve a ProviderEarnings from the index fields
+// (the month format is sortable so records are iterated in order)
+func ProviderEarningsKey(provider, chainID, month string) []byte {
+	return append(ProviderEarningsPrefix(provider, chainID), []byte(month)...)
+}
@@ -0,0 +1,27 @@
+package types
+
+import "encoding/binary"
+
+const (
+	// SlashRecordKeyPrefix is the prefix to retrieve all SlashRecord
+	SlashRecordKeyPrefix = "SlashRecord/value/"
+)
+
+// SlashRecordPrefix returns the store prefix of all the SlashRecord of a provider,
+// or of a provider on a specific chain (when chainID is not empty)
+func SlashRecordPrefix(provider, chainID string) []byte {
+	key := []byte(provider + "/")
+	if chainID != "" {
+		key = append(key, []byte(chainID+"/")...)
+	}
+	return key
+}
+
+// SlashRecordKey returns the store key to retrieve a SlashRecord from the index fields
+// (the block and sequence are big-endian so records are iterated in order)
+func SlashRecordKey(provider, chainID string, block, sequence uint64) []byte {
+	key := SlashRecordPrefix(provider, chainID)
+	key = binary.BigEndian.AppendUint64(key, block)
+	key = binary.BigEndian.AppendUint64(key, sequence)
+	return key
+}
@@ -0,0 +1,47 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	legacyerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgUnjail = "unjail"
+
+var _ sdk.Msg = &MsgUnjail{}
+
+func NewMsgUnjail(creator string, chainIds []string) *MsgUnjail {
+	return &MsgUnjail{
+		Creator:  creator,
+		ChainIds: chainIds,
+	}
+}
+
+func (msg *MsgUnjail) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgUnjail) Type() string {
+	return TypeMsgUnjail
+}
+
+func (msg *MsgUnjail) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgUnjail) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgUnjail) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(legacyerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	return nil
+}
@@ -0,0 +1,4962 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: lavanet/lava/pairing/provider_admin.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type AdminActiveSessionsRequest struct {
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Epoch   uint64 `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+}
+
+func (m *AdminActiveSessionsRequest) Reset()         { *m = AdminActiveSessionsRequest{} }
+func (m *AdminActiveSessionsRequest) String() string { return proto.CompactTextString(m) }
+func (*AdminActiveSessionsRequest) ProtoMessage()    {}
+func (*AdminActiveSessionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82f866941077df06, []int{0}
+}
+func (m *AdminActiveSessionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AdminActiveSessionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AdminActiveSessionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AdminActiveSessionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdminActiveSessionsRequest.Merge(m, src)
+}
+func (m *AdminActiveSessionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AdminActiveSessionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdminActiveSessionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AdminActiveSessionsRequest proto.InternalMessageInfo
+
+func (m *AdminActiveSessionsRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *AdminActiveSessionsRequest) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+type AdminProjectSessionsInfo struct {
+	ChainId       string   `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	ApiInterface  string   `protobuf:"bytes,2,opt,name=api_interface,json=apiInterface,proto3" json:"api_interface,omitempty"`
+	Epoch         uint64   `protobuf:"varint,3,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	ProjectId     string   `protobuf:"bytes,4,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Consumers     []string `protobuf:"bytes,5,rep,name=consumers,proto3" json:"consumers,omitempty"`
+	UsedCu        uint64   `protobuf:"varint,6,opt,name=used_cu,json=usedCu,proto3" json:"used_cu,omitempty"`
+	MaxCu         uint64   `protobuf:"varint,7,opt,name=max_cu,json=maxCu,proto3" json:"max_cu,omitempty"`
+	MissingCu     uint64   `protobuf:"varint,8,opt,name=missing_cu,json=missingCu,proto3" json:"missing_cu,omitempty"`
+	SessionsCount uint64   `protobuf:"varint,9,opt,name=sessions_count,json=sessionsCount,proto3" json:"sessions_count,omitempty"`
+	Blocked       bool     `protobuf:"varint,10,opt,name=blocked,proto3" json:"blocked,omitempty"`
+}
+
+func (m *AdminProjectSessionsInfo) Reset()         { *m = AdminProjectSessionsInfo{} }
+func (m *AdminProjectSessionsInfo) String() string { return proto.CompactTextString(m) }
+func (*AdminProjectSessionsInfo) ProtoMessage()    {}
+func (*AdminProjectSessionsInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82f866941077df06, []int{1}
+}
+func (m *AdminProjectSessionsInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AdminProjectSessionsInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AdminProjectSessionsInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AdminProjectSessionsInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdminProjectSessionsInfo.Merge(m, src)
+}
+func (m *AdminProjectSessionsInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *AdminProjectSessionsInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdminProjectSessionsInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AdminProjectSessionsInfo proto.InternalMessageInfo
+
+func (m *AdminProjectSessionsInfo) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *AdminProjectSessionsInfo) GetApiInterface() string {
+	if m != nil {
+		return m.ApiInterface
+	}
+	return ""
+}
+
+func (m *AdminProjectSessionsInfo) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *AdminProjectSessionsInfo) GetProjectId() string {
+	if m != nil {
+		return m.ProjectId
+	}
+	return ""
+}
+
+func (m *AdminProjectSessionsInfo) GetConsumers() []string {
+	if m != nil {
+		return m.Consumers
+	}
+	return nil
+}
+
+func (m *AdminProjectSessionsInfo) GetUsedCu() uint64 {
+	if m != nil {
+		return m.UsedCu
+	}
+	return 0
+}
+
+func (m *AdminProjectSessionsInfo) GetMaxCu() uint64 {
+	if m != nil {
+		return m.MaxCu
+	}
+	return 0
+}
+
+func (m *AdminProjectSessionsInfo) GetMissingCu() uint64 {
+	if m != nil {
+		return m.MissingCu
+	}
+	return 0
+}
+
+func (m *AdminProjectSessionsInfo) GetSessionsCount() uint64 {
+	if m != nil {
+		return m.SessionsCount
+	}
+	return 0
+}
+
+func (m *AdminProjectSessionsInfo) GetBlocked() bool {
+	if m != nil {
+		return m.Blocked
+	}
+	return false
+}
+
+type AdminActiveSessionsResponse struct {
+	Sessions []AdminProjectSessionsInfo `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions"`
+}
+
+func (m *AdminActiveSessionsResponse) Reset()         { *m = AdminActiveSessionsResponse{} }
+func (m *AdminActiveSessionsResponse) String() string { return proto.CompactTextString(m) }
+func (*AdminActiveSessionsResponse) ProtoMessage()    {}
+func (*AdminActiveSessionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82f866941077df06, []int{2}
+}
+func (m *AdminActiveSessionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AdminActiveSessionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AdminActiveSessionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AdminActiveSessionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdminActiveSessionsResponse.Merge(m, src)
+}
+func (m *AdminActiveSessionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *AdminActiveSessionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdminActiveSessionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AdminActiveSessionsResponse proto.InternalMessageInfo
+
+func (m *AdminActiveSessionsResponse) GetSessions() []AdminProjectSessionsInfo {
+	if m != nil {
+		return m.Sessions
+	}
+	return nil
+}
+
+type AdminActiveSubscriptionsRequest struct {
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+}
+
+func (m *AdminActiveSubscriptionsRequest) Reset()         { *m = AdminActiveSubscriptionsRequest{} }
+func (m *AdminActiveSubscriptionsRequest) String() string { return proto.CompactTextString(m) }
+func (*AdminActiveSubscriptionsRequest) ProtoMessage()    {}
+func (*AdminActiveSubscriptionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82f866941077df06, []int{3}
+}
+func (m *AdminActiveSubscriptionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AdminActiveSubscriptionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AdminActiveSubscriptionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AdminActiveSubscriptionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdminActiveSubscriptionsRequest.Merge(m, src)
+}
+func (m *AdminActiveSubscriptionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AdminActiveSubscriptionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdminActiveSubscriptionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AdminActiveSubscriptionsRequest proto.InternalMessageInfo
+
+func (m *AdminActiveSubscriptionsRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+type AdminSubscriptionInfo struct {
+	ChainId        string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	ApiInterface   string `protobuf:"bytes,2,opt,name=api_interface,json=apiInterface,proto3" json:"api_interface,omitempty"`
+	Epoch          uint64 `protobuf:"varint,3,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	ProjectId      string `protobuf:"bytes,4,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	SubscriptionId string `protobuf:"bytes,5,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+}
+
+func (m *AdminSubscriptionInfo) Reset()         { *m = AdminSubscriptionInfo{} }
+func (m *AdminSubscriptionInfo) String() string { return proto.CompactTextString(m) }
+func (*AdminSubscriptionInfo) ProtoMessage()    {}
+func (*AdminSubscriptionInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82f866941077df06, []int{4}
+}
+func (m *AdminSubscriptionInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AdminSubscriptionInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AdminSubscriptionInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AdminSubscriptionInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdminSubscriptionInfo.Merge(m, src)
+}
+func (m *AdminSubscriptionInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *AdminSubscriptionInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdminSubscriptionInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AdminSubscriptionInfo proto.InternalMessageInfo
+
+func (m *AdminSubscriptionInfo) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *AdminSubscriptionInfo) GetApiInterface() string {
+	if m != nil {
+		return m.ApiInterface
+	}
+	return ""
+}
+
+func (m *AdminSubscriptionInfo) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *AdminSubscriptionInfo) GetProjectId() string {
+	if m != nil {
+		return m.ProjectId
+	}
+	return ""
+}
+
+func (m *AdminSubscriptionInfo) GetSubscriptionId() string {
+	if m != nil {
+		return m.SubscriptionId
+	}
+	return ""
+}
+
+type AdminActiveSubscriptionsResponse struct {
+	Subscriptions []AdminSubscriptionInfo `protobuf:"bytes,1,rep,name=subscriptions,proto3" json:"subscriptions"`
+}
+
+func (m *AdminActiveSubscriptionsResponse) Reset()         { *m = AdminActiveSubscriptionsResponse{} }
+func (m *AdminActiveSubscriptionsResponse) String() string { return proto.CompactTextString(m) }
+func (*AdminActiveSubscriptionsResponse) ProtoMessage()    {}
+func (*AdminActiveSubscriptionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82f866941077df06, []int{5}
+}
+func (m *AdminActiveSubscriptionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AdminActiveSubscriptionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AdminActiveSubscriptionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AdminActiveSubscriptionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdminActiveSubscriptionsResponse.Merge(m, src)
+}
+func (m *AdminActiveSubscriptionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *AdminActiveSubscriptionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdminActiveSubscriptionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AdminActiveSubscriptionsResponse proto.InternalMessageInfo
+
+func (m *AdminActiveSubscriptionsResponse) GetSubscriptions() []AdminSubscriptionInfo {
+	if m != nil {
+		return m.Subscriptions
+	}
+	return nil
+}
+
+type AdminPendingRewardsRequest struct {
+}
+
+func (m *AdminPendingRewardsRequest) Reset()         { *m = AdminPendingRewardsRequest{} }
+func (m *AdminPendingRewardsRequest) String() string { return proto.CompactTextString(m) }
+func (*AdminPendingRewardsRequest) ProtoMessage()    {}
+func (*AdminPendingRewardsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82f866941077df06, []int{6}
+}
+func (m *AdminPendingRewardsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AdminPendingRewardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AdminPendingRewardsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AdminPendingRewardsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdminPendingRewardsRequest.Merge(m, src)
+}
+func (m *AdminPendingRewardsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AdminPendingRewardsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdminPendingRewardsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AdminPendingRewardsRequest proto.InternalMessageInfo
+
+type AdminPendingProof struct {
+	Epoch    uint64        `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Consumer string        `protobuf:"bytes,2,opt,name=consumer,proto3" json:"consumer,omitempty"`
+	Proof    *RelaySession `protobuf:"bytes,3,opt,name=proof,proto3" json:"proof,omitempty"`
+}
+
+func (m *AdminPendingProof) Reset()         { *m = AdminPendingProof{} }
+func (m *AdminPendingProof) String() string { return proto.CompactTextString(m) }
+func (*AdminPendingProof) ProtoMessage()    {}
+func (*AdminPendingProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82f866941077df06, []int{7}
+}
+func (m *AdminPendingProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AdminPendingProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AdminPendingProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AdminPendingProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdminPendingProof.Merge(m, src)
+}
+func (m *AdminPendingProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *AdminPendingProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdminPendingProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AdminPendingProof proto.InternalMessageInfo
+
+func (m *AdminPendingProof) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *AdminPendingProof) GetConsumer() string {
+	if m != nil {
+		return m.Consumer
+	}
+	return ""
+}
+
+func (m *AdminPendingProof) GetProof() *RelaySession {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+type AdminExpectedPayment struct {
+	ChainId             string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Consumer            string `protobuf:"bytes,2,opt,name=consumer,proto3" json:"consumer,omitempty"`
+	Cu                  uint64 `protobuf:"varint,3,opt,name=cu,proto3" json:"cu,omitempty"`
+	BlockHeightDeadline int64  `protobuf:"varint,4,opt,name=block_height_deadline,json=blockHeightDeadline,proto3" json:"block_height_deadline,omitempty"`
+	UniqueIdentifier    uint64 `protobuf:"varint,5,opt,name=unique_identifier,json=uniqueIdentifier,proto3" json:"unique_identifier,omitempty"`
+}
+
+func (m *AdminExpectedPayment) Reset()         { *m = AdminExpectedPayment{} }
+func (m *AdminExpectedPayment) String() string { return proto.CompactTextString(m) }
+func (*AdminExpectedPayment) ProtoMessage()    {}
+func (*AdminExpectedPayment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82f866941077df06, []int{8}
+}
+func (m *AdminExpectedPayment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AdminExpectedPayment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AdminExpectedPayment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AdminExpectedPayment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdminExpectedPayment.Merge(m, src)
+}
+func (m *AdminExpectedPayment) XXX_Size() int {
+	return m.Size()
+}
+func (m *AdminExpectedPayment) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdminExpectedPayment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AdminExpectedPayment proto.InternalMessageInfo
+
+func (m *AdminExpectedPayment) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *AdminExpectedPayment) GetConsumer() string {
+	if m != nil {
+		return m.Consumer
+	}
+	return ""
+}
+
+func (m *AdminExpectedPayment) GetCu() uint64 {
+	if m != nil {
+		return m.Cu
+	}
+	return 0
+}
+
+func (m *AdminExpectedPayment) GetBlockHeightDeadline() int64 {
+	if m != nil {
+		return m.BlockHeightDeadline
+	}
+	return 0
+}
+
+func (m *AdminExpectedPayment) GetUniqueIdentifier() uint64 {
+	if m != nil {
+		return m.UniqueIdentifier
+	}
+	return 0
+}
+
+type AdminPendingRewardsResponse struct {
+	PendingProofs    []AdminPendingProof    `protobuf:"bytes,1,rep,name=pending_proofs,json=pendingProofs,proto3" json:"pending_proofs"`
+	ExpectedPayments []AdminExpectedPayment `protobuf:"bytes,2,rep,name=expected_payments,json=expectedPayments,proto3" json:"expected_payments"`
+	TotalCuServiced  uint64                 `protobuf:"varint,3,opt,name=total_cu_serviced,json=totalCuServiced,proto3" json:"total_cu_serviced,omitempty"`
+	TotalCuPaid      uint64                 `protobuf:"varint,4,opt,name=total_cu_paid,json=totalCuPaid,proto3" json:"total_cu_paid,omitempty"`
+}
+
+func (m *AdminPendingRewardsResponse) Reset()         { *m = AdminPendingRewardsResponse{} }
+func (m *AdminPendingRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*AdminPendingRewardsResponse) ProtoMessage()    {}
+func (*AdminPendingRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82f866941077df06, []int{9}
+}
+func (m *AdminPendingRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AdminPendingRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AdminPendingRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AdminPendingRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdminPendingRewardsResponse.Merge(m, src)
+}
+func (m *AdminPendingRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *AdminPendingRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdminPendingRewardsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AdminPendingRewardsResponse proto.InternalMessageInfo
+
+func (m *AdminPendingRewardsResponse) GetPendingProofs() []AdminPendingProof {
+	if m != nil {
+		return m.PendingProofs
+	}
+	return nil
+}
+
+func (m *AdminPendingRewardsResponse) GetExpectedPayments() []AdminExpectedPayment {
+	if m != nil {
+		return m.ExpectedPayments
+	}
+	return nil
+}
+
+func (m *AdminPendingRewardsResponse) GetTotalCuServiced() uint64 {
+	if m != nil {
+		return m.TotalCuServiced
+	}
+	return 0
+}
+
+func (m *AdminPendingRewardsResponse) GetTotalCuPaid() uint64 {
+	if m != nil {
+		return m.TotalCuPaid
+	}
+	return 0
+}
+
+type AdminClaimRewardsRequest struct {
+}
+
+func (m *AdminClaimRewardsRequest) Reset()         { *m = AdminClaimRewardsRequest{} }
+func (m *AdminClaimRewardsRequest) String() string { return proto.CompactTextString(m) }
+func (*AdminClaimRewardsRequest) ProtoMessage()    {}
+func (*AdminClaimRewardsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82f866941077df06, []int{10}
+}
+func (m *AdminClaimRewardsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AdminClaimRewardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AdminClaimRewardsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AdminClaimRewardsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdminClaimRewardsRequest.Merge(m, src)
+}
+func (m *AdminClaimRewardsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AdminClaimRewardsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdminClaimRewardsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AdminClaimRewardsRequest proto.InternalMessageInfo
+
+type AdminClaimRewardsResponse struct {
+	ClaimedProofs uint64 `protobuf:"varint,1,opt,name=claimed_proofs,json=claimedProofs,proto3" json:"claimed_proofs,omitempty"`
+	ClaimedCu     uint64 `protobuf:"varint,2,opt,name=claimed_cu,json=claimedCu,proto3" json:"claimed_cu,omitempty"`
+}
+
+func (m *AdminClaimRewardsResponse) Reset()         { *m = AdminClaimRewardsResponse{} }
+func (m *AdminClaimRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*AdminClaimRewardsResponse) ProtoMessage()    {}
+func (*AdminClaimRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82f866941077df06, []int{11}
+}
+func (m *AdminClaimRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AdminClaimRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AdminClaimRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AdminClaimRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdminClaimRewardsResponse.Merge(m, src)
+}
+func (m *AdminClaimRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *AdminClaimRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdminClaimRewardsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AdminClaimRewardsResponse proto.InternalMessageInfo
+
+func (m *AdminClaimRewardsResponse) GetClaimedProofs() uint64 {
+	if m != nil {
+		return m.ClaimedProofs
+	}
+	return 0
+}
+
+func (m *AdminClaimRewardsResponse) GetClaimedCu() uint64 {
+	if m != nil {
+		return m.ClaimedCu
+	}
+	return 0
+}
+
+type AdminBlockConsumerRequest struct {
+	Consumer string `protobuf:"bytes,1,opt,name=consumer,proto3" json:"consumer,omitempty"`
+	Unblock  bool   `protobuf:"varint,2,opt,name=unblock,proto3" json:"unblock,omitempty"`
+}
+
+func (m *AdminBlockConsumerRequest) Reset()         { *m = AdminBlockConsumerRequest{} }
+func (m *AdminBlockConsumerRequest) String() string { return proto.CompactTextString(m) }
+func (*AdminBlockConsumerRequest) ProtoMessage()    {}
+func (*AdminBlockConsumerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82f866941077df06, []int{12}
+}
+func (m *AdminBlockConsumerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AdminBlockConsumerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AdminBlockConsumerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AdminBlockConsumerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdminBlockConsumerRequest.Merge(m, src)
+}
+func (m *AdminBlockConsumerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AdminBlockConsumerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdminBlockConsumerRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AdminBlockConsumerRequest proto.InternalMessageInfo
+
+func (m *AdminBlockConsumerRequest) GetConsumer() string {
+	if m != nil {
+		return m.Consumer
+	}
+	return ""
+}
+
+func (m *AdminBlockConsumerRequest) GetUnblock() bool {
+	if m != nil {
+		return m.Unblock
+	}
+	return false
+}
+
+type AdminBlockConsumerResponse struct {
+	BlockedConsumers []string `protobuf:"bytes,1,rep,name=blocked_consumers,json=blockedConsumers,proto3" json:"blocked_consumers,omitempty"`
+}
+
+func (m *AdminBlockConsumerResponse) Reset()         { *m = AdminBlockConsumerResponse{} }
+func (m *AdminBlockConsumerResponse) String() string { return proto.CompactTextString(m) }
+func (*AdminBlockConsumerResponse) ProtoMessage()    {}
+func (*AdminBlockConsumerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82f866941077df06, []int{13}
+}
+func (m *AdminBlockConsumerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AdminBlockConsumerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AdminBlockConsumerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AdminBlockConsumerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdminBlockConsumerResponse.Merge(m, src)
+}
+func (m *AdminBlockConsumerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *AdminBlockConsumerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdminBlockConsumerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AdminBlockConsumerResponse proto.InternalMessageInfo
+
+func (m *AdminBlockConsumerResponse) GetBlockedConsumers() []string {
+	if m != nil {
+		return m.BlockedConsumers
+	}
+	return nil
+}
+
+type AdminReloadConfigRequest struct {
+}
+
+func (m *AdminReloadConfigRequest) Reset()         { *m = AdminReloadConfigRequest{} }
+func (m *AdminReloadConfigRequest) String() string { return proto.CompactTextString(m) }
+func (*AdminReloadConfigRequest) ProtoMessage()    {}
+func (*AdminReloadConfigRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82f866941077df06, []int{14}
+}
+func (m *AdminReloadConfigRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AdminReloadConfigRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AdminReloadConfigRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AdminReloadConfigRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdminReloadConfigRequest.Merge(m, src)
+}
+func (m *AdminReloadConfigRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AdminReloadConfigRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdminReloadConfigRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AdminReloadConfigRequest proto.InternalMessageInfo
+
+type AdminReloadConfigResponse struct {
+	Added   []string `protobuf:"bytes,1,rep,name=added,proto3" json:"added,omitempty"`
+	Updated []string `protobuf:"bytes,2,rep,name=updated,proto3" json:"updated,omitempty"`
+	Removed []string `protobuf:"bytes,3,rep,name=removed,proto3" json:"removed,omitempty"`
+	Failed  []string `protobuf:"bytes,4,rep,name=failed,proto3" json:"failed,omitempty"`
+}
+
+func (m *AdminReloadConfigResponse) Reset()         { *m = AdminReloadConfigResponse{} }
+func (m *AdminReloadConfigResponse) String() string { return proto.CompactTextString(m) }
+func (*AdminReloadConfigResponse) ProtoMessage()    {}
+func (*AdminReloadConfigResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82f866941077df06, []int{15}
+}
+func (m *AdminReloadConfigResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AdminReloadConfigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AdminReloadConfigResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AdminReloadConfigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdminReloadConfigResponse.Merge(m, src)
+}
+func (m *AdminReloadConfigResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *AdminReloadConfigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdminReloadConfigResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AdminReloadConfigResponse proto.InternalMessageInfo
+
+func (m *AdminReloadConfigResponse) GetAdded() []string {
+	if m != nil {
+		return m.Added
+	}
+	return nil
+}
+
+func (m *AdminReloadConfigResponse) GetUpdated() []string {
+	if m != nil {
+		return m.Updated
+	}
+	return nil
+}
+
+func (m *AdminReloadConfigResponse) GetRemoved() []string {
+	if m != nil {
+		return m.Removed
+	}
+	return nil
+}
+
+func (m *AdminReloadConfigResponse) GetFailed() []string {
+	if m != nil {
+		return m.Failed
+	}
+	return nil
+}
+
+type AdminStartMaintenanceRequest struct {
+	ChainIds []string `protobuf:"bytes,1,rep,name=chain_ids,json=chainIds,proto3" json:"chain_ids,omitempty"`
+	Reason   string   `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *AdminStartMaintenanceRequest) Reset()         { *m = AdminStartMaintenanceRequest{} }
+func (m *AdminStartMaintenanceRequest) String() string { return proto.CompactTextString(m) }
+func (*AdminStartMaintenanceRequest) ProtoMessage()    {}
+func (*AdminStartMaintenanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82f866941077df06, []int{16}
+}
+func (m *AdminStartMaintenanceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AdminStartMaintenanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AdminStartMaintenanceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AdminStartMaintenanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdminStartMaintenanceRequest.Merge(m, src)
+}
+func (m *AdminStartMaintenanceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AdminStartMaintenanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdminStartMaintenanceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AdminStartMaintenanceRequest proto.InternalMessageInfo
+
+func (m *AdminStartMaintenanceRequest) GetChainIds() []string {
+	if m != nil {
+		return m.ChainIds
+	}
+	return nil
+}
+
+func (m *AdminStartMaintenanceRequest) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+type AdminStopMaintenanceRequest struct {
+}
+
+func (m *AdminStopMaintenanceRequest) Reset()         { *m = AdminStopMaintenanceRequest{} }
+func (m *AdminStopMaintenanceRequest) String() string { return proto.CompactTextString(m) }
+func (*AdminStopMaintenanceRequest) ProtoMessage()    {}
+func (*AdminStopMaintenanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82f866941077df06, []int{17}
+}
+func (m *AdminStopMaintenanceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AdminStopMaintenanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AdminStopMaintenanceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AdminStopMaintenanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdminStopMaintenanceRequest.Merge(m, src)
+}
+func (m *AdminStopMaintenanceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AdminStopMaintenanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdminStopMaintenanceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AdminStopMaintenanceRequest proto.InternalMessageInfo
+
+type AdminMaintenanceResponse struct {
+	State       string   `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	ChainIds    []string `protobuf:"bytes,2,rep,name=chain_ids,json=chainIds,proto3" json:"chain_ids,omitempty"`
+	FreezeEpoch uint64   `protobuf:"varint,3,opt,name=freeze_epoch,json=freezeEpoch,proto3" json:"freeze_epoch,omitempty"`
+}
+
+func (m *AdminMaintenanceResponse) Reset()         { *m = AdminMaintenanceResponse{} }
+func (m *AdminMaintenanceResponse) String() string { return proto.CompactTextString(m) }
+func (*AdminMaintenanceResponse) ProtoMessage()    {}
+func (*AdminMaintenanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82f866941077df06, []int{18}
+}
+func (m *AdminMaintenanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AdminMaintenanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AdminMaintenanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AdminMaintenanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdminMaintenanceResponse.Merge(m, src)
+}
+func (m *AdminMaintenanceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *AdminMaintenanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdminMaintenanceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AdminMaintenanceResponse proto.InternalMessageInfo
+
+func (m *AdminMaintenanceResponse) GetState() string {
+	if m != nil {
+		return m.State
+	}
+	return ""
+}
+
+func (m *AdminMaintenanceResponse) GetChainIds() []string {
+	if m != nil {
+		return m.ChainIds
+	}
+	return nil
+}
+
+func (m *AdminMaintenanceResponse) GetFreezeEpoch() uint64 {
+	if m != nil {
+		return m.FreezeEpoch
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*AdminActiveSessionsRequest)(nil), "lavanet.lava.pairing.AdminActiveSessionsRequest")
+	proto.RegisterType((*AdminProjectSessionsInfo)(nil), "lavanet.lava.pairing.AdminProjectSessionsInfo")
+	proto.RegisterType((*AdminActiveSessionsResponse)(nil), "lavanet.lava.pairing.AdminActiveSessionsResponse")
+	proto.RegisterType((*AdminActiveSubscriptionsRequest)(nil), "lavanet.lava.pairing.AdminActiveSubscriptionsRequest")
+	proto.RegisterType((*AdminSubscriptionInfo)(nil), "lavanet.lava.pairing.AdminSubscriptionInfo")
+	proto.RegisterType((*AdminActiveSubscriptionsResponse)(nil), "lavanet.lava.pairing.AdminActiveSubscriptionsResponse")
+	proto.RegisterType((*AdminPendingRewardsRequest)(nil), "lavanet.lava.pairing.AdminPendingRewardsRequest")
+	proto.RegisterType((*AdminPendingProof)(nil), "lavanet.lava.pairing.AdminPendingProof")
+	proto.RegisterType((*AdminExpectedPayment)(nil), "lavanet.lava.pairing.AdminExpectedPayment")
+	proto.RegisterType((*AdminPendingRewardsResponse)(nil), "lavanet.lava.pairing.AdminPendingRewardsResponse")
+	proto.RegisterType((*AdminClaimRewardsRequest)(nil), "lavanet.lava.pairing.AdminClaimRewardsRequest")
+	proto.RegisterType((*AdminClaimRewardsResponse)(nil), "lavanet.lava.pairing.AdminClaimRewardsResponse")
+	proto.RegisterType((*AdminBlockConsumerRequest)(nil), "lavanet.lava.pairing.AdminBlockConsumerRequest")
+	proto.RegisterType((*AdminBlockConsumerResponse)(nil), "lavanet.lava.pairing.AdminBlockConsumerResponse")
+	proto.RegisterType((*AdminReloadConfigRequest)(nil), "lavanet.lava.pairing.AdminReloadConfigRequest")
+	proto.RegisterType((*AdminReloadConfigResponse)(nil), "lavanet.lava.pairing.AdminReloadConfigResponse")
+	proto.RegisterType((*AdminStartMaintenanceRequest)(nil), "lavanet.lava.pairing.AdminStartMaintenanceRequest")
+	proto.RegisterType((*AdminStopMaintenanceRequest)(nil), "lavanet.lava.pairing.AdminStopMaintenanceRequest")
+	proto.RegisterType((*AdminMaintenanceResponse)(nil), "lavanet.lava.pairing.AdminMaintenanceResponse")
+}
+
+func init() {
+	proto.RegisterFile("lavanet/lava/pairing/provider_admin.proto", fileDescriptor_82f866941077df06)
+}
+
+var fileDescriptor_82f866941077df06 = []byte{
+	// 1147 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0x4d, 0x6f, 0xe3, 0x44,
+	0x18, 0x8e, 0xd3, 0x8f, 0x24, 0x6f, 0x37, 0x69, 0x3b, 0xdb, 0x42, 0xea, 0xb6, 0xd9, 0x60, 0xb4,
+	0x6a, 0xd9, 0x4a, 0xc9, 0xb6, 0x08, 0xc4, 0x81, 0x4b, 0x1b, 0x56, 0x22, 0x87, 0x95, 0x82, 0x8b,
+	0x84, 0x84, 0x84, 0xac, 0xa9, 0x3d, 0x49, 0x07, 0x12, 0x8f, 0xeb, 0x19, 0xa7, 0x2d, 0x08, 0x71,
+	0xe3, 0xcc, 0x6f, 0xe0, 0x3f, 0x70, 0x84, 0xf3, 0x1e, 0xf7, 0xb8, 0x27, 0x84, 0xda, 0x3f, 0x82,
+	0x66, 0x3c, 0x76, 0x9d, 0xe0, 0x35, 0xad, 0x84, 0xc4, 0xa9, 0x7d, 0xbf, 0x9e, 0x77, 0xe6, 0x79,
+	0x3f, 0xc6, 0x81, 0x0f, 0xc6, 0x78, 0x8a, 0x7d, 0x22, 0xba, 0xf2, 0x6f, 0x37, 0xc0, 0x34, 0xa4,
+	0xfe, 0xa8, 0x1b, 0x84, 0x6c, 0x4a, 0x3d, 0x12, 0x3a, 0xd8, 0x9b, 0x50, 0xbf, 0x13, 0x84, 0x4c,
+	0x30, 0xb4, 0xa1, 0x5d, 0x3b, 0xf2, 0x6f, 0x47, 0xbb, 0x9a, 0x1b, 0x23, 0x36, 0x62, 0xca, 0xa1,
+	0x2b, 0xff, 0x8b, 0x7d, 0xcd, 0x76, 0x2e, 0x6c, 0x48, 0xc6, 0xf8, 0x3a, 0xf6, 0xb0, 0x5e, 0x82,
+	0x79, 0x2c, 0xc1, 0x8f, 0x5d, 0x41, 0xa7, 0xe4, 0x94, 0x70, 0x4e, 0x99, 0xcf, 0x6d, 0x72, 0x11,
+	0x11, 0x2e, 0xd0, 0x16, 0x54, 0xdd, 0x73, 0x4c, 0x7d, 0x87, 0x7a, 0x4d, 0xa3, 0x6d, 0xec, 0xd7,
+	0xec, 0x8a, 0x92, 0xfb, 0x1e, 0xda, 0x80, 0x25, 0x12, 0x30, 0xf7, 0xbc, 0x59, 0x6e, 0x1b, 0xfb,
+	0x8b, 0x76, 0x2c, 0x58, 0xbf, 0x97, 0xa1, 0xa9, 0xf0, 0x06, 0x21, 0xfb, 0x96, 0xb8, 0x22, 0x01,
+	0xec, 0xfb, 0x43, 0x56, 0x84, 0xf6, 0x3e, 0xd4, 0x71, 0x40, 0x1d, 0xea, 0x0b, 0x12, 0x0e, 0xb1,
+	0x4b, 0x14, 0x6a, 0xcd, 0x7e, 0x84, 0x03, 0xda, 0x4f, 0x74, 0x77, 0x29, 0x17, 0x32, 0x29, 0xd1,
+	0x2e, 0x40, 0x10, 0x27, 0x93, 0xb8, 0x8b, 0x2a, 0xae, 0xa6, 0x35, 0x7d, 0x0f, 0xed, 0x40, 0xcd,
+	0x65, 0x3e, 0x8f, 0x26, 0x24, 0xe4, 0xcd, 0xa5, 0xf6, 0x82, 0xb4, 0xa6, 0x0a, 0xf4, 0x2e, 0x54,
+	0x22, 0x4e, 0x3c, 0xc7, 0x8d, 0x9a, 0xcb, 0x0a, 0x74, 0x59, 0x8a, 0xbd, 0x08, 0x6d, 0xc2, 0xf2,
+	0x04, 0x5f, 0x49, 0x7d, 0x25, 0x4e, 0x36, 0xc1, 0x57, 0xbd, 0x48, 0x26, 0x9b, 0x50, 0xce, 0xa9,
+	0x3f, 0x92, 0xa6, 0xaa, 0x32, 0xd5, 0xb4, 0xa6, 0x17, 0xa1, 0xa7, 0xd0, 0xe0, 0xfa, 0xc6, 0x8e,
+	0xcb, 0x22, 0x5f, 0x34, 0x6b, 0xca, 0xa5, 0x9e, 0x68, 0x7b, 0x52, 0x89, 0x9a, 0x50, 0x39, 0x1b,
+	0x33, 0xf7, 0x3b, 0xe2, 0x35, 0xa1, 0x6d, 0xec, 0x57, 0xed, 0x44, 0xb4, 0x18, 0x6c, 0xe7, 0x96,
+	0x83, 0x07, 0xcc, 0xe7, 0x04, 0x0d, 0xa0, 0x9a, 0x20, 0x35, 0x8d, 0xf6, 0xc2, 0xfe, 0xca, 0x51,
+	0xa7, 0x93, 0xd7, 0x0e, 0x9d, 0xb7, 0xd5, 0xe0, 0x64, 0xf1, 0xd5, 0x9f, 0x4f, 0x4a, 0x76, 0x8a,
+	0x62, 0x7d, 0x0a, 0x4f, 0xb2, 0x09, 0xa3, 0x33, 0xee, 0x86, 0x34, 0x10, 0xf7, 0x6b, 0x02, 0xeb,
+	0x37, 0x03, 0x36, 0x55, 0x78, 0x36, 0xf0, 0xff, 0xab, 0xf5, 0x1e, 0xac, 0xf2, 0xcc, 0x41, 0xa4,
+	0xcf, 0x92, 0xf2, 0x69, 0x64, 0xd5, 0x7d, 0xcf, 0xfa, 0x01, 0xda, 0x6f, 0xbf, 0xb5, 0xe6, 0xfa,
+	0x2b, 0xa8, 0x67, 0xa3, 0x12, 0xc2, 0x0f, 0x0a, 0x08, 0x9f, 0x67, 0x41, 0xb3, 0x3d, 0x8b, 0x63,
+	0xed, 0xe8, 0x91, 0x1b, 0x10, 0xdf, 0xa3, 0xfe, 0xc8, 0x26, 0x97, 0x38, 0xf4, 0x12, 0xb6, 0xad,
+	0x9f, 0x60, 0x3d, 0x6b, 0x1d, 0x84, 0x8c, 0x0d, 0xef, 0xd8, 0x30, 0xb2, 0x6c, 0x98, 0x50, 0x4d,
+	0x3a, 0x59, 0x73, 0x98, 0xca, 0xe8, 0x13, 0x58, 0x0a, 0x64, 0xa8, 0xe2, 0x6f, 0xe5, 0xc8, 0xca,
+	0x3f, 0xb5, 0x2d, 0x37, 0x81, 0xee, 0x0f, 0x3b, 0x0e, 0xb0, 0xfe, 0x30, 0x60, 0x43, 0x9d, 0xe0,
+	0xc5, 0x55, 0x40, 0x5c, 0x41, 0xbc, 0x01, 0xbe, 0x9e, 0x10, 0xbf, 0x70, 0x19, 0x14, 0x9d, 0xa4,
+	0x01, 0x65, 0x37, 0xd2, 0x65, 0x2c, 0xbb, 0x11, 0x3a, 0x82, 0x4d, 0xd5, 0xed, 0xce, 0x39, 0xa1,
+	0xa3, 0x73, 0xe1, 0x78, 0x04, 0x7b, 0x63, 0xea, 0x13, 0x55, 0xce, 0x05, 0xfb, 0xb1, 0x32, 0x7e,
+	0xae, 0x6c, 0x9f, 0x69, 0x13, 0x3a, 0x80, 0xf5, 0xc8, 0xa7, 0x17, 0x11, 0x71, 0xa8, 0x47, 0x7c,
+	0x41, 0x87, 0x94, 0x84, 0xaa, 0xb4, 0x8b, 0xf6, 0x5a, 0x6c, 0xe8, 0xa7, 0x7a, 0xeb, 0xd7, 0x32,
+	0x6c, 0x67, 0x29, 0x4c, 0x09, 0xd6, 0x85, 0xfd, 0x12, 0x1a, 0x41, 0x6c, 0x71, 0xd4, 0x8d, 0x93,
+	0xca, 0xee, 0x15, 0x8d, 0x52, 0xa6, 0x1a, 0x49, 0x55, 0x83, 0x8c, 0x8e, 0xa3, 0x6f, 0x60, 0x9d,
+	0x68, 0xc2, 0x9c, 0x20, 0x66, 0x8c, 0x37, 0xcb, 0x0a, 0xf8, 0x59, 0x01, 0xf0, 0x1c, 0xc9, 0x1a,
+	0x7b, 0x8d, 0xcc, 0xaa, 0x39, 0x7a, 0x06, 0xeb, 0x82, 0x09, 0x3c, 0x76, 0xdc, 0xc8, 0xe1, 0x24,
+	0x9c, 0x52, 0x97, 0x78, 0x9a, 0xd4, 0x55, 0x65, 0xe8, 0x45, 0xa7, 0x5a, 0x8d, 0x2c, 0xa8, 0xa7,
+	0xbe, 0x01, 0xd6, 0x83, 0xb2, 0x68, 0xaf, 0x68, 0xbf, 0x01, 0xa6, 0x9e, 0x65, 0xea, 0x3d, 0xdd,
+	0x1b, 0x63, 0x3a, 0x99, 0x6b, 0x41, 0x0c, 0x5b, 0x39, 0x36, 0xcd, 0xde, 0x53, 0x68, 0xb8, 0x52,
+	0x2f, 0xaf, 0x99, 0xb0, 0xa7, 0x56, 0x9c, 0xd6, 0x6a, 0x3a, 0x76, 0x01, 0x12, 0x37, 0x37, 0xd2,
+	0x6f, 0x44, 0x4d, 0x6b, 0x7a, 0x91, 0xf5, 0x85, 0x4e, 0x71, 0x22, 0x8b, 0xdd, 0xd3, 0xad, 0x92,
+	0x2c, 0x9c, 0x6c, 0x37, 0x19, 0x73, 0xdd, 0xd4, 0x84, 0x4a, 0xe4, 0xab, 0x16, 0x51, 0xa0, 0x55,
+	0x3b, 0x11, 0xad, 0x3e, 0x98, 0x79, 0x90, 0xfa, 0xd8, 0x07, 0xb0, 0xae, 0x77, 0xac, 0x73, 0xf7,
+	0x1c, 0x18, 0xea, 0x39, 0x58, 0xd3, 0x86, 0x24, 0x86, 0xa7, 0xe4, 0xd8, 0x64, 0xcc, 0xb0, 0xd4,
+	0x0f, 0xe9, 0x28, 0x21, 0xe7, 0x47, 0xd8, 0xca, 0xb1, 0xe9, 0x2c, 0x1b, 0xb0, 0x84, 0x3d, 0x8f,
+	0x78, 0x1a, 0x39, 0x16, 0xd4, 0x99, 0x03, 0x0f, 0x0b, 0xe2, 0xa9, 0x86, 0xa8, 0xd9, 0x89, 0x28,
+	0x2d, 0x21, 0x99, 0xb0, 0xa9, 0xaa, 0xa5, 0xb2, 0x68, 0x11, 0xbd, 0x03, 0xcb, 0x43, 0x4c, 0xc7,
+	0x44, 0x16, 0x4f, 0x1a, 0xb4, 0x64, 0x9d, 0xc2, 0x4e, 0xbc, 0x6a, 0x04, 0x0e, 0xc5, 0x4b, 0x2c,
+	0x97, 0xa8, 0x8f, 0x7d, 0x97, 0x24, 0xdc, 0x6d, 0x43, 0x2d, 0x19, 0xd2, 0xe4, 0x7e, 0x55, 0x3d,
+	0xa5, 0x5c, 0x82, 0x86, 0x04, 0x73, 0xe6, 0xeb, 0x21, 0xd5, 0x92, 0xb5, 0xab, 0x07, 0xe6, 0x54,
+	0xb0, 0xe0, 0x9f, 0x98, 0x56, 0xa0, 0xe9, 0x98, 0x31, 0xdd, 0xdd, 0x98, 0x0b, 0x2c, 0x88, 0x2e,
+	0x54, 0x2c, 0xcc, 0x9e, 0xa2, 0x3c, 0x77, 0x8a, 0xf7, 0xe0, 0xd1, 0x30, 0x24, 0xe4, 0x7b, 0xe2,
+	0x64, 0x37, 0xfc, 0x4a, 0xac, 0x7b, 0x21, 0x55, 0x47, 0x6f, 0x2a, 0x50, 0x1f, 0xe8, 0x8f, 0x1f,
+	0x95, 0x1a, 0x5d, 0x42, 0x63, 0xf6, 0x4d, 0x44, 0xcf, 0x0b, 0xa6, 0x2a, 0xf7, 0x6b, 0xc6, 0x3c,
+	0x7c, 0x40, 0x44, 0x7c, 0x3d, 0xab, 0x84, 0x7e, 0x36, 0xe0, 0x71, 0xce, 0x33, 0x81, 0x3e, 0xfa,
+	0x77, 0xb0, 0x9c, 0xc7, 0xd4, 0xfc, 0xf8, 0xa1, 0x61, 0xe9, 0x41, 0x2e, 0xa1, 0x31, 0xbb, 0xd0,
+	0x0a, 0x19, 0xc8, 0x7d, 0x5c, 0xcc, 0xc3, 0x07, 0x44, 0xa4, 0x89, 0x2f, 0xe0, 0x51, 0x76, 0x13,
+	0xa0, 0xa2, 0x4f, 0x8e, 0x9c, 0x75, 0x62, 0x76, 0xef, 0xed, 0x9f, 0xa6, 0x14, 0x50, 0x9f, 0x19,
+	0x63, 0x54, 0x84, 0x91, 0xb7, 0x43, 0xcc, 0xe7, 0xf7, 0x0f, 0xc8, 0x5e, 0x34, 0x3b, 0xd5, 0x85,
+	0x17, 0xcd, 0x59, 0x0d, 0x66, 0xf7, 0xde, 0xfe, 0x69, 0xca, 0x2b, 0x58, 0x9b, 0x9f, 0x64, 0x74,
+	0x54, 0xf4, 0x85, 0x91, 0x3f, 0xf6, 0x66, 0xd1, 0x51, 0x73, 0xc6, 0xd6, 0x2a, 0xa1, 0x29, 0xac,
+	0xce, 0x8d, 0x3b, 0x3a, 0x2c, 0x4c, 0xcc, 0x82, 0xff, 0x22, 0xef, 0xc9, 0xf1, 0xab, 0x9b, 0x96,
+	0xf1, 0xfa, 0xa6, 0x65, 0xfc, 0x75, 0xd3, 0x32, 0x7e, 0xb9, 0x6d, 0x95, 0x5e, 0xdf, 0xb6, 0x4a,
+	0x6f, 0x6e, 0x5b, 0xa5, 0xaf, 0xf7, 0x46, 0x54, 0x9c, 0x47, 0x67, 0x1d, 0x97, 0x4d, 0xba, 0x33,
+	0xbf, 0x5b, 0xae, 0xd2, 0x5f, 0x2e, 0xe2, 0x3a, 0x20, 0xfc, 0x6c, 0x59, 0xfd, 0x74, 0xf9, 0xf0,
+	0xef, 0x01, 0x00, 0x47, 0xfb, 0x7b, 0xf2, 0x35, 0x0d, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// ProviderAdminClient is the client API for ProviderAdmin service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ProviderAdminClient interface {
+	ActiveSessions(ctx context.Context, in *AdminActiveSessionsRequest, opts ...grpc.CallOption) (*AdminActiveSessionsResponse, error)
+	ActiveSubscriptions(ctx context.Context, in *AdminActiveSubscriptionsRequest, opts ...grpc.CallOption) (*AdminActiveSubscriptionsResponse, error)
+	PendingRewards(ctx context.Context, in *AdminPendingRewardsRequest, opts ...grpc.CallOption) (*AdminPendingRewardsResponse, error)
+	ClaimRewards(ctx context.Context, in *AdminClaimRewardsRequest, opts ...grpc.CallOption) (*AdminClaimRewardsResponse, error)
+	BlockConsumer(ctx context.Context, in *AdminBlockConsumerRequest, opts ...grpc.CallOption) (*AdminBlockConsumerResponse, error)
+	ReloadConfig(ctx context.Context, in *AdminReloadConfigRequest, opts ...grpc.CallOption) (*AdminReloadConfigResponse, error)
+	StartMaintenance(ctx context.Context, in *AdminStartMaintenanceRequest, opts ...grpc.CallOption) (*AdminMaintenanceResponse, error)
+	StopMaintenance(ctx context.Context, in *AdminStopMaintenanceRequest, opts ...grpc.CallOption) (*AdminMaintenanceResponse, error)
+}
+
+type providerAdminClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewProviderAdminClient(cc grpc1.ClientConn) ProviderAdminClient {
+	return &providerAdminClient{cc}
+}
+
+func (c *providerAdminClient) ActiveSessions(ctx context.Context, in *AdminActiveSessionsRequest, opts ...grpc.CallOption) (*AdminActiveSessionsResponse, error) {
+	out := new(AdminActiveSessionsResponse)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.ProviderAdmin/ActiveSessions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerAdminClient) ActiveSubscriptions(ctx context.Context, in *AdminActiveSubscriptionsRequest, opts ...grpc.CallOption) (*AdminActiveSubscriptionsResponse, error) {
+	out := new(AdminActiveSubscriptionsResponse)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.ProviderAdmin/ActiveSubscriptions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerAdminClient) PendingRewards(ctx context.Context, in *AdminPendingRewardsRequest, opts ...grpc.CallOption) (*AdminPendingRewardsResponse, error) {
+	out := new(AdminPendingRewardsResponse)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.ProviderAdmin/PendingRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerAdminClient) ClaimRewards(ctx context.Context, in *AdminClaimRewardsRequest, opts ...grpc.CallOption) (*AdminClaimRewardsResponse, error) {
+	out := new(AdminClaimRewardsResponse)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.ProviderAdmin/ClaimRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerAdminClient) BlockConsumer(ctx context.Context, in *AdminBlockConsumerRequest, opts ...grpc.CallOption) (*AdminBlockConsumerResponse, error) {
+	out := new(AdminBlockConsumerResponse)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.ProviderAdmin/BlockConsumer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerAdminClient) ReloadConfig(ctx context.Context, in *AdminReloadConfigRequest, opts ...grpc.CallOption) (*AdminReloadConfigResponse, error) {
+	out := new(AdminReloadConfigResponse)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.ProviderAdmin/ReloadConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerAdminClient) StartMaintenance(ctx context.Context, in *AdminStartMaintenanceRequest, opts ...grpc.CallOption) (*AdminMaintenanceResponse, error) {
+	out := new(AdminMaintenanceResponse)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.ProviderAdmin/StartMaintenance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerAdminClient) StopMaintenance(ctx context.Context, in *AdminStopMaintenanceRequest, opts ...grpc.CallOption) (*AdminMaintenanceResponse, error) {
+	out := new(AdminMaintenanceResponse)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.ProviderAdmin/StopMaintenance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ProviderAdminServer is the server API for ProviderAdmin service.
+type ProviderAdminServer interface {
+	ActiveSessions(context.Context, *AdminActiveSessionsRequest) (*AdminActiveSessionsResponse, error)
+	ActiveSubscriptions(context.Context, *AdminActiveSubscriptionsRequest) (*AdminActiveSubscriptionsResponse, error)
+	PendingRewards(context.Context, *AdminPendingRewardsRequest) (*AdminPendingRewardsResponse, error)
+	ClaimRewards(context.Context, *AdminClaimRewardsRequest) (*AdminClaimRewardsResponse, error)
+	BlockConsumer(context.Context, *AdminBlockConsumerRequest) (*AdminBlockConsumerResponse, error)
+	ReloadConfig(context.Context, *AdminReloadConfigRequest) (*AdminReloadConfigResponse, error)
+	StartMaintenance(context.Context, *AdminStartMaintenanceRequest) (*AdminMaintenanceResponse, error)
+	StopMaintenance(context.Context, *AdminStopMaintenanceRequest) (*AdminMaintenanceResponse, error)
+}
+
+// UnimplementedProviderAdminServer can be embedded to have forward compatible implementations.
+type UnimplementedProviderAdminServer struct {
+}
+
+func (*UnimplementedProviderAdminServer) ActiveSessions(ctx context.Context, req *AdminActiveSessionsRequest) (*AdminActiveSessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ActiveSessions not implemented")
+}
+func (*UnimplementedProviderAdminServer) ActiveSubscriptions(ctx context.Context, req *AdminActiveSubscriptionsRequest) (*AdminActiveSubscriptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ActiveSubscriptions not implemented")
+}
+func (*UnimplementedProviderAdminServer) PendingRewards(ctx context.Context, req *AdminPendingRewardsRequest) (*AdminPendingRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingRewards not implemented")
+}
+func (*UnimplementedProviderAdminServer) ClaimRewards(ctx context.Context, req *AdminClaimRewardsRequest) (*AdminClaimRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimRewards not implemented")
+}
+func (*UnimplementedProviderAdminServer) BlockConsumer(ctx context.Context, req *AdminBlockConsumerRequest) (*AdminBlockConsumerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockConsumer not implemented")
+}
+func (*UnimplementedProviderAdminServer) ReloadConfig(ctx context.Context, req *AdminReloadConfigRequest) (*AdminReloadConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReloadConfig not implemented")
+}
+func (*UnimplementedProviderAdminServer) StartMaintenance(ctx context.Context, req *AdminStartMaintenanceRequest) (*AdminMaintenanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartMaintenance not implemented")
+}
+func (*UnimplementedProviderAdminServer) StopMaintenance(ctx context.Context, req *AdminStopMaintenanceRequest) (*AdminMaintenanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StopMaintenance not implemented")
+}
+
+func RegisterProviderAdminServer(s grpc1.Server, srv ProviderAdminServer) {
+	s.RegisterService(&_ProviderAdmin_serviceDesc, srv)
+}
+
+func _ProviderAdmin_ActiveSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminActiveSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderAdminServer).ActiveSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.pairing.ProviderAdmin/ActiveSessions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderAdminServer).ActiveSessions(ctx, req.(*AdminActiveSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProviderAdmin_ActiveSubscriptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminActiveSubscriptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderAdminServer).ActiveSubscriptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.pairing.ProviderAdmin/ActiveSubscriptions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderAdminServer).ActiveSubscriptions(ctx, req.(*AdminActiveSubscriptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProviderAdmin_PendingRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminPendingRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderAdminServer).PendingRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.pairing.ProviderAdmin/PendingRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderAdminServer).PendingRewards(ctx, req.(*AdminPendingRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProviderAdmin_ClaimRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminClaimRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderAdminServer).ClaimRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.pairing.ProviderAdmin/ClaimRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderAdminServer).ClaimRewards(ctx, req.(*AdminClaimRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProviderAdmin_BlockConsumer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminBlockConsumerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderAdminServer).BlockConsumer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.pairing.ProviderAdmin/BlockConsumer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderAdminServer).BlockConsumer(ctx, req.(*AdminBlockConsumerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProviderAdmin_ReloadConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminReloadConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderAdminServer).ReloadConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.pairing.ProviderAdmin/ReloadConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderAdminServer).ReloadConfig(ctx, req.(*AdminReloadConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProviderAdmin_StartMaintenance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminStartMaintenanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderAdminServer).StartMaintenance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.pairing.ProviderAdmin/StartMaintenance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderAdminServer).StartMaintenance(ctx, req.(*AdminStartMaintenanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProviderAdmin_StopMaintenance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminStopMaintenanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderAdminServer).StopMaintenance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.pairing.ProviderAdmin/StopMaintenance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderAdminServer).StopMaintenance(ctx, req.(*AdminStopMaintenanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _ProviderAdmin_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "lavanet.lava.pairing.ProviderAdmin",
+	HandlerType: (*ProviderAdminServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ActiveSessions",
+			Handler:    _ProviderAdmin_ActiveSessions_Handler,
+		},
+		{
+			MethodName: "ActiveSubscriptions",
+			Handler:    _ProviderAdmin_ActiveSubscriptions_Handler,
+		},
+		{
+			MethodName: "PendingRewards",
+			Handler:    _ProviderAdmin_PendingRewards_Handler,
+		},
+		{
+			MethodName: "ClaimRewards",
+			Handler:    _ProviderAdmin_ClaimRewards_Handler,
+		},
+		{
+			MethodName: "BlockConsumer",
+			Handler:    _ProviderAdmin_BlockConsumer_Handler,
+		},
+		{
+			MethodName: "ReloadConfig",
+			Handler:    _ProviderAdmin_ReloadConfig_Handler,
+		},
+		{
+			MethodName: "StartMaintenance",
+			Handler:    _ProviderAdmin_StartMaintenance_Handler,
+		},
+		{
+			MethodName: "StopMaintenance",
+			Handler:    _ProviderAdmin_StopMaintenance_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "lavanet/lava/pairing/provider_admin.proto",
+}
+
+func (m *AdminActiveSessionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AdminActiveSessionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AdminActiveSessionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		i = encodeVarintProviderAdmin(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintProviderAdmin(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AdminProjectSessionsInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AdminProjectSessionsInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AdminProjectSessionsInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Blocked {
+		i--
+		if m.Blocked {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.SessionsCount != 0 {
+		i = encodeVarintProviderAdmin(dAtA, i, uint64(m.SessionsCount))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.MissingCu != 0 {
+		i = encodeVarintProviderAdmin(dAtA, i, uint64(m.MissingCu))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.MaxCu != 0 {
+		i = encodeVarintProviderAdmin(dAtA, i, uint64(m.MaxCu))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.UsedCu != 0 {
+		i = encodeVarintProviderAdmin(dAtA, i, uint64(m.UsedCu))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Consumers) > 0 {
+		for iNdEx := len(m.Consumers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Consumers[iNdEx])
+			copy(dAtA[i:], m.Consumers[iNdEx])
+			i = encodeVarintProviderAdmin(dAtA, i, uint64(len(m.Consumers[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.ProjectId) > 0 {
+		i -= len(m.ProjectId)
+		copy(dAtA[i:], m.ProjectId)
+		i = encodeVarintProviderAdmin(dAtA, i, uint64(len(m.ProjectId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintProviderAdmin(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ApiInterface) > 0 {
+		i -= len(m.ApiInterface)
+		copy(dAtA[i:], m.ApiInterface)
+		i = encodeVarintProviderAdmin(dAtA, i, uint64(len(m.ApiInterface)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintProviderAdmin(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AdminActiveSessionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AdminActiveSessionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AdminActiveSessionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sessions) > 0 {
+		for iNdEx := len(m.Sessions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Sessions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProviderAdmin(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AdminActiveSubscriptionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AdminActiveSubscriptionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AdminActiveSubscriptionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintProviderAdmin(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AdminSubscriptionInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AdminSubscriptionInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AdminSubscriptionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SubscriptionId) > 0 {
+		i -= len(m.SubscriptionId)
+		copy(dAtA[i:], m.SubscriptionId)
+		i = encodeVarintProviderAdmin(dAtA, i, uint64(len(m.SubscriptionId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ProjectId) > 0 {
+		i -= len(m.ProjectId)
+		copy(dAtA[i:], m.ProjectId)
+		i = encodeVarintProviderAdmin(dAtA, i, uint64(len(m.ProjectId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintProviderAdmin(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ApiInterface) > 0 {
+		i -= len(m.ApiInterface)
+		copy(dAtA[i:], m.ApiInterface)
+		i = encodeVarintProviderAdmin(dAtA, i, uint64(len(m.ApiInterface)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintProviderAdmin(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AdminActiveSubscriptionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AdminActiveSubscriptionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AdminActiveSubscriptionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Subscriptions) > 0 {
+		for iNdEx := len(m.Subscriptions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Subscriptions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProviderAdmin(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AdminPendingRewardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AdminPendingRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AdminPendingRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *AdminPendingProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AdminPendingProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AdminPendingProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Proof != nil {
+		{
+			size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProviderAdmin(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Consumer) > 0 {
+		i -= len(m.Consumer)
+		copy(dAtA[i:], m.Consumer)
+		i = encodeVarintProviderAdmin(dAtA, i, uint64(len(m.Consumer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintProviderAdmin(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AdminExpectedPayment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AdminExpectedPayment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AdminExpectedPayment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UniqueIdentifier != 0 {
+		i = encodeVarintProviderAdmin(dAtA, i, uint64(m.UniqueIdentifier))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.BlockHeightDeadline != 0 {
+		i = encodeVarintProviderAdmin(dAtA, i, uint64(m.BlockHeightDeadline))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Cu != 0 {
+		i = encodeVarintProviderAdmin(dAtA, i, uint64(m.Cu))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Consumer) > 0 {
+		i -= len(m.Consumer)
+		copy(dAtA[i:], m.Consumer)
+		i = encodeVarintProviderAdmin(dAtA, i, uint64(len(m.Consumer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintProviderAdmin(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AdminPendingRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AdminPendingRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AdminPendingRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TotalCuPaid != 0 {
+		i = encodeVarintProviderAdmin(dAtA, i, uint64(m.TotalCuPaid))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TotalCuServiced != 0 {
+		i = encodeVarintProviderAdmin(dAtA, i, uint64(m.TotalCuServiced))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ExpectedPayments) > 0 {
+		for iNdEx := len(m.ExpectedPayments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExpectedPayments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProviderAdmin(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.PendingProofs) > 0 {
+		for iNdEx := len(m.PendingProofs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingProofs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProviderAdmin(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AdminClaimRewardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AdminClaimRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AdminClaimRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *AdminClaimRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AdminClaimRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AdminClaimRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ClaimedCu != 0 {
+		i = encodeVarintProviderAdmin(dAtA, i, uint64(m.ClaimedCu))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ClaimedProofs != 0 {
+		i = encodeVarintProviderAdmin(dAtA, i, uint64(m.ClaimedProofs))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AdminBlockConsumerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AdminBlockConsumerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AdminBlockConsumerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Unblock {
+		i--
+		if m.Unblock {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Consumer) > 0 {
+		i -= len(m.Consumer)
+		copy(dAtA[i:], m.Consumer)
+		i = encodeVarintProviderAdmin(dAtA, i, uint64(len(m.Consumer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AdminBlockConsumerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AdminBlockConsumerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AdminBlockConsumerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BlockedConsumers) > 0 {
+		for iNdEx := len(m.BlockedConsumers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.BlockedConsumers[iNdEx])
+			copy(dAtA[i:], m.BlockedConsumers[iNdEx])
+			i = encodeVarintProviderAdmin(dAtA, i, uint64(len(m.BlockedConsumers[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AdminReloadConfigRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AdminReloadConfigRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AdminReloadConfigRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *AdminReloadConfigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AdminReloadConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AdminReloadConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Failed) > 0 {
+		for iNdEx := len(m.Failed) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Failed[iNdEx])
+			copy(dAtA[i:], m.Failed[iNdEx])
+			i = encodeVarintProviderAdmin(dAtA, i, uint64(len(m.Failed[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Removed) > 0 {
+		for iNdEx := len(m.Removed) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Removed[iNdEx])
+			copy(dAtA[i:], m.Removed[iNdEx])
+			i = encodeVarintProviderAdmin(dAtA, i, uint64(len(m.Removed[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Updated) > 0 {
+		for iNdEx := len(m.Updated) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Updated[iNdEx])
+			copy(dAtA[i:], m.Updated[iNdEx])
+			i = encodeVarintProviderAdmin(dAtA, i, uint64(len(m.Updated[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Added) > 0 {
+		for iNdEx := len(m.Added) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Added[iNdEx])
+			copy(dAtA[i:], m.Added[iNdEx])
+			i = encodeVarintProviderAdmin(dAtA, i, uint64(len(m.Added[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AdminStartMaintenanceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AdminStartMaintenanceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AdminStartMaintenanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintProviderAdmin(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainIds) > 0 {
+		for iNdEx := len(m.ChainIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ChainIds[iNdEx])
+			copy(dAtA[i:], m.ChainIds[iNdEx])
+			i = encodeVarintProviderAdmin(dAtA, i, uint64(len(m.ChainIds[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AdminStopMaintenanceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AdminStopMaintenanceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AdminStopMaintenanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *AdminMaintenanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AdminMaintenanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AdminMaintenanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FreezeEpoch != 0 {
+		i = encodeVarintProviderAdmin(dAtA, i, uint64(m.FreezeEpoch))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChainIds) > 0 {
+		for iNdEx := len(m.ChainIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ChainIds[iNdEx])
+			copy(dAtA[i:], m.ChainIds[iNdEx])
+			i = encodeVarintProviderAdmin(dAtA, i, uint64(len(m.ChainIds[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.State) > 0 {
+		i -= len(m.State)
+		copy(dAtA[i:], m.State)
+		i = encodeVarintProviderAdmin(dAtA, i, uint64(len(m.State)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintProviderAdmin(dAtA []byte, offset int, v uint64) int {
+	offset -= sovProviderAdmin(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AdminActiveSessionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovProviderAdmin(uint64(l))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovProviderAdmin(uint64(m.Epoch))
+	}
+	return n
+}
+
+func (m *AdminProjectSessionsInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovProviderAdmin(uint64(l))
+	}
+	l = len(m.ApiInterface)
+	if l > 0 {
+		n += 1 + l + sovProviderAdmin(uint64(l))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovProviderAdmin(uint64(m.Epoch))
+	}
+	l = len(m.ProjectId)
+	if l > 0 {
+		n += 1 + l + sovProviderAdmin(uint64(l))
+	}
+	if len(m.Consumers) > 0 {
+		for _, s := range m.Consumers {
+			l = len(s)
+			n += 1 + l + sovProviderAdmin(uint64(l))
+		}
+	}
+	if m.UsedCu != 0 {
+		n += 1 + sovProviderAdmin(uint64(m.UsedCu))
+	}
+	if m.MaxCu != 0 {
+		n += 1 + sovProviderAdmin(uint64(m.MaxCu))
+	}
+	if m.MissingCu != 0 {
+		n += 1 + sovProviderAdmin(uint64(m.MissingCu))
+	}
+	if m.SessionsCount != 0 {
+		n += 1 + sovProviderAdmin(uint64(m.SessionsCount))
+	}
+	if m.Blocked {
+		n += 2
+	}
+	return n
+}
+
+func (m *AdminActiveSessionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Sessions) > 0 {
+		for _, e := range m.Sessions {
+			l = e.Size()
+			n += 1 + l + sovProviderAdmin(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AdminActiveSubscriptionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovProviderAdmin(uint64(l))
+	}
+	return n
+}
+
+func (m *AdminSubscriptionInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovProviderAdmin(uint64(l))
+	}
+	l = len(m.ApiInterface)
+	if l > 0 {
+		n += 1 + l + sovProviderAdmin(uint64(l))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovProviderAdmin(uint64(m.Epoch))
+	}
+	l = len(m.ProjectId)
+	if l > 0 {
+		n += 1 + l + sovProviderAdmin(uint64(l))
+	}
+	l = len(m.SubscriptionId)
+	if l > 0 {
+		n += 1 + l + sovProviderAdmin(uint64(l))
+	}
+	return n
+}
+
+func (m *AdminActiveSubscriptionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Subscriptions) > 0 {
+		for _, e := range m.Subscriptions {
+			l = e.Size()
+			n += 1 + l + sovProviderAdmin(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AdminPendingRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *AdminPendingProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovProviderAdmin(uint64(m.Epoch))
+	}
+	l = len(m.Consumer)
+	if l > 0 {
+		n += 1 + l + sovProviderAdmin(uint64(l))
+	}
+	if m.Proof != nil {
+		l = m.Proof.Size()
+		n += 1 + l + sovProviderAdmin(uint64(l))
+	}
+	return n
+}
+
+func (m *AdminExpectedPayment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovProviderAdmin(uint64(l))
+	}
+	l = len(m.Consumer)
+	if l > 0 {
+		n += 1 + l + sovProviderAdmin(uint64(l))
+	}
+	if m.Cu != 0 {
+		n += 1 + sovProviderAdmin(uint64(m.Cu))
+	}
+	if m.BlockHeightDeadline != 0 {
+		n += 1 + sovProviderAdmin(uint64(m.BlockHeightDeadline))
+	}
+	if m.UniqueIdentifier != 0 {
+		n += 1 + sovProviderAdmin(uint64(m.UniqueIdentifier))
+	}
+	return n
+}
+
+func (m *AdminPendingRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PendingProofs) > 0 {
+		for _, e := range m.PendingProofs {
+			l = e.Size()
+			n += 1 + l + sovProviderAdmin(uint64(l))
+		}
+	}
+	if len(m.ExpectedPayments) > 0 {
+		for _, e := range m.ExpectedPayments {
+			l = e.Size()
+			n += 1 + l + sovProviderAdmin(uint64(l))
+		}
+	}
+	if m.TotalCuServiced != 0 {
+		n += 1 + sovProviderAdmin(uint64(m.TotalCuServiced))
+	}
+	if m.TotalCuPaid != 0 {
+		n += 1 + sovProviderAdmin(uint64(m.TotalCuPaid))
+	}
+	return n
+}
+
+func (m *AdminClaimRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *AdminClaimRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ClaimedProofs != 0 {
+		n += 1 + sovProviderAdmin(uint64(m.ClaimedProofs))
+	}
+	if m.ClaimedCu != 0 {
+		n += 1 + sovProviderAdmin(uint64(m.ClaimedCu))
+	}
+	return n
+}
+
+func (m *AdminBlockConsumerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Consumer)
+	if l > 0 {
+		n += 1 + l + sovProviderAdmin(uint64(l))
+	}
+	if m.Unblock {
+		n += 2
+	}
+	return n
+}
+
+func (m *AdminBlockConsumerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BlockedConsumers) > 0 {
+		for _, s := range m.BlockedConsumers {
+			l = len(s)
+			n += 1 + l + sovProviderAdmin(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AdminReloadConfigRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *AdminReloadConfigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Added) > 0 {
+		for _, s := range m.Added {
+			l = len(s)
+			n += 1 + l + sovProviderAdmin(uint64(l))
+		}
+	}
+	if len(m.Updated) > 0 {
+		for _, s := range m.Updated {
+			l = len(s)
+			n += 1 + l + sovProviderAdmin(uint64(l))
+		}
+	}
+	if len(m.Removed) > 0 {
+		for _, s := range m.Removed {
+			l = len(s)
+			n += 1 + l + sovProviderAdmin(uint64(l))
+		}
+	}
+	if len(m.Failed) > 0 {
+		for _, s := range m.Failed {
+			l = len(s)
+			n += 1 + l + sovProviderAdmin(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AdminStartMaintenanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ChainIds) > 0 {
+		for _, s := range m.ChainIds {
+			l = len(s)
+			n += 1 + l + sovProviderAdmin(uint64(l))
+		}
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovProviderAdmin(uint64(l))
+	}
+	return n
+}
+
+func (m *AdminStopMaintenanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *AdminMaintenanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.State)
+	if l > 0 {
+		n += 1 + l + sovProviderAdmin(uint64(l))
+	}
+	if len(m.ChainIds) > 0 {
+		for _, s := range m.ChainIds {
+			l = len(s)
+			n += 1 + l + sovProviderAdmin(uint64(l))
+		}
+	}
+	if m.FreezeEpoch != 0 {
+		n += 1 + sovProviderAdmin(uint64(m.FreezeEpoch))
+	}
+	return n
+}
+
+func sovProviderAdmin(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozProviderAdmin(x uint64) (n int) {
+	return sovProviderAdmin(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *AdminActiveSessionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProviderAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AdminActiveSessionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AdminActiveSessionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProviderAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProviderAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProviderAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProviderAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProviderAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProviderAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AdminProjectSessionsInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProviderAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AdminProjectSessionsInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AdminProjectSessionsInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProviderAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProviderAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProviderAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiInterface", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProviderAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProviderAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProviderAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiInterface = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProviderAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProviderAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProviderAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProviderAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProjectId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Consumers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProviderAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProviderAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProviderAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Consumers = append(m.Consumers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsedCu", wireType)
+			}
+			m.UsedCu = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProviderAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UsedCu |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCu", wireType)
+			}
+			m.MaxCu = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProviderAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxCu |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissingCu", wireType)
+			}
+			m.MissingCu = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProviderAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissingCu |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionsCount", wireType)
+			}
+			m.SessionsCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProviderAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SessionsCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocked", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProviderAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Blocked = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProviderAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProviderAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AdminActiveSessionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProviderAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AdminActiveSessionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AdminActiveSessionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sessions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProviderAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProviderAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProviderAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sessions = append(m.Sessions, AdminProjectSessionsInfo{})
+			if err := m.Sessions[len(m.Sessions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProviderAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProviderAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AdminActiveSubscriptionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProviderAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AdminActiveSubscriptionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AdminActiveSubscriptionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProviderAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProviderAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProviderAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProviderAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProviderAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AdminSubscriptionInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProviderAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AdminSubscriptionInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AdminSubscriptionInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProviderAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProviderAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProviderAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiInterface", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProviderAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProviderAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProviderAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiInterface = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProviderAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProviderAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProviderAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProviderAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProjectId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubscriptionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProviderAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProviderAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProviderAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubscriptionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProviderAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProviderAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AdminActiveSubscriptionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProviderAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AdminActiveSubscriptionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AdminActiveSubscriptionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subscriptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProviderAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProviderAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProviderAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subscriptions = append(m.Subscriptions, AdminSubscriptionInfo{})
+			if err := m.Subscriptions[len(m.Subscriptions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProviderAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProviderAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AdminPendingRewardsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProviderAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AdminPendingRewardsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AdminPendingRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProviderAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProviderAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AdminPendingProof) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProviderAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AdminPendingProof: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AdminPendingProof: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProviderAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Consumer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProviderAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProviderAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProviderAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Consumer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProviderAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProviderAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProviderAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Proof == nil {
+				m.Proof = &RelaySession{}
+			}
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProviderAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProviderAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AdminExpectedPayment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProviderAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AdminExpectedPayment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AdminExpectedPayment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProviderAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProviderAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProviderAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Consumer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProviderAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProviderAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProviderAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Consumer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cu", wireType)
+			}
+			m.Cu = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProviderAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Cu |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeightDeadline", wireType)
+			}
+			m.BlockHeightDeadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProviderAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeightDeadline |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UniqueIdentifier", wireType)
+			}
+			m.UniqueIdentifier = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProviderAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UniqueIdentifier |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProviderAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProviderAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AdminPendingRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProviderAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AdminPendingRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AdminPendingRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingProofs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProviderAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProviderAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProviderAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingProofs = append(m.PendingProofs, AdminPendingProof{})
+			if err := m.PendingProofs[len(m.PendingProofs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedPayments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProviderAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProviderAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProviderAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExpectedPayments = append(m.ExpectedPayments, AdminExpectedPayment{})
+			if err := m.ExpectedPayments[len(m.ExpectedPayments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalCuServiced", wireType)
+			}
+			m.TotalCuServiced = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProviderAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalCuServiced |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalCuPaid", wireType)
+			}
+			m.TotalCuPaid = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProviderAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalCuPaid |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProviderAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProviderAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AdminClaimRewardsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProviderAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AdminClaimRewardsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AdminClaimRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProviderAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProviderAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AdminClaimRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProviderAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AdminClaimRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AdminClaimRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimedProofs", wireType)
+			}
+			m.ClaimedProofs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProviderAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClaimedProofs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimedCu", wireType)
+			}
+			m.ClaimedCu = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProviderAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClaimedCu |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProviderAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProviderAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AdminBlockConsumerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProviderAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AdminBlockConsumerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AdminBlockConsumerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Consumer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProviderAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProviderAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProviderAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Consumer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unblock", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProviderAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Unblock = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProviderAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProviderAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AdminBlockConsumerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProviderAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AdminBlockConsumerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AdminBlockConsumerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockedConsumers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProviderAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProviderAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProviderAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockedConsumers = append(m.BlockedConsumers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProviderAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProviderAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AdminReloadConfigRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProviderAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AdminReloadConfigRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AdminReloadConfigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProviderAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProviderAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AdminReloadConfigResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProviderAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AdminReloadConfigResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AdminReloadConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Added", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProviderAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProviderAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProviderAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Added = append(m.Added, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Updated", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProviderAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProviderAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProviderAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Updated = append(m.Updated, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Removed", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProviderAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProviderAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProviderAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Removed = append(m.Removed, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failed", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProviderAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProviderAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProviderAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Failed = append(m.Failed, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProviderAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProviderAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AdminStartMaintenanceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProviderAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AdminStartMaintenanceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AdminStartMaintenanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProviderAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProviderAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProviderAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainIds = append(m.ChainIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProviderAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProviderAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProviderAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProviderAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProviderAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AdminStopMaintenanceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProviderAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AdminStopMaintenanceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AdminStopMaintenanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProviderAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProviderAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AdminMaintenanceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProviderAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AdminMaintenanceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AdminMaintenanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProviderAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProviderAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProviderAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.State = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProviderAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProviderAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProviderAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainIds = append(m.ChainIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FreezeEpoch", wireType)
+			}
+			m.FreezeEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProviderAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FreezeEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProviderAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProviderAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipProviderAdmin(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowProviderAdmin
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowProviderAdmin
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowProviderAdmin
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthProviderAdmin
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupProviderAdmin
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthProviderAdmin
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthProviderAdmin        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowProviderAdmin          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupProviderAdmin = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,663 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: lavanet/lava/pairing/provider_earnings.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ProviderEarnings accumulates the CU served and the rewards of a provider on a chain in a single month.
+type ProviderEarnings struct {
+	Provider         string     `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	ChainID          string     `protobuf:"bytes,2,opt,name=chainID,proto3" json:"chainID,omitempty"`
+	Month            string     `protobuf:"bytes,3,opt,name=month,proto3" json:"month,omitempty"`
+	CuServed         uint64     `protobuf:"varint,4,opt,name=cu_served,json=cuServed,proto3" json:"cu_served,omitempty"`
+	RelayPayments    uint64     `protobuf:"varint,5,opt,name=relay_payments,json=relayPayments,proto3" json:"relay_payments,omitempty"`
+	TotalReward      types.Coin `protobuf:"bytes,6,opt,name=total_reward,json=totalReward,proto3" json:"total_reward"`
+	ProviderReward   types.Coin `protobuf:"bytes,7,opt,name=provider_reward,json=providerReward,proto3" json:"provider_reward"`
+	DelegatorsReward types.Coin `protobuf:"bytes,8,opt,name=delegators_reward,json=delegatorsReward,proto3" json:"delegators_reward"`
+}
+
+func (m *ProviderEarnings) Reset()         { *m = ProviderEarnings{} }
+func (m *ProviderEarnings) String() string { return proto.CompactTextString(m) }
+func (*ProviderEarnings) ProtoMessage()    {}
+func (*ProviderEarnings) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1b3b4561907f591, []int{0}
+}
+func (m *ProviderEarnings) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProviderEarnings) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProviderEarnings.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProviderEarnings) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProviderEarnings.Merge(m, src)
+}
+func (m *ProviderEarnings) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProviderEarnings) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProviderEarnings.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProviderEarnings proto.InternalMessageInfo
+
+func (m *ProviderEarnings) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
+}
+
+func (m *ProviderEarnings) GetChainID() string {
+	if m != nil {
+		return m.ChainID
+	}
+	return ""
+}
+
+func (m *ProviderEarnings) GetMonth() string {
+	if m != nil {
+		return m.Month
+	}
+	return ""
+}
+
+func (m *ProviderEarnings) GetCuServed() uint64 {
+	if m != nil {
+		return m.CuServed
+	}
+	return 0
+}
+
+func (m *ProviderEarnings) GetRelayPayments() uint64 {
+	if m != nil {
+		return m.RelayPayments
+	}
+	return 0
+}
+
+func (m *ProviderEarnings) GetTotalReward() types.Coin {
+	if m != nil {
+		return m.TotalReward
+	}
+	return types.Coin{}
+}
+
+func (m *ProviderEarnings) GetProviderReward() types.Coin {
+	if m != nil {
+		return m.ProviderReward
+	}
+	return types.Coin{}
+}
+
+func (m *ProviderEarnings) GetDelegatorsReward() types.Coin {
+	if m != nil {
+		return m.DelegatorsReward
+	}
+	return types.Coin{}
+}
+
+func init() {
+	proto.RegisterType((*ProviderEarnings)(nil), "lavanet.lava.pairing.ProviderEarnings")
+}
+
+func init() {
+	proto.RegisterFile("lavanet/lava/pairing/provider_earnings.proto", fileDescriptor_b1b3b4561907f591)
+}
+
+var fileDescriptor_b1b3b4561907f591 = []byte{
+	// 365 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0xcf, 0x6b, 0xdb, 0x30,
+	0x14, 0xb6, 0xb3, 0xfc, 0x54, 0xb6, 0x2c, 0x13, 0x39, 0x78, 0x19, 0x78, 0x61, 0x30, 0x96, 0xc3,
+	0x90, 0xc8, 0xf6, 0x17, 0x2c, 0xdb, 0xa0, 0x85, 0x1e, 0x82, 0x7b, 0xeb, 0xc5, 0xc8, 0xb6, 0x70,
+	0x04, 0xb6, 0x64, 0x24, 0xc5, 0x6d, 0xfe, 0x8b, 0xfe, 0x59, 0x39, 0xe6, 0xd8, 0x53, 0x29, 0xc9,
+	0xff, 0x51, 0x8a, 0x65, 0x39, 0xa5, 0xb7, 0x9c, 0x9e, 0xbf, 0xf7, 0xbe, 0xef, 0x7b, 0x7c, 0xd6,
+	0x03, 0x3f, 0x33, 0x52, 0x12, 0x4e, 0x35, 0xae, 0x2a, 0x2e, 0x08, 0x93, 0x8c, 0xa7, 0xb8, 0x90,
+	0xa2, 0x64, 0x09, 0x95, 0x21, 0x25, 0x92, 0x33, 0x9e, 0x2a, 0x54, 0x48, 0xa1, 0x05, 0x9c, 0x58,
+	0x36, 0xaa, 0x2a, 0xb2, 0xec, 0xe9, 0x24, 0x15, 0xa9, 0x30, 0x04, 0x5c, 0x7d, 0xd5, 0xdc, 0xa9,
+	0x1f, 0x0b, 0x95, 0x0b, 0x85, 0x23, 0xa2, 0x28, 0x2e, 0x17, 0x11, 0xd5, 0x64, 0x81, 0x63, 0xc1,
+	0x78, 0x3d, 0xff, 0xf6, 0xdc, 0x02, 0xe3, 0x95, 0xdd, 0xf3, 0xdf, 0xae, 0x81, 0x53, 0xd0, 0x6f,
+	0x76, 0x7b, 0xee, 0xcc, 0x9d, 0x0f, 0x82, 0x13, 0x86, 0x1e, 0xe8, 0xc5, 0x6b, 0xc2, 0xf8, 0xe5,
+	0x3f, 0xaf, 0x65, 0x46, 0x0d, 0x84, 0x13, 0xd0, 0xc9, 0x05, 0xd7, 0x6b, 0xef, 0x9d, 0xe9, 0xd7,
+	0x00, 0x7e, 0x01, 0x83, 0x78, 0x13, 0x2a, 0x2a, 0x4b, 0x9a, 0x78, 0xed, 0x99, 0x3b, 0x6f, 0x07,
+	0xfd, 0x78, 0x73, 0x6d, 0x30, 0xfc, 0x0e, 0x46, 0x92, 0x66, 0x64, 0x1b, 0x16, 0x64, 0x9b, 0x53,
+	0xae, 0x95, 0xd7, 0x31, 0x8c, 0x0f, 0xa6, 0xbb, 0xb2, 0x4d, 0xb8, 0x04, 0xef, 0xb5, 0xd0, 0x24,
+	0x0b, 0x25, 0xbd, 0x25, 0x32, 0xf1, 0xba, 0x33, 0x77, 0x3e, 0xfc, 0xf5, 0x19, 0xd5, 0xd9, 0x50,
+	0x95, 0x0d, 0xd9, 0x6c, 0xe8, 0xaf, 0x60, 0x7c, 0xd9, 0xde, 0x3d, 0x7e, 0x75, 0x82, 0xa1, 0x11,
+	0x05, 0x46, 0x03, 0x2f, 0xc0, 0xc7, 0xd3, 0xff, 0xb4, 0x36, 0xbd, 0xf3, 0x6c, 0x46, 0x8d, 0xce,
+	0x3a, 0x5d, 0x81, 0x4f, 0x09, 0xcd, 0x68, 0x4a, 0xb4, 0x90, 0xaa, 0xf1, 0xea, 0x9f, 0xe7, 0x35,
+	0x7e, 0x55, 0xd6, 0x6e, 0xcb, 0x3f, 0xbb, 0x83, 0xef, 0xee, 0x0f, 0xbe, 0xfb, 0x74, 0xf0, 0xdd,
+	0xfb, 0xa3, 0xef, 0xec, 0x8f, 0xbe, 0xf3, 0x70, 0xf4, 0x9d, 0x9b, 0x1f, 0x29, 0xd3, 0xeb, 0x4d,
+	0x84, 0x62, 0x91, 0xe3, 0x37, 0xf7, 0x71, 0x77, 0xba, 0x10, 0xbd, 0x2d, 0xa8, 0x8a, 0xba, 0xe6,
+	0x29, 0x7f, 0xbf, 0x0c, 0x00, 0x40, 0x61, 0x4a, 0xc1, 0x46, 0x02, 0x00, 0x00,
+}
+
+func (m *ProviderEarnings) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProviderEarnings) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProviderEarnings) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.DelegatorsReward.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProviderEarnings(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size, err := m.ProviderReward.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProviderEarnings(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size, err := m.TotalReward.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProviderEarnings(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.RelayPayments != 0 {
+		i = encodeVarintProviderEarnings(dAtA, i, uint64(m.RelayPayments))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.CuServed != 0 {
+		i = encodeVarintProviderEarnings(dAtA, i, uint64(m.CuServed))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Month) > 0 {
+		i -= len(m.Month)
+		copy(dAtA[i:], m.Month)
+		i = encodeVarintProviderEarnings(dAtA, i, uint64(len(m.Month)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintProviderEarnings(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintProviderEarnings(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintProviderEarnings(dAtA []byte, offset int, v uint64) int {
+	offset -= sovProviderEarnings(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ProviderEarnings) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovProviderEarnings(uint64(l))
+	}
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovProviderEarnings(uint64(l))
+	}
+	l = len(m.Month)
+	if l > 0 {
+		n += 1 + l + sovProviderEarnings(uint64(l))
+	}
+	if m.CuServed != 0 {
+		n += 1 + sovProviderEarnings(uint64(m.CuServed))
+	}
+	if m.RelayPayments != 0 {
+		n += 1 + sovProviderEarnings(uint64(m.RelayPayments))
+	}
+	l = m.TotalReward.Size()
+	n += 1 + l + sovProviderEarnings(uint64(l))
+	l = m.ProviderReward.Size()
+	n += 1 + l + sovProviderEarnings(uint64(l))
+	l = m.DelegatorsReward.Size()
+	n += 1 + l + sovProviderEarnings(uint64(l))
+	return n
+}
+
+func sovProviderEarnings(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozProviderEarnings(x uint64) (n int) {
+	return sovProviderEarnings(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ProviderEarnings) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProviderEarnings
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProviderEarnings: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProviderEarnings: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProviderEarnings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProviderEarnings
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProviderEarnings
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProviderEarnings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProviderEarnings
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProviderEarnings
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Month", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProviderEarnings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProviderEarnings
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProviderEarnings
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Month = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CuServed", wireType)
+			}
+			m.CuServed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProviderEarnings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CuServed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayPayments", wireType)
+			}
+			m.RelayPayments = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProviderEarnings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RelayPayments |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalReward", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProviderEarnings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProviderEarnings
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProviderEarnings
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalReward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderReward", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProviderEarnings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProviderEarnings
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProviderEarnings
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProviderReward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorsReward", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProviderEarnings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProviderEarnings
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProviderEarnings
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DelegatorsReward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProviderEarnings(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProviderEarnings
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipProviderEarnings(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowProviderEarnings
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowProviderEarnings
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowProviderEarnings
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthProviderEarnings
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupProviderEarnings
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthProviderEarnings
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthProviderEarnings        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowProviderEarnings          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupProviderEarnings = fmt.Errorf("proto: unexpected end of group")
+)
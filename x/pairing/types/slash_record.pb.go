@@ -0,0 +1,607 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: lavanet/lava/pairing/slash_record.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// SlashRecord keeps the details of a single slash of a provider on a chain.
+type SlashRecord struct {
+	Provider           string                                 `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	ChainID            string                                 `protobuf:"bytes,2,opt,name=chainID,proto3" json:"chainID,omitempty"`
+	Block              uint64                                 `protobuf:"varint,3,opt,name=block,proto3" json:"block,omitempty"`
+	Sequence           uint64                                 `protobuf:"varint,4,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Percentage         github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=percentage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"percentage"`
+	StakeSlashed       types.Coin                             `protobuf:"bytes,6,opt,name=stake_slashed,json=stakeSlashed,proto3" json:"stake_slashed"`
+	DelegationsSlashed types.Coin                             `protobuf:"bytes,7,opt,name=delegations_slashed,json=delegationsSlashed,proto3" json:"delegations_slashed"`
+}
+
+func (m *SlashRecord) Reset()         { *m = SlashRecord{} }
+func (m *SlashRecord) String() string { return proto.CompactTextString(m) }
+func (*SlashRecord) ProtoMessage()    {}
+func (*SlashRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6e233c0d36d28a72, []int{0}
+}
+func (m *SlashRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SlashRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SlashRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SlashRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SlashRecord.Merge(m, src)
+}
+func (m *SlashRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *SlashRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_SlashRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SlashRecord proto.InternalMessageInfo
+
+func (m *SlashRecord) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
+}
+
+func (m *SlashRecord) GetChainID() string {
+	if m != nil {
+		return m.ChainID
+	}
+	return ""
+}
+
+func (m *SlashRecord) GetBlock() uint64 {
+	if m != nil {
+		return m.Block
+	}
+	return 0
+}
+
+func (m *SlashRecord) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *SlashRecord) GetStakeSlashed() types.Coin {
+	if m != nil {
+		return m.StakeSlashed
+	}
+	return types.Coin{}
+}
+
+func (m *SlashRecord) GetDelegationsSlashed() types.Coin {
+	if m != nil {
+		return m.DelegationsSlashed
+	}
+	return types.Coin{}
+}
+
+func init() {
+	proto.RegisterType((*SlashRecord)(nil), "lavanet.lava.pairing.SlashRecord")
+}
+
+func init() {
+	proto.RegisterFile("lavanet/lava/pairing/slash_record.proto", fileDescriptor_6e233c0d36d28a72)
+}
+
+var fileDescriptor_6e233c0d36d28a72 = []byte{
+	// 356 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x51, 0xcd, 0x6e, 0xe2, 0x30,
+	0x18, 0x4c, 0x58, 0x7e, 0x76, 0xcd, 0xee, 0xc5, 0xcb, 0x21, 0xcb, 0x21, 0xa0, 0x3d, 0x2c, 0x5c,
+	0xd6, 0x16, 0xbb, 0x4f, 0x50, 0xca, 0xa5, 0x97, 0xaa, 0x0a, 0xb7, 0x5e, 0x90, 0xe3, 0x7c, 0x0a,
+	0x16, 0xc1, 0x4e, 0x63, 0x83, 0xda, 0xb7, 0xe8, 0x63, 0x71, 0xe4, 0x58, 0x55, 0x2a, 0xaa, 0xe0,
+	0x45, 0x2a, 0x3b, 0x69, 0x44, 0x6f, 0x3d, 0x7d, 0x19, 0x7d, 0x33, 0xf3, 0x65, 0x3c, 0x68, 0x94,
+	0xb1, 0x2d, 0x93, 0x60, 0xa8, 0x9d, 0x34, 0x67, 0xa2, 0x10, 0x32, 0xa5, 0x3a, 0x63, 0x7a, 0xb9,
+	0x28, 0x80, 0xab, 0x22, 0x21, 0x79, 0xa1, 0x8c, 0xc2, 0xbd, 0x8a, 0x48, 0xec, 0x24, 0x15, 0xb1,
+	0xdf, 0x4b, 0x55, 0xaa, 0x1c, 0x81, 0xda, 0xaf, 0x92, 0xdb, 0x0f, 0xb9, 0xd2, 0x6b, 0xa5, 0x69,
+	0xcc, 0x34, 0xd0, 0xed, 0x24, 0x06, 0xc3, 0x26, 0x94, 0x2b, 0x21, 0xcb, 0xfd, 0xef, 0x97, 0x06,
+	0xea, 0xce, 0xed, 0x89, 0xc8, 0x5d, 0xc0, 0x7d, 0xf4, 0x35, 0x2f, 0xd4, 0x56, 0x24, 0x50, 0x04,
+	0xfe, 0xd0, 0x1f, 0x7f, 0x8b, 0x6a, 0x8c, 0x03, 0xd4, 0xe1, 0x4b, 0x26, 0xe4, 0xd5, 0x2c, 0x68,
+	0xb8, 0xd5, 0x3b, 0xc4, 0x3d, 0xd4, 0x8a, 0x33, 0xc5, 0x57, 0xc1, 0x97, 0xa1, 0x3f, 0x6e, 0x46,
+	0x25, 0xb0, 0x5e, 0x1a, 0xee, 0x36, 0x20, 0x39, 0x04, 0x4d, 0xb7, 0xa8, 0x31, 0xbe, 0x46, 0x28,
+	0x87, 0x82, 0x83, 0x34, 0x2c, 0x85, 0xa0, 0x65, 0xed, 0xa6, 0x64, 0x77, 0x18, 0x78, 0xcf, 0x87,
+	0xc1, 0x9f, 0x54, 0x98, 0xe5, 0x26, 0x26, 0x5c, 0xad, 0x69, 0xf5, 0xfb, 0xe5, 0xf8, 0xab, 0x93,
+	0x15, 0x35, 0x0f, 0x39, 0x68, 0x32, 0x03, 0x1e, 0x9d, 0x39, 0xe0, 0x19, 0xfa, 0xa1, 0x0d, 0x5b,
+	0xc1, 0xc2, 0xbd, 0x17, 0x24, 0x41, 0x7b, 0xe8, 0x8f, 0xbb, 0xff, 0x7e, 0x91, 0x52, 0x49, 0x6c,
+	0x7e, 0x52, 0xe5, 0x27, 0x97, 0x4a, 0xc8, 0x69, 0xd3, 0x5e, 0x8b, 0xbe, 0x3b, 0xd5, 0xbc, 0x14,
+	0xe1, 0x1b, 0xf4, 0x33, 0x81, 0x0c, 0x52, 0x66, 0x84, 0x92, 0xba, 0xf6, 0xea, 0x7c, 0xce, 0x0b,
+	0x9f, 0x69, 0x2b, 0xc7, 0xe9, 0xc5, 0xee, 0x18, 0xfa, 0xfb, 0x63, 0xe8, 0xbf, 0x1e, 0x43, 0xff,
+	0xf1, 0x14, 0x7a, 0xfb, 0x53, 0xe8, 0x3d, 0x9d, 0x42, 0xef, 0x76, 0x74, 0x96, 0xf2, 0x43, 0xf3,
+	0xf7, 0x75, 0xf7, 0x2e, 0x6a, 0xdc, 0x76, 0x4d, 0xfd, 0x7f, 0x1b, 0x00, 0xca, 0x58, 0x97, 0x0c,
+	0x20, 0x02, 0x00, 0x00,
+}
+
+func (m *SlashRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SlashRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SlashRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.DelegationsSlashed.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintSlashRecord(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size, err := m.StakeSlashed.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintSlashRecord(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.Percentage.Size()
+		i -= size
+		if _, err := m.Percentage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashRecord(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.Sequence != 0 {
+		i = encodeVarintSlashRecord(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Block != 0 {
+		i = encodeVarintSlashRecord(dAtA, i, uint64(m.Block))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintSlashRecord(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintSlashRecord(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSlashRecord(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSlashRecord(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SlashRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovSlashRecord(uint64(l))
+	}
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovSlashRecord(uint64(l))
+	}
+	if m.Block != 0 {
+		n += 1 + sovSlashRecord(uint64(m.Block))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovSlashRecord(uint64(m.Sequence))
+	}
+	l = m.Percentage.Size()
+	n += 1 + l + sovSlashRecord(uint64(l))
+	l = m.StakeSlashed.Size()
+	n += 1 + l + sovSlashRecord(uint64(l))
+	l = m.DelegationsSlashed.Size()
+	n += 1 + l + sovSlashRecord(uint64(l))
+	return n
+}
+
+func sovSlashRecord(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSlashRecord(x uint64) (n int) {
+	return sovSlashRecord(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SlashRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashRecord
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SlashRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SlashRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+			}
+			m.Block = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Block |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Percentage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Percentage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakeSlashed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashRecord
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StakeSlashed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationsSlashed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashRecord
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DelegationsSlashed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashRecord(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSlashRecord
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipSlashRecord(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowSlashRecord
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSlashRecord
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSlashRecord
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthSlashRecord
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupSlashRecord
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthSlashRecord
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthSlashRecord        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowSlashRecord          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupSlashRecord = fmt.Errorf("proto: unexpected end of group")
+)
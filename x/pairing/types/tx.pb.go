@@ -534,6 +534,94 @@ func (m *MsgUnfreezeProviderResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUnfreezeProviderResponse proto.InternalMessageInfo
 
+type MsgUnjail struct {
+	Creator  string   `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	ChainIds []string `protobuf:"bytes,2,rep,name=chainIds,proto3" json:"chainIds,omitempty"`
+}
+
+func (m *MsgUnjail) Reset()         { *m = MsgUnjail{} }
+func (m *MsgUnjail) String() string { return proto.CompactTextString(m) }
+func (*MsgUnjail) ProtoMessage()    {}
+func (*MsgUnjail) Descriptor() ([]byte, []int) {
+	return fileDescriptor_07b85a84d2198a91, []int{10}
+}
+func (m *MsgUnjail) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnjail) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnjail.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnjail) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnjail.Merge(m, src)
+}
+func (m *MsgUnjail) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnjail) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnjail.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnjail proto.InternalMessageInfo
+
+func (m *MsgUnjail) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgUnjail) GetChainIds() []string {
+	if m != nil {
+		return m.ChainIds
+	}
+	return nil
+}
+
+type MsgUnjailResponse struct {
+}
+
+func (m *MsgUnjailResponse) Reset()         { *m = MsgUnjailResponse{} }
+func (m *MsgUnjailResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUnjailResponse) ProtoMessage()    {}
+func (*MsgUnjailResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_07b85a84d2198a91, []int{11}
+}
+func (m *MsgUnjailResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnjailResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnjailResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnjailResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnjailResponse.Merge(m, src)
+}
+func (m *MsgUnjailResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnjailResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnjailResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnjailResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgStakeProvider)(nil), "lavanet.lava.pairing.MsgStakeProvider")
 	proto.RegisterType((*MsgStakeProviderResponse)(nil), "lavanet.lava.pairing.MsgStakeProviderResponse")
@@ -545,53 +633,57 @@ func init() {
 	proto.RegisterType((*MsgFreezeProviderResponse)(nil), "lavanet.lava.pairing.MsgFreezeProviderResponse")
 	proto.RegisterType((*MsgUnfreezeProvider)(nil), "lavanet.lava.pairing.MsgUnfreezeProvider")
 	proto.RegisterType((*MsgUnfreezeProviderResponse)(nil), "lavanet.lava.pairing.MsgUnfreezeProviderResponse")
+	proto.RegisterType((*MsgUnjail)(nil), "lavanet.lava.pairing.MsgUnjail")
+	proto.RegisterType((*MsgUnjailResponse)(nil), "lavanet.lava.pairing.MsgUnjailResponse")
 }
 
 func init() { proto.RegisterFile("lavanet/lava/pairing/tx.proto", fileDescriptor_07b85a84d2198a91) }
 
 var fileDescriptor_07b85a84d2198a91 = []byte{
-	// 653 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x4b, 0x4f, 0xd5, 0x40,
-	0x14, 0xbe, 0xe5, 0x96, 0x0b, 0xf7, 0x20, 0xaf, 0x42, 0xb4, 0x14, 0xa9, 0x4d, 0x8d, 0x52, 0x13,
-	0x6d, 0x05, 0x17, 0x26, 0xee, 0x04, 0xc5, 0xe7, 0x4d, 0x48, 0x89, 0x1b, 0x37, 0x66, 0x6e, 0xef,
-	0x38, 0x8c, 0xdc, 0xce, 0x34, 0x9d, 0x81, 0x80, 0xbf, 0xc2, 0xbd, 0x2b, 0xff, 0x0d, 0x4b, 0x96,
-	0xae, 0x8c, 0x81, 0xdf, 0x61, 0x62, 0xfa, 0x84, 0xde, 0x07, 0x69, 0xa2, 0xab, 0xf6, 0xf4, 0x7c,
-	0xe7, 0x7c, 0xdf, 0x39, 0xf3, 0x35, 0x03, 0x6b, 0x7d, 0x74, 0x84, 0x18, 0x96, 0x5e, 0xf2, 0xf4,
-	0x22, 0x44, 0x63, 0xca, 0x88, 0x27, 0x8f, 0xdd, 0x28, 0xe6, 0x92, 0x6b, 0xcb, 0x79, 0xda, 0x4d,
-	0x9e, 0x6e, 0x9e, 0x36, 0xcc, 0x80, 0x8b, 0x90, 0x0b, 0xaf, 0x8b, 0x04, 0xf6, 0x8e, 0x36, 0xba,
-	0x58, 0xa2, 0x0d, 0x2f, 0xe0, 0x94, 0x65, 0x55, 0xc6, 0x32, 0xe1, 0x84, 0xa7, 0xaf, 0x5e, 0xf2,
-	0x96, 0x7f, 0x75, 0x2a, 0x54, 0x38, 0xe2, 0xc1, 0xbe, 0x90, 0x3c, 0x46, 0x04, 0x7b, 0x98, 0xf5,
-	0x22, 0x4e, 0x99, 0xcc, 0x91, 0xd6, 0x48, 0x51, 0x31, 0xee, 0xa3, 0x93, 0x0c, 0x61, 0xff, 0x99,
-	0x80, 0x85, 0x8e, 0x20, 0x7b, 0x12, 0x1d, 0xe0, 0xdd, 0x98, 0x1f, 0xd1, 0x1e, 0x8e, 0x35, 0x1d,
-	0xa6, 0x82, 0x18, 0x23, 0xc9, 0x63, 0x5d, 0xb1, 0x14, 0xa7, 0xed, 0x17, 0x61, 0x9a, 0xd9, 0x47,
-	0x94, 0xbd, 0x79, 0xa1, 0x4f, 0xe4, 0x99, 0x2c, 0xd4, 0x9e, 0x42, 0x0b, 0x85, 0xfc, 0x90, 0x49,
-	0xbd, 0x69, 0x29, 0xce, 0xcc, 0xe6, 0x8a, 0x9b, 0xcd, 0xe6, 0x26, 0xb3, 0xb9, 0xf9, 0x6c, 0xee,
-	0x36, 0xa7, 0x6c, 0x4b, 0x3d, 0xfd, 0x75, 0xa7, 0xe1, 0xe7, 0x70, 0xed, 0x15, 0xb4, 0x0b, 0xd5,
-	0x42, 0x57, 0xad, 0xa6, 0x33, 0xb3, 0x79, 0xd7, 0xad, 0x6c, 0xeb, 0xea, 0x84, 0xee, 0xcb, 0x1c,
-	0x9b, 0x77, 0xb9, 0xac, 0xd5, 0x2c, 0x98, 0x21, 0x98, 0xf7, 0x79, 0x80, 0x24, 0xe5, 0x4c, 0x9f,
-	0xb4, 0x14, 0x67, 0xd2, 0xbf, 0xfa, 0x29, 0x51, 0x1f, 0x72, 0x46, 0x0f, 0x70, 0xac, 0xb7, 0x32,
-	0xf5, 0x79, 0xa8, 0xed, 0xc0, 0x5c, 0x0f, 0xf7, 0x31, 0x41, 0x12, 0x7f, 0xea, 0xd3, 0x90, 0x4a,
-	0x7d, 0xaa, 0xde, 0x14, 0xb3, 0x45, 0xd9, 0xfb, 0xa4, 0x4a, 0xf3, 0x60, 0xa9, 0xec, 0x13, 0xf0,
-	0x30, 0xa4, 0x42, 0x24, 0x5a, 0xa6, 0x2d, 0xc5, 0x51, 0x7d, 0xad, 0x48, 0x6d, 0x97, 0x19, 0xdb,
-	0x00, 0x7d, 0x70, 0xfd, 0x3e, 0x16, 0x11, 0x67, 0x02, 0xdb, 0xaf, 0x41, 0xeb, 0x08, 0xf2, 0x81,
-	0x89, 0x7f, 0x3d, 0x1c, 0xfb, 0x36, 0x18, 0xc3, 0x9d, 0x4a, 0x9e, 0xef, 0x0a, 0xcc, 0x77, 0x04,
-	0xf1, 0x13, 0x5b, 0xec, 0xa2, 0x93, 0x10, 0x33, 0x79, 0x0d, 0xcb, 0x33, 0x68, 0xa5, 0x06, 0x12,
-	0xfa, 0x44, 0x7a, 0x58, 0xb6, 0x3b, 0xca, 0xda, 0x6e, 0xda, 0x6d, 0x0f, 0xa7, 0x53, 0xfa, 0x79,
-	0x85, 0xf6, 0x10, 0x16, 0x7b, 0x58, 0x04, 0x31, 0x8d, 0x92, 0xf3, 0xd8, 0x93, 0x09, 0x52, 0x57,
-	0xd3, 0xfe, 0xc3, 0x89, 0xb7, 0xea, 0x74, 0x73, 0x41, 0xb5, 0x57, 0xe0, 0xd6, 0x80, 0xb8, 0x52,
-	0x38, 0x82, 0xc5, 0x8e, 0x20, 0x3b, 0x31, 0xc6, 0x5f, 0xeb, 0xec, 0xc7, 0x80, 0xe9, 0x6c, 0x21,
-	0xbd, 0x4c, 0x7b, 0xdb, 0x2f, 0x63, 0xed, 0x66, 0x32, 0x15, 0x12, 0x9c, 0xa5, 0xf6, 0x6d, 0xfb,
-	0x79, 0x64, 0xaf, 0xc2, 0xca, 0x10, 0x45, 0xc9, 0xff, 0x0e, 0x96, 0xd2, 0xb5, 0x7e, 0xfe, 0x0f,
-	0x0a, 0xec, 0x35, 0x58, 0x1d, 0xd1, 0xac, 0xe0, 0xda, 0xfc, 0xa1, 0x42, 0xb3, 0x23, 0x88, 0x46,
-	0x60, 0xb6, 0xfa, 0xb3, 0xde, 0x1f, 0xbd, 0xff, 0x41, 0x57, 0x19, 0x6e, 0x3d, 0x5c, 0x41, 0xa8,
-	0x85, 0x30, 0x3f, 0x68, 0x3d, 0x67, 0x6c, 0x8b, 0x01, 0xa4, 0xf1, 0xb8, 0x2e, 0xb2, 0xa4, 0xeb,
-	0xc1, 0x8d, 0x8a, 0x01, 0xef, 0x8d, 0xed, 0x70, 0x15, 0x66, 0x3c, 0xaa, 0x05, 0x2b, 0x59, 0xbe,
-	0xc0, 0xdc, 0x80, 0x5d, 0xd6, 0xc7, 0x36, 0xa8, 0x02, 0x0d, 0xaf, 0x26, 0xb0, 0xe4, 0x8a, 0x60,
-	0x61, 0xc8, 0x1a, 0x0f, 0xae, 0xd9, 0x4b, 0x15, 0x6a, 0x6c, 0xd4, 0x86, 0x16, 0x8c, 0x5b, 0xcf,
-	0x4f, 0xcf, 0x4d, 0xe5, 0xec, 0xdc, 0x54, 0x7e, 0x9f, 0x9b, 0xca, 0xb7, 0x0b, 0xb3, 0x71, 0x76,
-	0x61, 0x36, 0x7e, 0x5e, 0x98, 0x8d, 0x8f, 0xeb, 0x84, 0xca, 0xfd, 0xc3, 0xae, 0x1b, 0xf0, 0xd0,
-	0xab, 0xdc, 0x09, 0xc7, 0x97, 0x57, 0xd5, 0x49, 0x84, 0x45, 0xb7, 0x95, 0x5e, 0x0b, 0x4f, 0xfe,
-	0x06, 0x00, 0x00, 0xff, 0xff, 0x29, 0x7b, 0xfb, 0x24, 0xcf, 0x06, 0x00, 0x00,
+	// 685 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x4d, 0x4f, 0xdc, 0x48,
+	0x10, 0x1d, 0x33, 0x66, 0x60, 0x8a, 0xe5, 0xcb, 0xa0, 0x5d, 0x63, 0x16, 0x63, 0x79, 0xb5, 0xc1,
+	0x91, 0x12, 0x3b, 0x90, 0x43, 0xa4, 0xdc, 0x80, 0x84, 0x7c, 0x8e, 0x84, 0x8c, 0x72, 0xc9, 0x25,
+	0xea, 0xf1, 0x74, 0x4c, 0xc3, 0xb8, 0xdb, 0x72, 0x37, 0x08, 0xf2, 0x2b, 0x72, 0xcf, 0x1f, 0xe2,
+	0xc8, 0x31, 0xa7, 0x28, 0x82, 0xdf, 0x90, 0x63, 0xa4, 0xc8, 0x76, 0xdb, 0xe0, 0x99, 0x81, 0x58,
+	0x49, 0x4e, 0x76, 0xb9, 0x5e, 0xbd, 0x57, 0x55, 0xfd, 0xe4, 0x86, 0x95, 0x3e, 0x3a, 0x46, 0x14,
+	0x0b, 0x2f, 0x7d, 0x7a, 0x31, 0x22, 0x09, 0xa1, 0xa1, 0x27, 0x4e, 0xdc, 0x38, 0x61, 0x82, 0x69,
+	0x8b, 0x32, 0xed, 0xa6, 0x4f, 0x57, 0xa6, 0x0d, 0x33, 0x60, 0x3c, 0x62, 0xdc, 0xeb, 0x22, 0x8e,
+	0xbd, 0xe3, 0xf5, 0x2e, 0x16, 0x68, 0xdd, 0x0b, 0x18, 0xa1, 0x79, 0x95, 0xb1, 0x18, 0xb2, 0x90,
+	0x65, 0xaf, 0x5e, 0xfa, 0x26, 0xbf, 0x3a, 0x15, 0x29, 0x1c, 0xb3, 0x60, 0x9f, 0x0b, 0x96, 0xa0,
+	0x10, 0x7b, 0x98, 0xf6, 0x62, 0x46, 0xa8, 0x90, 0x48, 0x6b, 0x64, 0x53, 0x09, 0xee, 0xa3, 0xd3,
+	0x1c, 0x61, 0x7f, 0x1f, 0x83, 0xb9, 0x0e, 0x0f, 0xf7, 0x04, 0x3a, 0xc4, 0xbb, 0x09, 0x3b, 0x26,
+	0x3d, 0x9c, 0x68, 0x3a, 0x4c, 0x04, 0x09, 0x46, 0x82, 0x25, 0xba, 0x62, 0x29, 0x4e, 0xdb, 0x2f,
+	0xc2, 0x2c, 0xb3, 0x8f, 0x08, 0x7d, 0xf1, 0x44, 0x1f, 0x93, 0x99, 0x3c, 0xd4, 0x1e, 0x41, 0x0b,
+	0x45, 0xec, 0x88, 0x0a, 0xbd, 0x69, 0x29, 0xce, 0xd4, 0xc6, 0x92, 0x9b, 0xcf, 0xe6, 0xa6, 0xb3,
+	0xb9, 0x72, 0x36, 0x77, 0x9b, 0x11, 0xba, 0xa5, 0x9e, 0x7d, 0x59, 0x6d, 0xf8, 0x12, 0xae, 0x3d,
+	0x83, 0x76, 0xd1, 0x35, 0xd7, 0x55, 0xab, 0xe9, 0x4c, 0x6d, 0xfc, 0xe7, 0x56, 0xb6, 0x75, 0x7d,
+	0x42, 0xf7, 0xa9, 0xc4, 0x4a, 0x96, 0xab, 0x5a, 0xcd, 0x82, 0xa9, 0x10, 0xb3, 0x3e, 0x0b, 0x90,
+	0x20, 0x8c, 0xea, 0xe3, 0x96, 0xe2, 0x8c, 0xfb, 0xd7, 0x3f, 0xa5, 0xdd, 0x47, 0x8c, 0x92, 0x43,
+	0x9c, 0xe8, 0xad, 0xbc, 0x7b, 0x19, 0x6a, 0x3b, 0x30, 0xd3, 0xc3, 0x7d, 0x1c, 0x22, 0x81, 0xdf,
+	0xf5, 0x49, 0x44, 0x84, 0x3e, 0x51, 0x6f, 0x8a, 0xe9, 0xa2, 0xec, 0x75, 0x5a, 0xa5, 0x79, 0xb0,
+	0x50, 0xf2, 0x04, 0x2c, 0x8a, 0x08, 0xe7, 0x69, 0x2f, 0x93, 0x96, 0xe2, 0xa8, 0xbe, 0x56, 0xa4,
+	0xb6, 0xcb, 0x8c, 0x6d, 0x80, 0x3e, 0xb8, 0x7e, 0x1f, 0xf3, 0x98, 0x51, 0x8e, 0xed, 0xe7, 0xa0,
+	0x75, 0x78, 0xf8, 0x86, 0xf2, 0xdf, 0x3d, 0x1c, 0xfb, 0x5f, 0x30, 0x86, 0x99, 0x4a, 0x9d, 0x4f,
+	0x0a, 0xcc, 0x76, 0x78, 0xe8, 0xa7, 0xb6, 0xd8, 0x45, 0xa7, 0x11, 0xa6, 0xe2, 0x16, 0x95, 0xc7,
+	0xd0, 0xca, 0x0c, 0xc4, 0xf5, 0xb1, 0xec, 0xb0, 0x6c, 0x77, 0x94, 0xb5, 0xdd, 0x8c, 0x6d, 0x0f,
+	0x67, 0x53, 0xfa, 0xb2, 0x42, 0xbb, 0x07, 0xf3, 0x3d, 0xcc, 0x83, 0x84, 0xc4, 0xe9, 0x79, 0xec,
+	0x89, 0x14, 0xa9, 0xab, 0x19, 0xff, 0x70, 0xe2, 0xa5, 0x3a, 0xd9, 0x9c, 0x53, 0xed, 0x25, 0xf8,
+	0x67, 0xa0, 0xb9, 0xb2, 0x71, 0x04, 0xf3, 0x1d, 0x1e, 0xee, 0x24, 0x18, 0x7f, 0xa8, 0xb3, 0x1f,
+	0x03, 0x26, 0xf3, 0x85, 0xf4, 0xf2, 0xde, 0xdb, 0x7e, 0x19, 0x6b, 0x7f, 0xa7, 0x53, 0x21, 0xce,
+	0x68, 0x66, 0xdf, 0xb6, 0x2f, 0x23, 0x7b, 0x19, 0x96, 0x86, 0x24, 0x4a, 0xfd, 0x57, 0xb0, 0x90,
+	0xad, 0xf5, 0xfd, 0x1f, 0xe8, 0xc0, 0x5e, 0x81, 0xe5, 0x11, 0x64, 0xa5, 0xd6, 0x26, 0xb4, 0xb3,
+	0xf4, 0x01, 0x22, 0xfd, 0x5f, 0x54, 0x58, 0x80, 0xf9, 0x92, 0xa2, 0xe0, 0xdd, 0xf8, 0xa6, 0x42,
+	0xb3, 0xc3, 0x43, 0x2d, 0x84, 0xe9, 0xea, 0x4f, 0xe0, 0xce, 0xe8, 0x73, 0x1d, 0x74, 0xab, 0xe1,
+	0xd6, 0xc3, 0x15, 0x82, 0x5a, 0x04, 0xb3, 0x83, 0x96, 0x76, 0x6e, 0xa4, 0x18, 0x40, 0x1a, 0x0f,
+	0xea, 0x22, 0x4b, 0xb9, 0x1e, 0xfc, 0x55, 0x31, 0xf6, 0xff, 0x37, 0x32, 0x5c, 0x87, 0x19, 0xf7,
+	0x6b, 0xc1, 0x4a, 0x95, 0x03, 0x98, 0x19, 0xb0, 0xe1, 0xda, 0x8d, 0x04, 0x55, 0xa0, 0xe1, 0xd5,
+	0x04, 0x96, 0x5a, 0x31, 0xcc, 0x0d, 0x59, 0xee, 0xee, 0x2d, 0x7b, 0xa9, 0x42, 0x8d, 0xf5, 0xda,
+	0xd0, 0x52, 0xd1, 0x87, 0x96, 0x34, 0xde, 0xea, 0x2d, 0xc5, 0x29, 0xc0, 0x58, 0xfb, 0x09, 0xa0,
+	0xe0, 0xdc, 0xda, 0x3c, 0xbb, 0x30, 0x95, 0xf3, 0x0b, 0x53, 0xf9, 0x7a, 0x61, 0x2a, 0x1f, 0x2f,
+	0xcd, 0xc6, 0xf9, 0xa5, 0xd9, 0xf8, 0x7c, 0x69, 0x36, 0xde, 0xae, 0x85, 0x44, 0xec, 0x1f, 0x75,
+	0xdd, 0x80, 0x45, 0x5e, 0xe5, 0xfe, 0x3a, 0xb9, 0xba, 0x56, 0x4f, 0x63, 0xcc, 0xbb, 0xad, 0xec,
+	0x0a, 0x7b, 0xf8, 0x63, 0x00, 0x02, 0xe2, 0xb9, 0xe6, 0x7b, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -611,6 +703,7 @@ type MsgClient interface {
 	RelayPayment(ctx context.Context, in *MsgRelayPayment, opts ...grpc.CallOption) (*MsgRelayPaymentResponse, error)
 	FreezeProvider(ctx context.Context, in *MsgFreezeProvider, opts ...grpc.CallOption) (*MsgFreezeProviderResponse, error)
 	UnfreezeProvider(ctx context.Context, in *MsgUnfreezeProvider, opts ...grpc.CallOption) (*MsgUnfreezeProviderResponse, error)
+	Unjail(ctx context.Context, in *MsgUnjail, opts ...grpc.CallOption) (*MsgUnjailResponse, error)
 }
 
 type msgClient struct {
@@ -666,6 +759,15 @@ func (c *msgClient) UnfreezeProvider(ctx context.Context, in *MsgUnfreezeProvide
 	return out, nil
 }
 
+func (c *msgClient) Unjail(ctx context.Context, in *MsgUnjail, opts ...grpc.CallOption) (*MsgUnjailResponse, error) {
+	out := new(MsgUnjailResponse)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.Msg/Unjail", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	StakeProvider(context.Context, *MsgStakeProvider) (*MsgStakeProviderResponse, error)
@@ -673,6 +775,7 @@ type MsgServer interface {
 	RelayPayment(context.Context, *MsgRelayPayment) (*MsgRelayPaymentResponse, error)
 	FreezeProvider(context.Context, *MsgFreezeProvider) (*MsgFreezeProviderResponse, error)
 	UnfreezeProvider(context.Context, *MsgUnfreezeProvider) (*MsgUnfreezeProviderResponse, error)
+	Unjail(context.Context, *MsgUnjail) (*MsgUnjailResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -694,6 +797,9 @@ func (*UnimplementedMsgServer) FreezeProvider(ctx context.Context, req *MsgFreez
 func (*UnimplementedMsgServer) UnfreezeProvider(ctx context.Context, req *MsgUnfreezeProvider) (*MsgUnfreezeProviderResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UnfreezeProvider not implemented")
 }
+func (*UnimplementedMsgServer) Unjail(ctx context.Context, req *MsgUnjail) (*MsgUnjailResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unjail not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -789,6 +895,24 @@ func _Msg_UnfreezeProvider_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_Unjail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnjail)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Unjail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.pairing.Msg/Unjail",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Unjail(ctx, req.(*MsgUnjail))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "lavanet.lava.pairing.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -813,6 +937,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UnfreezeProvider",
 			Handler:    _Msg_UnfreezeProvider_Handler,
 		},
+		{
+			MethodName: "Unjail",
+			Handler:    _Msg_Unjail_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "lavanet/lava/pairing/tx.proto",
@@ -1194,6 +1322,68 @@ func (m *MsgUnfreezeProviderResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgUnjail) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnjail) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnjail) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainIds) > 0 {
+		for iNdEx := len(m.ChainIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ChainIds[iNdEx])
+			copy(dAtA[i:], m.ChainIds[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.ChainIds[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnjailResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnjailResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnjailResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1369,6 +1559,34 @@ func (m *MsgUnfreezeProviderResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgUnjail) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.ChainIds) > 0 {
+		for _, s := range m.ChainIds {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUnjailResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2431,6 +2649,170 @@ func (m *MsgUnfreezeProviderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgUnjail) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnjail: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnjail: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainIds = append(m.ChainIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnjailResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnjailResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnjailResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1,6 +1,10 @@
 package types
 
-import math "math"
+import (
+	math "math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 const (
 	ProviderStakeEventName       = "stake_new_provider"
@@ -12,6 +16,10 @@ const (
 	UnresponsiveProviderUnstakeFailedEventName     = "unresponsive_provider"
 	ProviderJailedEventName                        = "provider_jailed"
 	ProviderReportedEventName                      = "provider_reported"
+	ProviderSlashedEventName                       = "provider_slashed"
+	ProviderUnjailedEventName                      = "provider_unjailed"
+	ProviderDelegationChangeScheduledEventName     = "provider_delegation_change_scheduled"
+	ProviderDelegationChangeAppliedEventName       = "provider_delegation_change_applied"
 )
 
 // unstake description strings
@@ -35,6 +43,24 @@ const (
 	EPOCHS_NUM_TO_CHECK_FOR_COMPLAINERS              uint64 = 2 // number of epochs to sum CU of complainers against the provider
 )
 
+// jail consts
+const (
+	JAIL_EPOCHS_BASE    uint64 = 4   // jail duration (in epochs) for the first offense
+	JAIL_ESCALATION_MAX uint64 = 5   // the jail duration doubles on every offense, up to 2^JAIL_ESCALATION_MAX times the base
+	JAIL_DECAY_EPOCHS   uint64 = 100 // one offense is forgiven for every JAIL_DECAY_EPOCHS epochs the provider isn't jailed
+)
+
+// delegation terms change consts
+const (
+	DELEGATION_CHANGE_NOTICE_EPOCHS uint64 = 8 // number of epochs before a change of the delegation terms (that is not in favor of the delegators) is applied
+	MAX_COMMISSION_INCREASE         uint64 = 5 // max delegation commission increase (percentage points) in a single change
+)
+
+// DelegationsSlashCap is the max percentage of a slashed provider's delegations that is
+// slashed: the delegators don't take part in the provider's offense, so they are never
+// slashed more than this even when the provider loses its whole self-stake
+var DelegationsSlashCap = sdk.NewDecWithPrec(1, 1) // 0.1
+
 // Frozen provider block const
 const FROZEN_BLOCK = math.MaxInt64
 
@@ -24,4 +24,5 @@ var (
 	ErrPolicyBasicValidation                = sdkerrors.Register(ModuleName, 14, "invalid policy")
 	ErrPolicyInvalidSelectedProvidersConfig = sdkerrors.Register(ModuleName, 15, "plan's selected providers config is invalid")
 	ErrPolicyGeolocation                    = sdkerrors.Register(ModuleName, 16, "plan's geolocation is invalid")
+	ErrPolicyQualityRequirements            = sdkerrors.Register(ModuleName, 17, "policy's providers quality requirements are invalid")
 )
@@ -136,6 +136,10 @@ func (policy Policy) ValidateBasicPolicy(isPlanPolicy bool) error {
 		return sdkerrors.Wrap(ErrPolicyGeolocation, `cannot configure geolocation = GLS (0)`)
 	}
 
+	if policy.MinQosExcellence > 100 {
+		return sdkerrors.Wrapf(ErrPolicyQualityRequirements, "invalid policy's MinQosExcellence field, must be a percentage (MinQosExcellence = %v)", policy.MinQosExcellence)
+	}
+
 	seen := map[string]bool{}
 	for _, addr := range policy.SelectedProviders {
 		_, err := sdk.AccAddressFromBech32(addr)
@@ -66,6 +66,10 @@ type Policy struct {
 	MaxProvidersToPair    uint64                  `protobuf:"varint,5,opt,name=max_providers_to_pair,json=maxProvidersToPair,proto3" json:"max_providers_to_pair"`
 	SelectedProvidersMode SELECTED_PROVIDERS_MODE `protobuf:"varint,6,opt,name=selected_providers_mode,json=selectedProvidersMode,proto3,enum=lavanet.lava.plans.SELECTED_PROVIDERS_MODE" json:"selected_providers_mode"`
 	SelectedProviders     []string                `protobuf:"bytes,7,rep,name=selected_providers,json=selectedProviders,proto3" json:"selected_providers"`
+	MinQosExcellence      uint64                  `protobuf:"varint,8,opt,name=min_qos_excellence,json=minQosExcellence,proto3" json:"min_qos_excellence"`
+	ExcludePunishedEpochs uint64                  `protobuf:"varint,9,opt,name=exclude_punished_epochs,json=excludePunishedEpochs,proto3" json:"exclude_punished_epochs"`
+	MinSelfStakeRatio     uint64                  `protobuf:"varint,10,opt,name=min_self_stake_ratio,json=minSelfStakeRatio,proto3" json:"min_self_stake_ratio"`
+	QualityMixed          bool                    `protobuf:"varint,11,opt,name=quality_mixed,json=qualityMixed,proto3" json:"quality_mixed"`
 }
 
 func (m *Policy) Reset()         { *m = Policy{} }
@@ -150,6 +154,34 @@ func (m *Policy) GetSelectedProviders() []string {
 	return nil
 }
 
+func (m *Policy) GetMinQosExcellence() uint64 {
+	if m != nil {
+		return m.MinQosExcellence
+	}
+	return 0
+}
+
+func (m *Policy) GetExcludePunishedEpochs() uint64 {
+	if m != nil {
+		return m.ExcludePunishedEpochs
+	}
+	return 0
+}
+
+func (m *Policy) GetMinSelfStakeRatio() uint64 {
+	if m != nil {
+		return m.MinSelfStakeRatio
+	}
+	return 0
+}
+
+func (m *Policy) GetQualityMixed() bool {
+	if m != nil {
+		return m.QualityMixed
+	}
+	return false
+}
+
 type ChainPolicy struct {
 	ChainId      string             `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id"`
 	Apis         []string           `protobuf:"bytes,2,rep,name=apis,proto3" json:"apis"`
@@ -280,50 +312,58 @@ func init() {
 func init() { proto.RegisterFile("lavanet/lava/plans/policy.proto", fileDescriptor_c2388e0faa8deb9b) }
 
 var fileDescriptor_c2388e0faa8deb9b = []byte{
-	// 678 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x54, 0xcb, 0x6e, 0xda, 0x4a,
-	0x18, 0x66, 0x02, 0x24, 0x30, 0x10, 0xc4, 0x99, 0x93, 0x8b, 0x4f, 0xce, 0x91, 0xcd, 0x89, 0x7a,
-	0x41, 0xad, 0x64, 0x2b, 0xe9, 0xa6, 0xdb, 0x18, 0x5b, 0x2a, 0x12, 0x69, 0xd0, 0x90, 0xa4, 0x51,
-	0x17, 0xb1, 0x06, 0x33, 0x25, 0x23, 0xd9, 0x8c, 0x6b, 0x9b, 0x88, 0xec, 0xfa, 0x08, 0x7d, 0x8c,
-	0x3e, 0x40, 0x57, 0x5d, 0x74, 0x9d, 0x65, 0x96, 0x5d, 0x59, 0x15, 0xd9, 0xf9, 0x29, 0x2a, 0x0f,
-	0x57, 0x27, 0x64, 0xc3, 0xfc, 0x97, 0xef, 0xfb, 0xff, 0x0f, 0xf8, 0x66, 0xa0, 0xe2, 0x90, 0x6b,
-	0x32, 0xa0, 0xa1, 0x96, 0x9c, 0x9a, 0xe7, 0x90, 0x41, 0xa0, 0x79, 0xdc, 0x61, 0xf6, 0x8d, 0xea,
-	0xf9, 0x3c, 0xe4, 0x08, 0x4d, 0x01, 0x6a, 0x72, 0xaa, 0x02, 0xb0, 0xb7, 0xd5, 0xe7, 0x7d, 0x2e,
-	0xda, 0x5a, 0x12, 0x4d, 0x90, 0x7b, 0xb2, 0xcd, 0x03, 0x97, 0x07, 0x5a, 0x97, 0x04, 0x54, 0xbb,
-	0x3e, 0xe8, 0xd2, 0x90, 0x1c, 0x68, 0x36, 0x67, 0x83, 0x69, 0xff, 0x45, 0x6a, 0x55, 0xe0, 0x51,
-	0x5b, 0x23, 0x1e, 0xb3, 0x6c, 0xee, 0x38, 0xd4, 0x0e, 0x19, 0x9f, 0xe2, 0xf6, 0x7f, 0xe6, 0xe0,
-	0x7a, 0x5b, 0x48, 0x40, 0x97, 0xb0, 0x62, 0x5f, 0x11, 0x36, 0xb0, 0x84, 0x24, 0x46, 0x03, 0x09,
-	0xd4, 0xb2, 0xf5, 0xd2, 0xa1, 0xa2, 0x3e, 0x56, 0xa5, 0x36, 0x12, 0xe4, 0x84, 0xa8, 0xef, 0xdc,
-	0x46, 0x4a, 0x26, 0x8e, 0x94, 0x07, 0x74, 0xbc, 0x69, 0xcf, 0x41, 0x8c, 0x06, 0xe8, 0x1d, 0xfc,
-	0xbb, 0x4f, 0xb9, 0xc3, 0x6d, 0x92, 0xec, 0xb7, 0x3c, 0x9f, 0x7f, 0x62, 0x0e, 0x95, 0xd6, 0x6a,
-	0xa0, 0x9e, 0xd7, 0x77, 0xe3, 0x48, 0x59, 0xd5, 0xc6, 0x68, 0xa9, 0xd8, 0x9e, 0xd4, 0xd0, 0x5b,
-	0x58, 0x09, 0x79, 0x48, 0x1c, 0xcb, 0x1e, 0x5a, 0x0e, 0x73, 0x59, 0x28, 0x65, 0x6b, 0xa0, 0x9e,
-	0xd3, 0x51, 0x22, 0x22, 0xdd, 0xc1, 0x65, 0x91, 0x37, 0x86, 0xad, 0x24, 0x4b, 0x98, 0xd4, 0xe3,
-	0xf6, 0xd5, 0x82, 0x99, 0x5b, 0x30, 0xd3, 0x1d, 0x5c, 0x16, 0xf9, 0x8c, 0xd9, 0x82, 0xdb, 0x2e,
-	0x19, 0x25, 0xb2, 0xae, 0x59, 0x8f, 0xfa, 0x81, 0x15, 0x72, 0xcb, 0x23, 0xcc, 0x97, 0xf2, 0x62,
-	0xc0, 0x3f, 0x71, 0xa4, 0xac, 0x06, 0x60, 0xe4, 0x92, 0x51, 0x7b, 0x56, 0x3d, 0xe5, 0x6d, 0xc2,
-	0x7c, 0xf4, 0x05, 0xc0, 0xdd, 0x80, 0x26, 0x7f, 0x05, 0xed, 0x2d, 0x51, 0x5c, 0xde, 0xa3, 0xd2,
-	0x7a, 0x0d, 0xd4, 0x2b, 0x87, 0xaf, 0x57, 0xfd, 0xea, 0x1d, 0xb3, 0x65, 0x36, 0x4e, 0x4d, 0xc3,
-	0x6a, 0xe3, 0x93, 0xf3, 0xa6, 0x61, 0xe2, 0x8e, 0x75, 0x7c, 0x62, 0x98, 0xfa, 0xbf, 0x71, 0xa4,
-	0x3c, 0x35, 0x0f, 0x6f, 0xcf, 0x1a, 0x73, 0x11, 0xc7, 0xbc, 0x47, 0x91, 0x09, 0xd1, 0x63, 0x86,
-	0xb4, 0x51, 0xcb, 0xd6, 0x8b, 0xfa, 0x4e, 0x1c, 0x29, 0x2b, 0xba, 0xf8, 0xaf, 0x47, 0xa3, 0xf6,
-	0xbf, 0x03, 0x58, 0x5a, 0x32, 0x03, 0x7a, 0x09, 0x0b, 0x13, 0x1b, 0xb0, 0x9e, 0x04, 0x6a, 0xa0,
-	0x5e, 0xd4, 0xcb, 0x71, 0xa4, 0xcc, 0x6b, 0x78, 0x43, 0x44, 0xcd, 0x1e, 0xfa, 0x0f, 0xe6, 0x88,
-	0xc7, 0x02, 0x69, 0x4d, 0x6c, 0x2c, 0xc4, 0x91, 0x22, 0x72, 0x2c, 0x3e, 0xd1, 0x25, 0x2c, 0xfb,
-	0xf4, 0xf3, 0x90, 0xf9, 0xd4, 0xa5, 0x83, 0x30, 0x90, 0xb2, 0xc2, 0x8a, 0xcf, 0x9e, 0xb4, 0x22,
-	0x5e, 0x80, 0xf5, 0xad, 0xa9, 0x1f, 0x53, 0x13, 0x70, 0x2a, 0xdb, 0xff, 0x01, 0x60, 0xf5, 0x21,
-	0x11, 0x9d, 0x41, 0xb8, 0xb8, 0x20, 0x42, 0x7d, 0xe9, 0xf0, 0xff, 0xf4, 0xca, 0xe4, 0x26, 0xa9,
-	0x8d, 0x39, 0xc8, 0x20, 0x21, 0xd1, 0xd1, 0x74, 0xdf, 0x12, 0x19, 0x2f, 0xc5, 0x48, 0x85, 0x90,
-	0x8e, 0x42, 0x3a, 0x08, 0x18, 0x1f, 0xcc, 0xbe, 0x6f, 0x25, 0xc1, 0x2f, 0xaa, 0x78, 0x29, 0x46,
-	0x0a, 0xcc, 0xbb, 0x6c, 0x44, 0x7b, 0xc2, 0xd5, 0x05, 0xbd, 0x18, 0x47, 0xca, 0xa4, 0x80, 0x27,
-	0xc7, 0xab, 0xf7, 0x70, 0xf7, 0x09, 0x27, 0xa0, 0x12, 0xdc, 0x38, 0x6a, 0xb5, 0x4e, 0x3e, 0x98,
-	0x46, 0x35, 0x83, 0x8a, 0x30, 0x7f, 0xdc, 0xbc, 0x30, 0x8d, 0x2a, 0x40, 0x9b, 0xb0, 0x68, 0x5e,
-	0x34, 0x5a, 0x67, 0x9d, 0xe6, 0xb9, 0x59, 0x5d, 0x43, 0x65, 0x58, 0x30, 0x9a, 0x9d, 0x23, 0xbd,
-	0x65, 0x1a, 0xd5, 0xac, 0xde, 0xf8, 0x36, 0x96, 0xc1, 0xed, 0x58, 0x06, 0x77, 0x63, 0x19, 0xfc,
-	0x1e, 0xcb, 0xe0, 0xeb, 0xbd, 0x9c, 0xb9, 0xbb, 0x97, 0x33, 0xbf, 0xee, 0xe5, 0xcc, 0xc7, 0xe7,
-	0x7d, 0x16, 0x5e, 0x0d, 0xbb, 0xaa, 0xcd, 0x5d, 0x2d, 0xf5, 0xaa, 0x8c, 0xa6, 0x4f, 0x58, 0x78,
-	0xe3, 0xd1, 0xa0, 0xbb, 0x2e, 0x1e, 0x94, 0x37, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x08, 0x9a,
-	0x8f, 0xe7, 0xe5, 0x04, 0x00, 0x00,
+	// 809 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x54, 0xcd, 0x6e, 0xe3, 0x36,
+	0x10, 0xb6, 0xe2, 0xfc, 0xd8, 0xb4, 0x13, 0x38, 0x6c, 0xb2, 0x51, 0xb7, 0x85, 0xe5, 0x06, 0xfd,
+	0x31, 0x5a, 0xc0, 0xc2, 0xa6, 0x40, 0xd1, 0xeb, 0xca, 0x12, 0x50, 0x03, 0x4e, 0xe3, 0xd2, 0xbb,
+	0xdb, 0x45, 0x0f, 0x4b, 0x30, 0xd2, 0x24, 0x21, 0x2a, 0x89, 0x8a, 0x29, 0x07, 0xce, 0xad, 0x8f,
+	0xd0, 0xc7, 0xe8, 0x03, 0xf4, 0xd4, 0x27, 0xd8, 0xe3, 0x1e, 0x7b, 0x28, 0x84, 0x22, 0xb9, 0xe9,
+	0x29, 0x16, 0xa4, 0x15, 0xff, 0x24, 0xf6, 0xc5, 0xe4, 0x7c, 0x3f, 0xc3, 0x11, 0xe9, 0x19, 0x64,
+	0x85, 0xec, 0x86, 0xc5, 0x90, 0xda, 0x6a, 0xb5, 0x93, 0x90, 0xc5, 0xd2, 0x4e, 0x44, 0xc8, 0xfd,
+	0xdb, 0x4e, 0x32, 0x12, 0xa9, 0xc0, 0xb8, 0x10, 0x74, 0xd4, 0xda, 0xd1, 0x82, 0xe7, 0x07, 0x97,
+	0xe2, 0x52, 0x68, 0xda, 0x56, 0xbb, 0xa9, 0xf2, 0x79, 0xd3, 0x17, 0x32, 0x12, 0xd2, 0x3e, 0x67,
+	0x12, 0xec, 0x9b, 0x17, 0xe7, 0x90, 0xb2, 0x17, 0xb6, 0x2f, 0x78, 0x5c, 0xf0, 0x5f, 0x2f, 0x1d,
+	0x25, 0x13, 0xf0, 0x6d, 0x96, 0x70, 0xea, 0x8b, 0x30, 0x04, 0x3f, 0xe5, 0xa2, 0xd0, 0x1d, 0xff,
+	0xb7, 0x8d, 0xb6, 0x07, 0xba, 0x04, 0xfc, 0x0e, 0xed, 0xf9, 0x57, 0x8c, 0xc7, 0x54, 0x97, 0xc4,
+	0x41, 0x9a, 0x46, 0xab, 0xdc, 0xae, 0x9d, 0x58, 0x9d, 0xa7, 0x55, 0x75, 0xba, 0x4a, 0x39, 0x35,
+	0x3a, 0xcf, 0xde, 0x67, 0x56, 0x29, 0xcf, 0xac, 0x47, 0x76, 0xb2, 0xeb, 0xcf, 0x44, 0x1c, 0x24,
+	0xfe, 0x09, 0x7d, 0x72, 0x09, 0x22, 0x14, 0x3e, 0x53, 0xe7, 0xd3, 0x64, 0x24, 0x2e, 0x78, 0x08,
+	0xe6, 0x46, 0xcb, 0x68, 0x6f, 0x39, 0x47, 0x79, 0x66, 0xad, 0xa2, 0x09, 0x5e, 0x00, 0x07, 0x53,
+	0x0c, 0xff, 0x88, 0xf6, 0x52, 0x91, 0xb2, 0x90, 0xfa, 0x63, 0x1a, 0xf2, 0x88, 0xa7, 0x66, 0xb9,
+	0x65, 0xb4, 0x37, 0x1d, 0xac, 0x8a, 0x58, 0x66, 0x48, 0x5d, 0xc7, 0xdd, 0x71, 0x5f, 0x45, 0xca,
+	0x09, 0x89, 0xf0, 0xaf, 0xe6, 0xce, 0xcd, 0xb9, 0x73, 0x99, 0x21, 0x75, 0x1d, 0x3f, 0x38, 0xfb,
+	0xe8, 0x30, 0x62, 0x13, 0x55, 0xd6, 0x0d, 0x0f, 0x60, 0x24, 0x69, 0x2a, 0x68, 0xc2, 0xf8, 0xc8,
+	0xdc, 0xd2, 0x09, 0x3e, 0xcd, 0x33, 0x6b, 0xb5, 0x80, 0xe0, 0x88, 0x4d, 0x06, 0x0f, 0xe8, 0x2b,
+	0x31, 0x60, 0x7c, 0x84, 0xff, 0x30, 0xd0, 0x91, 0x04, 0xf5, 0x14, 0x10, 0x2c, 0x58, 0x22, 0x11,
+	0x80, 0xb9, 0xdd, 0x32, 0xda, 0x7b, 0x27, 0xdf, 0xad, 0xba, 0xf5, 0xa1, 0xd7, 0xf7, 0xba, 0xaf,
+	0x3c, 0x97, 0x0e, 0xc8, 0xd9, 0x9b, 0x9e, 0xeb, 0x91, 0x21, 0x3d, 0x3d, 0x73, 0x3d, 0xe7, 0xb3,
+	0x3c, 0xb3, 0xd6, 0xe5, 0x23, 0x87, 0x0f, 0xc4, 0xac, 0x88, 0x53, 0x11, 0x00, 0xf6, 0x10, 0x7e,
+	0xea, 0x30, 0x77, 0x5a, 0xe5, 0x76, 0xd5, 0x79, 0x96, 0x67, 0xd6, 0x0a, 0x96, 0xec, 0x3f, 0x49,
+	0x85, 0x5d, 0x84, 0x23, 0x1e, 0xd3, 0x6b, 0x21, 0x29, 0x4c, 0x7c, 0x08, 0x43, 0x88, 0x7d, 0x30,
+	0x2b, 0xfa, 0x52, 0x74, 0x9a, 0xa7, 0x2c, 0x69, 0x44, 0x3c, 0xfe, 0x45, 0x48, 0x6f, 0x86, 0xe0,
+	0x21, 0x3a, 0x82, 0x89, 0x1f, 0x8e, 0x03, 0xa0, 0xc9, 0x38, 0xe6, 0xf2, 0x0a, 0x02, 0xaa, 0xaf,
+	0x5f, 0x9a, 0x55, 0x9d, 0x4a, 0x7f, 0xe1, 0x1a, 0x09, 0x39, 0x2c, 0x88, 0x41, 0x81, 0x7b, 0x1a,
+	0xc6, 0x3d, 0x74, 0xa0, 0x0e, 0x97, 0x10, 0x5e, 0x50, 0x99, 0xb2, 0xdf, 0x81, 0x8e, 0xd4, 0xdf,
+	0xc8, 0x44, 0x3a, 0xa3, 0x99, 0x67, 0xd6, 0x4a, 0x9e, 0xec, 0x47, 0x3c, 0x1e, 0x42, 0x78, 0x31,
+	0x54, 0x18, 0x51, 0x10, 0xfe, 0x01, 0xed, 0x5e, 0x8f, 0x59, 0xc8, 0xd3, 0x5b, 0x1a, 0xf1, 0x09,
+	0x04, 0x66, 0xad, 0x65, 0xb4, 0x2b, 0xce, 0x7e, 0x9e, 0x59, 0xcb, 0x04, 0xa9, 0x17, 0xe1, 0xa9,
+	0x8a, 0x8e, 0xff, 0x36, 0x50, 0x6d, 0xa1, 0x55, 0xf0, 0x37, 0xa8, 0x32, 0x6d, 0x12, 0x1e, 0x98,
+	0x46, 0xcb, 0x68, 0x57, 0x9d, 0x7a, 0x9e, 0x59, 0x33, 0x8c, 0xec, 0xe8, 0x5d, 0x2f, 0xc0, 0x9f,
+	0xa3, 0x4d, 0x96, 0x70, 0x69, 0x6e, 0xe8, 0xf7, 0xa8, 0xe4, 0x99, 0xa5, 0x63, 0xa2, 0x7f, 0xf1,
+	0x3b, 0x54, 0x1f, 0xc1, 0xf5, 0x98, 0x8f, 0x20, 0x82, 0x38, 0x95, 0x66, 0x59, 0x37, 0xea, 0x97,
+	0x6b, 0x1b, 0x95, 0xcc, 0xc5, 0xce, 0x41, 0xd1, 0xad, 0x4b, 0x19, 0xc8, 0x52, 0x74, 0xfc, 0x8f,
+	0x81, 0x1a, 0x8f, 0x8d, 0xf8, 0x35, 0x42, 0xf3, 0xf1, 0xa1, 0xab, 0xaf, 0x9d, 0x7c, 0xb1, 0x7c,
+	0xa4, 0x9a, 0x33, 0x9d, 0xee, 0x4c, 0xe4, 0xb2, 0x94, 0x39, 0xb8, 0x38, 0x6f, 0xc1, 0x4c, 0x16,
+	0xf6, 0xb8, 0x83, 0x10, 0x4c, 0x52, 0x88, 0x25, 0x17, 0xf1, 0xc3, 0xf7, 0xee, 0x29, 0xfd, 0x1c,
+	0x25, 0x0b, 0x7b, 0x6c, 0xa1, 0xad, 0xe9, 0x13, 0x94, 0xf5, 0x13, 0x54, 0xf3, 0xcc, 0x9a, 0x02,
+	0x64, 0xba, 0x7c, 0xfb, 0x33, 0x3a, 0x5a, 0xd3, 0x27, 0xb8, 0x86, 0x76, 0x5e, 0xf6, 0xfb, 0x67,
+	0xbf, 0x7a, 0x6e, 0xa3, 0x84, 0xab, 0x68, 0xeb, 0xb4, 0xf7, 0xd6, 0x73, 0x1b, 0x06, 0xde, 0x45,
+	0x55, 0xef, 0x6d, 0xb7, 0xff, 0x7a, 0xd8, 0x7b, 0xe3, 0x35, 0x36, 0x70, 0x1d, 0x55, 0xdc, 0xde,
+	0xf0, 0xa5, 0xd3, 0xf7, 0xdc, 0x46, 0xd9, 0xe9, 0xfe, 0x75, 0xd7, 0x34, 0xde, 0xdf, 0x35, 0x8d,
+	0x0f, 0x77, 0x4d, 0xe3, 0xff, 0xbb, 0xa6, 0xf1, 0xe7, 0x7d, 0xb3, 0xf4, 0xe1, 0xbe, 0x59, 0xfa,
+	0xf7, 0xbe, 0x59, 0xfa, 0xed, 0xab, 0x4b, 0x9e, 0x5e, 0x8d, 0xcf, 0x3b, 0xbe, 0x88, 0xec, 0xa5,
+	0x99, 0x3b, 0x29, 0x06, 0x7c, 0x7a, 0x9b, 0x80, 0x3c, 0xdf, 0xd6, 0xe3, 0xf6, 0xfb, 0x8f, 0x03,
+	0x00, 0xa7, 0x83, 0x0f, 0x40, 0x03, 0x06, 0x00, 0x00,
 }
 
 func (this *Policy) Equal(that interface{}) bool {
@@ -376,6 +416,18 @@ func (this *Policy) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if this.MinQosExcellence != that1.MinQosExcellence {
+		return false
+	}
+	if this.ExcludePunishedEpochs != that1.ExcludePunishedEpochs {
+		return false
+	}
+	if this.MinSelfStakeRatio != that1.MinSelfStakeRatio {
+		return false
+	}
+	if this.QualityMixed != that1.QualityMixed {
+		return false
+	}
 	return true
 }
 func (this *ChainPolicy) Equal(that interface{}) bool {
@@ -473,6 +525,31 @@ func (m *Policy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.QualityMixed {
+		i--
+		if m.QualityMixed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.MinSelfStakeRatio != 0 {
+		i = encodeVarintPolicy(dAtA, i, uint64(m.MinSelfStakeRatio))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.ExcludePunishedEpochs != 0 {
+		i = encodeVarintPolicy(dAtA, i, uint64(m.ExcludePunishedEpochs))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.MinQosExcellence != 0 {
+		i = encodeVarintPolicy(dAtA, i, uint64(m.MinQosExcellence))
+		i--
+		dAtA[i] = 0x40
+	}
 	if len(m.SelectedProviders) > 0 {
 		for iNdEx := len(m.SelectedProviders) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.SelectedProviders[iNdEx])
@@ -673,6 +750,18 @@ func (m *Policy) Size() (n int) {
 			n += 1 + l + sovPolicy(uint64(l))
 		}
 	}
+	if m.MinQosExcellence != 0 {
+		n += 1 + sovPolicy(uint64(m.MinQosExcellence))
+	}
+	if m.ExcludePunishedEpochs != 0 {
+		n += 1 + sovPolicy(uint64(m.ExcludePunishedEpochs))
+	}
+	if m.MinSelfStakeRatio != 0 {
+		n += 1 + sovPolicy(uint64(m.MinSelfStakeRatio))
+	}
+	if m.QualityMixed {
+		n += 2
+	}
 	return n
 }
 
@@ -917,6 +1006,83 @@ func (m *Policy) Unmarshal(dAtA []byte) error {
 			}
 			m.SelectedProviders = append(m.SelectedProviders, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinQosExcellence", wireType)
+			}
+			m.MinQosExcellence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinQosExcellence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExcludePunishedEpochs", wireType)
+			}
+			m.ExcludePunishedEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExcludePunishedEpochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSelfStakeRatio", wireType)
+			}
+			m.MinSelfStakeRatio = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinSelfStakeRatio |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QualityMixed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.QualityMixed = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPolicy(dAtA[iNdEx:])
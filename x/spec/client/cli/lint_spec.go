@@ -0,0 +1,84 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/lavanet/lava/x/spec/client/utils"
+	"github.com/spf13/cobra"
+)
+
+const FlagExpand = "expand"
+
+func CmdLintSpec() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "lint [spec-proposal-files...]",
+		Short: "validate spec add proposals offline",
+		Long: `Validates the specs of spec add proposal files without a running chain.
+Imports are resolved from the given files, so every imported spec must be in one of them.
+On top of the on-chain validation the expanded specs are checked for block parsing that doesn't fit the api interface
+and for inconsistent parse directives and verifications.`,
+		Example: `lavad spec lint cookbook/specs/spec_add_ethereum.json cookbook/specs/spec_add_polygon.json
+lavad spec lint cookbook/specs/*.json --expand POLYGON1`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// lint failures are not usage errors
+			cmd.SilenceUsage = true
+			fileNames := []string{}
+			for _, arg := range args {
+				fileNames = append(fileNames, strings.Split(arg, ",")...)
+			}
+			specs, _, err := utils.ReadSpecFiles(fileNames)
+			if err != nil {
+				return err
+			}
+			result, err := utils.LintSpecs(specs)
+			if err != nil {
+				return err
+			}
+
+			expand, _ := cmd.Flags().GetString(FlagExpand)
+			if expand != "" {
+				found := false
+				for idx := range result.ExpandedSpecs {
+					if result.ExpandedSpecs[idx].Index != expand {
+						continue
+					}
+					found = true
+					specJson, err := codec.ProtoMarshalJSON(&result.ExpandedSpecs[idx], nil)
+					if err != nil {
+						return err
+					}
+					var indented bytes.Buffer
+					if err := json.Indent(&indented, specJson, "", "  "); err != nil {
+						return err
+					}
+					cmd.Println(indented.String())
+				}
+				if !found {
+					return fmt.Errorf("spec %s was not found or failed expanding", expand)
+				}
+			}
+
+			errors, warnings := 0, 0
+			for _, issue := range result.Issues {
+				if issue.Severity == utils.LintSeverityError {
+					errors++
+				} else {
+					warnings++
+				}
+				cmd.PrintErrln(issue.String())
+			}
+			cmd.PrintErrf("linted %d specs: %d errors, %d warnings\n", len(specs), errors, warnings)
+			if result.HasErrors() {
+				return fmt.Errorf("spec lint failed with %d errors", errors)
+			}
+			return nil
+		},
+	}
+	cmd.Flags().String(FlagExpand, "", "print the expanded spec with this index")
+	return cmd
+}
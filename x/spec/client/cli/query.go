@@ -32,6 +32,9 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 
 	cmd.AddCommand(CmdShowChainInfo())
 
+	cmd.AddCommand(CmdSpecRevisions())
+	cmd.AddCommand(CmdSpecDiff())
+
 	// this line is used by starport scaffolding # 1
 
 	return cmd
@@ -0,0 +1,90 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/lavanet/lava/x/spec/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdSpecRevisions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revisions [chain-id]",
+		Short: "Query the blocks of the revisions of a spec",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QuerySpecRevisionsRequest{
+				ChainID: args[0],
+			}
+
+			res, err := queryClient.SpecRevisions(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdSpecDiff() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "diff [chain-id] [from-block] [optional: to-block]",
+		Short: "Query the changes between two revisions of a spec",
+		Long: `Query the added, removed and modified apis, compute units and api collections between two revisions of a spec.
+Each block is resolved to the latest revision that is not later than it, the latest revision is used when to-block is omitted.`,
+		Example: `lavad q spec revisions ETH1
+lavad q spec diff ETH1 1000 2000`,
+		Args: cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			fromBlock, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+			var toBlock uint64
+			if len(args) > 2 {
+				toBlock, err = strconv.ParseUint(args[2], 10, 64)
+				if err != nil {
+					return err
+				}
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QuerySpecDiffRequest{
+				ChainID:   args[0],
+				FromBlock: fromBlock,
+				ToBlock:   toBlock,
+			}
+
+			res, err := queryClient.SpecDiff(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
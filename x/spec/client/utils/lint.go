@@ -0,0 +1,336 @@
+package utils
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	tmdb "github.com/cometbft/cometbft-db"
+	"github.com/cometbft/cometbft/libs/log"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/lavanet/lava/x/spec/keeper"
+	"github.com/lavanet/lava/x/spec/types"
+)
+
+const (
+	LintSeverityError   = "error"
+	LintSeverityWarning = "warning"
+)
+
+var (
+	restParamRegex       = regexp.MustCompile(`{[^}]+}`)
+	blockNumTemplateVerb = regexp.MustCompile(`%[dx]`)
+	blockTags            = map[string]struct{}{"latest": {}, "earliest": {}, "pending": {}, "safe": {}, "finalized": {}}
+)
+
+type LintIssue struct {
+	Spec     string
+	Location string
+	Severity string
+	Message  string
+}
+
+func (li LintIssue) String() string {
+	if li.Location == "" {
+		return fmt.Sprintf("%s: %s: %s", li.Severity, li.Spec, li.Message)
+	}
+	return fmt.Sprintf("%s: %s: %s: %s", li.Severity, li.Spec, li.Location, li.Message)
+}
+
+type LintResult struct {
+	Issues        []LintIssue
+	ExpandedSpecs []types.Spec
+}
+
+func (lr LintResult) HasErrors() bool {
+	for _, issue := range lr.Issues {
+		if issue.Severity == LintSeverityError {
+			return true
+		}
+	}
+	return false
+}
+
+// ReadSpecFiles reads the specs of spec add proposal files, the file of each spec is returned by spec index
+func ReadSpecFiles(fileNames []string) (specs []types.Spec, specFiles map[string]string, err error) {
+	specFiles = map[string]string{}
+	readFiles := map[string]struct{}{}
+	for _, fileName := range fileNames {
+		// the same file can be passed explicitly and in a glob
+		if _, ok := readFiles[filepath.Clean(fileName)]; ok {
+			continue
+		}
+		readFiles[filepath.Clean(fileName)] = struct{}{}
+		proposal := SpecAddProposalJSON{}
+		contents, err := os.ReadFile(fileName)
+		if err != nil {
+			return nil, nil, err
+		}
+		decoder := json.NewDecoder(bytes.NewReader(contents))
+		decoder.DisallowUnknownFields() // This will make the unmarshal fail if there are unused fields
+		if err := decoder.Decode(&proposal); err != nil {
+			return nil, nil, fmt.Errorf("failed in file: %s, error %w", fileName, err)
+		}
+		for _, spec := range proposal.Proposal.Specs {
+			if otherFile, ok := specFiles[spec.Index]; ok {
+				return nil, nil, fmt.Errorf("spec %s is defined in both %s and %s", spec.Index, otherFile, fileName)
+			}
+			specFiles[spec.Index] = fileName
+			specs = append(specs, spec)
+		}
+	}
+	return specs, specFiles, nil
+}
+
+// LintSpecs validates the specs without a running chain, imports are resolved from the given specs,
+// on top of the on-chain validation it checks the block parsing, parse directives and verifications of the expanded specs
+func LintSpecs(specs []types.Spec) (LintResult, error) {
+	specKeeper, ctx, err := offlineSpecKeeper()
+	if err != nil {
+		return LintResult{}, err
+	}
+	for _, spec := range specs {
+		specKeeper.SetSpec(ctx, spec)
+	}
+	result := LintResult{}
+	for _, spec := range specs {
+		details, err := specKeeper.ValidateSpec(ctx, spec)
+		if err != nil {
+			result.Issues = append(result.Issues, LintIssue{Spec: spec.Index, Severity: LintSeverityError, Message: fmt.Sprintf("%s %v", err, details)})
+		}
+		expanded, err := specKeeper.ExpandSpec(ctx, spec)
+		if err != nil {
+			// already reported by the validation
+			continue
+		}
+		result.ExpandedSpecs = append(result.ExpandedSpecs, expanded)
+		result.Issues = append(result.Issues, lintExpandedSpec(expanded)...)
+	}
+	return result, nil
+}
+
+func offlineSpecKeeper() (*keeper.Keeper, sdk.Context, error) {
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	memStoreKey := storetypes.NewMemoryStoreKey(types.MemStoreKey)
+
+	db := tmdb.NewMemDB()
+	stateStore := store.NewCommitMultiStore(db)
+	stateStore.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, db)
+	stateStore.MountStoreWithDB(memStoreKey, storetypes.StoreTypeMemory, nil)
+	err := stateStore.LoadLatestVersion()
+	if err != nil {
+		return nil, sdk.Context{}, err
+	}
+
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	paramsSubspace := paramstypes.NewSubspace(cdc, types.Amino, storeKey, memStoreKey, "SpecParams")
+	k := keeper.NewKeeper(cdc, storeKey, memStoreKey, paramsSubspace)
+	ctx := sdk.NewContext(stateStore, tmproto.Header{}, false, log.NewNopLogger())
+	k.SetParams(ctx, types.DefaultParams())
+	return k, ctx, nil
+}
+
+func lintExpandedSpec(spec types.Spec) []LintIssue {
+	issues := []LintIssue{}
+	for _, apiCollection := range spec.ApiCollections {
+		if !apiCollection.Enabled {
+			continue
+		}
+		collectionData := apiCollection.CollectionData
+		location := collectionData.ApiInterface
+		if collectionData.Type != "" {
+			location += " " + collectionData.Type
+		}
+		if collectionData.AddOn != "" {
+			location += " addon " + collectionData.AddOn
+		}
+		report := func(severity string, format string, args ...interface{}) {
+			issues = append(issues, LintIssue{Spec: spec.Index, Location: location, Severity: severity, Message: fmt.Sprintf(format, args...)})
+		}
+
+		apis := map[string]*types.Api{}
+		for _, api := range apiCollection.Apis {
+			apis[api.Name] = api
+			if !api.Enabled {
+				continue
+			}
+			for _, problem := range lintBlockParser(collectionData.ApiInterface, api.Name, api.BlockParsing) {
+				report(LintSeverityError, "api %s: %s", api.Name, problem)
+			}
+		}
+
+		functionTags := map[types.FUNCTION_TAG]struct{}{}
+		for _, parsing := range apiCollection.ParseDirectives {
+			if _, ok := functionTags[parsing.FunctionTag]; ok {
+				report(LintSeverityError, "parse directive %s is defined twice", parsing.FunctionTag)
+			}
+			functionTags[parsing.FunctionTag] = struct{}{}
+			for _, problem := range lintParseDirective(parsing, apis) {
+				report(LintSeverityError, "parse directive %s: %s", parsing.FunctionTag, problem)
+			}
+		}
+
+		extensions := map[string]struct{}{}
+		for _, extension := range apiCollection.Extensions {
+			if extension.Name == "" {
+				report(LintSeverityError, "extension with an empty name")
+				continue
+			}
+			extensions[extension.Name] = struct{}{}
+			if extension.Rule != nil && extension.Rule.RangeStartParsing.ParserFunc != types.PARSER_FUNC_EMPTY && extension.Rule.BlockRange == 0 {
+				report(LintSeverityWarning, "extension %s: range_start_parsing is ignored without block_range", extension.Name)
+			}
+		}
+
+		verifications := map[string]struct{}{}
+		for _, verification := range apiCollection.Verifications {
+			if _, ok := verifications[verification.Name]; ok {
+				report(LintSeverityError, "verification %s is defined twice", verification.Name)
+			}
+			verifications[verification.Name] = struct{}{}
+			if len(verification.Values) == 0 {
+				// base specs leave the values to the specs importing them
+				report(LintSeverityWarning, "verification %s: no expected values, it is not verified", verification.Name)
+			}
+			for _, problem := range lintVerification(verification, extensions) {
+				report(LintSeverityError, "verification %s: %s", verification.Name, problem)
+			}
+		}
+	}
+	sort.SliceStable(issues, func(i, j int) bool {
+		return issues[i].Location < issues[j].Location
+	})
+	return issues
+}
+
+// lintBlockParser checks the parser args match the parser function and the params of the api interface
+func lintBlockParser(apiInterface string, apiName string, blockParser types.BlockParser) []string {
+	problems := []string{}
+	args := blockParser.ParserArg
+	switch blockParser.ParserFunc {
+	case types.PARSER_FUNC_DEFAULT:
+		if len(args) != 1 {
+			problems = append(problems, fmt.Sprintf("DEFAULT block parsing expects a single block arg, got %v", args))
+		} else if !isBlockValue(args[0]) {
+			problems = append(problems, fmt.Sprintf("DEFAULT block parsing arg %s is not a block number or tag", args[0]))
+		}
+	case types.PARSER_FUNC_PARSE_BY_ARG:
+		if len(args) != 1 {
+			problems = append(problems, fmt.Sprintf("PARSE_BY_ARG expects a single param index, got %v", args))
+			break
+		}
+		index, err := strconv.Atoi(args[0])
+		if err != nil || index < 0 {
+			problems = append(problems, fmt.Sprintf("PARSE_BY_ARG param index %s is not a non negative number", args[0]))
+			break
+		}
+		if apiInterface == types.APIInterfaceRest {
+			// rest params are the templated segments of the api path
+			if pathParams := len(restParamRegex.FindAllString(apiName, -1)); index >= pathParams {
+				problems = append(problems, fmt.Sprintf("PARSE_BY_ARG param index %d is out of range, the rest api has %d path params", index, pathParams))
+			}
+		}
+	case types.PARSER_FUNC_PARSE_CANONICAL:
+		if len(args) < 2 {
+			problems = append(problems, fmt.Sprintf("PARSE_CANONICAL expects a param index and keys, got %v", args))
+		} else if index, err := strconv.Atoi(args[0]); err != nil || index < 0 {
+			problems = append(problems, fmt.Sprintf("PARSE_CANONICAL param index %s is not a non negative number", args[0]))
+		}
+	case types.PARSER_FUNC_PARSE_DICTIONARY:
+		if len(args) != 2 {
+			problems = append(problems, fmt.Sprintf("PARSE_DICTIONARY expects a key and a separator, got %v", args))
+		}
+	case types.PARSER_FUNC_PARSE_DICTIONARY_OR_ORDERED:
+		if len(args) != 3 {
+			problems = append(problems, fmt.Sprintf("PARSE_DICTIONARY_OR_ORDERED expects a key, a separator and a param index, got %v", args))
+		} else if index, err := strconv.Atoi(args[2]); err != nil || index < 0 {
+			problems = append(problems, fmt.Sprintf("PARSE_DICTIONARY_OR_ORDERED param index %s is not a non negative number", args[2]))
+		}
+	case types.PARSER_FUNC_PARSE_JSON_PATH:
+		if len(args) != 1 {
+			problems = append(problems, fmt.Sprintf("PARSE_JSON_PATH expects a single path, got %v", args))
+		}
+	}
+	if apiInterface == types.APIInterfaceRest {
+		switch blockParser.ParserFunc {
+		case types.PARSER_FUNC_PARSE_CANONICAL, types.PARSER_FUNC_PARSE_DICTIONARY, types.PARSER_FUNC_PARSE_DICTIONARY_OR_ORDERED:
+			problems = append(problems, fmt.Sprintf("%s can't parse rest params, they are the path params of the api", blockParser.ParserFunc))
+		}
+	}
+	if blockParser.DefaultValue != "" && !isBlockValue(blockParser.DefaultValue) {
+		problems = append(problems, fmt.Sprintf("default value %s is not a block number or tag", blockParser.DefaultValue))
+	}
+	if blockParser.BlockHash {
+		switch blockParser.ParserFunc {
+		case types.PARSER_FUNC_EMPTY, types.PARSER_FUNC_DEFAULT:
+			problems = append(problems, fmt.Sprintf("block_hash is set but %s doesn't parse the params", blockParser.ParserFunc))
+		}
+	}
+	return problems
+}
+
+func lintParseDirective(parsing *types.ParseDirective, apis map[string]*types.Api) []string {
+	problems := []string{}
+	switch parsing.FunctionTag {
+	case types.FUNCTION_TAG_GET_BLOCKNUM, types.FUNCTION_TAG_GET_BLOCK_BY_NUM, types.FUNCTION_TAG_GET_BLOCK_BY_HASH:
+		if _, ok := apis[parsing.ApiName]; !ok {
+			problems = append(problems, fmt.Sprintf("api %s is not defined in the collection", parsing.ApiName))
+		}
+		if parsing.ResultParsing.ParserFunc == types.PARSER_FUNC_EMPTY {
+			problems = append(problems, "missing result parsing")
+		}
+	}
+	switch parsing.FunctionTag {
+	case types.FUNCTION_TAG_GET_BLOCK_BY_NUM:
+		if verbs := len(blockNumTemplateVerb.FindAllString(parsing.FunctionTemplate, -1)); verbs != 1 {
+			problems = append(problems, fmt.Sprintf("function template must contain a single block number verb (%%d or %%x), found %d", verbs))
+		}
+	case types.FUNCTION_TAG_GET_BLOCK_BY_HASH:
+		if verbs := strings.Count(parsing.FunctionTemplate, "%s"); verbs != 1 {
+			problems = append(problems, fmt.Sprintf("function template must contain a single block hash verb (%%s), found %d", verbs))
+		}
+	}
+	return problems
+}
+
+func lintVerification(verification *types.Verification, extensions map[string]struct{}) []string {
+	problems := []string{}
+	if verification.ParseDirective == nil {
+		return append(problems, "missing parse directive, it is not inherited from an imported spec")
+	}
+	if verification.ParseDirective.FunctionTemplate == "" && verification.ParseDirective.ApiName == "" {
+		problems = append(problems, "parse directive has no function template or api name")
+	}
+	if verification.ParseDirective.ResultParsing.ParserFunc == types.PARSER_FUNC_EMPTY {
+		problems = append(problems, "parse directive is missing result parsing")
+	}
+	for _, value := range verification.Values {
+		if value.Extension == "" {
+			continue
+		}
+		if _, ok := extensions[value.Extension]; !ok {
+			problems = append(problems, fmt.Sprintf("value for extension %s that is not defined in the collection", value.Extension))
+		}
+	}
+	return problems
+}
+
+func isBlockValue(value string) bool {
+	if _, ok := blockTags[value]; ok {
+		return true
+	}
+	block, err := strconv.ParseInt(value, 0, 64)
+	return err == nil && block >= 0
+}
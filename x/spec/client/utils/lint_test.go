@@ -0,0 +1,80 @@
+package utils
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/lavanet/lava/x/spec/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLintCookbookSpecs(t *testing.T) {
+	fileNames, err := filepath.Glob("../../../../cookbook/specs/*.json")
+	require.NoError(t, err)
+	require.NotEmpty(t, fileNames)
+	specs, specFiles, err := ReadSpecFiles(fileNames)
+	require.NoError(t, err)
+	require.Len(t, specFiles, len(specs))
+
+	result, err := LintSpecs(specs)
+	require.NoError(t, err)
+	require.False(t, result.HasErrors(), "%v", result.Issues)
+	require.Len(t, result.ExpandedSpecs, len(specs))
+}
+
+func TestLintSpecIssues(t *testing.T) {
+	fileNames, err := filepath.Glob("../../../../cookbook/specs/spec_add_ethereum.json")
+	require.NoError(t, err)
+	specs, _, err := ReadSpecFiles(fileNames)
+	require.NoError(t, err)
+	spec := specs[0]
+	require.Equal(t, "ETH1", spec.Index)
+
+	for _, apiCollection := range spec.ApiCollections {
+		for _, api := range apiCollection.Apis {
+			switch api.Name {
+			case "eth_getBalance":
+				api.BlockParsing = types.BlockParser{ParserArg: []string{"0", "1"}, ParserFunc: types.PARSER_FUNC_PARSE_BY_ARG}
+			case "eth_chainId":
+				api.BlockParsing = types.BlockParser{ParserArg: []string{"newest"}, ParserFunc: types.PARSER_FUNC_DEFAULT}
+			}
+		}
+		for _, parsing := range apiCollection.ParseDirectives {
+			if parsing.FunctionTag == types.FUNCTION_TAG_GET_BLOCK_BY_NUM {
+				parsing.ApiName = "eth_getBlockByNum"
+			}
+		}
+		for _, verification := range apiCollection.Verifications {
+			for _, value := range verification.Values {
+				value.Extension = "unknown"
+			}
+		}
+	}
+	// a spec importing a spec that isn't linted
+	importing := spec
+	importing.Index = "ETH2"
+	importing.Imports = []string{"MISSING"}
+
+	result, err := LintSpecs([]types.Spec{spec, importing})
+	require.NoError(t, err)
+	require.True(t, result.HasErrors())
+	expected := []string{
+		"api eth_getBalance: PARSE_BY_ARG expects a single param index",
+		"api eth_chainId: DEFAULT block parsing arg newest is not a block number or tag",
+		"parse directive GET_BLOCK_BY_NUM: api eth_getBlockByNum is not defined in the collection",
+		"value for extension unknown that is not defined in the collection",
+		"imported spec unknown: MISSING",
+	}
+	for _, message := range expected {
+		found := false
+		for _, issue := range result.Issues {
+			if strings.Contains(issue.Message, message) {
+				found = true
+				break
+			}
+		}
+		require.True(t, found, "missing issue %s in %v", message, result.Issues)
+	}
+	require.Len(t, result.ExpandedSpecs, 1)
+}
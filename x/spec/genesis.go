@@ -16,6 +16,11 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 
 	// this line is used by starport scaffolding # genesis/module/init
 	k.SetParams(ctx, genState.Params)
+
+	for _, revision := range genState.SpecRevisions {
+		k.SetSpecRevision(ctx, revision)
+	}
+	k.AddMissingSpecRevisions(ctx)
 }
 
 // ExportGenesis returns the capability module's exported genesis.
@@ -25,6 +30,7 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 
 	genesis.SpecList = k.GetAllSpec(ctx)
 	genesis.SpecCount = uint64(len(genesis.SpecList))
+	genesis.SpecRevisions = k.GetAllSpecRevisions(ctx)
 
 	// this line is used by starport scaffolding # genesis/module/export
 
@@ -23,6 +23,11 @@ func TestGenesis(t *testing.T) {
 			},
 		},
 		SpecCount: 2,
+		SpecRevisions: []types.SpecRevision{
+			{ChainID: "0", Block: 0, Spec: types.Spec{Index: "0"}},
+			{ChainID: "0", Block: 10, Spec: types.Spec{Index: "0", Enabled: true}},
+			{ChainID: "1", Block: 0, Spec: types.Spec{Index: "1"}},
+		},
 
 		// this line is used by starport scaffolding # genesis/test/state
 	}
@@ -37,5 +42,6 @@ func TestGenesis(t *testing.T) {
 
 	require.ElementsMatch(t, genesisState.SpecList, got.SpecList)
 	require.Equal(t, genesisState.SpecCount, got.SpecCount)
+	require.ElementsMatch(t, genesisState.SpecRevisions, got.SpecRevisions)
 	// this line is used by starport scaffolding # genesis/test/assert
 }
@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/x/spec/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) SpecRevisions(goCtx context.Context, req *types.QuerySpecRevisionsRequest) (*types.QuerySpecRevisionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	blocks := k.GetSpecRevisionBlocks(ctx, req.ChainID)
+	if len(blocks) == 0 {
+		return nil, status.Error(codes.NotFound, "spec has no revisions")
+	}
+
+	return &types.QuerySpecRevisionsResponse{Blocks: blocks}, nil
+}
+
+func (k Keeper) SpecDiff(goCtx context.Context, req *types.QuerySpecDiffRequest) (*types.QuerySpecDiffResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	toBlock := req.ToBlock
+	if toBlock == 0 {
+		toBlock = uint64(ctx.BlockHeight())
+	}
+	if req.FromBlock > toBlock {
+		return nil, status.Error(codes.InvalidArgument, "from block is later than to block")
+	}
+
+	from, fromRevision, found := k.GetSpecRevision(ctx, req.ChainID, req.FromBlock)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no revision of spec %s at block %d", req.ChainID, req.FromBlock)
+	}
+	to, toRevision, found := k.GetSpecRevision(ctx, req.ChainID, toBlock)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no revision of spec %s at block %d", req.ChainID, toBlock)
+	}
+
+	diff := types.DiffSpecs(from, to)
+	// the revision blocks are the ones the diff was made of, spec block_last_updated may be older for the first revision
+	diff.FromBlock = fromRevision
+	diff.ToBlock = toRevision
+
+	return &types.QuerySpecDiffResponse{Diff: diff}, nil
+}
@@ -22,3 +22,10 @@ func (m Migrator) Migrate2to3(ctx sdk.Context) error {
 	}
 	return nil
 }
+
+// Migrate3to4 implements store migration from v3 to v4:
+// - add the first revision of every spec
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	m.keeper.AddMissingSpecRevisions(ctx)
+	return nil
+}
@@ -0,0 +1,104 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/utils"
+	"github.com/lavanet/lava/x/spec/types"
+)
+
+// AddSpecRevision stores the expanded spec as the revision of the current block.
+// Revisions are kept in a plain store keyed by (chainID, block) and are never removed.
+func (k Keeper) AddSpecRevision(ctx sdk.Context, spec types.Spec) error {
+	return k.addSpecRevision(ctx, spec, uint64(ctx.BlockHeight()))
+}
+
+func (k Keeper) addSpecRevision(ctx sdk.Context, spec types.Spec, block uint64) error {
+	expanded, err := k.ExpandSpec(ctx, spec)
+	if err != nil {
+		return err
+	}
+	// the same revision may be added twice in a block (a spec modified and refreshed
+	// by the same proposal), the latter overwrites the former
+	k.SetSpecRevision(ctx, types.SpecRevision{ChainID: spec.Index, Block: block, Spec: expanded})
+	return nil
+}
+
+// SetSpecRevision sets a spec revision in the store from its chainID and block
+func (k Keeper) SetSpecRevision(ctx sdk.Context, revision types.SpecRevision) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SpecRevisionKeyPrefix))
+	b := k.cdc.MustMarshal(&revision.Spec)
+	store.Set(types.SpecRevisionKey(revision.ChainID, revision.Block), b)
+}
+
+// GetSpecRevision returns the revision of the spec at the block (the nearest revision that is not later), and its block
+func (k Keeper) GetSpecRevision(ctx sdk.Context, chainID string, block uint64) (val types.Spec, revisionBlock uint64, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SpecRevisionKeyPrefix))
+	store = prefix.NewStore(store, types.SpecKey(chainID))
+
+	// the end of the iterator is exclusive, so start right after the block
+	iterator := store.ReverseIterator(nil, sdk.Uint64ToBigEndian(block+1))
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return val, 0, false
+	}
+
+	k.cdc.MustUnmarshal(iterator.Value(), &val)
+	return val, sdk.BigEndianToUint64(iterator.Key()), true
+}
+
+// GetSpecRevisionBlocks returns the blocks of all the revisions of the spec in ascending order
+func (k Keeper) GetSpecRevisionBlocks(ctx sdk.Context, chainID string) (blocks []uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SpecRevisionKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, types.SpecKey(chainID))
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+		blocks = append(blocks, sdk.BigEndianToUint64(key[len(key)-8:]))
+	}
+
+	return blocks
+}
+
+// GetAllSpecRevisions returns the revisions of all the specs
+func (k Keeper) GetAllSpecRevisions(ctx sdk.Context) (list []types.SpecRevision) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SpecRevisionKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+		// the key is "<chainID>/<block>", see SpecRevisionKey
+		revision := types.SpecRevision{
+			ChainID: string(key[:len(key)-9]),
+			Block:   sdk.BigEndianToUint64(key[len(key)-8:]),
+		}
+		k.cdc.MustUnmarshal(iterator.Value(), &revision.Spec)
+		list = append(list, revision)
+	}
+
+	return list
+}
+
+// AddMissingSpecRevisions adds a first revision for specs that have none (specs added before revisions
+// were kept, or from a genesis without revisions), at the block the spec was last updated
+func (k Keeper) AddMissingSpecRevisions(ctx sdk.Context) {
+	for _, spec := range k.GetAllSpec(ctx) {
+		if len(k.GetSpecRevisionBlocks(ctx, spec.Index)) > 0 {
+			continue
+		}
+		block := spec.BlockLastUpdated
+		if block > uint64(ctx.BlockHeight()) {
+			block = uint64(ctx.BlockHeight())
+		}
+		if err := k.addSpecRevision(ctx, spec, block); err != nil {
+			utils.LavaFormatWarning("failed adding first spec revision", err,
+				utils.Attribute{Key: "chainID", Value: spec.Index},
+			)
+		}
+	}
+}
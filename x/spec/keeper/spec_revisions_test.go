@@ -0,0 +1,156 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/lavanet/lava/testutil/common"
+	keepertest "github.com/lavanet/lava/testutil/keeper"
+	"github.com/lavanet/lava/x/spec/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSpecRevisions(t *testing.T) {
+	ts := newTester(t)
+
+	parentSpec := types.Spec{
+		Index:                         "parent",
+		Name:                          "parent spec",
+		Enabled:                       true,
+		ReliabilityThreshold:          268435455,
+		BlockDistanceForFinalizedData: 64,
+		BlocksInFinalizationProof:     3,
+		AverageBlockTime:              13000,
+		AllowedBlockLagForQosSync:     2,
+		MinStakeProvider:              common.NewCoin(5000),
+		MinStakeClient:                common.NewCoin(5000),
+		ApiCollections: []*types.ApiCollection{
+			{
+				Enabled:        true,
+				CollectionData: types.CollectionData{ApiInterface: "jsonrpc"},
+				Apis: []*types.Api{
+					{Enabled: true, Name: "eth_blockNumber", ComputeUnits: 10},
+					{Enabled: true, Name: "eth_chainId", ComputeUnits: 10},
+				},
+			},
+		},
+	}
+	childSpec := parentSpec
+	childSpec.Index = "child"
+	childSpec.Name = "child spec"
+	childSpec.ApiCollections = nil
+	childSpec.Imports = []string{"parent"}
+
+	err := keepertest.SimulateSpecAddProposal(ts.Ctx, ts.Keepers.Spec, []types.Spec{parentSpec, childSpec})
+	require.NoError(t, err)
+	block1 := ts.BlockHeight()
+
+	ts.AdvanceBlock()
+
+	// modify the parent, the child inherits the changes and gets a revision too
+	parentSpec.ApiCollections[0].Apis = []*types.Api{
+		{Enabled: true, Name: "eth_blockNumber", ComputeUnits: 20},
+		{Enabled: true, Name: "eth_getBalance", ComputeUnits: 10},
+	}
+	err = keepertest.SimulateSpecAddProposal(ts.Ctx, ts.Keepers.Spec, []types.Spec{parentSpec})
+	require.NoError(t, err)
+	block2 := ts.BlockHeight()
+
+	for _, index := range []string{"parent", "child"} {
+		res, err := ts.Keepers.Spec.SpecRevisions(ts.GoCtx, &types.QuerySpecRevisionsRequest{ChainID: index})
+		require.NoError(t, err)
+		require.Equal(t, []uint64{block1, block2}, res.Blocks)
+	}
+
+	// old revisions are kept after the stale period
+	ts.AdvanceBlockUntilStale()
+
+	res, err := ts.Keepers.Spec.SpecDiff(ts.GoCtx, &types.QuerySpecDiffRequest{ChainID: "parent", FromBlock: block1})
+	require.NoError(t, err)
+	diff := res.Diff
+	require.Equal(t, block1, diff.FromBlock)
+	require.Equal(t, block2, diff.ToBlock)
+	require.Empty(t, diff.ChangedFields)
+	require.Len(t, diff.Collections, 1)
+	require.Equal(t, types.CHANGE_TYPE_MODIFIED, diff.Collections[0].Change)
+	require.Empty(t, diff.Collections[0].ChangedFields)
+	require.Equal(t, []types.ApiDiff{
+		{Name: "eth_blockNumber", Change: types.CHANGE_TYPE_MODIFIED, FromComputeUnits: 10, ToComputeUnits: 20, ChangedFields: []string{"compute_units"}},
+		{Name: "eth_getBalance", Change: types.CHANGE_TYPE_ADDED, ToComputeUnits: 10},
+		{Name: "eth_chainId", Change: types.CHANGE_TYPE_REMOVED, FromComputeUnits: 10},
+	}, diff.Collections[0].Apis)
+
+	// the child revision holds the inherited api
+	childSpec, _, found := ts.Keepers.Spec.GetSpecRevision(ts.Ctx, "child", block2)
+	require.True(t, found)
+	require.Len(t, childSpec.ApiCollections, 1)
+	apiNames := []string{}
+	for _, api := range childSpec.ApiCollections[0].Apis {
+		apiNames = append(apiNames, api.Name)
+	}
+	require.Contains(t, apiNames, "eth_getBalance")
+
+	// the same revision on both sides
+	res, err = ts.Keepers.Spec.SpecDiff(ts.GoCtx, &types.QuerySpecDiffRequest{ChainID: "child", FromBlock: block2, ToBlock: block2 + 1})
+	require.NoError(t, err)
+	require.True(t, res.Diff.IsEmpty())
+
+	_, err = ts.Keepers.Spec.SpecDiff(ts.GoCtx, &types.QuerySpecDiffRequest{ChainID: "child", FromBlock: block1 - 1})
+	require.Error(t, err)
+	_, err = ts.Keepers.Spec.SpecRevisions(ts.GoCtx, &types.QuerySpecRevisionsRequest{ChainID: "other"})
+	require.Error(t, err)
+}
+
+func TestDiffSpecs(t *testing.T) {
+	collectionData := types.CollectionData{ApiInterface: "rest", Type: "GET"}
+	from := types.Spec{
+		Index:            "spec",
+		Enabled:          true,
+		AverageBlockTime: 1000,
+		MinStakeProvider: common.NewCoin(5000),
+		ApiCollections: []*types.ApiCollection{
+			{
+				Enabled:        true,
+				CollectionData: collectionData,
+				Apis:           []*types.Api{{Enabled: true, Name: "/blocks/{height}", ComputeUnits: 10}},
+			},
+			{
+				Enabled:        true,
+				CollectionData: types.CollectionData{ApiInterface: "grpc"},
+				Apis:           []*types.Api{{Enabled: true, Name: "lava.Query/Spec", ComputeUnits: 10}},
+			},
+		},
+	}
+	to := from
+	to.AverageBlockTime = 2000
+	to.MinStakeProvider = common.NewCoin(6000)
+	to.ApiCollections = []*types.ApiCollection{
+		{
+			Enabled:        true,
+			CollectionData: collectionData,
+			Apis:           []*types.Api{{Enabled: false, Name: "/blocks/{height}", ComputeUnits: 10}},
+			Extensions:     []*types.Extension{{Name: "archive", CuMultiplier: 2}},
+		},
+		{
+			Enabled:        true,
+			CollectionData: types.CollectionData{ApiInterface: "jsonrpc"},
+			Apis:           []*types.Api{{Enabled: true, Name: "eth_chainId", ComputeUnits: 10}},
+		},
+	}
+
+	diff := types.DiffSpecs(from, to)
+	require.Equal(t, []string{"average_block_time", "min_stake_provider"}, diff.ChangedFields)
+	require.Len(t, diff.Collections, 3)
+	require.Equal(t, types.CollectionDiff{
+		CollectionData: collectionData,
+		Change:         types.CHANGE_TYPE_MODIFIED,
+		ChangedFields:  []string{"extensions"},
+		Apis:           []types.ApiDiff{{Name: "/blocks/{height}", Change: types.CHANGE_TYPE_MODIFIED, FromComputeUnits: 10, ToComputeUnits: 10, ChangedFields: []string{"enabled"}}},
+	}, diff.Collections[0])
+	require.Equal(t, types.CHANGE_TYPE_ADDED, diff.Collections[1].Change)
+	require.Equal(t, "jsonrpc", diff.Collections[1].CollectionData.ApiInterface)
+	require.Equal(t, types.CHANGE_TYPE_REMOVED, diff.Collections[2].Change)
+	require.Equal(t, "grpc", diff.Collections[2].CollectionData.ApiInterface)
+	require.Len(t, diff.Changes(), 5)
+
+	require.True(t, types.DiffSpecs(from, from).IsEmpty())
+}
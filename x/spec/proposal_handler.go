@@ -148,6 +148,16 @@ func handleSpecProposal(ctx sdk.Context, k keeper.Keeper, p *types.SpecAddPropos
 		}
 	}
 
+	// keep a revision of every spec that changed, including the ones inheriting from the modified specs
+	for _, spec := range k.GetAllSpec(ctx) {
+		if spec.BlockLastUpdated != uint64(ctx.BlockHeight()) {
+			continue
+		}
+		if err := k.AddSpecRevision(ctx, spec); err != nil {
+			return utils.LavaFormatWarning("failed adding spec revision", err)
+		}
+	}
+
 	for _, e := range events {
 		utils.LogLavaEvent(ctx, logger, e.name, e.details, e.event)
 	}
@@ -58,6 +58,7 @@ const (
 	FUNCTION_TAG_SET_LATEST_IN_METADATA FUNCTION_TAG = 3
 	FUNCTION_TAG_SET_LATEST_IN_BODY     FUNCTION_TAG = 4
 	FUNCTION_TAG_VERIFICATION           FUNCTION_TAG = 5
+	FUNCTION_TAG_GET_BLOCK_BY_HASH      FUNCTION_TAG = 6
 )
 
 var FUNCTION_TAG_name = map[int32]string{
@@ -67,6 +68,7 @@ var FUNCTION_TAG_name = map[int32]string{
 	3: "SET_LATEST_IN_METADATA",
 	4: "SET_LATEST_IN_BODY",
 	5: "VERIFICATION",
+	6: "GET_BLOCK_BY_HASH",
 }
 
 var FUNCTION_TAG_value = map[string]int32{
@@ -76,6 +78,7 @@ var FUNCTION_TAG_value = map[string]int32{
 	"SET_LATEST_IN_METADATA": 3,
 	"SET_LATEST_IN_BODY":     4,
 	"VERIFICATION":           5,
+	"GET_BLOCK_BY_HASH":      6,
 }
 
 func (x FUNCTION_TAG) String() string {
@@ -95,7 +98,8 @@ const (
 	PARSER_FUNC_PARSE_DICTIONARY            PARSER_FUNC = 3
 	PARSER_FUNC_PARSE_DICTIONARY_OR_ORDERED PARSER_FUNC = 4
 	// reserved
-	PARSER_FUNC_DEFAULT PARSER_FUNC = 6
+	PARSER_FUNC_DEFAULT         PARSER_FUNC = 6
+	PARSER_FUNC_PARSE_JSON_PATH PARSER_FUNC = 7
 )
 
 var PARSER_FUNC_name = map[int32]string{
@@ -105,6 +109,7 @@ var PARSER_FUNC_name = map[int32]string{
 	3: "PARSE_DICTIONARY",
 	4: "PARSE_DICTIONARY_OR_ORDERED",
 	6: "DEFAULT",
+	7: "PARSE_JSON_PATH",
 }
 
 var PARSER_FUNC_value = map[string]int32{
@@ -114,6 +119,7 @@ var PARSER_FUNC_value = map[string]int32{
 	"PARSE_DICTIONARY":            3,
 	"PARSE_DICTIONARY_OR_ORDERED": 4,
 	"DEFAULT":                     6,
+	"PARSE_JSON_PATH":             7,
 }
 
 func (x PARSER_FUNC) String() string {
@@ -316,7 +322,11 @@ func (m *Extension) GetRule() *Rule {
 }
 
 type Rule struct {
-	Block uint64 `protobuf:"varint,1,opt,name=block,proto3" json:"block,omitempty"`
+	Block             uint64      `protobuf:"varint,1,opt,name=block,proto3" json:"block,omitempty"`
+	Methods           []string    `protobuf:"bytes,2,rep,name=methods,proto3" json:"methods,omitempty"`
+	BlockRange        uint64      `protobuf:"varint,3,opt,name=block_range,json=blockRange,proto3" json:"block_range,omitempty"`
+	RangeStartParsing BlockParser `protobuf:"bytes,4,opt,name=range_start_parsing,json=rangeStartParsing,proto3" json:"range_start_parsing"`
+	Headers           []string    `protobuf:"bytes,5,rep,name=headers,proto3" json:"headers,omitempty"`
 }
 
 func (m *Rule) Reset()         { *m = Rule{} }
@@ -359,6 +369,34 @@ func (m *Rule) GetBlock() uint64 {
 	return 0
 }
 
+func (m *Rule) GetMethods() []string {
+	if m != nil {
+		return m.Methods
+	}
+	return nil
+}
+
+func (m *Rule) GetBlockRange() uint64 {
+	if m != nil {
+		return m.BlockRange
+	}
+	return 0
+}
+
+func (m *Rule) GetRangeStartParsing() BlockParser {
+	if m != nil {
+		return m.RangeStartParsing
+	}
+	return BlockParser{}
+}
+
+func (m *Rule) GetHeaders() []string {
+	if m != nil {
+		return m.Headers
+	}
+	return nil
+}
+
 type Verification struct {
 	Name           string          `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	ParseDirective *ParseDirective `protobuf:"bytes,2,opt,name=parse_directive,json=parseDirective,proto3" json:"parse_directive,omitempty"`
@@ -764,6 +802,7 @@ type BlockParser struct {
 	ParserFunc   PARSER_FUNC `protobuf:"varint,2,opt,name=parser_func,json=parserFunc,proto3,enum=lavanet.lava.spec.PARSER_FUNC" json:"parser_func,omitempty"`
 	DefaultValue string      `protobuf:"bytes,3,opt,name=default_value,json=defaultValue,proto3" json:"default_value,omitempty"`
 	Encoding     string      `protobuf:"bytes,4,opt,name=encoding,proto3" json:"encoding,omitempty"`
+	BlockHash    bool        `protobuf:"varint,5,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
 }
 
 func (m *BlockParser) Reset()         { *m = BlockParser{} }
@@ -827,6 +866,13 @@ func (m *BlockParser) GetEncoding() string {
 	return ""
 }
 
+func (m *BlockParser) GetBlockHash() bool {
+	if m != nil {
+		return m.BlockHash
+	}
+	return false
+}
+
 type SpecCategory struct {
 	Deterministic bool   `protobuf:"varint,1,opt,name=deterministic,proto3" json:"deterministic,omitempty"`
 	Local         bool   `protobuf:"varint,2,opt,name=local,proto3" json:"local,omitempty"`
@@ -926,91 +972,97 @@ func init() {
 }
 
 var fileDescriptor_c9f7567a181f534f = []byte{
-	// 1335 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0x4f, 0x6f, 0xdb, 0xc6,
-	0x13, 0x35, 0x25, 0xda, 0x96, 0x46, 0x7f, 0xcc, 0x6c, 0xf2, 0xcb, 0x4f, 0x49, 0x1d, 0x29, 0x65,
-	0xd2, 0x36, 0x70, 0x00, 0x1b, 0x4d, 0x50, 0xa0, 0x08, 0x0a, 0x14, 0x94, 0x44, 0x27, 0x6a, 0x6c,
-	0xc9, 0x58, 0xcb, 0x46, 0xdd, 0x0b, 0xb1, 0x22, 0xd7, 0xd2, 0x22, 0x14, 0xc9, 0x92, 0x4b, 0xc3,
-	0xee, 0xb5, 0xb7, 0x9e, 0x7a, 0xea, 0x37, 0x28, 0x50, 0xa0, 0x40, 0x81, 0x7e, 0x8b, 0x1c, 0x73,
-	0xec, 0x49, 0x28, 0x9c, 0x43, 0xd1, 0x1c, 0x73, 0x2f, 0x50, 0xec, 0x92, 0x92, 0x45, 0x47, 0x49,
-	0x91, 0x13, 0x39, 0x6f, 0xde, 0xbc, 0x9d, 0xd9, 0x9d, 0x1d, 0x12, 0x3e, 0x76, 0xc9, 0x09, 0xf1,
-	0x28, 0xdf, 0x12, 0xcf, 0xad, 0x28, 0xa0, 0xf6, 0x16, 0x09, 0x98, 0x65, 0xfb, 0xae, 0x4b, 0x6d,
-	0xce, 0x7c, 0x6f, 0x33, 0x08, 0x7d, 0xee, 0xa3, 0x2b, 0x29, 0x6f, 0x53, 0x3c, 0x37, 0x05, 0xef,
-	0xe6, 0xb5, 0xa1, 0x3f, 0xf4, 0xa5, 0x77, 0x4b, 0xbc, 0x25, 0x44, 0xfd, 0x9f, 0x3c, 0x54, 0x8c,
-	0x80, 0xb5, 0x66, 0x02, 0xa8, 0x06, 0xab, 0xd4, 0x23, 0x03, 0x97, 0x3a, 0x35, 0xe5, 0xb6, 0x72,
-	0xaf, 0x80, 0xa7, 0x26, 0xda, 0x83, 0xb5, 0x8b, 0x85, 0x2c, 0x87, 0x70, 0x52, 0xcb, 0xdd, 0x56,
-	0xee, 0x95, 0x1e, 0x7c, 0xb8, 0xf9, 0xc6, 0x72, 0x9b, 0x17, 0x8a, 0x6d, 0xc2, 0x49, 0x53, 0x7d,
-	0x3e, 0x69, 0x2c, 0xe1, 0xaa, 0x9d, 0x41, 0xd1, 0x06, 0xa8, 0x24, 0x60, 0x51, 0x2d, 0x7f, 0x3b,
-	0x7f, 0xaf, 0xf4, 0xe0, 0xfa, 0x02, 0x19, 0x23, 0x60, 0x58, 0x72, 0xd0, 0x43, 0x58, 0x1d, 0x51,
-	0xe2, 0xd0, 0x30, 0xaa, 0xa9, 0x92, 0x7e, 0x63, 0x01, 0xfd, 0x89, 0x64, 0xe0, 0x29, 0x13, 0xed,
-	0x80, 0xc6, 0xbc, 0x11, 0x0d, 0x19, 0x27, 0x9e, 0x4d, 0x2d, 0xb9, 0xd8, 0xb2, 0x8c, 0xfe, 0xef,
-	0x9c, 0xf1, 0xda, 0x5c, 0xa8, 0x21, 0x52, 0xd8, 0x01, 0x2d, 0x20, 0x61, 0x44, 0x2d, 0x87, 0x85,
-	0x82, 0x77, 0x42, 0xa3, 0xda, 0xca, 0x5b, 0xd5, 0xf6, 0x04, 0xb5, 0x3d, 0x65, 0xe2, 0xb5, 0x20,
-	0x63, 0x47, 0xe8, 0x0b, 0x00, 0x7a, 0xca, 0xa9, 0x17, 0x31, 0xdf, 0x8b, 0x6a, 0xab, 0x52, 0x67,
-	0x7d, 0x81, 0x8e, 0x39, 0x25, 0xe1, 0x39, 0x3e, 0x32, 0xa1, 0x72, 0x42, 0x43, 0x76, 0xcc, 0x6c,
-	0xc2, 0xa5, 0x40, 0x41, 0x0a, 0x34, 0x16, 0x08, 0x1c, 0xce, 0xf1, 0x70, 0x36, 0x4a, 0xff, 0x16,
-	0x8a, 0x33, 0x7d, 0x84, 0x40, 0xf5, 0xc8, 0x98, 0xca, 0x73, 0x2f, 0x62, 0xf9, 0x8e, 0xee, 0x40,
-	0xc5, 0x8e, 0xad, 0x71, 0xec, 0x72, 0x16, 0xb8, 0x8c, 0x86, 0xf2, 0xc8, 0x73, 0xb8, 0x6c, 0xc7,
-	0xbb, 0x33, 0x0c, 0xdd, 0x07, 0x35, 0x8c, 0x5d, 0x5a, 0xcb, 0xcb, 0x76, 0xf8, 0xff, 0x82, 0x1c,
-	0x70, 0xec, 0x52, 0x2c, 0x49, 0xfa, 0x3a, 0xa8, 0xc2, 0x42, 0xd7, 0x60, 0x79, 0xe0, 0xfa, 0xf6,
-	0x33, 0xb9, 0x9c, 0x8a, 0x13, 0x43, 0xff, 0x59, 0x81, 0xf2, 0x7c, 0xc2, 0x0b, 0x93, 0xfa, 0x0a,
-	0xd6, 0x2e, 0x1d, 0xc4, 0x3b, 0x3a, 0xf1, 0xd2, 0x39, 0x54, 0xb3, 0xe7, 0x80, 0x3e, 0x83, 0x95,
-	0x13, 0xe2, 0xc6, 0x74, 0xda, 0x85, 0xb7, 0xde, 0x26, 0x71, 0x28, 0x58, 0x38, 0x25, 0xeb, 0xdf,
-	0x01, 0x5c, 0xa0, 0x68, 0x1d, 0x8a, 0xb3, 0xb3, 0x49, 0x33, 0xbd, 0x00, 0xd0, 0x47, 0x50, 0xa5,
-	0xa7, 0x01, 0xb5, 0x39, 0x75, 0x2c, 0x19, 0x2e, 0xb3, 0x2d, 0xe2, 0xca, 0x14, 0x4d, 0x44, 0x3e,
-	0x81, 0x35, 0x97, 0x70, 0x1a, 0x71, 0xcb, 0x61, 0x91, 0xec, 0x3a, 0xb9, 0xa1, 0x2a, 0xae, 0x26,
-	0x70, 0x3b, 0x45, 0xf5, 0xdf, 0x73, 0x50, 0xcd, 0xf6, 0x2a, 0x3a, 0x84, 0x8a, 0x18, 0x04, 0xcc,
-	0xe3, 0x34, 0x3c, 0x26, 0x76, 0xba, 0x5d, 0xcd, 0x4f, 0x5f, 0x4d, 0x1a, 0x59, 0xc7, 0xeb, 0x49,
-	0x63, 0x7d, 0x4c, 0x82, 0x88, 0x87, 0xb1, 0xcd, 0xe3, 0x90, 0x3e, 0xd2, 0x33, 0x6e, 0x1d, 0x97,
-	0x49, 0xc0, 0x3a, 0x53, 0x53, 0xe8, 0x4a, 0x9f, 0x47, 0x5c, 0x2b, 0x20, 0x7c, 0x94, 0x64, 0x9e,
-	0xe8, 0x66, 0x1c, 0x6f, 0xea, 0x66, 0xdc, 0x3a, 0x2e, 0x4f, 0xed, 0x3d, 0xc2, 0x47, 0xe8, 0x21,
-	0xa8, 0xfc, 0x2c, 0x48, 0x0a, 0x2c, 0x36, 0x1b, 0xaf, 0x26, 0x0d, 0x69, 0xbf, 0x9e, 0x34, 0xae,
-	0x66, 0x55, 0x04, 0xaa, 0x63, 0xe9, 0x44, 0x8f, 0x60, 0x85, 0x38, 0x8e, 0xe5, 0x7b, 0x35, 0x55,
-	0x86, 0xdd, 0x79, 0x35, 0x69, 0xa4, 0xc8, 0xeb, 0x49, 0xe3, 0x7f, 0x97, 0xca, 0x92, 0xb8, 0x8e,
-	0x97, 0x89, 0xe3, 0xf4, 0x3c, 0xfd, 0x2f, 0x05, 0x56, 0x92, 0xe9, 0xb0, 0xb0, 0xa3, 0x3e, 0x07,
-	0xf5, 0x19, 0xf3, 0x1c, 0x59, 0x5e, 0xf5, 0xc1, 0xdd, 0xb7, 0x8e, 0x96, 0xf4, 0xd1, 0x3f, 0x0b,
-	0x28, 0x96, 0x11, 0xa8, 0x09, 0xe5, 0xe3, 0xd8, 0x4b, 0x66, 0x22, 0x27, 0x43, 0x59, 0x51, 0x75,
-	0xe1, 0x3d, 0xdc, 0x3e, 0xe8, 0xb6, 0xfa, 0x9d, 0x5e, 0xd7, 0xea, 0x1b, 0x8f, 0x71, 0x69, 0x1a,
-	0xd4, 0x27, 0x43, 0xfd, 0x29, 0xc0, 0x85, 0x2e, 0xaa, 0x40, 0x31, 0x20, 0x51, 0x64, 0x45, 0xd4,
-	0x73, 0xb4, 0x25, 0x54, 0x05, 0x90, 0x66, 0x48, 0x03, 0xf7, 0x4c, 0x53, 0x66, 0xee, 0x81, 0xcf,
-	0x47, 0x5a, 0x0e, 0xad, 0x41, 0x49, 0x9a, 0x6c, 0xe8, 0xf9, 0x21, 0xd5, 0xf2, 0xfa, 0x4f, 0x39,
-	0xc8, 0x1b, 0x01, 0x7b, 0xc7, 0x20, 0x9f, 0x6e, 0x40, 0xee, 0xd2, 0x3d, 0xf7, 0xc7, 0x41, 0xcc,
-	0xa9, 0x15, 0x7b, 0x8c, 0x47, 0x69, 0xeb, 0x95, 0x53, 0xf0, 0x40, 0x60, 0x68, 0x13, 0xae, 0xd2,
-	0x53, 0x1e, 0x12, 0x2b, 0x4b, 0x55, 0x25, 0xf5, 0x8a, 0x74, 0xb5, 0xe6, 0xf9, 0x06, 0x14, 0x6c,
-	0xc2, 0xe9, 0xd0, 0x0f, 0xcf, 0x6a, 0x2b, 0xf2, 0x82, 0x2e, 0xda, 0x97, 0xfd, 0x80, 0xda, 0xad,
-	0x94, 0x96, 0x7e, 0x28, 0x66, 0x61, 0xa8, 0x03, 0x15, 0x39, 0x18, 0x2c, 0x71, 0x6d, 0x99, 0x37,
-	0xac, 0xad, 0x4a, 0x9d, 0xfa, 0x02, 0x9d, 0xa6, 0xe0, 0xc9, 0x4b, 0x19, 0xa6, 0x32, 0xe5, 0xc1,
-	0x14, 0x62, 0xde, 0x50, 0xff, 0x5b, 0x81, 0x6a, 0x76, 0x18, 0xbc, 0x71, 0x78, 0xca, 0xfb, 0x1f,
-	0x1e, 0xba, 0x0f, 0x57, 0x2e, 0x34, 0xe8, 0x38, 0x10, 0x97, 0x35, 0xdd, 0x5a, 0x6d, 0xc6, 0x4b,
-	0x71, 0xf4, 0x14, 0xaa, 0x21, 0x8d, 0x62, 0x97, 0xcf, 0xea, 0xc9, 0xbf, 0x47, 0x3d, 0x95, 0x24,
-	0x36, 0x2d, 0x08, 0xdd, 0x80, 0x82, 0xb8, 0xbc, 0xf2, 0x2c, 0xe5, 0x8d, 0xc0, 0xab, 0x24, 0x60,
-	0x5d, 0x32, 0xa6, 0xfa, 0x6f, 0x0a, 0x94, 0xe6, 0xe2, 0xd1, 0x2d, 0xd1, 0x44, 0xe2, 0xcd, 0x22,
-	0xa1, 0x28, 0x33, 0x2f, 0x26, 0x54, 0x82, 0x18, 0xe1, 0x10, 0x7d, 0x29, 0x9a, 0x48, 0xba, 0x45,
-	0xc6, 0xe9, 0x2d, 0x58, 0x94, 0xd3, 0x9e, 0x81, 0xf7, 0x4d, 0x6c, 0x89, 0xdd, 0xc0, 0xa9, 0xe2,
-	0x76, 0xec, 0xd9, 0xa2, 0x7d, 0x1c, 0x7a, 0x4c, 0x44, 0x61, 0xc9, 0x84, 0x93, 0x17, 0x1b, 0x97,
-	0x53, 0x30, 0x19, 0x70, 0x37, 0xa1, 0x40, 0x3d, 0xdb, 0x77, 0x44, 0xd9, 0x49, 0xbe, 0x33, 0x5b,
-	0xff, 0x55, 0x81, 0xf2, 0x7c, 0x23, 0xa0, 0xbb, 0x42, 0x91, 0xd3, 0x70, 0xcc, 0x3c, 0x16, 0x71,
-	0x66, 0xa7, 0x4d, 0x9c, 0x05, 0xc5, 0x47, 0xc4, 0xf5, 0x6d, 0xe2, 0xca, 0x94, 0x0b, 0x38, 0x31,
-	0x90, 0x0e, 0xe5, 0x28, 0x1e, 0x44, 0x76, 0xc8, 0x02, 0xb1, 0xfb, 0x32, 0x99, 0x02, 0xce, 0x60,
-	0x22, 0x99, 0x88, 0x13, 0x4e, 0x8f, 0x63, 0x57, 0x26, 0x53, 0xc1, 0x33, 0x1b, 0x35, 0xa0, 0x34,
-	0x22, 0xde, 0x90, 0x79, 0x43, 0xf1, 0xcb, 0x50, 0x5b, 0x96, 0xe1, 0x90, 0x42, 0x46, 0xc0, 0x36,
-	0x74, 0x28, 0x9a, 0x5f, 0xf7, 0xcd, 0xee, 0x7e, 0xa7, 0xd7, 0x45, 0x05, 0x50, 0xbb, 0xbd, 0xae,
-	0xa9, 0x2d, 0xa1, 0x12, 0xac, 0x1a, 0xb8, 0xf5, 0xa4, 0x73, 0x68, 0x6a, 0xca, 0xc6, 0x0f, 0x0a,
-	0x94, 0xe7, 0xbb, 0x06, 0x95, 0xa1, 0xd0, 0xee, 0xec, 0x1b, 0xcd, 0x1d, 0xb3, 0xad, 0x2d, 0x21,
-	0x0d, 0xca, 0x8f, 0xcd, 0xbe, 0xd5, 0xdc, 0xe9, 0xb5, 0x9e, 0x76, 0x0f, 0x76, 0x35, 0x05, 0x5d,
-	0x03, 0x6d, 0x86, 0x58, 0xcd, 0x23, 0x4b, 0xa0, 0x39, 0x74, 0x13, 0xae, 0xef, 0x9b, 0x7d, 0x6b,
-	0xc7, 0xe8, 0x9b, 0xfb, 0x7d, 0xab, 0xd3, 0xb5, 0x76, 0xcd, 0xbe, 0xd1, 0x36, 0xfa, 0x86, 0x96,
-	0x47, 0xd7, 0x01, 0x65, 0x7d, 0xcd, 0x5e, 0xfb, 0x48, 0x53, 0x85, 0xf6, 0xa1, 0x89, 0x3b, 0xdb,
-	0x9d, 0x96, 0x21, 0x56, 0xd7, 0x96, 0x37, 0xbe, 0x57, 0xa0, 0x34, 0x77, 0x76, 0xa8, 0x08, 0xcb,
-	0xe6, 0xee, 0x5e, 0xff, 0x28, 0x49, 0x44, 0x7a, 0xc4, 0x92, 0x06, 0x7e, 0xac, 0x29, 0xe8, 0x2a,
-	0xac, 0x25, 0x48, 0xcb, 0xe8, 0xf6, 0xba, 0x9d, 0x96, 0xb1, 0xa3, 0xe5, 0x44, 0x76, 0x09, 0xd8,
-	0xee, 0xc8, 0x92, 0x0c, 0x7c, 0xa4, 0xe5, 0x51, 0x03, 0x3e, 0xb8, 0x8c, 0x5a, 0x3d, 0x6c, 0xf5,
-	0x70, 0xdb, 0xc4, 0x66, 0x5b, 0x53, 0xc5, 0x96, 0xb4, 0xcd, 0x6d, 0xe3, 0x60, 0xa7, 0xaf, 0xad,
-	0x34, 0x9b, 0xbf, 0x9c, 0xd7, 0x95, 0xe7, 0xe7, 0x75, 0xe5, 0xc5, 0x79, 0x5d, 0xf9, 0xf3, 0xbc,
-	0xae, 0xfc, 0xf8, 0xb2, 0xbe, 0xf4, 0xe2, 0x65, 0x7d, 0xe9, 0x8f, 0x97, 0xf5, 0xa5, 0x6f, 0xee,
-	0x0e, 0x19, 0x1f, 0xc5, 0x83, 0x4d, 0xdb, 0x1f, 0x6f, 0x65, 0xfe, 0x73, 0x4f, 0x93, 0x3f, 0x5d,
-	0xf1, 0x0d, 0x88, 0x06, 0x2b, 0xf2, 0xc7, 0xf5, 0xe1, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x61,
-	0x6b, 0xc1, 0x70, 0x0b, 0x0b, 0x00, 0x00,
+	// 1433 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0x4d, 0x6f, 0xdb, 0x46,
+	0x10, 0x35, 0x25, 0x59, 0x96, 0x46, 0x1f, 0xa6, 0xd7, 0x49, 0xaa, 0xa4, 0x89, 0xe4, 0x32, 0x69,
+	0x6b, 0x38, 0x80, 0x8d, 0x26, 0x28, 0x50, 0x04, 0x05, 0x0a, 0x4a, 0xa2, 0x63, 0x25, 0xb6, 0x64,
+	0xac, 0x64, 0xa3, 0xee, 0x85, 0x58, 0x93, 0x6b, 0x89, 0x08, 0x45, 0xb2, 0xe4, 0xd2, 0xb0, 0xfb,
+	0x2b, 0x7a, 0x2a, 0x7a, 0xed, 0xa1, 0x40, 0x81, 0x9e, 0xfa, 0x2b, 0x9a, 0x4b, 0x81, 0x1c, 0x7b,
+	0x32, 0x0a, 0xe7, 0x50, 0x34, 0xc7, 0xdc, 0x0b, 0x14, 0xbb, 0x24, 0x25, 0xd1, 0x51, 0x82, 0xe6,
+	0x44, 0xce, 0x9b, 0x37, 0x6f, 0x67, 0x77, 0x67, 0x86, 0x84, 0x4f, 0x6c, 0x72, 0x4a, 0x1c, 0xca,
+	0xb6, 0xf8, 0x73, 0x2b, 0xf0, 0xa8, 0xb1, 0x45, 0x3c, 0x4b, 0x37, 0x5c, 0xdb, 0xa6, 0x06, 0xb3,
+	0x5c, 0x67, 0xd3, 0xf3, 0x5d, 0xe6, 0xa2, 0x95, 0x98, 0xb7, 0xc9, 0x9f, 0x9b, 0x9c, 0x77, 0xeb,
+	0xda, 0xd0, 0x1d, 0xba, 0xc2, 0xbb, 0xc5, 0xdf, 0x22, 0xa2, 0xf2, 0x6f, 0x16, 0x2a, 0xaa, 0x67,
+	0xb5, 0x26, 0x02, 0xa8, 0x06, 0x4b, 0xd4, 0x21, 0xc7, 0x36, 0x35, 0x6b, 0xd2, 0x9a, 0xb4, 0x5e,
+	0xc0, 0x89, 0x89, 0xf6, 0x61, 0x79, 0xba, 0x90, 0x6e, 0x12, 0x46, 0x6a, 0x99, 0x35, 0x69, 0xbd,
+	0xf4, 0xe0, 0xa3, 0xcd, 0x37, 0x96, 0xdb, 0x9c, 0x2a, 0xb6, 0x09, 0x23, 0xcd, 0xdc, 0xf3, 0x8b,
+	0xc6, 0x02, 0xae, 0x1a, 0x29, 0x14, 0x6d, 0x40, 0x8e, 0x78, 0x56, 0x50, 0xcb, 0xae, 0x65, 0xd7,
+	0x4b, 0x0f, 0x6e, 0xcc, 0x91, 0x51, 0x3d, 0x0b, 0x0b, 0x0e, 0x7a, 0x08, 0x4b, 0x23, 0x4a, 0x4c,
+	0xea, 0x07, 0xb5, 0x9c, 0xa0, 0xdf, 0x9c, 0x43, 0xdf, 0x11, 0x0c, 0x9c, 0x30, 0xd1, 0x2e, 0xc8,
+	0x96, 0x33, 0xa2, 0xbe, 0xc5, 0x88, 0x63, 0x50, 0x5d, 0x2c, 0xb6, 0xb8, 0x96, 0xfd, 0x5f, 0x39,
+	0xe3, 0xe5, 0x99, 0x50, 0x95, 0xa7, 0xb0, 0x0b, 0xb2, 0x47, 0xfc, 0x80, 0xea, 0xa6, 0xe5, 0x73,
+	0xde, 0x29, 0x0d, 0x6a, 0xf9, 0xb7, 0xaa, 0xed, 0x73, 0x6a, 0x3b, 0x61, 0xe2, 0x65, 0x2f, 0x65,
+	0x07, 0xe8, 0x4b, 0x00, 0x7a, 0xc6, 0xa8, 0x13, 0x58, 0xae, 0x13, 0xd4, 0x96, 0x84, 0xce, 0xed,
+	0x39, 0x3a, 0x5a, 0x42, 0xc2, 0x33, 0x7c, 0xa4, 0x41, 0xe5, 0x94, 0xfa, 0xd6, 0x89, 0x65, 0x10,
+	0x26, 0x04, 0x0a, 0x42, 0xa0, 0x31, 0x47, 0xe0, 0x70, 0x86, 0x87, 0xd3, 0x51, 0xca, 0xb7, 0x50,
+	0x9c, 0xe8, 0x23, 0x04, 0x39, 0x87, 0x8c, 0xa9, 0xb8, 0xf7, 0x22, 0x16, 0xef, 0xe8, 0x2e, 0x54,
+	0x8c, 0x50, 0x1f, 0x87, 0x36, 0xb3, 0x3c, 0xdb, 0xa2, 0xbe, 0xb8, 0xf2, 0x0c, 0x2e, 0x1b, 0xe1,
+	0xde, 0x04, 0x43, 0xf7, 0x21, 0xe7, 0x87, 0x36, 0xad, 0x65, 0x45, 0x39, 0x7c, 0x30, 0x27, 0x07,
+	0x1c, 0xda, 0x14, 0x0b, 0x92, 0xf2, 0xbb, 0x04, 0x39, 0x6e, 0xa2, 0x6b, 0xb0, 0x78, 0x6c, 0xbb,
+	0xc6, 0x33, 0xb1, 0x5e, 0x0e, 0x47, 0x06, 0xaf, 0xbf, 0x31, 0x65, 0x23, 0xd7, 0x0c, 0x6a, 0x99,
+	0xb5, 0xec, 0x7a, 0x11, 0x27, 0x26, 0x6a, 0x40, 0x49, 0x50, 0x74, 0x9f, 0x38, 0xc3, 0x68, 0xb1,
+	0x1c, 0x06, 0x01, 0x61, 0x8e, 0xa0, 0x01, 0xac, 0x0a, 0x97, 0x1e, 0x30, 0xe2, 0x33, 0x9d, 0x1f,
+	0xb8, 0xe5, 0x0c, 0x6b, 0x39, 0x91, 0x55, 0x7d, 0x4e, 0x56, 0x4d, 0x1e, 0x2b, 0xee, 0xc9, 0x8f,
+	0x2b, 0x74, 0x45, 0x08, 0xf4, 0x79, 0xfc, 0x7e, 0x14, 0xce, 0x13, 0x4a, 0x0a, 0x6f, 0x31, 0x4a,
+	0x28, 0x36, 0x95, 0x9f, 0x25, 0x28, 0xcf, 0x1e, 0xee, 0xdc, 0x03, 0x7c, 0x02, 0xcb, 0x57, 0x8a,
+	0xe6, 0x1d, 0x5d, 0x73, 0xa5, 0x66, 0xaa, 0xe9, 0x9a, 0x41, 0x9f, 0x43, 0xfe, 0x94, 0xd8, 0x21,
+	0x4d, 0x3a, 0xe6, 0xce, 0xdb, 0x24, 0x0e, 0x39, 0x0b, 0xc7, 0x64, 0xe5, 0x3b, 0x80, 0x29, 0x8a,
+	0x6e, 0x43, 0x71, 0x52, 0x47, 0x71, 0xa6, 0x53, 0x00, 0x7d, 0x0c, 0x55, 0x7a, 0xe6, 0x51, 0x83,
+	0x51, 0x53, 0x17, 0xe1, 0x22, 0xdb, 0x22, 0xae, 0x24, 0x68, 0x24, 0xf2, 0x29, 0x2c, 0xdb, 0x84,
+	0xd1, 0x80, 0xe9, 0xa6, 0x15, 0x88, 0x0e, 0x89, 0xef, 0xa3, 0x1a, 0xc1, 0xed, 0x18, 0x55, 0x7e,
+	0xcb, 0x40, 0x35, 0xdd, 0x57, 0xe8, 0x10, 0x2a, 0x7c, 0x68, 0x59, 0x0e, 0xa3, 0xfe, 0x09, 0x31,
+	0xe2, 0xe3, 0x6a, 0x7e, 0xf6, 0xea, 0xa2, 0x91, 0x76, 0xbc, 0xbe, 0x68, 0xdc, 0x1e, 0x13, 0x2f,
+	0x60, 0x7e, 0x68, 0xb0, 0xd0, 0xa7, 0x8f, 0x94, 0x94, 0x5b, 0xc1, 0x65, 0xe2, 0x59, 0x9d, 0xc4,
+	0xe4, 0xba, 0xc2, 0xe7, 0x10, 0x5b, 0xf7, 0x08, 0x1b, 0xd5, 0x32, 0x53, 0xdd, 0x94, 0xe3, 0x4d,
+	0xdd, 0x94, 0x5b, 0xc1, 0xe5, 0xc4, 0xde, 0x27, 0x6c, 0x84, 0x1e, 0x42, 0x8e, 0x9d, 0x7b, 0xd1,
+	0x06, 0x8b, 0xcd, 0xc6, 0xab, 0x8b, 0x86, 0xb0, 0x5f, 0x5f, 0x34, 0x56, 0xd3, 0x2a, 0x1c, 0x55,
+	0xb0, 0x70, 0xa2, 0x47, 0x90, 0x27, 0xa6, 0xa9, 0xbb, 0x8e, 0x28, 0xbf, 0x62, 0xf3, 0xee, 0xab,
+	0x8b, 0x46, 0x8c, 0xbc, 0xbe, 0x68, 0x5c, 0xbf, 0xb2, 0x2d, 0x81, 0x2b, 0x78, 0x91, 0x98, 0x66,
+	0xcf, 0x51, 0xfe, 0x96, 0x20, 0x1f, 0x4d, 0xb2, 0xb9, 0x15, 0xf5, 0x05, 0xe4, 0x9e, 0x59, 0x8e,
+	0x29, 0xb6, 0x57, 0x7d, 0x70, 0xef, 0xad, 0x63, 0x30, 0x7e, 0x0c, 0xce, 0x3d, 0x8a, 0x45, 0x04,
+	0x6a, 0x42, 0xf9, 0x24, 0x74, 0xa2, 0xf9, 0xcd, 0xc8, 0x50, 0xec, 0xa8, 0x3a, 0x77, 0x66, 0x6c,
+	0x1f, 0x74, 0x5b, 0x83, 0x4e, 0xaf, 0xab, 0x0f, 0xd4, 0xc7, 0xb8, 0x94, 0x04, 0x0d, 0xc8, 0x50,
+	0x79, 0x0a, 0x30, 0xd5, 0x45, 0x15, 0x28, 0x7a, 0x24, 0x08, 0xf4, 0x80, 0x3a, 0xa6, 0xbc, 0x80,
+	0xaa, 0x00, 0xc2, 0xf4, 0xa9, 0x67, 0x9f, 0xcb, 0xd2, 0xc4, 0x7d, 0xec, 0xb2, 0x91, 0x9c, 0x41,
+	0xcb, 0x50, 0x12, 0xa6, 0x35, 0x74, 0x5c, 0x9f, 0xca, 0x59, 0xe5, 0x87, 0x0c, 0x64, 0x55, 0xcf,
+	0x7a, 0xc7, 0x47, 0x27, 0x39, 0x80, 0xcc, 0x95, 0x99, 0xe4, 0x8e, 0xbd, 0x90, 0x51, 0x3d, 0x74,
+	0x2c, 0x16, 0xc4, 0xa5, 0x57, 0x8e, 0xc1, 0x03, 0x8e, 0xa1, 0x4d, 0x58, 0xa5, 0x67, 0xcc, 0x27,
+	0x7a, 0x9a, 0x9a, 0x13, 0xd4, 0x15, 0xe1, 0x6a, 0xcd, 0xf2, 0x55, 0x28, 0x18, 0x84, 0xd1, 0xa1,
+	0xeb, 0x9f, 0xd7, 0xf2, 0xa2, 0x41, 0xe7, 0x9d, 0x4b, 0xdf, 0xa3, 0x46, 0x2b, 0xa6, 0xc5, 0x23,
+	0x63, 0x12, 0x86, 0x3a, 0x50, 0x89, 0x06, 0x54, 0x32, 0x79, 0x96, 0xde, 0x63, 0xf2, 0x94, 0x8f,
+	0x13, 0xc8, 0x72, 0x86, 0xca, 0x3f, 0x12, 0x54, 0xd3, 0xc3, 0xe0, 0x8d, 0xcb, 0x93, 0xde, 0xff,
+	0xf2, 0xd0, 0x7d, 0x58, 0x99, 0x6a, 0xd0, 0xb1, 0xc7, 0x9b, 0x35, 0x3e, 0x5a, 0x79, 0xc2, 0x8b,
+	0x71, 0xf4, 0x14, 0xaa, 0x3e, 0x0d, 0x42, 0x7b, 0x3a, 0x49, 0xb3, 0xef, 0xb1, 0x9f, 0x4a, 0x14,
+	0x9b, 0x4c, 0xd1, 0x9b, 0x50, 0xe0, 0xcd, 0x2b, 0xee, 0x52, 0x74, 0x04, 0x5e, 0x22, 0x9e, 0xd5,
+	0x25, 0x63, 0xaa, 0xfc, 0x21, 0x41, 0x69, 0x26, 0x1e, 0xdd, 0xe1, 0x45, 0xc4, 0xdf, 0x74, 0xe2,
+	0xf3, 0x6d, 0xf2, 0x99, 0x5b, 0x8c, 0x10, 0xd5, 0x1f, 0xa2, 0xaf, 0xa0, 0x14, 0x19, 0x3a, 0xcf,
+	0x38, 0xee, 0x82, 0x79, 0x39, 0xed, 0xab, 0xb8, 0xaf, 0x61, 0x9d, 0x9f, 0x06, 0x8e, 0x15, 0xb7,
+	0x43, 0xc7, 0xe0, 0xe5, 0x63, 0xd2, 0x13, 0xc2, 0x37, 0x16, 0x4d, 0x38, 0xd1, 0xd8, 0xb8, 0x1c,
+	0x83, 0xd1, 0x80, 0xbb, 0x05, 0x05, 0xea, 0x18, 0xae, 0x99, 0x7c, 0x40, 0x8a, 0x78, 0x62, 0xf3,
+	0x04, 0xa3, 0x7b, 0x1e, 0x91, 0x60, 0x54, 0x5b, 0x14, 0x05, 0x5b, 0x14, 0xc8, 0x0e, 0x09, 0x46,
+	0xca, 0xaf, 0x12, 0x94, 0x67, 0xeb, 0x04, 0xdd, 0xe3, 0x0b, 0x32, 0xea, 0x8f, 0x2d, 0xc7, 0x0a,
+	0x98, 0x65, 0xc4, 0x35, 0x9e, 0x06, 0xf9, 0xe7, 0xd0, 0x76, 0x0d, 0x62, 0x8b, 0x1d, 0x15, 0x70,
+	0x64, 0x20, 0x05, 0xca, 0x41, 0x78, 0x1c, 0x18, 0xbe, 0xe5, 0xf1, 0xcb, 0x11, 0xb9, 0x16, 0x70,
+	0x0a, 0xe3, 0xb9, 0x06, 0x8c, 0x30, 0x7a, 0x12, 0xda, 0x22, 0xd7, 0x0a, 0x9e, 0xd8, 0xfc, 0xa3,
+	0x39, 0x22, 0xce, 0xd0, 0x72, 0x86, 0xfc, 0xef, 0x27, 0x4e, 0x16, 0x62, 0x48, 0xf5, 0xac, 0x0d,
+	0x05, 0x8a, 0xda, 0xd7, 0x03, 0xad, 0xdb, 0xef, 0xf4, 0xba, 0xa8, 0x00, 0xb9, 0x6e, 0xaf, 0xab,
+	0xc9, 0x0b, 0xa8, 0x04, 0x4b, 0x2a, 0x6e, 0xed, 0x74, 0x0e, 0x35, 0x59, 0xda, 0xf8, 0x49, 0x82,
+	0xf2, 0x6c, 0x51, 0xa1, 0x32, 0x14, 0xda, 0x9d, 0xbe, 0xda, 0xdc, 0xd5, 0xda, 0xf2, 0x02, 0x92,
+	0xa1, 0xfc, 0x58, 0x1b, 0xe8, 0xcd, 0xdd, 0x5e, 0xeb, 0x69, 0xf7, 0x60, 0x4f, 0x96, 0xd0, 0x35,
+	0x90, 0x27, 0x88, 0xde, 0x3c, 0xd2, 0x39, 0x9a, 0x41, 0xb7, 0xe0, 0x46, 0x5f, 0x1b, 0xe8, 0xbb,
+	0xea, 0x40, 0xeb, 0x0f, 0xf4, 0x4e, 0x57, 0xdf, 0xd3, 0x06, 0x6a, 0x5b, 0x1d, 0xa8, 0x72, 0x16,
+	0xdd, 0x00, 0x94, 0xf6, 0x35, 0x7b, 0xed, 0x23, 0x39, 0xc7, 0xb5, 0x0f, 0x35, 0xdc, 0xd9, 0xee,
+	0xb4, 0x54, 0xbe, 0xba, 0xbc, 0x88, 0xae, 0xc3, 0x4a, 0x4a, 0x7b, 0x47, 0xed, 0xef, 0xc8, 0xf9,
+	0x8d, 0x1f, 0x25, 0x28, 0xcd, 0xdc, 0x38, 0x2a, 0xc2, 0xa2, 0xb6, 0xb7, 0x3f, 0x38, 0x8a, 0xf2,
+	0x13, 0x1e, 0xce, 0x56, 0xf1, 0x63, 0x59, 0x42, 0xab, 0xb0, 0x1c, 0x21, 0x2d, 0xb5, 0xdb, 0xeb,
+	0x76, 0x5a, 0xea, 0xae, 0x9c, 0xe1, 0x49, 0x47, 0x60, 0xbb, 0x23, 0x76, 0xaa, 0xe2, 0x23, 0x39,
+	0x8b, 0x1a, 0xf0, 0xe1, 0x55, 0x54, 0xef, 0x61, 0xbd, 0x87, 0xdb, 0x1a, 0xd6, 0xda, 0x72, 0x8e,
+	0x9f, 0x54, 0x5b, 0xdb, 0x56, 0x0f, 0x76, 0x07, 0x72, 0x7e, 0x2a, 0xfc, 0xa4, 0xdf, 0xeb, 0xea,
+	0xfb, 0xea, 0x60, 0x47, 0x5e, 0x6a, 0x36, 0x7f, 0xb9, 0xac, 0x4b, 0xcf, 0x2f, 0xeb, 0xd2, 0x8b,
+	0xcb, 0xba, 0xf4, 0xd7, 0x65, 0x5d, 0xfa, 0xfe, 0x65, 0x7d, 0xe1, 0xc5, 0xcb, 0xfa, 0xc2, 0x9f,
+	0x2f, 0xeb, 0x0b, 0xdf, 0xdc, 0x1b, 0x5a, 0x6c, 0x14, 0x1e, 0x6f, 0x1a, 0xee, 0x78, 0x2b, 0xf5,
+	0x7b, 0x7f, 0x16, 0xfd, 0xe0, 0xf3, 0xcf, 0x49, 0x70, 0x9c, 0x17, 0xff, 0xeb, 0x0f, 0xff, 0x1b,
+	0x00, 0x88, 0x82, 0x6a, 0x2b, 0x02, 0x0c, 0x00, 0x00,
 }
 
 func (this *ApiCollection) Equal(that interface{}) bool {
@@ -1140,6 +1192,28 @@ func (this *Rule) Equal(that interface{}) bool {
 	if this.Block != that1.Block {
 		return false
 	}
+	if len(this.Methods) != len(that1.Methods) {
+		return false
+	}
+	for i := range this.Methods {
+		if this.Methods[i] != that1.Methods[i] {
+			return false
+		}
+	}
+	if this.BlockRange != that1.BlockRange {
+		return false
+	}
+	if !this.RangeStartParsing.Equal(&that1.RangeStartParsing) {
+		return false
+	}
+	if len(this.Headers) != len(that1.Headers) {
+		return false
+	}
+	for i := range this.Headers {
+		if this.Headers[i] != that1.Headers[i] {
+			return false
+		}
+	}
 	return true
 }
 func (this *Verification) Equal(that interface{}) bool {
@@ -1378,6 +1452,9 @@ func (this *BlockParser) Equal(that interface{}) bool {
 	if this.Encoding != that1.Encoding {
 		return false
 	}
+	if this.BlockHash != that1.BlockHash {
+		return false
+	}
 	return true
 }
 func (this *SpecCategory) Equal(that interface{}) bool {
@@ -1611,6 +1688,39 @@ func (m *Rule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Headers) > 0 {
+		for iNdEx := len(m.Headers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Headers[iNdEx])
+			copy(dAtA[i:], m.Headers[iNdEx])
+			i = encodeVarintApiCollection(dAtA, i, uint64(len(m.Headers[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	{
+		size, err := m.RangeStartParsing.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintApiCollection(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.BlockRange != 0 {
+		i = encodeVarintApiCollection(dAtA, i, uint64(m.BlockRange))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Methods) > 0 {
+		for iNdEx := len(m.Methods) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Methods[iNdEx])
+			copy(dAtA[i:], m.Methods[iNdEx])
+			i = encodeVarintApiCollection(dAtA, i, uint64(len(m.Methods[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if m.Block != 0 {
 		i = encodeVarintApiCollection(dAtA, i, uint64(m.Block))
 		i--
@@ -1950,6 +2060,16 @@ func (m *BlockParser) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.BlockHash {
+		i--
+		if m.BlockHash {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
 	if len(m.Encoding) > 0 {
 		i -= len(m.Encoding)
 		copy(dAtA[i:], m.Encoding)
@@ -2139,6 +2259,23 @@ func (m *Rule) Size() (n int) {
 	if m.Block != 0 {
 		n += 1 + sovApiCollection(uint64(m.Block))
 	}
+	if len(m.Methods) > 0 {
+		for _, s := range m.Methods {
+			l = len(s)
+			n += 1 + l + sovApiCollection(uint64(l))
+		}
+	}
+	if m.BlockRange != 0 {
+		n += 1 + sovApiCollection(uint64(m.BlockRange))
+	}
+	l = m.RangeStartParsing.Size()
+	n += 1 + l + sovApiCollection(uint64(l))
+	if len(m.Headers) > 0 {
+		for _, s := range m.Headers {
+			l = len(s)
+			n += 1 + l + sovApiCollection(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2300,6 +2437,9 @@ func (m *BlockParser) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovApiCollection(uint64(l))
 	}
+	if m.BlockHash {
+		n += 2
+	}
 	return n
 }
 
@@ -2817,6 +2957,122 @@ func (m *Rule) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Methods", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApiCollection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApiCollection
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApiCollection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Methods = append(m.Methods, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRange", wireType)
+			}
+			m.BlockRange = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApiCollection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockRange |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RangeStartParsing", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApiCollection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApiCollection
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApiCollection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RangeStartParsing.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApiCollection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApiCollection
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApiCollection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Headers = append(m.Headers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApiCollection(dAtA[iNdEx:])
@@ -3937,6 +4193,26 @@ func (m *BlockParser) Unmarshal(dAtA []byte) error {
 			}
 			m.Encoding = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApiCollection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BlockHash = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApiCollection(dAtA[iNdEx:])
@@ -1,8 +1,6 @@
 package types
 
-import (
-	"fmt"
-)
+import "fmt"
 
 // DefaultIndex is the default capability global index
 const DefaultIndex uint64 = 1
@@ -10,7 +8,8 @@ const DefaultIndex uint64 = 1
 // DefaultGenesis returns the default Capability genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
-		SpecList: []Spec{},
+		SpecList:      []Spec{},
+		SpecRevisions: []SpecRevision{},
 		// this line is used by starport scaffolding # genesis/types/default
 		Params: DefaultParams(),
 	}
@@ -25,9 +25,10 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the spec module's genesis state.
 type GenesisState struct {
-	Params    Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	SpecList  []Spec `protobuf:"bytes,2,rep,name=specList,proto3" json:"specList"`
-	SpecCount uint64 `protobuf:"varint,3,opt,name=specCount,proto3" json:"specCount,omitempty"`
+	Params        Params         `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	SpecList      []Spec         `protobuf:"bytes,2,rep,name=specList,proto3" json:"specList"`
+	SpecCount     uint64         `protobuf:"varint,3,opt,name=specCount,proto3" json:"specCount,omitempty"`
+	SpecRevisions []SpecRevision `protobuf:"bytes,4,rep,name=specRevisions,proto3" json:"specRevisions"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -84,30 +85,104 @@ func (m *GenesisState) GetSpecCount() uint64 {
 	return 0
 }
 
+func (m *GenesisState) GetSpecRevisions() []SpecRevision {
+	if m != nil {
+		return m.SpecRevisions
+	}
+	return nil
+}
+
+// SpecRevision is the expanded spec of a chain as of the block it was added
+type SpecRevision struct {
+	ChainID string `protobuf:"bytes,1,opt,name=chainID,proto3" json:"chainID,omitempty"`
+	Block   uint64 `protobuf:"varint,2,opt,name=block,proto3" json:"block,omitempty"`
+	Spec    Spec   `protobuf:"bytes,3,opt,name=spec,proto3" json:"spec"`
+}
+
+func (m *SpecRevision) Reset()         { *m = SpecRevision{} }
+func (m *SpecRevision) String() string { return proto.CompactTextString(m) }
+func (*SpecRevision) ProtoMessage()    {}
+func (*SpecRevision) Descriptor() ([]byte, []int) {
+	return fileDescriptor_012a82932c0e5e6a, []int{1}
+}
+func (m *SpecRevision) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SpecRevision) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SpecRevision.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SpecRevision) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpecRevision.Merge(m, src)
+}
+func (m *SpecRevision) XXX_Size() int {
+	return m.Size()
+}
+func (m *SpecRevision) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpecRevision.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SpecRevision proto.InternalMessageInfo
+
+func (m *SpecRevision) GetChainID() string {
+	if m != nil {
+		return m.ChainID
+	}
+	return ""
+}
+
+func (m *SpecRevision) GetBlock() uint64 {
+	if m != nil {
+		return m.Block
+	}
+	return 0
+}
+
+func (m *SpecRevision) GetSpec() Spec {
+	if m != nil {
+		return m.Spec
+	}
+	return Spec{}
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "lavanet.lava.spec.GenesisState")
+	proto.RegisterType((*SpecRevision)(nil), "lavanet.lava.spec.SpecRevision")
 }
 
 func init() { proto.RegisterFile("lavanet/lava/spec/genesis.proto", fileDescriptor_012a82932c0e5e6a) }
 
 var fileDescriptor_012a82932c0e5e6a = []byte{
-	// 244 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xcf, 0x49, 0x2c, 0x4b,
-	0xcc, 0x4b, 0x2d, 0xd1, 0x07, 0xd1, 0xfa, 0xc5, 0x05, 0xa9, 0xc9, 0xfa, 0xe9, 0xa9, 0x79, 0xa9,
-	0xc5, 0x99, 0xc5, 0x7a, 0x05, 0x45, 0xf9, 0x25, 0xf9, 0x42, 0x82, 0x50, 0x05, 0x7a, 0x20, 0x5a,
-	0x0f, 0xa4, 0x40, 0x4a, 0x24, 0x3d, 0x3f, 0x3d, 0x1f, 0x2c, 0xab, 0x0f, 0x62, 0x41, 0x14, 0x4a,
-	0xc9, 0x61, 0x9a, 0x54, 0x90, 0x58, 0x94, 0x98, 0x0b, 0x35, 0x48, 0x4a, 0x06, 0x53, 0x1e, 0x44,
-	0x40, 0x64, 0x95, 0x16, 0x30, 0x72, 0xf1, 0xb8, 0x43, 0x2c, 0x0e, 0x2e, 0x49, 0x2c, 0x49, 0x15,
-	0x32, 0xe7, 0x62, 0x83, 0x68, 0x97, 0x60, 0x54, 0x60, 0xd4, 0xe0, 0x36, 0x92, 0xd4, 0xc3, 0x70,
-	0x88, 0x5e, 0x00, 0x58, 0x81, 0x13, 0xcb, 0x89, 0x7b, 0xf2, 0x0c, 0x41, 0x50, 0xe5, 0x42, 0x96,
-	0x5c, 0x1c, 0x20, 0x49, 0x9f, 0xcc, 0xe2, 0x12, 0x09, 0x26, 0x05, 0x66, 0x0d, 0x6e, 0x23, 0x71,
-	0x2c, 0x5a, 0x83, 0x0b, 0x52, 0x93, 0xa1, 0x1a, 0xe1, 0xca, 0x85, 0x64, 0xb8, 0x38, 0x41, 0x6c,
-	0xe7, 0xfc, 0xd2, 0xbc, 0x12, 0x09, 0x66, 0x05, 0x46, 0x0d, 0x96, 0x20, 0x84, 0x80, 0x93, 0xdd,
-	0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c,
-	0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0xa9, 0xa4, 0x67, 0x96, 0x64, 0x94,
-	0x26, 0xe9, 0x25, 0xe7, 0xe7, 0xea, 0xa3, 0xf8, 0xb2, 0x02, 0xe2, 0xcf, 0x92, 0xca, 0x82, 0xd4,
-	0xe2, 0x24, 0x36, 0xb0, 0x4f, 0x8d, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0x3b, 0xb9, 0xed, 0xea,
-	0x73, 0x01, 0x00, 0x00,
+	// 325 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x91, 0x31, 0x4f, 0x3a, 0x31,
+	0x18, 0xc6, 0xaf, 0x70, 0x7f, 0xfe, 0x52, 0x70, 0xb0, 0x21, 0xb1, 0x12, 0x52, 0x08, 0x71, 0x60,
+	0xea, 0x45, 0x1c, 0x8c, 0x8b, 0x03, 0x9a, 0x18, 0xa3, 0x83, 0x39, 0x36, 0xb7, 0x72, 0x69, 0x8e,
+	0x46, 0xb8, 0x9e, 0xb4, 0x10, 0xfd, 0x16, 0x7e, 0x2c, 0x46, 0x46, 0x27, 0x63, 0xb8, 0xd9, 0xef,
+	0x60, 0xda, 0x1e, 0x2a, 0x39, 0x8d, 0xcb, 0xb5, 0xef, 0x3d, 0xbf, 0xe7, 0xe9, 0xfb, 0xe6, 0x85,
+	0xed, 0x09, 0x5b, 0xb0, 0x84, 0xeb, 0xc0, 0x9c, 0x81, 0x4a, 0x79, 0x14, 0xc4, 0x3c, 0xe1, 0x4a,
+	0x28, 0x9a, 0xce, 0xa4, 0x96, 0x68, 0x2f, 0x07, 0xa8, 0x39, 0xa9, 0x01, 0x9a, 0x8d, 0x58, 0xc6,
+	0xd2, 0xaa, 0x81, 0xb9, 0x39, 0xb0, 0x49, 0x8a, 0x49, 0x29, 0x9b, 0xb1, 0x69, 0x1e, 0xd4, 0x6c,
+	0x15, 0x75, 0xf3, 0x71, 0x6a, 0xf7, 0x1d, 0xc0, 0xfa, 0xa5, 0x7b, 0x78, 0xa8, 0x99, 0xe6, 0xe8,
+	0x04, 0x56, 0x9c, 0x1d, 0x83, 0x0e, 0xe8, 0xd5, 0xfa, 0x07, 0xb4, 0xd0, 0x08, 0xbd, 0xb5, 0xc0,
+	0xc0, 0x5f, 0xbe, 0xb6, 0xbd, 0x30, 0xc7, 0xd1, 0x29, 0xdc, 0x31, 0xe2, 0x8d, 0x50, 0x1a, 0x97,
+	0x3a, 0xe5, 0x5e, 0xad, 0xbf, 0xff, 0x83, 0x75, 0x98, 0xf2, 0x28, 0x37, 0x7e, 0xe2, 0xa8, 0x05,
+	0xab, 0xe6, 0x7e, 0x2e, 0xe7, 0x89, 0xc6, 0xe5, 0x0e, 0xe8, 0xf9, 0xe1, 0xd7, 0x0f, 0x74, 0x0d,
+	0x77, 0x4d, 0x11, 0xf2, 0x85, 0x50, 0x42, 0x26, 0x0a, 0xfb, 0x36, 0xbd, 0xfd, 0x4b, 0xfa, 0x86,
+	0xcb, 0x5f, 0xd9, 0xf6, 0x76, 0x1f, 0x60, 0xfd, 0x3b, 0x84, 0x30, 0xfc, 0x1f, 0x8d, 0x99, 0x48,
+	0xae, 0x2e, 0xec, 0xbc, 0xd5, 0x70, 0x53, 0xa2, 0x06, 0xfc, 0x37, 0x9a, 0xc8, 0xe8, 0x1e, 0x97,
+	0x6c, 0x43, 0xae, 0x40, 0x47, 0xd0, 0x37, 0x81, 0xb6, 0xcb, 0x3f, 0x27, 0xb4, 0xe8, 0xe0, 0x6c,
+	0xb9, 0x26, 0x60, 0xb5, 0x26, 0xe0, 0x6d, 0x4d, 0xc0, 0x73, 0x46, 0xbc, 0x55, 0x46, 0xbc, 0x97,
+	0x8c, 0x78, 0x77, 0x87, 0xb1, 0xd0, 0xe3, 0xf9, 0x88, 0x46, 0x72, 0x1a, 0x6c, 0x6d, 0xe9, 0xd1,
+	0xed, 0x49, 0x3f, 0xa5, 0x5c, 0x8d, 0x2a, 0x76, 0x53, 0xc7, 0x1f, 0x03, 0x00, 0xca, 0x21, 0xac,
+	0x94, 0x33, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -130,6 +205,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SpecRevisions) > 0 {
+		for iNdEx := len(m.SpecRevisions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpecRevisions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if m.SpecCount != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.SpecCount))
 		i--
@@ -162,6 +251,51 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SpecRevision) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SpecRevision) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SpecRevision) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Block != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Block))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -190,6 +324,30 @@ func (m *GenesisState) Size() (n int) {
 	if m.SpecCount != 0 {
 		n += 1 + sovGenesis(uint64(m.SpecCount))
 	}
+	if len(m.SpecRevisions) > 0 {
+		for _, e := range m.SpecRevisions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SpecRevision) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Block != 0 {
+		n += 1 + sovGenesis(uint64(m.Block))
+	}
+	l = m.Spec.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -314,6 +472,174 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpecRevisions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpecRevisions = append(m.SpecRevisions, SpecRevision{})
+			if err := m.SpecRevisions[len(m.SpecRevisions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SpecRevision) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SpecRevision: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SpecRevision: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+			}
+			m.Block = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Block |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
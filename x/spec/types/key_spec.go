@@ -1,12 +1,18 @@
 package types
 
-import "encoding/binary"
+import (
+	"encoding/binary"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 var _ binary.ByteOrder
 
 const (
 	// SpecKeyPrefix is the prefix to retrieve all Spec
 	SpecKeyPrefix = "Spec/value/"
+	// SpecRevisionKeyPrefix is the prefix to retrieve all spec revisions
+	SpecRevisionKeyPrefix = "SpecRevision/value/"
 )
 
 // SpecKey returns the store key to retrieve a Spec from the index fields
@@ -21,3 +27,15 @@ func SpecKey(
 
 	return key
 }
+
+// SpecRevisionKey returns the store key to retrieve a spec revision from its chainID and block.
+// The block is big endian encoded so the revisions of a chain are iterated in ascending order.
+func SpecRevisionKey(
+	chainID string,
+	block uint64,
+) []byte {
+	key := SpecKey(chainID)
+	key = append(key, sdk.Uint64ToBigEndian(block)...)
+
+	return key
+}
@@ -617,6 +617,198 @@ func (m *QueryShowChainInfoResponse) GetOptionalInterfaces() []string {
 	return nil
 }
 
+type QuerySpecRevisionsRequest struct {
+	ChainID string `protobuf:"bytes,1,opt,name=ChainID,proto3" json:"ChainID,omitempty"`
+}
+
+func (m *QuerySpecRevisionsRequest) Reset()         { *m = QuerySpecRevisionsRequest{} }
+func (m *QuerySpecRevisionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySpecRevisionsRequest) ProtoMessage()    {}
+func (*QuerySpecRevisionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fac9d1cad3c30379, []int{12}
+}
+func (m *QuerySpecRevisionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySpecRevisionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySpecRevisionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySpecRevisionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySpecRevisionsRequest.Merge(m, src)
+}
+func (m *QuerySpecRevisionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySpecRevisionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySpecRevisionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySpecRevisionsRequest proto.InternalMessageInfo
+
+func (m *QuerySpecRevisionsRequest) GetChainID() string {
+	if m != nil {
+		return m.ChainID
+	}
+	return ""
+}
+
+type QuerySpecRevisionsResponse struct {
+	Blocks []uint64 `protobuf:"varint,1,rep,packed,name=blocks,proto3" json:"blocks,omitempty"`
+}
+
+func (m *QuerySpecRevisionsResponse) Reset()         { *m = QuerySpecRevisionsResponse{} }
+func (m *QuerySpecRevisionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySpecRevisionsResponse) ProtoMessage()    {}
+func (*QuerySpecRevisionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fac9d1cad3c30379, []int{13}
+}
+func (m *QuerySpecRevisionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySpecRevisionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySpecRevisionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySpecRevisionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySpecRevisionsResponse.Merge(m, src)
+}
+func (m *QuerySpecRevisionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySpecRevisionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySpecRevisionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySpecRevisionsResponse proto.InternalMessageInfo
+
+func (m *QuerySpecRevisionsResponse) GetBlocks() []uint64 {
+	if m != nil {
+		return m.Blocks
+	}
+	return nil
+}
+
+type QuerySpecDiffRequest struct {
+	ChainID   string `protobuf:"bytes,1,opt,name=ChainID,proto3" json:"ChainID,omitempty"`
+	FromBlock uint64 `protobuf:"varint,2,opt,name=from_block,json=fromBlock,proto3" json:"from_block,omitempty"`
+	ToBlock   uint64 `protobuf:"varint,3,opt,name=to_block,json=toBlock,proto3" json:"to_block,omitempty"`
+}
+
+func (m *QuerySpecDiffRequest) Reset()         { *m = QuerySpecDiffRequest{} }
+func (m *QuerySpecDiffRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySpecDiffRequest) ProtoMessage()    {}
+func (*QuerySpecDiffRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fac9d1cad3c30379, []int{14}
+}
+func (m *QuerySpecDiffRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySpecDiffRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySpecDiffRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySpecDiffRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySpecDiffRequest.Merge(m, src)
+}
+func (m *QuerySpecDiffRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySpecDiffRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySpecDiffRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySpecDiffRequest proto.InternalMessageInfo
+
+func (m *QuerySpecDiffRequest) GetChainID() string {
+	if m != nil {
+		return m.ChainID
+	}
+	return ""
+}
+
+func (m *QuerySpecDiffRequest) GetFromBlock() uint64 {
+	if m != nil {
+		return m.FromBlock
+	}
+	return 0
+}
+
+func (m *QuerySpecDiffRequest) GetToBlock() uint64 {
+	if m != nil {
+		return m.ToBlock
+	}
+	return 0
+}
+
+type QuerySpecDiffResponse struct {
+	Diff SpecDiff `protobuf:"bytes,1,opt,name=diff,proto3" json:"diff"`
+}
+
+func (m *QuerySpecDiffResponse) Reset()         { *m = QuerySpecDiffResponse{} }
+func (m *QuerySpecDiffResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySpecDiffResponse) ProtoMessage()    {}
+func (*QuerySpecDiffResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fac9d1cad3c30379, []int{15}
+}
+func (m *QuerySpecDiffResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySpecDiffResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySpecDiffResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySpecDiffResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySpecDiffResponse.Merge(m, src)
+}
+func (m *QuerySpecDiffResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySpecDiffResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySpecDiffResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySpecDiffResponse proto.InternalMessageInfo
+
+func (m *QuerySpecDiffResponse) GetDiff() SpecDiff {
+	if m != nil {
+		return m.Diff
+	}
+	return SpecDiff{}
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "lavanet.lava.spec.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "lavanet.lava.spec.QueryParamsResponse")
@@ -630,66 +822,81 @@ func init() {
 	proto.RegisterType((*QueryShowChainInfoRequest)(nil), "lavanet.lava.spec.QueryShowChainInfoRequest")
 	proto.RegisterType((*ApiList)(nil), "lavanet.lava.spec.ApiList")
 	proto.RegisterType((*QueryShowChainInfoResponse)(nil), "lavanet.lava.spec.QueryShowChainInfoResponse")
+	proto.RegisterType((*QuerySpecRevisionsRequest)(nil), "lavanet.lava.spec.QuerySpecRevisionsRequest")
+	proto.RegisterType((*QuerySpecRevisionsResponse)(nil), "lavanet.lava.spec.QuerySpecRevisionsResponse")
+	proto.RegisterType((*QuerySpecDiffRequest)(nil), "lavanet.lava.spec.QuerySpecDiffRequest")
+	proto.RegisterType((*QuerySpecDiffResponse)(nil), "lavanet.lava.spec.QuerySpecDiffResponse")
 }
 
 func init() { proto.RegisterFile("lavanet/lava/spec/query.proto", fileDescriptor_fac9d1cad3c30379) }
 
 var fileDescriptor_fac9d1cad3c30379 = []byte{
-	// 857 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0x4d, 0x4f, 0xdb, 0x48,
-	0x18, 0xc7, 0xe3, 0x24, 0x24, 0x64, 0x56, 0x48, 0xbb, 0x43, 0x24, 0x12, 0x07, 0x0c, 0x98, 0xd7,
-	0x45, 0x8b, 0xbd, 0xb0, 0xd2, 0xae, 0xf6, 0xb2, 0x52, 0x60, 0xb5, 0x88, 0x55, 0x85, 0xa8, 0xb9,
-	0x21, 0x55, 0xd1, 0xc4, 0x0c, 0x89, 0x25, 0xc7, 0x63, 0xe2, 0x09, 0x29, 0x45, 0x5c, 0xb8, 0xf5,
-	0x56, 0xb5, 0x97, 0x9e, 0xab, 0x7e, 0x18, 0x8e, 0x48, 0xbd, 0x70, 0xaa, 0x2a, 0xd2, 0x0f, 0x52,
-	0xcd, 0x4b, 0x12, 0x9b, 0xd8, 0x01, 0x55, 0xbd, 0x60, 0x66, 0x9e, 0x97, 0xff, 0x6f, 0xfe, 0x33,
-	0x3c, 0x02, 0xcc, 0xb9, 0xe8, 0x1c, 0x79, 0x98, 0x9a, 0xec, 0x6b, 0x06, 0x3e, 0xb6, 0xcd, 0xb3,
-	0x0e, 0x6e, 0x5f, 0x18, 0x7e, 0x9b, 0x50, 0x02, 0x7f, 0x91, 0x61, 0x83, 0x7d, 0x0d, 0x16, 0x56,
-	0x8b, 0x0d, 0xd2, 0x20, 0x3c, 0x6a, 0xb2, 0xdf, 0x44, 0xa2, 0x3a, 0xdb, 0x20, 0xa4, 0xe1, 0x62,
-	0x13, 0xf9, 0x8e, 0x89, 0x3c, 0x8f, 0x50, 0x44, 0x1d, 0xe2, 0x05, 0x32, 0xba, 0x61, 0x93, 0xa0,
-	0x45, 0x02, 0xb3, 0x8e, 0x02, 0x2c, 0xfa, 0x9b, 0xe7, 0x5b, 0x75, 0x4c, 0xd1, 0x96, 0xe9, 0xa3,
-	0x86, 0xe3, 0xf1, 0x64, 0x99, 0xab, 0x8d, 0x12, 0xf9, 0xa8, 0x8d, 0x5a, 0xfd, 0x5e, 0xb3, 0xa3,
-	0x71, 0xf6, 0x43, 0x44, 0xf5, 0x22, 0x80, 0xcf, 0x59, 0xff, 0x43, 0x5e, 0x62, 0xe1, 0xb3, 0x0e,
-	0x0e, 0xa8, 0x7e, 0x00, 0xa6, 0x23, 0xbb, 0x81, 0x4f, 0xbc, 0x00, 0xc3, 0xbf, 0x40, 0x4e, 0xb4,
-	0x2e, 0x29, 0x0b, 0xca, 0xfa, 0x4f, 0xdb, 0x65, 0x63, 0xe4, 0xb8, 0x86, 0x28, 0xd9, 0xc9, 0xde,
-	0x7c, 0x9e, 0x4f, 0x59, 0x32, 0x5d, 0x37, 0x65, 0xbf, 0x3d, 0x4c, 0x8f, 0x7c, 0x6c, 0x4b, 0x19,
-	0x58, 0x02, 0xf9, 0xdd, 0x26, 0x72, 0xbc, 0xfd, 0x7f, 0x79, 0xc3, 0x82, 0xd5, 0x5f, 0xea, 0xfb,
-	0xa0, 0x18, 0x2d, 0x90, 0x04, 0x5b, 0x20, 0xcb, 0xd6, 0x52, 0x7f, 0x26, 0x46, 0x9f, 0x85, 0xa5,
-	0x3a, 0x4f, 0xd5, 0x5f, 0x48, 0xed, 0xaa, 0xeb, 0x86, 0xb5, 0xff, 0x03, 0x60, 0x68, 0xa5, 0xec,
-	0xb7, 0x6a, 0x08, 0xdf, 0x0d, 0xe6, 0xbb, 0x21, 0xee, 0x55, 0xfa, 0x6e, 0x1c, 0xa2, 0x06, 0x96,
-	0xb5, 0x56, 0xa8, 0x52, 0x7f, 0xab, 0x48, 0xd4, 0x41, 0xff, 0x11, 0xd4, 0xcc, 0x13, 0x51, 0xe1,
-	0x5e, 0x84, 0x29, 0xcd, 0x99, 0xd6, 0x1e, 0x65, 0x12, 0x7a, 0x11, 0xa8, 0x0a, 0x28, 0x73, 0xa6,
-	0xa3, 0x26, 0xe9, 0x56, 0x5d, 0x97, 0xbb, 0x3a, 0xb8, 0x5c, 0x0a, 0xd4, 0xb8, 0xa0, 0xc4, 0x3e,
-	0x04, 0x53, 0x36, 0xbf, 0x04, 0xef, 0x94, 0x3c, 0x73, 0x02, 0x5a, 0x4a, 0x73, 0xfe, 0x8d, 0x38,
-	0xfe, 0x70, 0x03, 0x96, 0x7f, 0x44, 0xdb, 0x1d, 0x9b, 0x5a, 0xd1, 0x06, 0xff, 0x67, 0x27, 0x95,
-	0x9f, 0xd3, 0xfa, 0x07, 0x05, 0xcc, 0x24, 0x14, 0xc0, 0x59, 0x50, 0xe0, 0x25, 0x07, 0xa8, 0x85,
-	0xe5, 0x4b, 0x18, 0x6e, 0xb0, 0x57, 0x62, 0xcb, 0x57, 0x92, 0x16, 0xaf, 0x44, 0x2e, 0xe1, 0x36,
-	0x28, 0x62, 0x0f, 0xd5, 0x5d, 0x7c, 0x52, 0xf5, 0x9d, 0x7d, 0x8f, 0xe2, 0xf6, 0x29, 0xb2, 0x71,
-	0x50, 0xca, 0x2c, 0x64, 0xd6, 0x0b, 0x56, 0x6c, 0x0c, 0x56, 0x40, 0x01, 0xf9, 0x4e, 0xcd, 0x26,
-	0x1d, 0x8f, 0x96, 0xb2, 0x0b, 0xca, 0x7a, 0xd6, 0x9a, 0x44, 0xbe, 0xb3, 0xcb, 0xd6, 0xfa, 0xdf,
-	0x21, 0xdf, 0x76, 0xfb, 0x87, 0xe8, 0xbf, 0x98, 0xb1, 0x94, 0xba, 0x0d, 0xf2, 0x55, 0xdf, 0x61,
-	0x07, 0x66, 0x89, 0x4e, 0x5f, 0x90, 0x4b, 0x14, 0xac, 0xe1, 0x06, 0x5c, 0x06, 0x53, 0x41, 0xc7,
-	0xf7, 0x49, 0x9b, 0x72, 0xb4, 0xa0, 0x34, 0xc1, 0x69, 0xa3, 0x9b, 0xb0, 0x08, 0x26, 0xd0, 0xc9,
-	0x09, 0xf1, 0x4a, 0x39, 0x5e, 0x2f, 0x16, 0x7a, 0x4f, 0x09, 0xdd, 0x5d, 0x08, 0x50, 0xde, 0x5d,
-	0xc8, 0x29, 0x25, 0xea, 0x94, 0x06, 0x80, 0x33, 0xf4, 0x27, 0xcd, 0x15, 0x43, 0x3b, 0xf0, 0x18,
-	0xa8, 0x11, 0xfd, 0x81, 0x61, 0xfc, 0x09, 0x64, 0xf8, 0x13, 0x50, 0x63, 0x9e, 0x80, 0x3c, 0xb2,
-	0x35, 0xa6, 0x1a, 0x9a, 0x60, 0x9a, 0xf8, 0xec, 0x59, 0x22, 0xb7, 0x16, 0x82, 0xc8, 0x72, 0x08,
-	0xd8, 0x0f, 0x0d, 0xaf, 0x68, 0xfb, 0x2e, 0x0f, 0x26, 0xf8, 0x29, 0xe1, 0x2b, 0x90, 0x13, 0xf3,
-	0x04, 0xae, 0xc4, 0x88, 0x8f, 0x0e, 0x2e, 0x75, 0xf5, 0xb1, 0x34, 0xe1, 0x94, 0xbe, 0x78, 0xfd,
-	0xe9, 0xeb, 0xbb, 0x74, 0x05, 0x96, 0xcd, 0xa4, 0xe9, 0x09, 0xaf, 0x15, 0xf1, 0x07, 0x0c, 0x13,
-	0x7b, 0x46, 0xa7, 0x99, 0xba, 0xf6, 0x68, 0x9e, 0x14, 0xff, 0x95, 0x8b, 0x2f, 0xc1, 0x45, 0x33,
-	0x7e, 0x34, 0x9b, 0x97, 0x72, 0x0c, 0x5e, 0xc1, 0x4b, 0x90, 0x67, 0xa5, 0x55, 0xd7, 0x4d, 0xc6,
-	0x88, 0x0e, 0xb6, 0x64, 0x8c, 0x07, 0x03, 0x4a, 0x9f, 0xe7, 0x18, 0x65, 0x38, 0x93, 0x80, 0x01,
-	0x5f, 0x2b, 0x42, 0xdd, 0x42, 0xdd, 0x1f, 0x6f, 0xc2, 0x26, 0x57, 0x5f, 0x83, 0x2b, 0x09, 0xea,
-	0xb5, 0x36, 0xea, 0x86, 0x8c, 0xb8, 0x56, 0x00, 0x90, 0x4e, 0x8c, 0xc5, 0xf9, 0x5e, 0x33, 0x96,
-	0x38, 0xce, 0x1c, 0xac, 0x8c, 0xc1, 0x81, 0xef, 0x15, 0x30, 0x15, 0x99, 0x61, 0xf0, 0xb7, 0xa4,
-	0xfe, 0x71, 0x93, 0x57, 0xdd, 0x7c, 0x62, 0xb6, 0x64, 0xda, 0xe0, 0x4c, 0xcb, 0x50, 0x8f, 0x63,
-	0x6a, 0x92, 0x6e, 0x0d, 0xb9, 0x6e, 0xcd, 0x16, 0x20, 0x1f, 0x25, 0xda, 0x60, 0x28, 0x8c, 0x47,
-	0x7b, 0x38, 0xdc, 0xc6, 0xa3, 0x8d, 0x4c, 0x1a, 0xfd, 0x4f, 0x8e, 0xf6, 0x3b, 0x34, 0x92, 0xd0,
-	0x38, 0x56, 0xcd, 0xf1, 0x4e, 0x89, 0x79, 0x39, 0x18, 0x92, 0x57, 0x3b, 0xff, 0xdc, 0xdc, 0x6b,
-	0xca, 0xed, 0xbd, 0xa6, 0x7c, 0xb9, 0xd7, 0x94, 0x37, 0x3d, 0x2d, 0x75, 0xdb, 0xd3, 0x52, 0x77,
-	0x3d, 0x2d, 0x75, 0xbc, 0xdc, 0x70, 0x68, 0xb3, 0x53, 0x37, 0x6c, 0xd2, 0x8a, 0xf6, 0x7c, 0x29,
-	0xba, 0xd2, 0x0b, 0x1f, 0x07, 0xf5, 0x1c, 0xff, 0xaf, 0xe5, 0x8f, 0x6f, 0x01, 0x00, 0x00, 0xff,
-	0xff, 0x5d, 0x85, 0xca, 0x90, 0x87, 0x09, 0x00, 0x00,
+	// 1032 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xc7, 0xb3, 0x8e, 0xe3, 0xc4, 0x0f, 0x45, 0x82, 0xa9, 0x21, 0xf6, 0x3a, 0x71, 0x93, 0x6d,
+	0x5a, 0x87, 0x80, 0x77, 0x49, 0x4a, 0x41, 0x5c, 0x2a, 0x9c, 0x54, 0xad, 0x82, 0xaa, 0x28, 0x6c,
+	0x6e, 0x95, 0x90, 0x35, 0xde, 0xac, 0x9d, 0x85, 0xf5, 0xce, 0xd6, 0x3b, 0x4e, 0x28, 0x56, 0x2e,
+	0xb9, 0x71, 0x43, 0x70, 0xe1, 0x0a, 0x2a, 0xff, 0x4b, 0x8f, 0x95, 0xb8, 0x70, 0x42, 0x28, 0xe1,
+	0x0f, 0x41, 0xf3, 0x76, 0xd6, 0xde, 0xad, 0x77, 0xed, 0x08, 0xf5, 0xd2, 0x74, 0xe6, 0xfd, 0xf8,
+	0x7e, 0xfc, 0xde, 0xf3, 0x1b, 0xc3, 0x9a, 0x4b, 0xcf, 0xa8, 0x67, 0x73, 0x43, 0xfc, 0x35, 0x02,
+	0xdf, 0xb6, 0x8c, 0xe7, 0x03, 0xbb, 0xff, 0x42, 0xf7, 0xfb, 0x8c, 0x33, 0xf2, 0x9e, 0x34, 0xeb,
+	0xe2, 0xaf, 0x2e, 0xcc, 0x6a, 0xa9, 0xcb, 0xba, 0x0c, 0xad, 0x86, 0xf8, 0x5f, 0xe8, 0xa8, 0xae,
+	0x76, 0x19, 0xeb, 0xba, 0xb6, 0x41, 0x7d, 0xc7, 0xa0, 0x9e, 0xc7, 0x38, 0xe5, 0x0e, 0xf3, 0x02,
+	0x69, 0xdd, 0xb6, 0x58, 0xd0, 0x63, 0x81, 0xd1, 0xa6, 0x81, 0x1d, 0xe6, 0x37, 0xce, 0x76, 0xda,
+	0x36, 0xa7, 0x3b, 0x86, 0x4f, 0xbb, 0x8e, 0x87, 0xce, 0xd2, 0xb7, 0x36, 0x49, 0xe4, 0xd3, 0x3e,
+	0xed, 0x45, 0xb9, 0x56, 0x27, 0xed, 0xe2, 0x1f, 0x69, 0xdd, 0x48, 0xb7, 0xb6, 0x4e, 0x9c, 0x4e,
+	0x27, 0x74, 0xd1, 0x4a, 0x40, 0xbe, 0x16, 0x08, 0x47, 0x98, 0xd5, 0xb4, 0x9f, 0x0f, 0xec, 0x80,
+	0x6b, 0x87, 0x70, 0x2b, 0x71, 0x1b, 0xf8, 0xcc, 0x0b, 0x6c, 0xf2, 0x39, 0x14, 0x42, 0xf5, 0xb2,
+	0xb2, 0xae, 0x6c, 0xbd, 0xb3, 0x5b, 0xd1, 0x27, 0x2a, 0xa2, 0x87, 0x21, 0x7b, 0xf9, 0x57, 0x7f,
+	0xdf, 0x9e, 0x33, 0xa5, 0xbb, 0x66, 0xc8, 0x7c, 0x4f, 0x6c, 0x7e, 0xec, 0xdb, 0x96, 0x94, 0x21,
+	0x65, 0x58, 0xdc, 0x3f, 0xa5, 0x8e, 0x77, 0xf0, 0x08, 0x13, 0x16, 0xcd, 0xe8, 0xa8, 0x1d, 0x40,
+	0x29, 0x19, 0x20, 0x09, 0x76, 0x20, 0x2f, 0xce, 0x52, 0x7f, 0x25, 0x45, 0x5f, 0x98, 0xa5, 0x3a,
+	0xba, 0x6a, 0xdf, 0x48, 0xed, 0xa6, 0xeb, 0xc6, 0xb5, 0x1f, 0x03, 0x8c, 0xab, 0x2d, 0xf3, 0xdd,
+	0xd3, 0xc3, 0xd6, 0xe8, 0xa2, 0x35, 0x7a, 0xd8, 0x7a, 0xd9, 0x1a, 0xfd, 0x88, 0x76, 0x6d, 0x19,
+	0x6b, 0xc6, 0x22, 0xb5, 0x9f, 0x15, 0x28, 0x25, 0xf3, 0x4f, 0xa0, 0xce, 0xdf, 0x10, 0x95, 0x3c,
+	0x49, 0x30, 0xe5, 0x90, 0xa9, 0x3e, 0x93, 0x29, 0xd4, 0x4b, 0x40, 0x55, 0xa1, 0x82, 0x4c, 0xc7,
+	0xa7, 0xec, 0xbc, 0xe9, 0xba, 0x58, 0xd5, 0x51, 0x73, 0x39, 0xa8, 0x69, 0x46, 0x89, 0x7d, 0x04,
+	0xcb, 0x16, 0x36, 0xc1, 0xeb, 0xb0, 0xa7, 0x4e, 0xc0, 0xcb, 0x39, 0xe4, 0xdf, 0x4e, 0xe3, 0x8f,
+	0x27, 0x10, 0xfe, 0xc7, 0xbc, 0x3f, 0xb0, 0xb8, 0x99, 0x4c, 0xf0, 0x55, 0x7e, 0x49, 0x79, 0x37,
+	0xa7, 0xfd, 0xae, 0xc0, 0x4a, 0x46, 0x00, 0x59, 0x85, 0x22, 0x86, 0x1c, 0xd2, 0x9e, 0x2d, 0x27,
+	0x61, 0x7c, 0x21, 0xa6, 0xc4, 0x92, 0x53, 0x92, 0x0b, 0xa7, 0x44, 0x1e, 0xc9, 0x2e, 0x94, 0x6c,
+	0x8f, 0xb6, 0x5d, 0xfb, 0xa4, 0xe9, 0x3b, 0x07, 0x1e, 0xb7, 0xfb, 0x1d, 0x6a, 0xd9, 0x41, 0x79,
+	0x7e, 0x7d, 0x7e, 0xab, 0x68, 0xa6, 0xda, 0x48, 0x15, 0x8a, 0xd4, 0x77, 0x5a, 0x16, 0x1b, 0x78,
+	0xbc, 0x9c, 0x5f, 0x57, 0xb6, 0xf2, 0xe6, 0x12, 0xf5, 0x9d, 0x7d, 0x71, 0xd6, 0xbe, 0x88, 0xd5,
+	0x6d, 0x3f, 0xfa, 0x10, 0xd1, 0xc4, 0x4c, 0xa5, 0xd4, 0x2c, 0x58, 0x6c, 0xfa, 0xce, 0x53, 0x27,
+	0x74, 0x74, 0x22, 0x41, 0x94, 0x28, 0x9a, 0xe3, 0x0b, 0xb2, 0x09, 0xcb, 0xc1, 0xc0, 0xf7, 0x59,
+	0x9f, 0x23, 0x5a, 0x50, 0x5e, 0x40, 0xda, 0xe4, 0x25, 0x29, 0xc1, 0x02, 0x3d, 0x39, 0x61, 0x5e,
+	0xb9, 0x80, 0xf1, 0xe1, 0x41, 0xbb, 0x56, 0x40, 0x4d, 0x03, 0x94, 0xbd, 0x8b, 0x55, 0x4a, 0x49,
+	0x56, 0xaa, 0x06, 0xe0, 0x8c, 0xeb, 0x93, 0x43, 0xc5, 0xd8, 0x0d, 0x79, 0x06, 0x6a, 0x42, 0x7f,
+	0x54, 0x30, 0x1c, 0x81, 0x79, 0x1c, 0x01, 0x35, 0x65, 0x04, 0xe4, 0x47, 0x36, 0xa7, 0x44, 0x13,
+	0x03, 0x6e, 0x31, 0x5f, 0x8c, 0x25, 0x75, 0x5b, 0x31, 0x88, 0x3c, 0x42, 0x90, 0xc8, 0x34, 0x6e,
+	0x91, 0xf6, 0x20, 0xea, 0x02, 0x7e, 0x9d, 0xce, 0x9c, 0x40, 0x2c, 0xcf, 0xd9, 0x3b, 0xe3, 0x53,
+	0x50, 0xd3, 0xc2, 0x64, 0x6d, 0x3e, 0x80, 0x42, 0xdb, 0x65, 0xd6, 0x77, 0x01, 0x7e, 0x21, 0xf3,
+	0xa6, 0x3c, 0x69, 0xdf, 0x42, 0x69, 0x14, 0xf5, 0xc8, 0xe9, 0x74, 0x66, 0xea, 0x90, 0x35, 0x80,
+	0x4e, 0x9f, 0xf5, 0x5a, 0x98, 0x00, 0x47, 0x32, 0x6f, 0x16, 0xc5, 0xcd, 0x9e, 0xb8, 0x20, 0x15,
+	0x58, 0xe2, 0x4c, 0x1a, 0xe7, 0xd1, 0xb8, 0xc8, 0x19, 0x9a, 0xb4, 0x43, 0x78, 0xff, 0x0d, 0x2d,
+	0x09, 0xf7, 0x00, 0xf2, 0x62, 0x27, 0xcb, 0x35, 0x54, 0xcd, 0xd8, 0x15, 0x22, 0x24, 0xda, 0x17,
+	0xc2, 0x7d, 0xf7, 0x25, 0xc0, 0x02, 0x26, 0x24, 0x3f, 0x40, 0x21, 0x5c, 0xbc, 0xe4, 0x6e, 0x4a,
+	0xf0, 0xe4, 0x86, 0x57, 0xef, 0xcd, 0x72, 0x0b, 0xc9, 0xb4, 0x8d, 0xcb, 0x3f, 0xff, 0xfd, 0x25,
+	0x57, 0x25, 0x15, 0x23, 0xeb, 0x25, 0x22, 0x97, 0x4a, 0xb8, 0xe9, 0x48, 0x66, 0xce, 0xe4, 0xda,
+	0x57, 0xeb, 0x33, 0xfd, 0xa4, 0xf8, 0x87, 0x28, 0x7e, 0x87, 0x6c, 0x18, 0xe9, 0x0f, 0x99, 0x31,
+	0x94, 0x3d, 0xb9, 0x20, 0x43, 0x58, 0x14, 0xa1, 0x4d, 0xd7, 0xcd, 0xc6, 0x48, 0xbe, 0x00, 0x6a,
+	0x7d, 0xa6, 0x9f, 0xc4, 0xb8, 0x8d, 0x18, 0x15, 0xb2, 0x92, 0x81, 0x41, 0x7e, 0x54, 0x42, 0x75,
+	0x93, 0x9e, 0xbf, 0xfd, 0x22, 0x34, 0x50, 0xbd, 0x4e, 0xee, 0x66, 0xa8, 0xb7, 0xfa, 0xf4, 0x3c,
+	0x56, 0x88, 0x4b, 0x05, 0x40, 0x56, 0x62, 0x2a, 0xce, 0xff, 0x2d, 0xc6, 0x1d, 0xc4, 0x59, 0x23,
+	0xd5, 0x29, 0x38, 0xe4, 0x57, 0x05, 0x96, 0x13, 0xcb, 0x9e, 0x7c, 0x9c, 0x95, 0x3f, 0xed, 0x89,
+	0x52, 0x1b, 0x37, 0xf4, 0x96, 0x4c, 0xdb, 0xc8, 0xb4, 0x49, 0xb4, 0x34, 0xa6, 0x53, 0x76, 0xde,
+	0xa2, 0xae, 0xdb, 0xb2, 0x42, 0x90, 0x97, 0x12, 0x6d, 0xb4, 0x3d, 0xa7, 0xa3, 0xbd, 0xf9, 0x0a,
+	0xa8, 0x8d, 0x1b, 0x7a, 0x4b, 0xb4, 0xcf, 0x10, 0xed, 0x13, 0xa2, 0x67, 0xa1, 0x21, 0x56, 0xcb,
+	0xf1, 0x3a, 0xcc, 0x18, 0x8e, 0x5e, 0x93, 0x0b, 0xf2, 0x9b, 0xc0, 0x8c, 0x2f, 0xb2, 0x29, 0x98,
+	0x29, 0x6b, 0x52, 0x6d, 0xdc, 0xd0, 0x5b, 0x62, 0xde, 0x47, 0xcc, 0x06, 0xf9, 0x28, 0xb3, 0xab,
+	0x51, 0x48, 0x6c, 0xd4, 0xfe, 0x50, 0x60, 0x29, 0xda, 0x4b, 0xa4, 0x3e, 0x4d, 0x30, 0xb6, 0x58,
+	0xd5, 0xad, 0xd9, 0x8e, 0x12, 0xea, 0x31, 0x42, 0x7d, 0x49, 0x1e, 0x1a, 0x53, 0x7e, 0xc7, 0x8e,
+	0x79, 0x8c, 0xe1, 0x78, 0x2d, 0x5f, 0x18, 0xc3, 0x68, 0x09, 0x5f, 0xec, 0x3d, 0x7c, 0x75, 0x55,
+	0x53, 0x5e, 0x5f, 0xd5, 0x94, 0x7f, 0xae, 0x6a, 0xca, 0x4f, 0xd7, 0xb5, 0xb9, 0xd7, 0xd7, 0xb5,
+	0xb9, 0xbf, 0xae, 0x6b, 0x73, 0xcf, 0x36, 0xbb, 0x0e, 0x3f, 0x1d, 0xb4, 0x75, 0x8b, 0xf5, 0x92,
+	0x1a, 0xdf, 0x87, 0x2a, 0xfc, 0x85, 0x6f, 0x07, 0xed, 0x02, 0xfe, 0x54, 0xbe, 0xff, 0xdf, 0x00,
+	0x70, 0x14, 0xfe, 0xe2, 0x1f, 0x0c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -718,6 +925,10 @@ type QueryClient interface {
 	ShowAllChains(ctx context.Context, in *QueryShowAllChainsRequest, opts ...grpc.CallOption) (*QueryShowAllChainsResponse, error)
 	// Queries a list of ShowChainInfo items.
 	ShowChainInfo(ctx context.Context, in *QueryShowChainInfoRequest, opts ...grpc.CallOption) (*QueryShowChainInfoResponse, error)
+	// Queries the blocks of the revisions of a spec.
+	SpecRevisions(ctx context.Context, in *QuerySpecRevisionsRequest, opts ...grpc.CallOption) (*QuerySpecRevisionsResponse, error)
+	// Queries the changes between two revisions of a spec.
+	SpecDiff(ctx context.Context, in *QuerySpecDiffRequest, opts ...grpc.CallOption) (*QuerySpecDiffResponse, error)
 }
 
 type queryClient struct {
@@ -791,6 +1002,24 @@ func (c *queryClient) ShowChainInfo(ctx context.Context, in *QueryShowChainInfoR
 	return out, nil
 }
 
+func (c *queryClient) SpecRevisions(ctx context.Context, in *QuerySpecRevisionsRequest, opts ...grpc.CallOption) (*QuerySpecRevisionsResponse, error) {
+	out := new(QuerySpecRevisionsResponse)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.spec.Query/SpecRevisions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SpecDiff(ctx context.Context, in *QuerySpecDiffRequest, opts ...grpc.CallOption) (*QuerySpecDiffResponse, error) {
+	out := new(QuerySpecDiffResponse)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.spec.Query/SpecDiff", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Parameters queries the parameters of the module.
@@ -807,6 +1036,10 @@ type QueryServer interface {
 	ShowAllChains(context.Context, *QueryShowAllChainsRequest) (*QueryShowAllChainsResponse, error)
 	// Queries a list of ShowChainInfo items.
 	ShowChainInfo(context.Context, *QueryShowChainInfoRequest) (*QueryShowChainInfoResponse, error)
+	// Queries the blocks of the revisions of a spec.
+	SpecRevisions(context.Context, *QuerySpecRevisionsRequest) (*QuerySpecRevisionsResponse, error)
+	// Queries the changes between two revisions of a spec.
+	SpecDiff(context.Context, *QuerySpecDiffRequest) (*QuerySpecDiffResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -834,6 +1067,12 @@ func (*UnimplementedQueryServer) ShowAllChains(ctx context.Context, req *QuerySh
 func (*UnimplementedQueryServer) ShowChainInfo(ctx context.Context, req *QueryShowChainInfoRequest) (*QueryShowChainInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ShowChainInfo not implemented")
 }
+func (*UnimplementedQueryServer) SpecRevisions(ctx context.Context, req *QuerySpecRevisionsRequest) (*QuerySpecRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SpecRevisions not implemented")
+}
+func (*UnimplementedQueryServer) SpecDiff(ctx context.Context, req *QuerySpecDiffRequest) (*QuerySpecDiffResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SpecDiff not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -965,6 +1204,42 @@ func _Query_ShowChainInfo_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_SpecRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySpecRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SpecRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.spec.Query/SpecRevisions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SpecRevisions(ctx, req.(*QuerySpecRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SpecDiff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySpecDiffRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SpecDiff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.spec.Query/SpecDiff",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SpecDiff(ctx, req.(*QuerySpecDiffRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "lavanet.lava.spec.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -997,6 +1272,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ShowChainInfo",
 			Handler:    _Query_ShowChainInfo_Handler,
 		},
+		{
+			MethodName: "SpecRevisions",
+			Handler:    _Query_SpecRevisions_Handler,
+		},
+		{
+			MethodName: "SpecDiff",
+			Handler:    _Query_SpecDiff_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "lavanet/lava/spec/query.proto",
@@ -1454,73 +1737,217 @@ func (m *QueryShowChainInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QuerySpecRevisionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *QuerySpecRevisionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QuerySpecRevisionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetSpecRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QuerySpecRevisionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySpecRevisionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySpecRevisionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ChainID)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Blocks) > 0 {
+		dAtA6 := make([]byte, len(m.Blocks)*10)
+		var j5 int
+		for _, num := range m.Blocks {
+			for num >= 1<<7 {
+				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j5++
+			}
+			dAtA6[j5] = uint8(num)
+			j5++
+		}
+		i -= j5
+		copy(dAtA[i:], dAtA6[:j5])
+		i = encodeVarintQuery(dAtA, i, uint64(j5))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetSpecResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QuerySpecDiffRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Spec.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryAllSpecRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QuerySpecDiffRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySpecDiffRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.ToBlock != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ToBlock))
+		i--
+		dAtA[i] = 0x18
 	}
-	return n
-}
+	if m.FromBlock != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FromBlock))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySpecDiffResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySpecDiffResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySpecDiffResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Diff.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetSpecRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetSpecResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Spec.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllSpecRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
 
 func (m *QueryAllSpecResponse) Size() (n int) {
 	if m == nil {
@@ -1658,6 +2085,65 @@ func (m *QueryShowChainInfoResponse) Size() (n int) {
 	return n
 }
 
+func (m *QuerySpecRevisionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySpecRevisionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Blocks) > 0 {
+		l = 0
+		for _, e := range m.Blocks {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *QuerySpecDiffRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.FromBlock != 0 {
+		n += 1 + sovQuery(uint64(m.FromBlock))
+	}
+	if m.ToBlock != 0 {
+		n += 1 + sovQuery(uint64(m.ToBlock))
+	}
+	return n
+}
+
+func (m *QuerySpecDiffResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Diff.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2875,6 +3361,417 @@ func (m *QueryShowChainInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QuerySpecRevisionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySpecRevisionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySpecRevisionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySpecRevisionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySpecRevisionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySpecRevisionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Blocks = append(m.Blocks, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Blocks) == 0 {
+					m.Blocks = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Blocks = append(m.Blocks, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySpecDiffRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySpecDiffRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySpecDiffRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromBlock", wireType)
+			}
+			m.FromBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToBlock", wireType)
+			}
+			m.ToBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySpecDiffResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySpecDiffResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySpecDiffResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Diff", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Diff.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
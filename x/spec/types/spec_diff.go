@@ -0,0 +1,194 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+)
+
+type equaler interface {
+	Equal(that interface{}) bool
+}
+
+func equalLists[T equaler](from, to []T) bool {
+	if len(from) != len(to) {
+		return false
+	}
+	for idx := range from {
+		if !from[idx].Equal(to[idx]) {
+			return false
+		}
+	}
+	return true
+}
+
+func equalStrings(from, to []string) bool {
+	if len(from) != len(to) {
+		return false
+	}
+	for idx := range from {
+		if from[idx] != to[idx] {
+			return false
+		}
+	}
+	return true
+}
+
+// DiffSpecs returns the changes from one revision of an expanded spec to another,
+// collections and apis of the newer revision come first in their order, followed by the removed ones
+func DiffSpecs(from Spec, to Spec) SpecDiff {
+	diff := SpecDiff{Index: to.Index, FromBlock: from.BlockLastUpdated, ToBlock: to.BlockLastUpdated}
+
+	specFields := []struct {
+		name  string
+		equal bool
+	}{
+		{"name", from.Name == to.Name},
+		{"enabled", from.Enabled == to.Enabled},
+		{"reliability_threshold", from.ReliabilityThreshold == to.ReliabilityThreshold},
+		{"data_reliability_enabled", from.DataReliabilityEnabled == to.DataReliabilityEnabled},
+		{"block_distance_for_finalized_data", from.BlockDistanceForFinalizedData == to.BlockDistanceForFinalizedData},
+		{"blocks_in_finalization_proof", from.BlocksInFinalizationProof == to.BlocksInFinalizationProof},
+		{"average_block_time", from.AverageBlockTime == to.AverageBlockTime},
+		{"allowed_block_lag_for_qos_sync", from.AllowedBlockLagForQosSync == to.AllowedBlockLagForQosSync},
+		{"min_stake_provider", from.MinStakeProvider.Equal(to.MinStakeProvider)},
+		{"min_stake_client", from.MinStakeClient.Equal(to.MinStakeClient)},
+		{"providers_types", from.ProvidersTypes == to.ProvidersTypes},
+		{"imports", equalStrings(from.Imports, to.Imports)},
+	}
+	for _, field := range specFields {
+		if !field.equal {
+			diff.ChangedFields = append(diff.ChangedFields, field.name)
+		}
+	}
+
+	fromCollections := map[CollectionData]*ApiCollection{}
+	for _, collection := range from.ApiCollections {
+		fromCollections[collection.CollectionData] = collection
+	}
+	toCollections := map[CollectionData]struct{}{}
+	for _, collection := range to.ApiCollections {
+		toCollections[collection.CollectionData] = struct{}{}
+		fromCollection, ok := fromCollections[collection.CollectionData]
+		if !ok {
+			diff.Collections = append(diff.Collections, CollectionDiff{CollectionData: collection.CollectionData, Change: CHANGE_TYPE_ADDED, Apis: diffApis(nil, collection.Apis)})
+			continue
+		}
+		if collectionDiff := diffCollections(fromCollection, collection); len(collectionDiff.ChangedFields) > 0 || len(collectionDiff.Apis) > 0 {
+			diff.Collections = append(diff.Collections, collectionDiff)
+		}
+	}
+	for _, collection := range from.ApiCollections {
+		if _, ok := toCollections[collection.CollectionData]; !ok {
+			diff.Collections = append(diff.Collections, CollectionDiff{CollectionData: collection.CollectionData, Change: CHANGE_TYPE_REMOVED, Apis: diffApis(collection.Apis, nil)})
+		}
+	}
+	return diff
+}
+
+func diffCollections(from *ApiCollection, to *ApiCollection) CollectionDiff {
+	diff := CollectionDiff{CollectionData: to.CollectionData, Change: CHANGE_TYPE_MODIFIED}
+	collectionFields := []struct {
+		name  string
+		equal bool
+	}{
+		{"enabled", from.Enabled == to.Enabled},
+		{"headers", equalLists(from.Headers, to.Headers)},
+		{"inheritance_apis", equalLists(from.InheritanceApis, to.InheritanceApis)},
+		{"parse_directives", equalLists(from.ParseDirectives, to.ParseDirectives)},
+		{"extensions", equalLists(from.Extensions, to.Extensions)},
+		{"verifications", equalLists(from.Verifications, to.Verifications)},
+	}
+	for _, field := range collectionFields {
+		if !field.equal {
+			diff.ChangedFields = append(diff.ChangedFields, field.name)
+		}
+	}
+	diff.Apis = diffApis(from.Apis, to.Apis)
+	return diff
+}
+
+func diffApis(from []*Api, to []*Api) (diffs []ApiDiff) {
+	fromApis := map[string]*Api{}
+	for _, api := range from {
+		fromApis[api.Name] = api
+	}
+	toApis := map[string]struct{}{}
+	for _, api := range to {
+		toApis[api.Name] = struct{}{}
+		fromApi, ok := fromApis[api.Name]
+		if !ok {
+			diffs = append(diffs, ApiDiff{Name: api.Name, Change: CHANGE_TYPE_ADDED, ToComputeUnits: api.ComputeUnits})
+			continue
+		}
+		apiFields := []struct {
+			name  string
+			equal bool
+		}{
+			{"enabled", fromApi.Enabled == api.Enabled},
+			{"compute_units", fromApi.ComputeUnits == api.ComputeUnits},
+			{"extra_compute_units", fromApi.ExtraComputeUnits == api.ExtraComputeUnits},
+			{"category", fromApi.Category.Equal(api.Category)},
+			{"block_parsing", fromApi.BlockParsing.Equal(api.BlockParsing)},
+		}
+		changedFields := []string{}
+		for _, field := range apiFields {
+			if !field.equal {
+				changedFields = append(changedFields, field.name)
+			}
+		}
+		if len(changedFields) > 0 {
+			diffs = append(diffs, ApiDiff{Name: api.Name, Change: CHANGE_TYPE_MODIFIED, FromComputeUnits: fromApi.ComputeUnits, ToComputeUnits: api.ComputeUnits, ChangedFields: changedFields})
+		}
+	}
+	for _, api := range from {
+		if _, ok := toApis[api.Name]; !ok {
+			diffs = append(diffs, ApiDiff{Name: api.Name, Change: CHANGE_TYPE_REMOVED, FromComputeUnits: api.ComputeUnits})
+		}
+	}
+	return diffs
+}
+
+// IsEmpty returns true if the revisions are the same
+func (sd SpecDiff) IsEmpty() bool {
+	return len(sd.ChangedFields) == 0 && len(sd.Collections) == 0
+}
+
+// Changes returns a line describing every change, for logs
+func (sd SpecDiff) Changes() []string {
+	changes := []string{}
+	if len(sd.ChangedFields) > 0 {
+		changes = append(changes, "spec fields changed: "+strings.Join(sd.ChangedFields, ","))
+	}
+	for _, collection := range sd.Collections {
+		collectionName := collection.CollectionData.ApiInterface + "/" + collection.CollectionData.Type
+		if collection.CollectionData.InternalPath != "" {
+			collectionName += "/" + collection.CollectionData.InternalPath
+		}
+		if collection.CollectionData.AddOn != "" {
+			collectionName += " addon " + collection.CollectionData.AddOn
+		}
+		switch collection.Change {
+		case CHANGE_TYPE_ADDED, CHANGE_TYPE_REMOVED:
+			changes = append(changes, fmt.Sprintf("collection %s %s with %d apis", collectionName, strings.ToLower(collection.Change.String()), len(collection.Apis)))
+			continue
+		}
+		if len(collection.ChangedFields) > 0 {
+			changes = append(changes, fmt.Sprintf("collection %s fields changed: %s", collectionName, strings.Join(collection.ChangedFields, ",")))
+		}
+		for _, api := range collection.Apis {
+			switch api.Change {
+			case CHANGE_TYPE_ADDED:
+				changes = append(changes, fmt.Sprintf("collection %s api %s added, cu %d", collectionName, api.Name, api.ToComputeUnits))
+			case CHANGE_TYPE_REMOVED:
+				changes = append(changes, fmt.Sprintf("collection %s api %s removed", collectionName, api.Name))
+			default:
+				change := fmt.Sprintf("collection %s api %s fields changed: %s", collectionName, api.Name, strings.Join(api.ChangedFields, ","))
+				if api.FromComputeUnits != api.ToComputeUnits {
+					change += fmt.Sprintf(", cu %d -> %d", api.FromComputeUnits, api.ToComputeUnits)
+				}
+				changes = append(changes, change)
+			}
+		}
+	}
+	return changes
+}
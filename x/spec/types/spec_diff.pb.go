@@ -0,0 +1,1206 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: lavanet/lava/spec/spec_diff.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type CHANGE_TYPE int32
+
+const (
+	CHANGE_TYPE_MODIFIED CHANGE_TYPE = 0
+	CHANGE_TYPE_ADDED    CHANGE_TYPE = 1
+	CHANGE_TYPE_REMOVED  CHANGE_TYPE = 2
+)
+
+var CHANGE_TYPE_name = map[int32]string{
+	0: "MODIFIED",
+	1: "ADDED",
+	2: "REMOVED",
+}
+
+var CHANGE_TYPE_value = map[string]int32{
+	"MODIFIED": 0,
+	"ADDED":    1,
+	"REMOVED":  2,
+}
+
+func (x CHANGE_TYPE) String() string {
+	return proto.EnumName(CHANGE_TYPE_name, int32(x))
+}
+
+func (CHANGE_TYPE) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_358e54bf0922fc79, []int{0}
+}
+
+// SpecDiff describes what changed between two revisions of an expanded spec
+type SpecDiff struct {
+	Index         string           `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
+	FromBlock     uint64           `protobuf:"varint,2,opt,name=from_block,json=fromBlock,proto3" json:"from_block,omitempty"`
+	ToBlock       uint64           `protobuf:"varint,3,opt,name=to_block,json=toBlock,proto3" json:"to_block,omitempty"`
+	ChangedFields []string         `protobuf:"bytes,4,rep,name=changed_fields,json=changedFields,proto3" json:"changed_fields,omitempty"`
+	Collections   []CollectionDiff `protobuf:"bytes,5,rep,name=collections,proto3" json:"collections"`
+}
+
+func (m *SpecDiff) Reset()         { *m = SpecDiff{} }
+func (m *SpecDiff) String() string { return proto.CompactTextString(m) }
+func (*SpecDiff) ProtoMessage()    {}
+func (*SpecDiff) Descriptor() ([]byte, []int) {
+	return fileDescriptor_358e54bf0922fc79, []int{0}
+}
+func (m *SpecDiff) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SpecDiff) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SpecDiff.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SpecDiff) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpecDiff.Merge(m, src)
+}
+func (m *SpecDiff) XXX_Size() int {
+	return m.Size()
+}
+func (m *SpecDiff) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpecDiff.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SpecDiff proto.InternalMessageInfo
+
+func (m *SpecDiff) GetIndex() string {
+	if m != nil {
+		return m.Index
+	}
+	return ""
+}
+
+func (m *SpecDiff) GetFromBlock() uint64 {
+	if m != nil {
+		return m.FromBlock
+	}
+	return 0
+}
+
+func (m *SpecDiff) GetToBlock() uint64 {
+	if m != nil {
+		return m.ToBlock
+	}
+	return 0
+}
+
+func (m *SpecDiff) GetChangedFields() []string {
+	if m != nil {
+		return m.ChangedFields
+	}
+	return nil
+}
+
+func (m *SpecDiff) GetCollections() []CollectionDiff {
+	if m != nil {
+		return m.Collections
+	}
+	return nil
+}
+
+type CollectionDiff struct {
+	CollectionData CollectionData `protobuf:"bytes,1,opt,name=collection_data,json=collectionData,proto3" json:"collection_data"`
+	Change         CHANGE_TYPE    `protobuf:"varint,2,opt,name=change,proto3,enum=lavanet.lava.spec.CHANGE_TYPE" json:"change,omitempty"`
+	ChangedFields  []string       `protobuf:"bytes,3,rep,name=changed_fields,json=changedFields,proto3" json:"changed_fields,omitempty"`
+	Apis           []ApiDiff      `protobuf:"bytes,4,rep,name=apis,proto3" json:"apis"`
+}
+
+func (m *CollectionDiff) Reset()         { *m = CollectionDiff{} }
+func (m *CollectionDiff) String() string { return proto.CompactTextString(m) }
+func (*CollectionDiff) ProtoMessage()    {}
+func (*CollectionDiff) Descriptor() ([]byte, []int) {
+	return fileDescriptor_358e54bf0922fc79, []int{1}
+}
+func (m *CollectionDiff) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CollectionDiff) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CollectionDiff.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CollectionDiff) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CollectionDiff.Merge(m, src)
+}
+func (m *CollectionDiff) XXX_Size() int {
+	return m.Size()
+}
+func (m *CollectionDiff) XXX_DiscardUnknown() {
+	xxx_messageInfo_CollectionDiff.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CollectionDiff proto.InternalMessageInfo
+
+func (m *CollectionDiff) GetCollectionData() CollectionData {
+	if m != nil {
+		return m.CollectionData
+	}
+	return CollectionData{}
+}
+
+func (m *CollectionDiff) GetChange() CHANGE_TYPE {
+	if m != nil {
+		return m.Change
+	}
+	return CHANGE_TYPE_MODIFIED
+}
+
+func (m *CollectionDiff) GetChangedFields() []string {
+	if m != nil {
+		return m.ChangedFields
+	}
+	return nil
+}
+
+func (m *CollectionDiff) GetApis() []ApiDiff {
+	if m != nil {
+		return m.Apis
+	}
+	return nil
+}
+
+type ApiDiff struct {
+	Name             string      `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Change           CHANGE_TYPE `protobuf:"varint,2,opt,name=change,proto3,enum=lavanet.lava.spec.CHANGE_TYPE" json:"change,omitempty"`
+	FromComputeUnits uint64      `protobuf:"varint,3,opt,name=from_compute_units,json=fromComputeUnits,proto3" json:"from_compute_units,omitempty"`
+	ToComputeUnits   uint64      `protobuf:"varint,4,opt,name=to_compute_units,json=toComputeUnits,proto3" json:"to_compute_units,omitempty"`
+	ChangedFields    []string    `protobuf:"bytes,5,rep,name=changed_fields,json=changedFields,proto3" json:"changed_fields,omitempty"`
+}
+
+func (m *ApiDiff) Reset()         { *m = ApiDiff{} }
+func (m *ApiDiff) String() string { return proto.CompactTextString(m) }
+func (*ApiDiff) ProtoMessage()    {}
+func (*ApiDiff) Descriptor() ([]byte, []int) {
+	return fileDescriptor_358e54bf0922fc79, []int{2}
+}
+func (m *ApiDiff) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApiDiff) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApiDiff.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ApiDiff) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApiDiff.Merge(m, src)
+}
+func (m *ApiDiff) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApiDiff) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApiDiff.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApiDiff proto.InternalMessageInfo
+
+func (m *ApiDiff) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ApiDiff) GetChange() CHANGE_TYPE {
+	if m != nil {
+		return m.Change
+	}
+	return CHANGE_TYPE_MODIFIED
+}
+
+func (m *ApiDiff) GetFromComputeUnits() uint64 {
+	if m != nil {
+		return m.FromComputeUnits
+	}
+	return 0
+}
+
+func (m *ApiDiff) GetToComputeUnits() uint64 {
+	if m != nil {
+		return m.ToComputeUnits
+	}
+	return 0
+}
+
+func (m *ApiDiff) GetChangedFields() []string {
+	if m != nil {
+		return m.ChangedFields
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("lavanet.lava.spec.CHANGE_TYPE", CHANGE_TYPE_name, CHANGE_TYPE_value)
+	proto.RegisterType((*SpecDiff)(nil), "lavanet.lava.spec.SpecDiff")
+	proto.RegisterType((*CollectionDiff)(nil), "lavanet.lava.spec.CollectionDiff")
+	proto.RegisterType((*ApiDiff)(nil), "lavanet.lava.spec.ApiDiff")
+}
+
+func init() { proto.RegisterFile("lavanet/lava/spec/spec_diff.proto", fileDescriptor_358e54bf0922fc79) }
+
+var fileDescriptor_358e54bf0922fc79 = []byte{
+	// 479 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0x4f, 0x6f, 0xd3, 0x30,
+	0x18, 0xc6, 0xeb, 0x35, 0x5d, 0xdb, 0x37, 0x10, 0x82, 0xb5, 0x43, 0xa9, 0x44, 0xe8, 0x2a, 0x40,
+	0x11, 0x42, 0xa9, 0xd4, 0x21, 0x8e, 0x48, 0xed, 0x92, 0x41, 0x0f, 0x63, 0x53, 0xf8, 0x23, 0xc1,
+	0x25, 0x72, 0x13, 0xa7, 0xb3, 0x48, 0xe3, 0x68, 0x71, 0xd1, 0xf8, 0x16, 0x7c, 0xac, 0x1d, 0x38,
+	0xec, 0xc0, 0x81, 0x13, 0x42, 0xed, 0x87, 0xe0, 0x8a, 0xec, 0x44, 0xac, 0xa5, 0x91, 0x90, 0x76,
+	0xa9, 0xdd, 0xe7, 0xf9, 0xc5, 0x7e, 0xfd, 0xbc, 0x7a, 0x61, 0x3f, 0x21, 0x9f, 0x49, 0x4a, 0xc5,
+	0x40, 0xae, 0x83, 0x3c, 0xa3, 0xa1, 0xfa, 0x09, 0x22, 0x16, 0xc7, 0x4e, 0x76, 0xce, 0x05, 0xc7,
+	0x77, 0x4b, 0xc4, 0x91, 0xab, 0x23, 0xdd, 0xee, 0xde, 0x8c, 0xcf, 0xb8, 0x72, 0x07, 0x72, 0x57,
+	0x80, 0xdd, 0xc7, 0xdb, 0x67, 0x91, 0x8c, 0x05, 0x21, 0x4f, 0x12, 0x1a, 0x0a, 0xc6, 0xd3, 0x82,
+	0xeb, 0x7f, 0x43, 0xd0, 0x7a, 0x93, 0xd1, 0xd0, 0x65, 0x71, 0x8c, 0xf7, 0xa0, 0xc1, 0xd2, 0x88,
+	0x5e, 0x74, 0x50, 0x0f, 0xd9, 0x6d, 0xbf, 0xf8, 0x83, 0xef, 0x03, 0xc4, 0xe7, 0x7c, 0x1e, 0x4c,
+	0x13, 0x1e, 0x7e, 0xea, 0xec, 0xf4, 0x90, 0xad, 0xf9, 0x6d, 0xa9, 0x8c, 0xa5, 0x80, 0xef, 0x41,
+	0x4b, 0xf0, 0xd2, 0xac, 0x2b, 0xb3, 0x29, 0x78, 0x61, 0x3d, 0x02, 0x23, 0x3c, 0x23, 0xe9, 0x8c,
+	0x46, 0x41, 0xcc, 0x68, 0x12, 0xe5, 0x1d, 0xad, 0x57, 0xb7, 0xdb, 0xfe, 0xed, 0x52, 0x3d, 0x52,
+	0x22, 0x9e, 0x80, 0x7e, 0x5d, 0x57, 0xde, 0x69, 0xf4, 0xea, 0xb6, 0x3e, 0xdc, 0x77, 0xb6, 0x9e,
+	0xea, 0x1c, 0xfe, 0xa5, 0x64, 0xb9, 0x63, 0xed, 0xf2, 0xe7, 0x83, 0x9a, 0xbf, 0xfe, 0x6d, 0xff,
+	0x37, 0x02, 0x63, 0x93, 0xc2, 0xa7, 0x70, 0xe7, 0x9a, 0x08, 0x22, 0x22, 0x88, 0x7a, 0xde, 0x7f,
+	0x6f, 0x20, 0x82, 0x94, 0x37, 0x18, 0xe1, 0x86, 0x8a, 0x9f, 0xc3, 0x6e, 0xf1, 0x00, 0x15, 0x86,
+	0x31, 0xb4, 0xaa, 0x0e, 0x7a, 0x35, 0x7a, 0xfd, 0xd2, 0x0b, 0xde, 0x7e, 0x38, 0xf5, 0xfc, 0x92,
+	0xae, 0x88, 0xa3, 0x5e, 0x15, 0xc7, 0x33, 0xd0, 0x48, 0xc6, 0x8a, 0xac, 0xf4, 0x61, 0xb7, 0xe2,
+	0xf0, 0x51, 0xc6, 0xd6, 0x02, 0x50, 0x74, 0xff, 0x3b, 0x82, 0x66, 0xa9, 0x63, 0x0c, 0x5a, 0x4a,
+	0xe6, 0xb4, 0x6c, 0xa3, 0xda, 0xdf, 0xb8, 0xe8, 0xa7, 0x80, 0x55, 0xf7, 0x43, 0x3e, 0xcf, 0x16,
+	0x82, 0x06, 0x8b, 0x94, 0x89, 0xbc, 0x6c, 0xb4, 0x29, 0x9d, 0xc3, 0xc2, 0x78, 0x27, 0x75, 0x6c,
+	0x83, 0x29, 0xf8, 0x3f, 0xac, 0xa6, 0x58, 0x43, 0xf0, 0x0d, 0x72, 0x3b, 0x8c, 0x46, 0x45, 0x18,
+	0x4f, 0x0e, 0x40, 0x5f, 0xab, 0x0a, 0xdf, 0x82, 0xd6, 0xf1, 0x89, 0x3b, 0x39, 0x9a, 0x78, 0xae,
+	0x59, 0xc3, 0x6d, 0x68, 0x8c, 0x5c, 0xd7, 0x73, 0x4d, 0x84, 0x75, 0x68, 0xfa, 0xde, 0xf1, 0xc9,
+	0x7b, 0xcf, 0x35, 0x77, 0xc6, 0x2f, 0x2e, 0x97, 0x16, 0xba, 0x5a, 0x5a, 0xe8, 0xd7, 0xd2, 0x42,
+	0x5f, 0x57, 0x56, 0xed, 0x6a, 0x65, 0xd5, 0x7e, 0xac, 0xac, 0xda, 0xc7, 0x87, 0x33, 0x26, 0xce,
+	0x16, 0x53, 0x27, 0xe4, 0xf3, 0xc1, 0xc6, 0x84, 0x5c, 0x14, 0x33, 0x22, 0xbe, 0x64, 0x34, 0x9f,
+	0xee, 0xaa, 0xd9, 0x38, 0xf8, 0x33, 0x00, 0xc3, 0x62, 0xab, 0xa1, 0x91, 0x03, 0x00, 0x00,
+}
+
+func (m *SpecDiff) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SpecDiff) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SpecDiff) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Collections) > 0 {
+		for iNdEx := len(m.Collections) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Collections[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSpecDiff(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.ChangedFields) > 0 {
+		for iNdEx := len(m.ChangedFields) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ChangedFields[iNdEx])
+			copy(dAtA[i:], m.ChangedFields[iNdEx])
+			i = encodeVarintSpecDiff(dAtA, i, uint64(len(m.ChangedFields[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.ToBlock != 0 {
+		i = encodeVarintSpecDiff(dAtA, i, uint64(m.ToBlock))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.FromBlock != 0 {
+		i = encodeVarintSpecDiff(dAtA, i, uint64(m.FromBlock))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintSpecDiff(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CollectionDiff) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CollectionDiff) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CollectionDiff) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Apis) > 0 {
+		for iNdEx := len(m.Apis) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Apis[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSpecDiff(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ChangedFields) > 0 {
+		for iNdEx := len(m.ChangedFields) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ChangedFields[iNdEx])
+			copy(dAtA[i:], m.ChangedFields[iNdEx])
+			i = encodeVarintSpecDiff(dAtA, i, uint64(len(m.ChangedFields[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Change != 0 {
+		i = encodeVarintSpecDiff(dAtA, i, uint64(m.Change))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.CollectionData.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintSpecDiff(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ApiDiff) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApiDiff) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApiDiff) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChangedFields) > 0 {
+		for iNdEx := len(m.ChangedFields) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ChangedFields[iNdEx])
+			copy(dAtA[i:], m.ChangedFields[iNdEx])
+			i = encodeVarintSpecDiff(dAtA, i, uint64(len(m.ChangedFields[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.ToComputeUnits != 0 {
+		i = encodeVarintSpecDiff(dAtA, i, uint64(m.ToComputeUnits))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.FromComputeUnits != 0 {
+		i = encodeVarintSpecDiff(dAtA, i, uint64(m.FromComputeUnits))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Change != 0 {
+		i = encodeVarintSpecDiff(dAtA, i, uint64(m.Change))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintSpecDiff(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSpecDiff(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSpecDiff(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SpecDiff) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovSpecDiff(uint64(l))
+	}
+	if m.FromBlock != 0 {
+		n += 1 + sovSpecDiff(uint64(m.FromBlock))
+	}
+	if m.ToBlock != 0 {
+		n += 1 + sovSpecDiff(uint64(m.ToBlock))
+	}
+	if len(m.ChangedFields) > 0 {
+		for _, s := range m.ChangedFields {
+			l = len(s)
+			n += 1 + l + sovSpecDiff(uint64(l))
+		}
+	}
+	if len(m.Collections) > 0 {
+		for _, e := range m.Collections {
+			l = e.Size()
+			n += 1 + l + sovSpecDiff(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CollectionDiff) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CollectionData.Size()
+	n += 1 + l + sovSpecDiff(uint64(l))
+	if m.Change != 0 {
+		n += 1 + sovSpecDiff(uint64(m.Change))
+	}
+	if len(m.ChangedFields) > 0 {
+		for _, s := range m.ChangedFields {
+			l = len(s)
+			n += 1 + l + sovSpecDiff(uint64(l))
+		}
+	}
+	if len(m.Apis) > 0 {
+		for _, e := range m.Apis {
+			l = e.Size()
+			n += 1 + l + sovSpecDiff(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ApiDiff) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovSpecDiff(uint64(l))
+	}
+	if m.Change != 0 {
+		n += 1 + sovSpecDiff(uint64(m.Change))
+	}
+	if m.FromComputeUnits != 0 {
+		n += 1 + sovSpecDiff(uint64(m.FromComputeUnits))
+	}
+	if m.ToComputeUnits != 0 {
+		n += 1 + sovSpecDiff(uint64(m.ToComputeUnits))
+	}
+	if len(m.ChangedFields) > 0 {
+		for _, s := range m.ChangedFields {
+			l = len(s)
+			n += 1 + l + sovSpecDiff(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovSpecDiff(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSpecDiff(x uint64) (n int) {
+	return sovSpecDiff(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SpecDiff) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSpecDiff
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SpecDiff: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SpecDiff: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpecDiff
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSpecDiff
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSpecDiff
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromBlock", wireType)
+			}
+			m.FromBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpecDiff
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToBlock", wireType)
+			}
+			m.ToBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpecDiff
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangedFields", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpecDiff
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSpecDiff
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSpecDiff
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChangedFields = append(m.ChangedFields, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Collections", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpecDiff
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSpecDiff
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSpecDiff
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Collections = append(m.Collections, CollectionDiff{})
+			if err := m.Collections[len(m.Collections)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSpecDiff(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSpecDiff
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CollectionDiff) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSpecDiff
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CollectionDiff: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CollectionDiff: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollectionData", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpecDiff
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSpecDiff
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSpecDiff
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CollectionData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Change", wireType)
+			}
+			m.Change = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpecDiff
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Change |= CHANGE_TYPE(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangedFields", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpecDiff
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSpecDiff
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSpecDiff
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChangedFields = append(m.ChangedFields, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Apis", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpecDiff
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSpecDiff
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSpecDiff
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Apis = append(m.Apis, ApiDiff{})
+			if err := m.Apis[len(m.Apis)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSpecDiff(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSpecDiff
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApiDiff) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSpecDiff
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApiDiff: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApiDiff: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpecDiff
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSpecDiff
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSpecDiff
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Change", wireType)
+			}
+			m.Change = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpecDiff
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Change |= CHANGE_TYPE(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromComputeUnits", wireType)
+			}
+			m.FromComputeUnits = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpecDiff
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromComputeUnits |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToComputeUnits", wireType)
+			}
+			m.ToComputeUnits = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpecDiff
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToComputeUnits |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangedFields", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpecDiff
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSpecDiff
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSpecDiff
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChangedFields = append(m.ChangedFields, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSpecDiff(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSpecDiff
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipSpecDiff(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowSpecDiff
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSpecDiff
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSpecDiff
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthSpecDiff
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupSpecDiff
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthSpecDiff
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthSpecDiff        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowSpecDiff          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupSpecDiff = fmt.Errorf("proto: unexpected end of group")
+)